@@ -16,7 +16,7 @@ package client
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto/ed25519"
 	"fmt"
 	"net"
 	"sync"
@@ -52,7 +52,7 @@ func (c *RelayClient) Start() error {
 	// we will have to make credentials deterministic so that the relay side and
 	// the client side share the same knowledge
 	rng := utils.NewDetermRng()
-	priv, err := rsa.GenerateKey(rng, 512)
+	_, priv, err := ed25519.GenerateKey(rng)
 	if err != nil {
 		return fmt.Errorf("error generating private key: %s", err.Error())
 	}
@@ -94,7 +94,7 @@ func (c *RelayClient) Start() error {
 			}()
 
 			// Generate mock credentials
-			credentials, err := security.Credential(priv, protocol.UserID(1), protocol.PeerID(index), net.ParseIP("1.2.3.4"), time.Hour)
+			credentials, err := security.Credential(security.NewSigningKey(priv), protocol.UserID(1), protocol.PeerID(index), net.ParseIP("1.2.3.4"), nil, time.Hour)
 			if err != nil {
 				zap.L().Error(fmt.Sprintf("error generating credentials: %s", err.Error()))
 				return
@@ -16,7 +16,7 @@ package server
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto/ed25519"
 	"fmt"
 	"time"
 
@@ -25,6 +25,7 @@ import (
 	"github.com/pairmesh/pairmesh/bench/utils"
 	"github.com/pairmesh/pairmesh/internal/relay"
 	"github.com/pairmesh/pairmesh/message"
+	"github.com/pairmesh/pairmesh/security"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
@@ -47,7 +48,7 @@ func (s *RelayServer) Start() error {
 	// we will have to make credentials deterministic so that the relay side and
 	// the client side share the same knowledge
 	rng := utils.NewDetermRng()
-	priv, err := rsa.GenerateKey(rng, 512)
+	_, priv, err := ed25519.GenerateKey(rng)
 	if err != nil {
 		return fmt.Errorf("error generating private key: %s", err.Error())
 	}
@@ -58,7 +59,7 @@ func (s *RelayServer) Start() error {
 	}
 
 	addr := fmt.Sprintf("0.0.0.0:%d", s.cfg.Port())
-	server := relay.NewServer(addr, 5*time.Second, serverDHKey, &priv.PublicKey)
+	server := relay.NewServer(addr, 5*time.Second, serverDHKey, security.NewKeyRing(priv).VerifyingKeys())
 	// Register customize callback
 	server.Handler().On(message.PacketType__UnitTestRequest, func(s *relay.Session, typ message.PacketType, msg proto.Message) error {
 		res := &message.P_UnitTestResponse{Field: msg.(*message.P_UnitTestRequest).Field}
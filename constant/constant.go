@@ -14,7 +14,10 @@
 
 package constant
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 // PluginKeyType represents the unique type of plugin key
 type PluginKeyType string
@@ -29,11 +32,14 @@ const (
 // API path group
 const (
 	URIDevicePeerGraph = "/api/v1/device/peers"
+	URIDevicePeerWatch = "/api/v1/device/peers/watch"
 	URIDevicePreflight = "/api/v1/device/preflight"
+	URIDeviceLatency   = "/api/v1/device/latency"
 	URIRelay           = "/api/v1/relay"
 	URILogout          = "/api/v1/logout"
 	URLKeyExchange     = "/api/v1/key/exchange"
 	URIRenewCredential = "/api/v1/credential/renew"
+	URIVersionCheck    = "/api/v1/version/check"
 )
 
 // HTTP header constants
@@ -70,6 +76,14 @@ const (
 	FragmentHeaderSize   = 14
 )
 
+// Address ranges of the devices in the mesh network. The IPv4 addresses are
+// allocated from MeshIPv4Prefix, and the IPv6 addresses are in the unique local
+// prefix MeshIPv6Prefix whose 40-bit global ID is the ASCII of "pairm".
+const (
+	MeshIPv4Prefix = "10.0.0.0/8"
+	MeshIPv6Prefix = "fd70:6169:726d::/48"
+)
+
 // MaxBufferSize represents the max buffer size of read UDP packet
 const MaxBufferSize = 4096
 
@@ -85,3 +99,15 @@ const (
 
 // HeartbeatInterval is the heart beat interval time constant
 const HeartbeatInterval = 30 * time.Second
+
+// Session key rotation constants of the tunnels between peers. The session key
+// will be rotated after sending RekeyAfterMessages packets or being used for
+// RekeyAfterTime. No more packets will be sent with the session key after
+// RejectAfterMessages to avoid the nonce being reused.
+const (
+	RekeyAfterMessages  = 1 << 30
+	RejectAfterMessages = math.MaxUint32 - (1 << 13)
+	RekeyAfterTime      = 10 * time.Minute
+	RekeyTimeout        = 5 * time.Second
+	RekeyGracePeriod    = 30 * time.Second
+)
@@ -28,6 +28,7 @@ const (
 	IllegalRequest
 	IllegalOperation
 	DeviceExceed
+	DeviceRevoked
 )
 
 // NOTE: notify error to mobile platform, don't delete any item and resort the order.
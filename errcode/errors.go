@@ -32,6 +32,7 @@ var (
 	ErrIllegalRequest       = withcode(errors.New("illegal request"), IllegalRequest)
 	ErrIllegalOperation     = withcode(errors.New("illegal operation"), IllegalOperation)
 	ErrDeviceExceed         = withcode(errors.New("device exceed"), DeviceExceed)
+	ErrDeviceRevoked        = fn.ErrorWithStatusCode(withcode(errors.New("device revoked"), DeviceRevoked), http.StatusForbidden)
 )
 
 // Error represent a dedicated error type, which contain the API status code
@@ -17,7 +17,6 @@ package codec
 import (
 	"encoding/binary"
 	"errors"
-	"math/rand"
 
 	"github.com/flynn/noise"
 	"github.com/pairmesh/pairmesh/constant"
@@ -26,18 +25,19 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-// EncodeMessage encodes a given message with certain type, cipher and peerID together to make a byte slice
-func EncodeMessage(typ message.PacketType, cipher noise.Cipher, peerID protocol.PeerID, msg proto.Message) ([]byte, error) {
+// EncodeMessage encodes a given message with certain type, cipher, nonce and peerID together to make a byte slice
+func EncodeMessage(typ message.PacketType, cipher noise.Cipher, nonce uint32, peerID protocol.PeerID, msg proto.Message) ([]byte, error) {
 	data, err := proto.Marshal(msg)
 	if err != nil {
 		return nil, err
 	}
-	return Encode(typ, cipher, peerID, data), nil
+	return Encode(typ, cipher, nonce, peerID, data), nil
 }
 
-// Encode encodes byte slice payload into binary format
-func Encode(typ message.PacketType, cipher noise.Cipher, peerID protocol.PeerID, payload []byte) []byte {
-	nonce := rand.Uint32()
+// Encode encodes byte slice payload into binary format. The nonce should be a
+// monotonically increasing counter of the sender, which is used by the receiver
+// to reject the replayed packets.
+func Encode(typ message.PacketType, cipher noise.Cipher, nonce uint32, peerID protocol.PeerID, payload []byte) []byte {
 	encrypted := cipher.Encrypt(nil, uint64(nonce), nil, payload)
 
 	// Packet format:
@@ -48,10 +48,11 @@ func TestEncode(t *testing.T) {
 	peerID := protocol.PeerID(1234567)
 	data := []byte{1, 2, 3, 4, 5}
 
-	encoded := Encode(message.PacketType_Handshake, cipher, peerID, data)
+	encoded := Encode(message.PacketType_Handshake, cipher, 42, peerID, data)
 	nonce, typ, pid, payload, err := Decode(encoded)
 	assert.Nil(t, err)
 
+	assert.Equal(t, nonce, uint32(42))
 	assert.Equal(t, typ, message.PacketType_Handshake)
 	assert.Equal(t, pid, peerID)
 
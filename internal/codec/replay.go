@@ -0,0 +1,79 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"sync"
+)
+
+const (
+	replayBlockBitsLog = 6
+	replayBlockBits    = 1 << replayBlockBitsLog
+	replayRingBlocks   = 1 << 7
+	replayBlockMask    = replayRingBlocks - 1
+	replayBitMask      = replayBlockBits - 1
+
+	// ReplayWindowSize is the count of counters behind the latest one which
+	// are still accepted by the ReplayFilter.
+	ReplayWindowSize = (replayRingBlocks - 1) * replayBlockBits
+)
+
+// ReplayFilter rejects the duplicated or too old packet counters. It is
+// a sliding window bitmap in the same way as WireGuard (RFC 6479).
+type ReplayFilter struct {
+	mu   sync.Mutex
+	last uint64
+	ring [replayRingBlocks]uint64
+}
+
+// Reset resets the filter to the initial state.
+func (f *ReplayFilter) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.last = 0
+	f.ring = [replayRingBlocks]uint64{}
+}
+
+// ValidateCounter checks the counter and marks it as seen. It returns false
+// if the counter had been seen before or is behind the sliding window. The
+// counter should only be validated after the packet has been authenticated.
+func (f *ReplayFilter) ValidateCounter(counter uint32) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c := uint64(counter)
+	indexBlock := c >> replayBlockBitsLog
+	if c > f.last {
+		// Move the window forward and clear the blocks which are skipped.
+		current := f.last >> replayBlockBitsLog
+		diff := indexBlock - current
+		if diff > replayRingBlocks {
+			diff = replayRingBlocks
+		}
+		for i := current + 1; i <= current+diff; i++ {
+			f.ring[i&replayBlockMask] = 0
+		}
+		f.last = c
+	} else if f.last-c > ReplayWindowSize {
+		return false
+	}
+
+	indexBlock &= replayBlockMask
+	indexBit := c & replayBitMask
+	old := f.ring[indexBlock]
+	f.ring[indexBlock] = old | (1 << indexBit)
+	return old != f.ring[indexBlock]
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplayFilter(t *testing.T) {
+	a := assert.New(t)
+
+	var f ReplayFilter
+	a.True(f.ValidateCounter(0))
+	a.False(f.ValidateCounter(0))
+	a.True(f.ValidateCounter(1))
+	a.True(f.ValidateCounter(3))
+	a.True(f.ValidateCounter(2))
+	a.False(f.ValidateCounter(2))
+	a.False(f.ValidateCounter(3))
+
+	// Out-of-order counters inside the window are accepted exactly once.
+	a.True(f.ValidateCounter(ReplayWindowSize + 10))
+	a.True(f.ValidateCounter(10))
+	a.False(f.ValidateCounter(10))
+	a.False(f.ValidateCounter(9))
+
+	// Counters behind the window are rejected.
+	a.True(f.ValidateCounter(3 * ReplayWindowSize))
+	a.False(f.ValidateCounter(ReplayWindowSize + 11))
+	a.True(f.ValidateCounter(2*ReplayWindowSize + 1))
+	a.False(f.ValidateCounter(2*ReplayWindowSize + 1))
+
+	// Large jumps clear the whole ring.
+	a.True(f.ValidateCounter(1 << 31))
+	a.True(f.ValidateCounter(1<<31 - 1))
+	a.False(f.ValidateCounter(3 * ReplayWindowSize))
+
+	f.Reset()
+	a.True(f.ValidateCounter(0))
+	a.True(f.ValidateCounter(10))
+}
@@ -34,6 +34,7 @@ var sharedProtos = []reflect.Type{
 	message.PacketType_SyncPeer:      reflect.TypeOf(&message.PacketSyncPeer{}),
 	message.PacketType_Forward:       reflect.TypeOf(&message.PacketForward{}),
 	message.PacketType_Discovery:     reflect.TypeOf(&message.PacketDiscovery{}),
+	message.PacketType_RelayPeers:    reflect.TypeOf(&message.PacketRelayPeers{}),
 
 	// Unit test
 	message.PacketType__UnitTestRequest:  reflect.TypeOf(&message.P_UnitTestRequest{}),
@@ -34,6 +34,27 @@ import (
 	"go.uber.org/zap"
 )
 
+// defaultTimeout is the timeout of the requests.
+const defaultTimeout = 10 * time.Second
+
+// Error is the error responded by the remote gateway.
+type Error struct {
+	Code    errcode.ErrCode
+	Message string
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+// IsErrCode returns true if the err is responded by the remote gateway with
+// the code.
+func IsErrCode(err error, code errcode.ErrCode) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Code == code
+}
+
 // Client is used to access with the remote gateway
 type Client struct {
 	server    string
@@ -56,10 +77,10 @@ func (c *Client) SetToken(token string) {
 	c.token.Store(token)
 }
 
-func (c *Client) do(method, api string, reader io.Reader, res interface{}) error {
+func (c *Client) do(method, api string, reader io.Reader, res interface{}, timeout time.Duration) error {
 	url := fmt.Sprintf("%s/%s", strings.TrimSuffix(c.server, "/"), strings.TrimPrefix(api, "/"))
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, method, url, reader)
@@ -85,6 +106,10 @@ func (c *Client) do(method, api string, reader io.Reader, res interface{}) error
 	}
 
 	if resp.StatusCode == http.StatusOK {
+		// The response body is ignored if the result is not required.
+		if res == nil {
+			return nil
+		}
 		return json.NewDecoder(resp.Body).Decode(res)
 	}
 
@@ -98,7 +123,7 @@ func (c *Client) do(method, api string, reader io.Reader, res interface{}) error
 		return err
 	}
 
-	return fmt.Errorf("%d: %s", result.Code, result.Error)
+	return &Error{Code: result.Code, Message: result.Error}
 }
 
 // Get is used to send the GET request
@@ -106,7 +131,16 @@ func (c *Client) Get(api string, res interface{}) error {
 	if logutil.IsEnablePortal() {
 		zap.L().Debug("HTTP Request", zap.String("method", "GET"), zap.String("url", api))
 	}
-	return c.do(http.MethodGet, api, nil, res)
+	return c.do(http.MethodGet, api, nil, res, defaultTimeout)
+}
+
+// GetWithTimeout is used to send the GET request with the timeout, which is
+// used by the long polling requests.
+func (c *Client) GetWithTimeout(api string, timeout time.Duration, res interface{}) error {
+	if logutil.IsEnablePortal() {
+		zap.L().Debug("HTTP Request", zap.String("method", "GET"), zap.String("url", api))
+	}
+	return c.do(http.MethodGet, api, nil, res, timeout)
 }
 
 // Post is used to send the POST request
@@ -119,7 +153,7 @@ func (c *Client) Post(api string, req, res interface{}) error {
 	if logutil.IsEnablePortal() {
 		zap.L().Debug("HTTP Request", zap.String("method", "POST"), zap.String("url", api), zap.String("data", buffer.String()))
 	}
-	return c.do(http.MethodPost, api, buffer, res)
+	return c.do(http.MethodPost, api, buffer, res, defaultTimeout)
 }
 
 // Put is used to send the PUT request
@@ -132,5 +166,5 @@ func (c *Client) Put(api string, req, res interface{}) error {
 	if logutil.IsEnablePortal() {
 		zap.L().Debug("HTTP Request", zap.String("method", "PUT"), zap.String("url", api), zap.String("data", buffer.String()))
 	}
-	return c.do(http.MethodPut, api, buffer, res)
+	return c.do(http.MethodPut, api, buffer, res, defaultTimeout)
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pairmesh/pairmesh/message"
@@ -26,15 +27,35 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-// Client represents the relay server client which is used to interactive with relay server.
-type Client struct {
-	ClientTransporter
+const (
+	// backlogMaxPackets and backlogMaxBytes bound the memory of the packets
+	// buffered during resuming the session.
+	backlogMaxPackets = 256
+	backlogMaxBytes   = 1 << 20
+)
 
-	handler  ClientHandler
-	latency  time.Duration
-	closed   *atomic.Bool
-	onClosed func() // Callback function
-}
+type (
+	// Client represents the relay server client which is used to interactive with relay server.
+	Client struct {
+		ClientTransporter
+
+		handler  ClientHandler
+		latency  time.Duration
+		closed   *atomic.Bool
+		onClosed func() // Callback function
+		backlog  backlog
+	}
+
+	// backlog buffers the packets sent after the client closed and before the
+	// session resumed, and the packets exceeding the limits are dropped.
+	backlog struct {
+		mu      sync.Mutex
+		holding bool
+		packets []Packet
+		size    int
+		dropped int
+	}
+)
 
 // NewClient returns a new client instance.
 func NewClient(transporter ClientTransporter) *Client {
@@ -59,6 +80,9 @@ func (c *Client) Handler() ClientHandler {
 // Send sends a given packet by pushing it into write queue
 func (c *Client) Send(typ message.PacketType, msg proto.Message) (err error) {
 	if c.closed.Load() {
+		if c.backlog.push(Packet{Type: typ, Message: msg}) {
+			return nil
+		}
 		return errors.New("cannot send message to closed client")
 	}
 
@@ -91,6 +115,8 @@ func (c *Client) Serve(ctx context.Context) {
 			return
 		case p, ok := <-c.ReadQueue():
 			if !ok {
+				// The connection is broken.
+				_ = c.Close()
 				return
 			}
 			err := c.handler.Handle(c, p)
@@ -108,16 +134,55 @@ func (c *Client) OnClosed(cb func()) {
 	c.onClosed = cb
 }
 
+// Hold buffers the packets sent after the client closed until Release is
+// called, which is used to replay the packets after the session resumed.
+func (c *Client) Hold() {
+	c.backlog.mu.Lock()
+	defer c.backlog.mu.Unlock()
+	c.backlog.holding = true
+}
+
+// Release stops buffering the packets, and returns the buffered packets and
+// the number of dropped packets.
+func (c *Client) Release() ([]Packet, int) {
+	c.backlog.mu.Lock()
+	defer c.backlog.mu.Unlock()
+
+	packets, dropped := c.backlog.packets, c.backlog.dropped
+	c.backlog.holding = false
+	c.backlog.packets = nil
+	c.backlog.size = 0
+	c.backlog.dropped = 0
+	return packets, dropped
+}
+
 // Close actually closes the client
 func (c *Client) Close() error {
 	if c.closed.Swap(true) {
 		return errors.New("close a closed client")
 	}
-	if err := c.ClientTransporter.Close(); err != nil {
-		return err
-	}
+	// The transporter may be closed already if the connection is broken.
+	err := c.ClientTransporter.Close()
 	if c.onClosed != nil {
 		c.onClosed()
 	}
-	return nil
+	return err
+}
+
+// push buffers the packet if holding, and returns false if not holding.
+func (b *backlog) push(p Packet) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.holding {
+		return false
+	}
+	size := proto.Size(p.Message)
+	if len(b.packets) >= backlogMaxPackets || b.size+size > backlogMaxBytes {
+		b.dropped++
+		return true
+	}
+	b.packets = append(b.packets, p)
+	b.size += size
+	return true
 }
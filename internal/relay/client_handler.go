@@ -98,7 +98,18 @@ func (h *clientHandler) onHandshakeAck(c *Client, _ message.PacketType, msg prot
 		parsed = constant.HeartbeatInterval
 	}
 
-	c.SetCipher(es.Cipher())
+	// The relay server issues a new resumption ticket on every handshake.
+	cipher := es.Cipher()
+	var resumption *Resumption
+	if len(ack.Resumption) > 0 {
+		resumption, err = openResumption(cipher, ack.Resumption, ack.ResumptionNonce)
+		if err != nil {
+			zap.L().Warn("Open resumption ticket failed", zap.Error(err))
+		}
+	}
+	c.SetResumption(resumption)
+
+	c.SetCipher(cipher)
 	c.SetState(ClientTransporterStateConnected)
 	c.SetHeartbeatInterval(parsed)
 
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/pairmesh/pairmesh/security"
+	"github.com/pkg/errors"
 
 	"google.golang.org/protobuf/proto"
 
@@ -35,7 +36,13 @@ import (
 	"go.uber.org/zap"
 )
 
-const eventBufferSize = 1024
+const (
+	eventBufferSize = 1024
+
+	// resumeTimeout is the timeout to resume the session after the client
+	// closed, and the full handshake is used to reconnect if failed.
+	resumeTimeout = 3 * time.Second
+)
 
 type (
 	// PacketCallback is the interface to handle given packet with certain types
@@ -135,17 +142,8 @@ func (m *Manager) connect(ctx context.Context, r protocol.RelayServer) bool {
 		zap.L().Debug("Add relay server", zap.String("address", address))
 	}
 
-	publicKey, err := base64.StdEncoding.DecodeString(r.PublicKey)
+	client, err := m.dial(ctx, r, nil)
 	if err != nil {
-		zap.L().Error("Unmarshal public key of relay server failed", zap.String("vaddress", address), zap.Error(err))
-		return false
-	}
-
-	trs := NewClientTransporter(r, m.credential.Load().([]byte), m.staticKey, security.NewDHPublic(publicKey))
-	client := NewClient(trs)
-	client.SetIsPrimary(r.ID == m.PrimaryServerID())
-	go client.Serve(ctx)
-	if err := client.Connect(ctx); err != nil {
 		zap.L().Error("Connect to relay server failed", zap.String("vaddress", address), zap.Error(err))
 		return false
 	}
@@ -160,40 +158,121 @@ func (m *Manager) connect(ctx context.Context, r protocol.RelayServer) bool {
 		},
 	})
 
+	client.OnClosed(m.onClientClosed(ctx, r, client))
+	return true
+}
+
+// dial connects to the relay server and finishes the handshake. The session is
+// resumed in one round trip if the resumption ticket is valid, and the timeout
+// is implemented by closing the client because the context of connecting is
+// used by the connection after connected.
+func (m *Manager) dial(ctx context.Context, r protocol.RelayServer, resumption *Resumption) (*Client, error) {
+	publicKey, err := base64.StdEncoding.DecodeString(r.PublicKey)
+	if err != nil {
+		return nil, errors.WithMessage(err, "unmarshal public key of relay server")
+	}
+
+	trs := NewClientTransporter(r, m.credential.Load().([]byte), m.staticKey, security.NewDHPublic(publicKey))
+	trs.SetResumption(resumption)
+	client := NewClient(trs)
+	client.SetIsPrimary(r.ID == m.PrimaryServerID())
+
 	// Proxy the callback of client to the driver callback.
 	client.Handler().On(message.PacketType_Forward, m.callback.OnForward)
 	client.Handler().On(message.PacketType_SyncPeer, m.callback.OnSyncPeer)
 	client.Handler().On(message.PacketType_ProbeResponse, m.callback.OnProbeResponse)
 
-	// Avoid closure problem.
-	capture := r
-	client.OnClosed(func() {
+	go client.Serve(ctx)
+
+	var timer *time.Timer
+	if resumption != nil {
+		timer = time.AfterFunc(resumeTimeout, func() { _ = client.Close() })
+	}
+	err = client.Connect(ctx)
+	if timer != nil && !timer.Stop() && err == nil {
+		err = errors.New("resume session timeout")
+	}
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+// onClosed returns the callback of the closed client. The session is resumed
+// immediately if the resumption ticket is valid, and the packets sent during
+// resuming are buffered and replayed. Otherwise, the relay server is put into
+// the pending list to reconnect with the full handshake.
+func (m *Manager) onClientClosed(ctx context.Context, r protocol.RelayServer, client *Client) func() {
+	return func() {
 		// Don't reconnect when manager stopped.
 		if m.closed.Load() {
 			return
 		}
 
 		// Don't reconnect if it is not a managed client.
-		if _, found := m.clients.Load(capture.ID); !found {
+		if v, found := m.clients.Load(r.ID); !found || v.(*Client) != client {
+			return
+		}
+
+		if client.Resumption().Valid() && ctx.Err() == nil {
+			// Keep the closed client in the clients list to buffer the packets.
+			client.Hold()
+			m.wg.Add(1)
+			go m.resume(ctx, r, client)
 			return
 		}
+		m.disconnected(r, client)
+	}
+}
 
-		m.event(Event{
-			Type: EventTypeClientClosed,
-			Data: EventClientClosed{
-				RelayServer: r,
-				Client:      client,
-			},
-		})
+// resume resumes the session of the closed client.
+func (m *Manager) resume(ctx context.Context, r protocol.RelayServer, closed *Client) {
+	defer m.wg.Done()
 
-		// Remove the client from the clients list.
-		m.clients.Delete(capture.ID)
+	client, err := m.dial(ctx, r, closed.Resumption())
+	if err != nil {
+		zap.L().Warn("Resume relay session failed", zap.String("host", r.Host), zap.Error(err))
+		closed.Release()
+		if v, found := m.clients.Load(r.ID); !m.closed.Load() && found && v.(*Client) == closed {
+			m.disconnected(r, closed)
+		}
+		return
+	}
+
+	// The relay server may be removed during resuming.
+	if v, found := m.clients.Load(r.ID); m.closed.Load() || !found || v.(*Client) != closed {
+		closed.Release()
+		_ = client.Close()
+		return
+	}
+	m.clients.Store(r.ID, client)
+	client.OnClosed(m.onClientClosed(ctx, r, client))
+
+	packets, dropped := closed.Release()
+	for _, p := range packets {
+		if err := client.Send(p.Type, p.Message); err != nil {
+			dropped++
+		}
+	}
+	zap.L().Info("Relay session resumed", zap.String("host", r.Host), zap.Int("replayed", len(packets)), zap.Int("dropped", dropped))
+}
 
-		// Reconnect to the relay server if the connection closed.
-		m.pending.Store(capture.ID, capture)
+// disconnected removes the closed client and reconnects to the relay server later.
+func (m *Manager) disconnected(r protocol.RelayServer, client *Client) {
+	m.event(Event{
+		Type: EventTypeClientClosed,
+		Data: EventClientClosed{
+			RelayServer: r,
+			Client:      client,
+		},
 	})
 
-	return true
+	// Remove the client from the clients list.
+	m.clients.Delete(r.ID)
+
+	// Reconnect to the relay server if the connection closed.
+	m.pending.Store(r.ID, r)
 }
 
 // Tick ticks the relay client manager to flush the pending connecting relay servers.
@@ -266,6 +345,21 @@ func (m *Manager) Update(ctx context.Context, relayServers []protocol.RelayServe
 	})
 }
 
+// Reconnect closes all the relay clients and reconnects them with the full
+// handshake in the next tick, which presents the current credential to the
+// relay servers instead of resuming the sessions of the stale one.
+func (m *Manager) Reconnect() {
+	m.clients.Range(func(key, value interface{}) bool {
+		client := value.(*Client)
+		// MUST remove the client first to avoid resuming when closing client connection.
+		m.disconnected(client.RelayServer(), client)
+		if err := client.Close(); err != nil {
+			zap.L().Error("Close relay server connection failed", zap.Error(err))
+		}
+		return true
+	})
+}
+
 // Stop stops the relay manager and all the relay clients.
 func (m *Manager) Stop() {
 	if m.closed.Swap(true) {
@@ -15,7 +15,9 @@
 package relay
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"net"
@@ -51,6 +53,8 @@ type ClientTransporter interface {
 	SetCipher(cipher noise.Cipher)
 	SetHeartbeatInterval(interval time.Duration)
 	SetIsPrimary(is bool)
+	Resumption() *Resumption
+	SetResumption(r *Resumption)
 	HandshakeState() *noise.HandshakeState
 	ReadQueue() <-chan codec.RawPacket
 	WriteQueue() chan<- Packet
@@ -72,6 +76,8 @@ type clientTransporterImpl struct {
 	handshakeState    *noise.HandshakeState
 	heartbeatInterval time.Duration
 	isPrimary         bool
+	link              bool
+	resumption        *Resumption // The ticket to resume the session if valid.
 	closed            *atomic.Bool
 	hsSignal          chan struct{} // handshake notifier
 }
@@ -91,6 +97,15 @@ func NewClientTransporter(server protocol.RelayServer, credentials []byte, nodeD
 	}
 }
 
+// NewLinkTransporter generates a clientTransporterImpl struct which links the
+// current relay server to another relay server, and the static key is used to
+// authenticate instead of credentials.
+func NewLinkTransporter(server protocol.RelayServer, dhKey noise.DHKey, srvPubKey security.DHPublic) *clientTransporterImpl {
+	c := NewClientTransporter(server, nil, dhKey, srvPubKey)
+	c.link = true
+	return c
+}
+
 // RelayServer returns the relay server information of the client transporter
 func (c *clientTransporterImpl) RelayServer() protocol.RelayServer {
 	return c.relayServer
@@ -121,6 +136,17 @@ func (c *clientTransporterImpl) SetIsPrimary(is bool) {
 	c.isPrimary = is
 }
 
+// Resumption returns the resumption ticket of the client transporter
+func (c *clientTransporterImpl) Resumption() *Resumption {
+	return c.resumption
+}
+
+// SetResumption sets the resumption ticket of the client transporter, which is
+// used to resume the session by Connect, and updated once the handshake finished.
+func (c *clientTransporterImpl) SetResumption(r *Resumption) {
+	c.resumption = r
+}
+
 // HandshakeState returns the handshake state of the client transporter
 func (c *clientTransporterImpl) HandshakeState() *noise.HandshakeState {
 	return c.handshakeState
@@ -150,6 +176,31 @@ func (c *clientTransporterImpl) Connect(ctx context.Context) error {
 		Initiator:     true,
 		StaticKeypair: c.nodeDHKey,
 	}
+	if c.link {
+		noiseCfg.Pattern = security.HandshakePatternIK
+		noiseCfg.PeerStatic = c.srvPubKey.Bytes()
+	}
+
+	// Make `credentials` as the handshake payload message, or the proof of the
+	// resumption secret if the session is resumed. The proof is bound to the
+	// ephemeral key, which is generated by the handshake state from the random
+	// source, so the same random seed is used to derive it in advance.
+	payload := c.credentials
+	var ticket []byte
+	if !c.link && c.resumption.Valid() {
+		seed := make([]byte, security.CipherSuite.DHLen())
+		if _, err := rand.Read(seed); err != nil {
+			return err
+		}
+		ephemeral, err := security.CipherSuite.GenerateKeypair(bytes.NewReader(seed))
+		if err != nil {
+			return err
+		}
+		noiseCfg.Random = bytes.NewReader(seed)
+		payload = resumptionProof(c.resumption.Secret, ephemeral.Public)
+		ticket = c.resumption.Ticket
+	}
+
 	state, err := noise.NewHandshakeState(noiseCfg)
 	if err != nil {
 		return err
@@ -157,8 +208,7 @@ func (c *clientTransporterImpl) Connect(ctx context.Context) error {
 
 	c.handshakeState = state
 
-	// Make `credentials` as the handshake payload message.
-	out, _, _, err := c.handshakeState.WriteMessage(make([]byte, 0, 128), c.credentials)
+	out, _, _, err := c.handshakeState.WriteMessage(make([]byte, 0, 128), payload)
 	if err != nil {
 		return err
 	}
@@ -166,6 +216,8 @@ func (c *clientTransporterImpl) Connect(ctx context.Context) error {
 	msg := &message.PacketHandshake{
 		Message:   out,
 		IsPrimary: c.isPrimary,
+		Link:      c.link,
+		Ticket:    ticket,
 	}
 
 	c.chWrite <- Packet{
@@ -176,6 +228,8 @@ func (c *clientTransporterImpl) Connect(ctx context.Context) error {
 	select {
 	case <-c.hsSignal:
 		return nil
+	case <-c.die:
+		return errors.New("connection closed before handshake finished")
 	case <-ctx.Done():
 		return ctx.Err()
 	}
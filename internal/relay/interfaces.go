@@ -15,13 +15,13 @@
 package relay
 
 import (
-	"crypto/rsa"
 	"time"
 
 	"github.com/flynn/noise"
 	"github.com/pairmesh/pairmesh/internal/codec"
 	"github.com/pairmesh/pairmesh/message"
 	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/pairmesh/pairmesh/security"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -44,9 +44,11 @@ type (
 		Handle(s *Client, packet codec.RawPacket) error
 	}
 
-	// SessionLifetimeHook is hook interface to specifically handle OnSessionHandshake and OnSessionClosed
+	// SessionLifetimeHook is hook interface to specifically handle OnSessionHandshake,
+	// OnSessionHandshakeFailed and OnSessionClosed
 	SessionLifetimeHook interface {
 		OnSessionHandshake(ses *Session)
+		OnSessionHandshakeFailed(ses *Session, err error)
 		OnSessionClosed(ses *Session)
 	}
 
@@ -54,7 +56,10 @@ type (
 	SessionManager interface {
 		HeartbeatInterval() time.Duration
 		DHKey() noise.DHKey
-		RSAPublicKey() *rsa.PublicKey
+		TicketKey() []byte
+		VerifyingKeys() security.VerifyingKeys
+		RelayServerID(publicKey []byte) (protocol.ServerID, bool)
+		IsRevoked(peerID protocol.PeerID) bool
 		Session(peerID protocol.PeerID) *Session
 	}
 
@@ -16,7 +16,7 @@ package relay
 
 import (
 	"context"
-	"crypto/rsa"
+	"encoding/base64"
 	"errors"
 	"net"
 	"sync"
@@ -24,6 +24,7 @@ import (
 
 	"github.com/pairmesh/pairmesh/pkg/logutil"
 	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/pairmesh/pairmesh/security"
 
 	"github.com/flynn/noise"
 	"go.uber.org/atomic"
@@ -36,31 +37,61 @@ import (
 type Server struct {
 	addr string
 
+	// Statistics of handshakes.
+	handshakes        atomic.Uint64
+	handshakeFailures atomic.Uint64
+	resumptions       atomic.Uint64
+
 	running           *atomic.Bool
 	closed            *atomic.Bool
 	dhKey             noise.DHKey
-	publicKey         *rsa.PublicKey
+	ticketKey         []byte       // The key to seal the resumption tickets.
+	verifyingKeys     atomic.Value // An atomic value of: security.VerifyingKeys
+	relayKeys         atomic.Value // An atomic value of: map[security.DHKeyBytes]protocol.ServerID
+	revoked           atomic.Value // An atomic value of: map[protocol.PeerID]struct{}
+	reachable         atomic.Value // An atomic value of: map[protocol.PeerID]map[protocol.PeerID]struct{}
+	unreachable       chan struct{}
+	observer          SessionLifetimeHook
 	wg                *sync.WaitGroup
 	heartbeatInterval time.Duration
 	handler           SessionHandler
 	// sessions only contain Session which completed handshake.
 	// mscfg.PeerID -> *Session
 	sessions sync.Map
+	// links contain the sessions linked from other relay servers.
+	// protocol.ServerID -> *Session
+	links sync.Map
+}
+
+// ServerStats represents the statistics of the relay server.
+type ServerStats struct {
+	Sessions          int
+	PrimarySessions   int
+	Handshakes        uint64
+	HandshakeFailures uint64
+	// Resumptions is the number of sessions resumed by the resumption tickets,
+	// which are also counted in Handshakes.
+	Resumptions uint64
 }
 
 // NewServer returns a new Server instance according to the serve vaddress and heartbeat
 // interval.
-func NewServer(addr string, heartbeatInterval time.Duration, dhKey noise.DHKey, publicKey *rsa.PublicKey) *Server {
+func NewServer(addr string, heartbeatInterval time.Duration, dhKey noise.DHKey, keys security.VerifyingKeys) *Server {
 	s := &Server{
 		addr:              addr,
 		running:           atomic.NewBool(false),
 		closed:            atomic.NewBool(false),
 		dhKey:             dhKey,
-		publicKey:         publicKey,
+		ticketKey:         ticketKey(dhKey),
 		wg:                &sync.WaitGroup{},
 		heartbeatInterval: heartbeatInterval,
 		sessions:          sync.Map{},
+		unreachable:       make(chan struct{}, 1),
 	}
+	s.verifyingKeys.Store(keys)
+	s.relayKeys.Store(map[security.DHKeyBytes]protocol.ServerID{})
+	s.revoked.Store(map[protocol.PeerID]struct{}{})
+	s.reachable.Store(map[protocol.PeerID]map[protocol.PeerID]struct{}{})
 	s.handler = NewSessionHandler(s)
 	return s
 }
@@ -80,14 +111,105 @@ func (s *Server) DHKey() noise.DHKey {
 	return s.dhKey
 }
 
-// RSAPublicKey implements the handler.SessionManager interface
-func (s *Server) RSAPublicKey() *rsa.PublicKey {
-	return s.publicKey
+// TicketKey implements the handler.SessionManager interface
+func (s *Server) TicketKey() []byte {
+	return s.ticketKey
+}
+
+// VerifyingKeys implements the handler.SessionManager interface
+func (s *Server) VerifyingKeys() security.VerifyingKeys {
+	return s.verifyingKeys.Load().(security.VerifyingKeys)
+}
+
+// SetVerifyingKeys sets the public keys to verify the credentials
+func (s *Server) SetVerifyingKeys(keys security.VerifyingKeys) {
+	s.verifyingKeys.Store(keys)
+}
+
+// SetRelayServers sets the relay servers which are allowed to link to the server.
+func (s *Server) SetRelayServers(relayServers []protocol.RelayServer) {
+	keys := map[security.DHKeyBytes]protocol.ServerID{}
+	for _, r := range relayServers {
+		publicKey, err := base64.StdEncoding.DecodeString(r.PublicKey)
+		if err != nil {
+			zap.L().Error("Unmarshal public key of relay server failed", zap.String("name", r.Name), zap.Error(err))
+			continue
+		}
+		keys[security.NewDHPublic(publicKey).DHKeyBytes] = r.ID
+	}
+	s.relayKeys.Store(keys)
+}
+
+// RelayServerID implements the handler.SessionManager interface
+func (s *Server) RelayServerID(publicKey []byte) (protocol.ServerID, bool) {
+	keys := s.relayKeys.Load().(map[security.DHKeyBytes]protocol.ServerID)
+	id, found := keys[security.NewDHPublic(publicKey).DHKeyBytes]
+	return id, found
+}
+
+// SetRevokedPeers sets the revoked peers whose credentials are rejected, and
+// the sessions of them are closed.
+func (s *Server) SetRevokedPeers(peers []protocol.PeerID) {
+	revoked := map[protocol.PeerID]struct{}{}
+	for _, peerID := range peers {
+		revoked[peerID] = struct{}{}
+	}
+	s.revoked.Store(revoked)
+
+	for _, peerID := range peers {
+		if ses := s.Session(peerID); ses != nil {
+			zap.L().Info("Close the session of revoked peer", zap.Reflect("peerID", peerID))
+			ses.Close()
+		}
+	}
+}
+
+// IsRevoked implements the SessionManager interface.
+func (s *Server) IsRevoked(peerID protocol.PeerID) bool {
+	_, found := s.revoked.Load().(map[protocol.PeerID]struct{})[peerID]
+	return found
+}
+
+// SetReachablePeers sets the peers which each connected peer is allowed to
+// reach, i.e: the devices of the same user and the devices which share any
+// approved network with the peer.
+func (s *Server) SetReachablePeers(peers map[protocol.PeerID][]protocol.PeerID) {
+	reachable := make(map[protocol.PeerID]map[protocol.PeerID]struct{}, len(peers))
+	for src, dsts := range peers {
+		set := make(map[protocol.PeerID]struct{}, len(dsts))
+		for _, dst := range dsts {
+			set[dst] = struct{}{}
+		}
+		reachable[src] = set
+	}
+	s.reachable.Store(reachable)
+}
+
+// IsReachable returns whether the source peer is allowed to reach the destination
+// peer. The reachable peers may be outdated, e.g: the source peer connected or
+// the devices were approved after the last update, so the unreachable pairs are
+// notified to refresh the reachable peers.
+func (s *Server) IsReachable(src, dst protocol.PeerID) bool {
+	if _, found := s.reachable.Load().(map[protocol.PeerID]map[protocol.PeerID]struct{})[src][dst]; found {
+		return true
+	}
+	select {
+	case s.unreachable <- struct{}{}:
+	default:
+	}
+	return false
+}
+
+// Unreachable returns the channel which receives a notification if any pair of
+// peers is unreachable since the last receiving.
+func (s *Server) Unreachable() <-chan struct{} {
+	return s.unreachable
 }
 
-// SetRSAPublicKey sets the public key of the server
-func (s *Server) SetRSAPublicKey(key *rsa.PublicKey) {
-	s.publicKey = key
+// SetObserver sets the hook to observe the lifetime of the sessions, which is
+// called after the server handled the events.
+func (s *Server) SetObserver(observer SessionLifetimeHook) {
+	s.observer = observer
 }
 
 // Session implements the handler.SessionManager interface
@@ -99,6 +221,15 @@ func (s *Server) Session(peerID protocol.PeerID) *Session {
 	return v.(*Session)
 }
 
+// LinkSession returns the session linked from the relay server.
+func (s *Server) LinkSession(id protocol.ServerID) *Session {
+	v, found := s.links.Load(id)
+	if !found {
+		return nil
+	}
+	return v.(*Session)
+}
+
 // ForeachSession handles each session of the server,
 // according to the given callback function
 func (s *Server) ForeachSession(fn func(*Session)) {
@@ -108,6 +239,22 @@ func (s *Server) ForeachSession(fn func(*Session)) {
 	})
 }
 
+// Stats returns the statistics of the relay server.
+func (s *Server) Stats() ServerStats {
+	stats := ServerStats{
+		Handshakes:        s.handshakes.Load(),
+		HandshakeFailures: s.handshakeFailures.Load(),
+		Resumptions:       s.resumptions.Load(),
+	}
+	s.ForeachSession(func(ses *Session) {
+		stats.Sessions++
+		if ses.IsPrimary() {
+			stats.PrimarySessions++
+		}
+	})
+	return stats
+}
+
 // Serve starts to serve the server process.
 func (s *Server) Serve(ctx context.Context) error {
 	if s.running.Swap(true) {
@@ -150,13 +297,15 @@ func (s *Server) Close() error {
 		return errors.New("close a closed server")
 	}
 
-	s.sessions.Range(func(key, value interface{}) bool {
+	closeSession := func(key, value interface{}) bool {
 		ses := value.(*Session)
 		if err := ses.Close(); err != nil {
 			zap.L().Error("Close Session failed", zap.Error(err), zap.Stringer("session", ses))
 		}
 		return true
-	})
+	}
+	s.sessions.Range(closeSession)
+	s.links.Range(closeSession)
 
 	s.wg.Wait()
 
@@ -169,13 +318,29 @@ func (s *Server) OnSessionHandshake(ses *Session) {
 		return
 	}
 
+	if ses.IsLink() {
+		s.handshakes.Inc()
+		old, exists := s.links.Load(ses.linkServerID)
+		if exists {
+			old.(*Session).Close()
+		}
+		s.links.Store(ses.linkServerID, ses)
+		s.observe(ses, true)
+		return
+	}
+
 	// Handshake session always has a non-zero peerID.
 	if ses.peerID == 0 {
 		return
 	}
 
+	s.handshakes.Inc()
+	if ses.Resumed() {
+		s.resumptions.Inc()
+	}
+
 	if logutil.IsEnablePeer() {
-		zap.L().Debug("New session handshake successfully", zap.Reflect("peerID", ses.PeerID()), zap.Bool("isPrimary", ses.IsPrimary()))
+		zap.L().Debug("New session handshake successfully", zap.Reflect("peerID", ses.PeerID()), zap.Bool("isPrimary", ses.IsPrimary()), zap.Bool("resumed", ses.Resumed()))
 	}
 
 	// Close the old session if new connection established.
@@ -188,6 +353,16 @@ func (s *Server) OnSessionHandshake(ses *Session) {
 
 	// Add or update with the new session.
 	s.sessions.Store(ses.peerID, ses)
+	s.observe(ses, true)
+}
+
+// OnSessionHandshakeFailed implements the SessionLifetimeHook interface
+func (s *Server) OnSessionHandshakeFailed(_ *Session, err error) {
+	s.handshakeFailures.Inc()
+
+	if logutil.IsEnablePeer() {
+		zap.L().Debug("Session handshake failed", zap.Error(err))
+	}
 }
 
 // OnSessionClosed implements the SessionLifetimeHook interface
@@ -196,9 +371,29 @@ func (s *Server) OnSessionClosed(ses *Session) {
 		return
 	}
 
+	if ses.IsLink() {
+		if v, found := s.links.Load(ses.linkServerID); found && v.(*Session) == ses {
+			s.links.Delete(ses.linkServerID)
+		}
+		s.observe(ses, false)
+		return
+	}
+
 	// Non-zero peerID session should not be appearance in sessions.
 	if ses.peerID == 0 {
 		return
 	}
 	s.sessions.Delete(ses.peerID)
+	s.observe(ses, false)
+}
+
+func (s *Server) observe(ses *Session, handshake bool) {
+	if s.observer == nil {
+		return
+	}
+	if handshake {
+		s.observer.OnSessionHandshake(ses)
+	} else {
+		s.observer.OnSessionClosed(ses)
+	}
 }
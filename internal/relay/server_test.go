@@ -15,8 +15,8 @@
 package relay
 
 import (
+	"crypto/ed25519"
 	"crypto/rand"
-	"crypto/rsa"
 	"fmt"
 	"testing"
 	"time"
@@ -24,6 +24,7 @@ import (
 	"github.com/flynn/noise"
 	gomock "github.com/golang/mock/gomock"
 	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/pairmesh/pairmesh/security"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
 )
@@ -35,9 +36,9 @@ func createServer(t *testing.T) *Server {
 	duration := 5 * time.Second
 	serverDHKey, err := noise.DH25519.GenerateKeypair(rand.Reader)
 	assert.Nil(t, err)
-	priv, err := rsa.GenerateKey(rand.Reader, 512)
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
 	assert.Nil(t, err)
-	s := NewServer(addr, duration, serverDHKey, &priv.PublicKey)
+	s := NewServer(addr, duration, serverDHKey, security.NewKeyRing(priv).VerifyingKeys())
 	return s
 }
 
@@ -65,27 +66,52 @@ func TestGetDHKey(t *testing.T) {
 	assert.Equal(t, dhKey, s.dhKey)
 }
 
-func TestGetRSAPublicKey(t *testing.T) {
+func TestGetVerifyingKeys(t *testing.T) {
 	s := createServer(t)
 
-	publicKey := s.RSAPublicKey()
+	keys := s.VerifyingKeys()
 
-	assert.True(t, publicKey == s.publicKey)
+	assert.Len(t, keys, 1)
 }
 
-func TestSetRSAPublicKey(t *testing.T) {
+func TestSetVerifyingKeys(t *testing.T) {
 	s := createServer(t)
 
-	oldPublicKey := s.RSAPublicKey()
-	newPriv, err := rsa.GenerateKey(rand.Reader, 512)
+	oldKeys := s.VerifyingKeys()
+	_, oldPriv, err := ed25519.GenerateKey(rand.Reader)
 	assert.Nil(t, err)
+	_, newPriv, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+
+	newKeys := security.NewKeyRing(newPriv, oldPriv.Public().(ed25519.PublicKey)).VerifyingKeys()
+	s.SetVerifyingKeys(newKeys)
 
-	newPublicKey := &newPriv.PublicKey
-	s.SetRSAPublicKey(newPublicKey)
+	assert.NotEqual(t, oldKeys, s.VerifyingKeys())
+	assert.Equal(t, newKeys, s.VerifyingKeys())
+}
 
-	assert.False(t, newPublicKey == oldPublicKey)
-	assert.True(t, newPublicKey == s.publicKey)
+func TestIsReachable(t *testing.T) {
+	s := createServer(t)
 
+	s.SetReachablePeers(map[protocol.PeerID][]protocol.PeerID{
+		1: {2, 3},
+		2: {1},
+	})
+
+	assert.True(t, s.IsReachable(1, 2))
+	assert.True(t, s.IsReachable(1, 3))
+	assert.True(t, s.IsReachable(2, 1))
+	assert.Len(t, s.Unreachable(), 0)
+
+	// The unreachable pairs are notified without blocking.
+	assert.False(t, s.IsReachable(2, 3))
+	assert.False(t, s.IsReachable(4, 1))
+	assert.Len(t, s.Unreachable(), 1)
+	<-s.Unreachable()
+
+	s.SetReachablePeers(map[protocol.PeerID][]protocol.PeerID{2: {3}})
+	assert.False(t, s.IsReachable(1, 2))
+	assert.True(t, s.IsReachable(2, 3))
 }
 
 func TestGetSessionFound(t *testing.T) {
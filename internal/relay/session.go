@@ -50,6 +50,8 @@ type Session struct {
 	peerID          protocol.PeerID
 	vaddress        net.IP // Virtual address allocated by Peerly
 	isPrimary       bool
+	resumed         bool              // Resumed by the resumption ticket instead of credentials.
+	linkServerID    protocol.ServerID // Non-zero if the session is a link from another relay server.
 	state           SessionState
 	closed          *atomic.Bool
 	lifetimeHook    SessionLifetimeHook
@@ -126,6 +128,31 @@ func (s *Session) SetIsPrimary(is bool) {
 	s.isPrimary = is
 }
 
+// Resumed returns whether the session is resumed by the resumption ticket
+func (s *Session) Resumed() bool {
+	return s.resumed
+}
+
+// SetResumed sets whether the session is resumed by the resumption ticket
+func (s *Session) SetResumed(resumed bool) {
+	s.resumed = resumed
+}
+
+// IsLink returns whether the session is a link from another relay server
+func (s *Session) IsLink() bool {
+	return s.linkServerID != 0
+}
+
+// LinkServerID returns the id of the relay server which initiates the link
+func (s *Session) LinkServerID() protocol.ServerID {
+	return s.linkServerID
+}
+
+// SetLinkServerID sets the id of the relay server which initiates the link
+func (s *Session) SetLinkServerID(id protocol.ServerID) {
+	s.linkServerID = id
+}
+
 // SyncAt returns the last time the session was synced
 func (s *Session) SyncAt() time.Time {
 	return s.lastSyncAt
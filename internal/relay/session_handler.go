@@ -15,6 +15,7 @@
 package relay
 
 import (
+	"crypto/hmac"
 	"errors"
 	"fmt"
 	"time"
@@ -24,6 +25,7 @@ import (
 	"github.com/pairmesh/pairmesh/internal/codec/serde"
 	"github.com/pairmesh/pairmesh/message"
 	"github.com/pairmesh/pairmesh/security"
+	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -85,8 +87,28 @@ func (h *sessionHandler) Handle(s *Session, packet codec.RawPacket) error {
 }
 
 // onHandshake handles the message.PacketHandshake and assign some values for session instance.
-func (h *sessionHandler) onHandshake(s *Session, _ message.PacketType, msg proto.Message) error {
+func (h *sessionHandler) onHandshake(s *Session, typ message.PacketType, msg proto.Message) error {
+	err := h.handshake(s, typ, msg)
+	if err != nil {
+		s.LifetimeHook().OnSessionHandshakeFailed(s, err)
+
+		// Close the session if the resumption is rejected, so the client can
+		// fall back to the full handshake immediately.
+		if len(msg.(*message.PacketHandshake).Ticket) > 0 {
+			_ = s.Close()
+		}
+	}
+	return err
+}
+
+func (h *sessionHandler) handshake(s *Session, _ message.PacketType, msg proto.Message) error {
 	hs := msg.(*message.PacketHandshake)
+	if hs.Link {
+		return h.linkHandshake(s, hs)
+	}
+	if len(hs.Ticket) > 0 {
+		return h.resume(s, hs)
+	}
 
 	config := noise.Config{
 		CipherSuite:   security.CipherSuite,
@@ -108,11 +130,76 @@ func (h *sessionHandler) onHandshake(s *Session, _ message.PacketType, msg proto
 	}
 
 	// Validate the credential.
-	userID, peerID, ip, valid := security.VerifyCredential(h.sm.RSAPublicKey(), credentials)
+	userID, peerID, ip, _, valid := security.VerifyCredential(h.sm.VerifyingKeys(), credentials)
 	if !valid {
 		return errors.New("invalid credentials")
 	}
+	if h.sm.IsRevoked(peerID) {
+		return errors.New("revoked peer")
+	}
 
+	s.SetUserID(userID)
+	s.SetPeerID(peerID)
+	s.SetVAddress(ip)
+	return h.accept(s, state, hs, credentialState{
+		keyID:     security.CredentialKeyID(credentials),
+		expiresAt: security.CredentialExpiration(credentials),
+	})
+}
+
+// resume resumes the session by the resumption ticket issued in the previous
+// session instead of verifying the credentials. The NN pattern is used as the
+// full handshake, and the payload proves the ownership of the ticket.
+func (h *sessionHandler) resume(s *Session, hs *message.PacketHandshake) error {
+	ticket, err := openTicket(h.sm.TicketKey(), hs.Ticket)
+	if err != nil {
+		return err
+	}
+
+	config := noise.Config{
+		CipherSuite:   security.CipherSuite,
+		Pattern:       security.HandshakePatternNN,
+		StaticKeypair: h.sm.DHKey(),
+		Initiator:     false,
+	}
+
+	state, err := noise.NewHandshakeState(config)
+	if err != nil {
+		return err
+	}
+
+	proof, _, _, err := state.ReadMessage(nil, hs.Message)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(proof, resumptionProof(ticket.Secret, state.PeerEphemeral())) {
+		return errors.New("invalid resumption proof")
+	}
+	if h.sm.IsRevoked(ticket.PeerID) {
+		return errors.New("revoked peer")
+	}
+	// The ticket is bounded by the credential, which would be rejected by the
+	// full handshake if expired or signed by a retired key.
+	if time.Now().Unix() > ticket.CredentialExpiresAt {
+		return errors.New("credential expired")
+	}
+	if _, found := h.sm.VerifyingKeys()[ticket.CredentialKeyID]; !found {
+		return errors.New("credential key retired")
+	}
+
+	s.SetUserID(ticket.UserID)
+	s.SetPeerID(ticket.PeerID)
+	s.SetVAddress(ticket.VAddress)
+	s.SetResumed(true)
+	return h.accept(s, state, hs, credentialState{
+		keyID:     ticket.CredentialKeyID,
+		expiresAt: time.Unix(ticket.CredentialExpiresAt, 0),
+	})
+}
+
+// accept finishes the handshake of the session and acknowledges the client
+// with a new resumption ticket.
+func (h *sessionHandler) accept(s *Session, state *noise.HandshakeState, hs *message.PacketHandshake, credential credentialState) error {
 	// Because we use the handshake NN pattern (see: https://noiseprotocol.org/noise.html)
 	// So there must be completed in the first RTT. The two cipher should be non-nil.
 	// To simplify the implementation, we ignore the ds returned value
@@ -121,18 +208,61 @@ func (h *sessionHandler) onHandshake(s *Session, _ message.PacketType, msg proto
 	if err != nil {
 		return err
 	}
+	cipher := es.Cipher()
 
-	s.SetCipher(es.Cipher())
+	// Construct the response message which is used to acknowledge handshake.
+	res := &message.PacketHandshakeAck{Message: out}
+	res.Resumption, res.ResumptionNonce, err = issueTicket(h.sm.TicketKey(), s, credential, cipher)
+	if err != nil {
+		zap.L().Warn("Issue resumption ticket failed", zap.Any("peer_id", s.PeerID()), zap.Error(err))
+	}
+
+	s.SetCipher(cipher)
 	s.SetState(SessionStateRunning)
-	s.SetUserID(userID)
-	s.SetPeerID(peerID)
-	s.SetVAddress(ip)
 	s.SetIsPrimary(hs.IsPrimary)
 	s.LifetimeHook().OnSessionHandshake(s)
 
-	// Construct the response message which is used to acknowledge handshake.
-	res := &message.PacketHandshakeAck{Message: out}
+	return s.Send(message.PacketType_HandshakeAck, res)
+}
+
+// linkHandshake handles the handshake initiated by another relay server. The IK
+// pattern is used and the initiator is authenticated if its static key belongs
+// to a relay server registered in the portal service.
+func (h *sessionHandler) linkHandshake(s *Session, hs *message.PacketHandshake) error {
+	config := noise.Config{
+		CipherSuite:   security.CipherSuite,
+		Pattern:       security.HandshakePatternIK,
+		StaticKeypair: h.sm.DHKey(),
+		Initiator:     false,
+	}
+
+	state, err := noise.NewHandshakeState(config)
+	if err != nil {
+		return err
+	}
+
+	_, _, _, err = state.ReadMessage(nil, hs.Message)
+	if err != nil {
+		return err
+	}
+
+	serverID, found := h.sm.RelayServerID(state.PeerStatic())
+	if !found {
+		return errors.New("unknown relay server static key")
+	}
+
+	interval := h.sm.HeartbeatInterval()
+	out, es, _, err := state.WriteMessage(make([]byte, 0, 128), []byte(interval.String()))
+	if err != nil {
+		return err
+	}
 
+	s.SetCipher(es.Cipher())
+	s.SetState(SessionStateRunning)
+	s.SetLinkServerID(serverID)
+	s.LifetimeHook().OnSessionHandshake(s)
+
+	res := &message.PacketHandshakeAck{Message: out}
 	return s.Send(message.PacketType_HandshakeAck, res)
 }
 
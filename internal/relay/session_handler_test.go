@@ -0,0 +1,150 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/flynn/noise"
+	"github.com/pairmesh/pairmesh/message"
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/pairmesh/pairmesh/security"
+	"github.com/stretchr/testify/assert"
+)
+
+// resumeHandshake returns the handshake message to resume the session with the
+// resumption ticket sealed from the state.
+func resumeHandshake(t *testing.T, key []byte, state ticketState) *message.PacketHandshake {
+	ticket, err := sealTicket(key, state)
+	assert.Nil(t, err)
+
+	seed := make([]byte, security.CipherSuite.DHLen())
+	_, err = rand.Read(seed)
+	assert.Nil(t, err)
+	ephemeral, err := security.CipherSuite.GenerateKeypair(bytes.NewReader(seed))
+	assert.Nil(t, err)
+	staticKey, err := noise.DH25519.GenerateKeypair(rand.Reader)
+	assert.Nil(t, err)
+
+	hs, err := noise.NewHandshakeState(noise.Config{
+		CipherSuite:   security.CipherSuite,
+		Pattern:       security.HandshakePatternNN,
+		Initiator:     true,
+		StaticKeypair: staticKey,
+		Random:        bytes.NewReader(seed),
+	})
+	assert.Nil(t, err)
+	msg, _, _, err := hs.WriteMessage(nil, resumptionProof(state.Secret, ephemeral.Public))
+	assert.Nil(t, err)
+
+	return &message.PacketHandshake{Message: msg, Ticket: ticket}
+}
+
+func TestResumeCredential(t *testing.T) {
+	s := createServer(t)
+	h := NewSessionHandler(s).(*sessionHandler)
+
+	var keyID security.KeyID
+	for id := range s.VerifyingKeys() {
+		keyID = id
+	}
+	now := time.Now()
+	state := ticketState{
+		UserID:    1,
+		PeerID:    2,
+		VAddress:  net.ParseIP("10.0.0.2"),
+		Secret:    []byte("resumption secret"),
+		ExpiresAt: now.Add(ticketLifetime).Unix(),
+
+		CredentialExpiresAt: now.Add(time.Hour).Unix(),
+		CredentialKeyID:     keyID,
+	}
+
+	// The ticket outlives the credential which is expired.
+	expired := state
+	expired.CredentialExpiresAt = now.Add(-time.Second).Unix()
+	err := h.handshake(&Session{}, message.PacketType_Handshake, resumeHandshake(t, s.TicketKey(), expired))
+	assert.EqualError(t, err, "credential expired")
+
+	// The key which signed the credential is retired.
+	retired := state
+	retired.CredentialKeyID = keyID + 1
+	err = h.handshake(&Session{}, message.PacketType_Handshake, resumeHandshake(t, s.TicketKey(), retired))
+	assert.EqualError(t, err, "credential key retired")
+
+	// The proof must be bound to the ephemeral key of the handshake.
+	forged := resumeHandshake(t, s.TicketKey(), state)
+	forged.Ticket, err = sealTicket(s.TicketKey(), ticketState{
+		PeerID:              state.PeerID,
+		Secret:              []byte("another secret"),
+		ExpiresAt:           state.ExpiresAt,
+		CredentialExpiresAt: state.CredentialExpiresAt,
+		CredentialKeyID:     keyID,
+	})
+	assert.Nil(t, err)
+	err = h.handshake(&Session{}, message.PacketType_Handshake, forged)
+	assert.EqualError(t, err, "invalid resumption proof")
+}
+
+func TestRevokedPeer(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	ring := security.NewKeyRing(priv)
+	serverDHKey, err := noise.DH25519.GenerateKeypair(rand.Reader)
+	assert.Nil(t, err)
+	s := NewServer("127.0.0.1:10042", 5*time.Second, serverDHKey, ring.VerifyingKeys())
+	h := NewSessionHandler(s).(*sessionHandler)
+
+	credential, err := security.Credential(ring.Active(), 1, 2, net.ParseIP("10.0.0.2"), nil, time.Hour)
+	assert.Nil(t, err)
+	_, _, _, _, valid := security.VerifyCredential(s.VerifyingKeys(), credential)
+	assert.True(t, valid)
+
+	hs, err := noise.NewHandshakeState(noise.Config{
+		CipherSuite: security.CipherSuite,
+		Pattern:     security.HandshakePatternNN,
+		Initiator:   true,
+	})
+	assert.Nil(t, err)
+	msg, _, _, err := hs.WriteMessage(nil, credential)
+	assert.Nil(t, err)
+
+	now := time.Now()
+	state := ticketState{
+		UserID:    1,
+		PeerID:    2,
+		VAddress:  net.ParseIP("10.0.0.2"),
+		Secret:    []byte("resumption secret"),
+		ExpiresAt: now.Add(ticketLifetime).Unix(),
+
+		CredentialExpiresAt: now.Add(time.Hour).Unix(),
+		CredentialKeyID:     security.CredentialKeyID(credential),
+	}
+
+	// Both the valid credential and the resumption ticket issued before the
+	// peer revoked are rejected.
+	s.SetRevokedPeers([]protocol.PeerID{2})
+	err = h.handshake(&Session{}, message.PacketType_Handshake, &message.PacketHandshake{Message: msg})
+	assert.EqualError(t, err, "revoked peer")
+	err = h.handshake(&Session{}, message.PacketType_Handshake, resumeHandshake(t, s.TicketKey(), state))
+	assert.EqualError(t, err, "revoked peer")
+	assert.True(t, s.IsRevoked(2))
+	assert.False(t, s.IsRevoked(3))
+}
@@ -16,8 +16,9 @@ package tests
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
-	"crypto/rsa"
+	"encoding/base64"
 	"fmt"
 	"net"
 	"os"
@@ -32,6 +33,7 @@ import (
 	"github.com/pairmesh/pairmesh/security"
 	"github.com/pairmesh/pairmesh/utils"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/protobuf/proto"
@@ -56,10 +58,10 @@ func TestRelay(t *testing.T) {
 	clientDHKey, err := noise.DH25519.GenerateKeypair(rand.Reader)
 	assert.Nil(t, err)
 
-	priv, err := rsa.GenerateKey(rand.Reader, 512)
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
 	assert.Nil(t, err)
 
-	server := relay.NewServer(addr, 5*time.Second, serverDHKey, &priv.PublicKey)
+	server := relay.NewServer(addr, 5*time.Second, serverDHKey, security.NewKeyRing(priv).VerifyingKeys())
 
 	// Register customize callback
 	server.Handler().On(message.PacketType__UnitTestRequest, func(s *relay.Session, typ message.PacketType, msg proto.Message) error {
@@ -81,7 +83,7 @@ func TestRelay(t *testing.T) {
 	assert.True(t, utils.WaitForServerUp(addr))
 
 	// Generate mock credentials
-	credentials, err := security.Credential(priv, protocol.UserID(1), protocol.PeerID(11000), net.ParseIP("1.2.3.4"), time.Hour)
+	credentials, err := security.Credential(security.NewSigningKey(priv), protocol.UserID(1), protocol.PeerID(11000), net.ParseIP("1.2.3.4"), nil, time.Hour)
 	assert.Nil(t, err)
 
 	relayServer := protocol.RelayServer{
@@ -140,10 +142,10 @@ func TestRelayNetworkFailure(t *testing.T) {
 	clientDHKey, err := noise.DH25519.GenerateKeypair(rand.Reader)
 	assert.Nil(t, err)
 
-	priv, err := rsa.GenerateKey(rand.Reader, 512)
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
 	assert.Nil(t, err)
 
-	server := relay.NewServer(addr, 5*time.Second, serverDHKey, &priv.PublicKey)
+	server := relay.NewServer(addr, 5*time.Second, serverDHKey, security.NewKeyRing(priv).VerifyingKeys())
 
 	// Register customize callback
 	server.Handler().On(message.PacketType__UnitTestRequest, func(s *relay.Session, typ message.PacketType, msg proto.Message) error {
@@ -167,7 +169,7 @@ func TestRelayNetworkFailure(t *testing.T) {
 	peerID := protocol.PeerID(11000)
 
 	// Generate mock credentials
-	credentials, err := security.Credential(priv, protocol.UserID(1), peerID, net.ParseIP("1.2.3.4"), time.Hour)
+	credentials, err := security.Credential(security.NewSigningKey(priv), protocol.UserID(1), peerID, net.ParseIP("1.2.3.4"), nil, time.Hour)
 	assert.Nil(t, err)
 
 	relayServer := protocol.RelayServer{
@@ -203,3 +205,141 @@ func TestRelayNetworkFailure(t *testing.T) {
 	// This should have triggered the onSessionClosed() function. So the session should be evicted from server.
 	assert.True(t, server.Session(peerID) == nil)
 }
+
+func TestRelayLink(t *testing.T) {
+	port, err := netutil.PickFreePort(netutil.TCP)
+	assert.Nil(t, err)
+	addr := fmt.Sprintf("127.0.0.1:%d", port)
+
+	serverDHKey, err := noise.DH25519.GenerateKeypair(rand.Reader)
+	assert.Nil(t, err)
+
+	linkDHKey, err := noise.DH25519.GenerateKeypair(rand.Reader)
+	assert.Nil(t, err)
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+
+	server := relay.NewServer(addr, 5*time.Second, serverDHKey, security.NewKeyRing(priv).VerifyingKeys())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		// Ignore Server errors here
+		_ = server.Serve(ctx)
+	}()
+	assert.True(t, utils.WaitForServerUp(addr))
+
+	relayServer := protocol.RelayServer{
+		Host: "127.0.0.1",
+		Port: port,
+	}
+	connect := func(ctx context.Context) error {
+		client := relay.NewClient(relay.NewLinkTransporter(relayServer, linkDHKey, security.NewDHPublic(serverDHKey.Public)))
+		go client.Serve(ctx)
+		return client.Connect(ctx)
+	}
+
+	// The link from an unknown relay server is rejected.
+	rejectCtx, rejectCancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer rejectCancel()
+	assert.NotNil(t, connect(rejectCtx))
+	assert.Nil(t, server.LinkSession(2))
+
+	server.SetRelayServers([]protocol.RelayServer{{
+		ID:        2,
+		PublicKey: base64.StdEncoding.EncodeToString(linkDHKey.Public),
+	}})
+	assert.Nil(t, connect(ctx))
+	assert.Eventually(t, func() bool {
+		ses := server.LinkSession(2)
+		return ses != nil && ses.IsLink()
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 0, server.Stats().Sessions)
+}
+
+type nopCallback struct{}
+
+func (nopCallback) OnForward(*relay.Client, message.PacketType, proto.Message) error  { return nil }
+func (nopCallback) OnSyncPeer(*relay.Client, message.PacketType, proto.Message) error { return nil }
+func (nopCallback) OnProbeResponse(*relay.Client, message.PacketType, proto.Message) error {
+	return nil
+}
+
+func TestRelayResumption(t *testing.T) {
+	port, err := netutil.PickFreePort(netutil.TCP)
+	assert.Nil(t, err)
+	addr := fmt.Sprintf("127.0.0.1:%d", port)
+
+	serverDHKey, err := noise.DH25519.GenerateKeypair(rand.Reader)
+	assert.Nil(t, err)
+
+	clientDHKey, err := noise.DH25519.GenerateKeypair(rand.Reader)
+	assert.Nil(t, err)
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+
+	server := relay.NewServer(addr, 5*time.Second, serverDHKey, security.NewKeyRing(priv).VerifyingKeys())
+	received := atomic.NewInt32(0)
+	server.Handler().On(message.PacketType__UnitTestRequest, func(s *relay.Session, typ message.PacketType, msg proto.Message) error {
+		received.Inc()
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		// Ignore Server errors here
+		_ = server.Serve(ctx)
+	}()
+	assert.True(t, utils.WaitForServerUp(addr))
+
+	peerID := protocol.PeerID(11000)
+	credentials, err := security.Credential(security.NewSigningKey(priv), protocol.UserID(1), peerID, net.ParseIP("1.2.3.4"), nil, time.Hour)
+	assert.Nil(t, err)
+
+	relayServer := protocol.RelayServer{
+		ID:        1,
+		Host:      "127.0.0.1",
+		Port:      port,
+		PublicKey: base64.StdEncoding.EncodeToString(serverDHKey.Public),
+	}
+	rm := relay.NewManager(clientDHKey, nopCallback{})
+	rm.SetCredential(credentials)
+	rm.SetPrimaryServerID(relayServer.ID)
+	rm.Update(ctx, []protocol.RelayServer{relayServer})
+	defer rm.Stop()
+
+	client := rm.RelayServerClient(relayServer.ID)
+	assert.NotNil(t, client)
+	assert.True(t, client.Resumption().Valid())
+
+	// The ticket can't be used without the secret.
+	stolen := *client.Resumption()
+	stolen.Secret = make([]byte, len(stolen.Secret))
+	trs := relay.NewClientTransporter(relayServer, nil, clientDHKey, security.NewDHPublic(serverDHKey.Public))
+	trs.SetResumption(&stolen)
+	attacker := relay.NewClient(trs)
+	go attacker.Serve(ctx)
+	assert.NotNil(t, attacker.Connect(ctx))
+
+	// The packets sent during resuming are replayed after resumed.
+	assert.Nil(t, client.Close())
+	const iter = 5
+	for i := 0; i < iter; i++ {
+		assert.Nil(t, client.Send(message.PacketType__UnitTestRequest, &message.P_UnitTestRequest{Field: fmt.Sprintf("magic-%d", i)}))
+	}
+	assert.Eventually(t, func() bool {
+		return received.Load() == iter
+	}, 3*time.Second, 10*time.Millisecond)
+
+	resumed := rm.RelayServerClient(relayServer.ID)
+	assert.NotNil(t, resumed)
+	assert.NotEqual(t, client, resumed)
+	assert.Equal(t, uint64(1), server.Stats().Resumptions)
+	assert.Equal(t, uint64(1), server.Stats().HandshakeFailures)
+	assert.NotNil(t, server.Session(peerID))
+}
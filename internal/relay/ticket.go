@@ -0,0 +1,200 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/flynn/noise"
+	"github.com/pairmesh/pairmesh/message"
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/pairmesh/pairmesh/security"
+	"golang.org/x/crypto/chacha20poly1305"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ticketLifetime is the maximum lifetime of the resumption tickets, which
+	// is also bounded by the expiration of the credential.
+	ticketLifetime   = 10 * time.Minute
+	ticketSecretSize = 32
+)
+
+type (
+	// Resumption is the resumption ticket held by the client, which is used
+	// to resume the session in one round trip after reconnected.
+	Resumption struct {
+		Ticket    []byte
+		Secret    []byte
+		ExpiresAt time.Time
+	}
+
+	// ticketState is the session state sealed in the resumption ticket, and
+	// the ticket can only be opened by the relay server which issued it. So
+	// the relay server doesn't need to keep any state of closed sessions.
+	ticketState struct {
+		UserID    protocol.UserID `json:"user_id"`
+		PeerID    protocol.PeerID `json:"peer_id"`
+		VAddress  net.IP          `json:"vaddress"`
+		Secret    []byte          `json:"secret"`
+		ExpiresAt int64           `json:"expires_at"`
+		// CredentialExpiresAt is the expiration of the credential verified by
+		// the full handshake, which bounds all the tickets issued afterwards.
+		CredentialExpiresAt int64 `json:"credential_expires_at"`
+		// CredentialKeyID is the key which signed the credential, and the
+		// ticket is rejected once the key is no longer a verifying key.
+		CredentialKeyID security.KeyID `json:"credential_key_id"`
+	}
+
+	// credentialState is the state of the credential verified by the full
+	// handshake, which is carried over by the resumption tickets.
+	credentialState struct {
+		keyID     security.KeyID
+		expiresAt time.Time
+	}
+)
+
+// Valid returns whether the resumption ticket can be used to resume.
+func (r *Resumption) Valid() bool {
+	return r != nil && len(r.Ticket) > 0 && time.Now().Before(r.ExpiresAt)
+}
+
+// ticketKey derives the key to seal the resumption tickets from the static key
+// of the relay server, so the tickets are still valid after restarted.
+func ticketKey(dhKey noise.DHKey) []byte {
+	h := sha256.New()
+	h.Write([]byte("pairmesh relay resumption ticket"))
+	h.Write(dhKey.Private)
+	return h.Sum(nil)
+}
+
+// sealTicket encrypts the session state into a resumption ticket.
+func sealTicket(key []byte, state ticketState) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, data, nil), nil
+}
+
+// openTicket decrypts the session state from the resumption ticket, and the
+// expired tickets are rejected.
+func openTicket(key, ticket []byte) (ticketState, error) {
+	var state ticketState
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return state, err
+	}
+	if len(ticket) < aead.NonceSize() {
+		return state, errors.New("illegal resumption ticket")
+	}
+	data, err := aead.Open(nil, ticket[:aead.NonceSize()], ticket[aead.NonceSize():], nil)
+	if err != nil {
+		return state, errors.New("illegal resumption ticket")
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, err
+	}
+	if time.Now().Unix() >= state.ExpiresAt {
+		return state, errors.New("resumption ticket expired")
+	}
+	return state, nil
+}
+
+// issueTicket issues a new resumption ticket of the session, and returns it
+// encrypted by the cipher of the session.
+func issueTicket(key []byte, s *Session, credential credentialState, cipher noise.Cipher) ([]byte, uint32, error) {
+	expiresAt := time.Now().Add(ticketLifetime)
+	if credential.expiresAt.Before(expiresAt) {
+		expiresAt = credential.expiresAt
+	}
+	if !time.Now().Before(expiresAt) {
+		return nil, 0, errors.New("credential expired")
+	}
+
+	secret := make([]byte, ticketSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, 0, err
+	}
+	ticket, err := sealTicket(key, ticketState{
+		UserID:    s.UserID(),
+		PeerID:    s.PeerID(),
+		VAddress:  s.VAddress(),
+		Secret:    secret,
+		ExpiresAt: expiresAt.Unix(),
+
+		CredentialExpiresAt: credential.expiresAt.Unix(),
+		CredentialKeyID:     credential.keyID,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	data, err := proto.Marshal(&message.ResumptionTicket{
+		Ticket:    ticket,
+		Secret:    secret,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var n [4]byte
+	if _, err := rand.Read(n[:]); err != nil {
+		return nil, 0, err
+	}
+	nonce := binary.BigEndian.Uint32(n[:])
+	return cipher.Encrypt(nil, uint64(nonce), nil, data), nonce, nil
+}
+
+// openResumption decrypts the resumption ticket acknowledged by the relay server.
+func openResumption(cipher noise.Cipher, data []byte, nonce uint32) (*Resumption, error) {
+	decrypted, err := cipher.Decrypt(nil, uint64(nonce), nil, data)
+	if err != nil {
+		return nil, err
+	}
+	ticket := &message.ResumptionTicket{}
+	if err := proto.Unmarshal(decrypted, ticket); err != nil {
+		return nil, err
+	}
+	return &Resumption{
+		Ticket:    ticket.Ticket,
+		Secret:    ticket.Secret,
+		ExpiresAt: time.Unix(ticket.ExpiresAt, 0),
+	}, nil
+}
+
+// resumptionProof returns the proof of the resumption secret bound to the
+// ephemeral key of the handshake, which prevents the ticket being replayed.
+func resumptionProof(secret, ephemeral []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(ephemeral)
+	return mac.Sum(nil)
+}
@@ -58,7 +58,10 @@ const (
 	// PEER MESSAGE: UDP packet between peers.
 	PacketType_Discovery PacketType = 7 // DIRECTION: peer -> peer (UDP)
 	// PEER MESSAGE: UDP packet the raw IP fragment red from virtual network device.
-	PacketType_Fragment          PacketType = 8 // DIRECTION: peer -> peer (UDP)
+	PacketType_Fragment PacketType = 8 // DIRECTION: peer -> peer (UDP)
+	// RelayPeers packet is used to advertise the peers homed on the relay server
+	// to the linked relay servers, which forward the packets destined to them.
+	PacketType_RelayPeers        PacketType = 9 // DIRECTION: relay server -> relay server (TCP)
 	PacketType__UnitTestRequest  PacketType = 99
 	PacketType__UnitTestResponse PacketType = 100
 )
@@ -75,6 +78,7 @@ var (
 		6:   "Forward",
 		7:   "Discovery",
 		8:   "Fragment",
+		9:   "RelayPeers",
 		99:  "_UnitTestRequest",
 		100: "_UnitTestResponse",
 	}
@@ -88,6 +92,7 @@ var (
 		"Forward":           6,
 		"Discovery":         7,
 		"Fragment":          8,
+		"RelayPeers":        9,
 		"_UnitTestRequest":  99,
 		"_UnitTestResponse": 100,
 	}
@@ -134,6 +139,11 @@ const (
 	PacketSyncPeer_PairRequest      PacketSyncPeer_Purpose = 3
 	PacketSyncPeer_PairResponse     PacketSyncPeer_Purpose = 4
 	PacketSyncPeer_EndpointsChanged PacketSyncPeer_Purpose = 5
+	// The `Rekey/RekeyAck` purpose is used to negotiate a new session key of the tunnel
+	// between peers. The session key is derived from the static shared key and the ephemeral
+	// keys exchanged by the two messages.
+	PacketSyncPeer_Rekey    PacketSyncPeer_Purpose = 6
+	PacketSyncPeer_RekeyAck PacketSyncPeer_Purpose = 7
 )
 
 // Enum value maps for PacketSyncPeer_Purpose.
@@ -145,6 +155,8 @@ var (
 		3: "PairRequest",
 		4: "PairResponse",
 		5: "EndpointsChanged",
+		6: "Rekey",
+		7: "RekeyAck",
 	}
 	PacketSyncPeer_Purpose_value = map[string]int32{
 		"Undefined":        0,
@@ -153,6 +165,8 @@ var (
 		"PairRequest":      3,
 		"PairResponse":     4,
 		"EndpointsChanged": 5,
+		"Rekey":            6,
+		"RekeyAck":         7,
 	}
 )
 
@@ -180,7 +194,7 @@ func (x PacketSyncPeer_Purpose) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PacketSyncPeer_Purpose.Descriptor instead.
 func (PacketSyncPeer_Purpose) EnumDescriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{5, 0}
+	return file_packet_proto_rawDescGZIP(), []int{6, 0}
 }
 
 type PacketHandshake struct {
@@ -195,6 +209,13 @@ type PacketHandshake struct {
 	Message []byte `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
 	// IsPrimary indicates whether is the destination relay server the primary server of client.
 	IsPrimary bool `protobuf:"varint,2,opt,name=IsPrimary,proto3" json:"IsPrimary,omitempty"`
+	// Link indicates the handshake is initiated by another relay server. The IK pattern
+	// is used and the relay server is authenticated by its static key instead of credentials.
+	Link bool `protobuf:"varint,3,opt,name=Link,proto3" json:"Link,omitempty"`
+	// Ticket is the resumption ticket issued by the relay server in the previous session.
+	// The session is resumed without credentials if it is not empty, and the handshake
+	// payload is the proof of the resumption secret bound to the ephemeral key.
+	Ticket []byte `protobuf:"bytes,4,opt,name=Ticket,proto3" json:"Ticket,omitempty"`
 }
 
 func (x *PacketHandshake) Reset() {
@@ -243,12 +264,30 @@ func (x *PacketHandshake) GetIsPrimary() bool {
 	return false
 }
 
+func (x *PacketHandshake) GetLink() bool {
+	if x != nil {
+		return x.Link
+	}
+	return false
+}
+
+func (x *PacketHandshake) GetTicket() []byte {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
 type PacketHandshakeAck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Message []byte `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	// Resumption is the ResumptionTicket encrypted by the cipher of the new session, which
+	// is used to resume the session after reconnected. Empty for the relay links.
+	Resumption      []byte `protobuf:"bytes,2,opt,name=Resumption,proto3" json:"Resumption,omitempty"`
+	ResumptionNonce uint32 `protobuf:"varint,3,opt,name=ResumptionNonce,proto3" json:"ResumptionNonce,omitempty"`
 }
 
 func (x *PacketHandshakeAck) Reset() {
@@ -290,6 +329,85 @@ func (x *PacketHandshakeAck) GetMessage() []byte {
 	return nil
 }
 
+func (x *PacketHandshakeAck) GetResumption() []byte {
+	if x != nil {
+		return x.Resumption
+	}
+	return nil
+}
+
+func (x *PacketHandshakeAck) GetResumptionNonce() uint32 {
+	if x != nil {
+		return x.ResumptionNonce
+	}
+	return 0
+}
+
+// ResumptionTicket is issued by the relay server to resume the session in one round trip.
+// The ticket is opaque to the client and the secret is used to prove the ownership.
+type ResumptionTicket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket    []byte `protobuf:"bytes,1,opt,name=Ticket,proto3" json:"Ticket,omitempty"`
+	Secret    []byte `protobuf:"bytes,2,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+}
+
+func (x *ResumptionTicket) Reset() {
+	*x = ResumptionTicket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_packet_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumptionTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumptionTicket) ProtoMessage() {}
+
+func (x *ResumptionTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_packet_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumptionTicket.ProtoReflect.Descriptor instead.
+func (*ResumptionTicket) Descriptor() ([]byte, []int) {
+	return file_packet_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResumptionTicket) GetTicket() []byte {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *ResumptionTicket) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *ResumptionTicket) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
 type PacketHeartbeat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -301,7 +419,7 @@ type PacketHeartbeat struct {
 func (x *PacketHeartbeat) Reset() {
 	*x = PacketHeartbeat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_packet_proto_msgTypes[2]
+		mi := &file_packet_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -314,7 +432,7 @@ func (x *PacketHeartbeat) String() string {
 func (*PacketHeartbeat) ProtoMessage() {}
 
 func (x *PacketHeartbeat) ProtoReflect() protoreflect.Message {
-	mi := &file_packet_proto_msgTypes[2]
+	mi := &file_packet_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,7 +445,7 @@ func (x *PacketHeartbeat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PacketHeartbeat.ProtoReflect.Descriptor instead.
 func (*PacketHeartbeat) Descriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{2}
+	return file_packet_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PacketHeartbeat) GetTimestamp() int64 {
@@ -348,7 +466,7 @@ type PacketProbeRequest struct {
 func (x *PacketProbeRequest) Reset() {
 	*x = PacketProbeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_packet_proto_msgTypes[3]
+		mi := &file_packet_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -361,7 +479,7 @@ func (x *PacketProbeRequest) String() string {
 func (*PacketProbeRequest) ProtoMessage() {}
 
 func (x *PacketProbeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_packet_proto_msgTypes[3]
+	mi := &file_packet_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +492,7 @@ func (x *PacketProbeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PacketProbeRequest.ProtoReflect.Descriptor instead.
 func (*PacketProbeRequest) Descriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{3}
+	return file_packet_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PacketProbeRequest) GetPeers() []uint64 {
@@ -396,7 +514,7 @@ type PacketProbeResponse struct {
 func (x *PacketProbeResponse) Reset() {
 	*x = PacketProbeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_packet_proto_msgTypes[4]
+		mi := &file_packet_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -409,7 +527,7 @@ func (x *PacketProbeResponse) String() string {
 func (*PacketProbeResponse) ProtoMessage() {}
 
 func (x *PacketProbeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_packet_proto_msgTypes[4]
+	mi := &file_packet_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +540,7 @@ func (x *PacketProbeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PacketProbeResponse.ProtoReflect.Descriptor instead.
 func (*PacketProbeResponse) Descriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{4}
+	return file_packet_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PacketProbeResponse) GetOnlinePeers() []uint64 {
@@ -450,12 +568,15 @@ type PacketSyncPeer struct {
 	Peer *PacketSyncPeer_PeerInfo `protobuf:"bytes,3,opt,name=Peer,proto3" json:"Peer,omitempty"`
 	// Only be assigned a value if the purpose is `PairRequest/PairResponse/EndpointsChanged`
 	Endpoints []string `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// Only be assigned a value if the purpose is `Catchup/CatchupAck/Rekey/RekeyAck`, and the
+	// key of `Catchup/CatchupAck` is used to derive the session 0 of the tunnel
+	Key *PacketSyncPeer_SessionKey `protobuf:"bytes,5,opt,name=Key,proto3" json:"Key,omitempty"`
 }
 
 func (x *PacketSyncPeer) Reset() {
 	*x = PacketSyncPeer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_packet_proto_msgTypes[5]
+		mi := &file_packet_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -468,7 +589,7 @@ func (x *PacketSyncPeer) String() string {
 func (*PacketSyncPeer) ProtoMessage() {}
 
 func (x *PacketSyncPeer) ProtoReflect() protoreflect.Message {
-	mi := &file_packet_proto_msgTypes[5]
+	mi := &file_packet_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +602,7 @@ func (x *PacketSyncPeer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PacketSyncPeer.ProtoReflect.Descriptor instead.
 func (*PacketSyncPeer) Descriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{5}
+	return file_packet_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PacketSyncPeer) GetDstPeerID() uint64 {
@@ -512,6 +633,13 @@ func (x *PacketSyncPeer) GetEndpoints() []string {
 	return nil
 }
 
+func (x *PacketSyncPeer) GetKey() *PacketSyncPeer_SessionKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
 type PacketForward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -526,7 +654,7 @@ type PacketForward struct {
 func (x *PacketForward) Reset() {
 	*x = PacketForward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_packet_proto_msgTypes[6]
+		mi := &file_packet_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -539,7 +667,7 @@ func (x *PacketForward) String() string {
 func (*PacketForward) ProtoMessage() {}
 
 func (x *PacketForward) ProtoReflect() protoreflect.Message {
-	mi := &file_packet_proto_msgTypes[6]
+	mi := &file_packet_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +680,7 @@ func (x *PacketForward) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PacketForward.ProtoReflect.Descriptor instead.
 func (*PacketForward) Descriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{6}
+	return file_packet_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PacketForward) GetSrcPeerID() uint64 {
@@ -583,6 +711,71 @@ func (x *PacketForward) GetFragment() []byte {
 	return nil
 }
 
+type PacketRelayPeers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Full indicates the online peers are the full set of the peers homed on the
+	// relay server, and the previous advertisements should be discarded.
+	Full    bool     `protobuf:"varint,1,opt,name=Full,proto3" json:"Full,omitempty"`
+	Online  []uint64 `protobuf:"varint,2,rep,packed,name=Online,proto3" json:"Online,omitempty"`
+	Offline []uint64 `protobuf:"varint,3,rep,packed,name=Offline,proto3" json:"Offline,omitempty"`
+}
+
+func (x *PacketRelayPeers) Reset() {
+	*x = PacketRelayPeers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_packet_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketRelayPeers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketRelayPeers) ProtoMessage() {}
+
+func (x *PacketRelayPeers) ProtoReflect() protoreflect.Message {
+	mi := &file_packet_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketRelayPeers.ProtoReflect.Descriptor instead.
+func (*PacketRelayPeers) Descriptor() ([]byte, []int) {
+	return file_packet_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PacketRelayPeers) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *PacketRelayPeers) GetOnline() []uint64 {
+	if x != nil {
+		return x.Online
+	}
+	return nil
+}
+
+func (x *PacketRelayPeers) GetOffline() []uint64 {
+	if x != nil {
+		return x.Offline
+	}
+	return nil
+}
+
 type PacketDiscovery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -596,7 +789,7 @@ type PacketDiscovery struct {
 func (x *PacketDiscovery) Reset() {
 	*x = PacketDiscovery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_packet_proto_msgTypes[7]
+		mi := &file_packet_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -609,7 +802,7 @@ func (x *PacketDiscovery) String() string {
 func (*PacketDiscovery) ProtoMessage() {}
 
 func (x *PacketDiscovery) ProtoReflect() protoreflect.Message {
-	mi := &file_packet_proto_msgTypes[7]
+	mi := &file_packet_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +815,7 @@ func (x *PacketDiscovery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PacketDiscovery.ProtoReflect.Descriptor instead.
 func (*PacketDiscovery) Descriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{7}
+	return file_packet_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PacketDiscovery) GetSenderPeerID() uint64 {
@@ -650,7 +843,7 @@ type P_UnitTestRequest struct {
 func (x *P_UnitTestRequest) Reset() {
 	*x = P_UnitTestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_packet_proto_msgTypes[8]
+		mi := &file_packet_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -663,7 +856,7 @@ func (x *P_UnitTestRequest) String() string {
 func (*P_UnitTestRequest) ProtoMessage() {}
 
 func (x *P_UnitTestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_packet_proto_msgTypes[8]
+	mi := &file_packet_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +869,7 @@ func (x *P_UnitTestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use P_UnitTestRequest.ProtoReflect.Descriptor instead.
 func (*P_UnitTestRequest) Descriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{8}
+	return file_packet_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *P_UnitTestRequest) GetField() string {
@@ -697,7 +890,7 @@ type P_UnitTestResponse struct {
 func (x *P_UnitTestResponse) Reset() {
 	*x = P_UnitTestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_packet_proto_msgTypes[9]
+		mi := &file_packet_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -710,7 +903,7 @@ func (x *P_UnitTestResponse) String() string {
 func (*P_UnitTestResponse) ProtoMessage() {}
 
 func (x *P_UnitTestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_packet_proto_msgTypes[9]
+	mi := &file_packet_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,7 +916,7 @@ func (x *P_UnitTestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use P_UnitTestResponse.ProtoReflect.Descriptor instead.
 func (*P_UnitTestResponse) Descriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{9}
+	return file_packet_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *P_UnitTestResponse) GetField() string {
@@ -745,7 +938,7 @@ type PacketSyncPeer_Network struct {
 func (x *PacketSyncPeer_Network) Reset() {
 	*x = PacketSyncPeer_Network{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_packet_proto_msgTypes[10]
+		mi := &file_packet_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -758,7 +951,7 @@ func (x *PacketSyncPeer_Network) String() string {
 func (*PacketSyncPeer_Network) ProtoMessage() {}
 
 func (x *PacketSyncPeer_Network) ProtoReflect() protoreflect.Message {
-	mi := &file_packet_proto_msgTypes[10]
+	mi := &file_packet_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,7 +964,7 @@ func (x *PacketSyncPeer_Network) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PacketSyncPeer_Network.ProtoReflect.Descriptor instead.
 func (*PacketSyncPeer_Network) Descriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{5, 0}
+	return file_packet_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *PacketSyncPeer_Network) GetName() string {
@@ -804,7 +997,7 @@ type PacketSyncPeer_RelayServer struct {
 func (x *PacketSyncPeer_RelayServer) Reset() {
 	*x = PacketSyncPeer_RelayServer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_packet_proto_msgTypes[11]
+		mi := &file_packet_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -817,7 +1010,7 @@ func (x *PacketSyncPeer_RelayServer) String() string {
 func (*PacketSyncPeer_RelayServer) ProtoMessage() {}
 
 func (x *PacketSyncPeer_RelayServer) ProtoReflect() protoreflect.Message {
-	mi := &file_packet_proto_msgTypes[11]
+	mi := &file_packet_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,7 +1023,7 @@ func (x *PacketSyncPeer_RelayServer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PacketSyncPeer_RelayServer.ProtoReflect.Descriptor instead.
 func (*PacketSyncPeer_RelayServer) Descriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{5, 1}
+	return file_packet_proto_rawDescGZIP(), []int{6, 1}
 }
 
 func (x *PacketSyncPeer_RelayServer) GetID() uint64 {
@@ -887,12 +1080,13 @@ type PacketSyncPeer_PeerInfo struct {
 	PublicKey     []byte                      `protobuf:"bytes,5,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
 	PrimaryServer *PacketSyncPeer_RelayServer `protobuf:"bytes,6,opt,name=PrimaryServer,proto3" json:"PrimaryServer,omitempty"`
 	Networks      []*PacketSyncPeer_Network   `protobuf:"bytes,7,rep,name=Networks,proto3" json:"Networks,omitempty"`
+	IPv6          string                      `protobuf:"bytes,8,opt,name=IPv6,proto3" json:"IPv6,omitempty"`
 }
 
 func (x *PacketSyncPeer_PeerInfo) Reset() {
 	*x = PacketSyncPeer_PeerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_packet_proto_msgTypes[12]
+		mi := &file_packet_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -905,7 +1099,7 @@ func (x *PacketSyncPeer_PeerInfo) String() string {
 func (*PacketSyncPeer_PeerInfo) ProtoMessage() {}
 
 func (x *PacketSyncPeer_PeerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_packet_proto_msgTypes[12]
+	mi := &file_packet_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,7 +1112,7 @@ func (x *PacketSyncPeer_PeerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PacketSyncPeer_PeerInfo.ProtoReflect.Descriptor instead.
 func (*PacketSyncPeer_PeerInfo) Descriptor() ([]byte, []int) {
-	return file_packet_proto_rawDescGZIP(), []int{5, 2}
+	return file_packet_proto_rawDescGZIP(), []int{6, 2}
 }
 
 func (x *PacketSyncPeer_PeerInfo) GetPeerID() uint64 {
@@ -970,83 +1164,174 @@ func (x *PacketSyncPeer_PeerInfo) GetNetworks() []*PacketSyncPeer_Network {
 	return nil
 }
 
+func (x *PacketSyncPeer_PeerInfo) GetIPv6() string {
+	if x != nil {
+		return x.IPv6
+	}
+	return ""
+}
+
+type PacketSyncPeer_SessionKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyID     uint32 `protobuf:"varint,1,opt,name=KeyID,proto3" json:"KeyID,omitempty"`
+	PublicKey []byte `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+}
+
+func (x *PacketSyncPeer_SessionKey) Reset() {
+	*x = PacketSyncPeer_SessionKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_packet_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketSyncPeer_SessionKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketSyncPeer_SessionKey) ProtoMessage() {}
+
+func (x *PacketSyncPeer_SessionKey) ProtoReflect() protoreflect.Message {
+	mi := &file_packet_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketSyncPeer_SessionKey.ProtoReflect.Descriptor instead.
+func (*PacketSyncPeer_SessionKey) Descriptor() ([]byte, []int) {
+	return file_packet_proto_rawDescGZIP(), []int{6, 3}
+}
+
+func (x *PacketSyncPeer_SessionKey) GetKeyID() uint32 {
+	if x != nil {
+		return x.KeyID
+	}
+	return 0
+}
+
+func (x *PacketSyncPeer_SessionKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
 var File_packet_proto protoreflect.FileDescriptor
 
 var file_packet_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x49,
+	0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x75,
 	0x0a, 0x0f, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b,
 	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0c, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49,
 	0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x49, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x2e, 0x0a, 0x12, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x6b, 0x12,
-	0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2f, 0x0a, 0x0f, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2a, 0x0a, 0x12, 0x50, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52,
-	0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x22, 0x5b, 0x0a, 0x13, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x04, 0x52, 0x0b, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12,
-	0x22, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x65,
-	0x65, 0x72, 0x73, 0x22, 0xd9, 0x05, 0x0a, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x79,
-	0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x73, 0x74, 0x50, 0x65, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x44, 0x73, 0x74, 0x50, 0x65,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x79,
-	0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x07,
-	0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x79,
-	0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x1a, 0x2d, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12,
-	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x49, 0x44, 0x1a, 0x8f, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x1a, 0xf8, 0x01, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x49, 0x50, 0x76, 0x34, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x49, 0x50, 0x76, 0x34, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x2e,
-	0x52, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x0d, 0x50, 0x72, 0x69,
-	0x6d, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x08, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x50,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x08, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x22,
-	0x6e, 0x0a, 0x07, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e,
-	0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x61, 0x74,
-	0x63, 0x68, 0x75, 0x70, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x63, 0x68, 0x75,
-	0x70, 0x41, 0x63, 0x6b, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x61, 0x69, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x10, 0x05, 0x22,
-	0x7d, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x72, 0x63, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x53, 0x72, 0x63, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c,
-	0x0a, 0x09, 0x44, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x44, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
-	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4e, 0x6f, 0x6e,
-	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x53,
+	0x49, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x69, 0x6e,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a,
+	0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x78, 0x0a, 0x12, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48,
+	0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22,
+	0x60, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x22, 0x2f, 0x0a, 0x0f, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x22, 0x2a, 0x0a, 0x12, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x65, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x22, 0x5b,
+	0x0a, 0x13, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x4f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x4f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x22, 0xf7, 0x06, 0x0a, 0x0e,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x44, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x44, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x07,
+	0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x50,
+	0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x03, 0x4b,
+	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x2d, 0x0a, 0x07, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x1a, 0x8f, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x6c,
+	0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x1a, 0x8c, 0x02, 0x0a, 0x08, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x49,
+	0x50, 0x76, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x49, 0x50, 0x76, 0x34, 0x12,
+	0x1c, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x41, 0x0a,
+	0x0d, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x0d, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x33, 0x0a, 0x08, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x50,
+	0x65, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x08, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x49, 0x50, 0x76, 0x36, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x49, 0x50, 0x76, 0x36, 0x1a, 0x40, 0x0a, 0x0a, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x87, 0x01, 0x0a, 0x07,
+	0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x61, 0x74, 0x63, 0x68, 0x75,
+	0x70, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x41, 0x63,
+	0x6b, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05,
+	0x52, 0x65, 0x6b, 0x65, 0x79, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x6b, 0x65, 0x79,
+	0x41, 0x63, 0x6b, 0x10, 0x07, 0x22, 0x7d, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x72, 0x63, 0x50, 0x65, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x53, 0x72, 0x63, 0x50, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x44, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x46, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x10, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x6c, 0x61, 0x79, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x4f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x53,
 	0x0a, 0x0f, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
 	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50,
@@ -1057,7 +1342,7 @@ var file_packet_proto_rawDesc = []byte{
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2a,
 	0x0a, 0x12, 0x50, 0x5f, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2a, 0xc6, 0x01, 0x0a, 0x0a, 0x50,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2a, 0xd6, 0x01, 0x0a, 0x0a, 0x50,
 	0x61, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x61, 0x6e,
 	0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64,
 	0x73, 0x68, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x6b, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x72,
@@ -1067,7 +1352,8 @@ var file_packet_proto_rawDesc = []byte{
 	0x0a, 0x08, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07,
 	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x69, 0x73,
 	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x72, 0x61, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x5f, 0x55, 0x6e, 0x69, 0x74, 0x54,
+	0x6d, 0x65, 0x6e, 0x74, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x5f, 0x55, 0x6e, 0x69, 0x74, 0x54,
 	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0x63, 0x12, 0x15, 0x0a, 0x11,
 	0x5f, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x10, 0x64, 0x42, 0x0f, 0x5a, 0x0d, 0x2e, 0x2e, 0x2f, 0x2e, 0x2e, 0x2f, 0x6d, 0x65, 0x73,
@@ -1087,34 +1373,38 @@ func file_packet_proto_rawDescGZIP() []byte {
 }
 
 var file_packet_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_packet_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_packet_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_packet_proto_goTypes = []interface{}{
 	(PacketType)(0),                    // 0: PacketType
 	(PacketSyncPeer_Purpose)(0),        // 1: PacketSyncPeer.Purpose
 	(*PacketHandshake)(nil),            // 2: PacketHandshake
 	(*PacketHandshakeAck)(nil),         // 3: PacketHandshakeAck
-	(*PacketHeartbeat)(nil),            // 4: PacketHeartbeat
-	(*PacketProbeRequest)(nil),         // 5: PacketProbeRequest
-	(*PacketProbeResponse)(nil),        // 6: PacketProbeResponse
-	(*PacketSyncPeer)(nil),             // 7: PacketSyncPeer
-	(*PacketForward)(nil),              // 8: PacketForward
-	(*PacketDiscovery)(nil),            // 9: PacketDiscovery
-	(*P_UnitTestRequest)(nil),          // 10: P_UnitTestRequest
-	(*P_UnitTestResponse)(nil),         // 11: P_UnitTestResponse
-	(*PacketSyncPeer_Network)(nil),     // 12: PacketSyncPeer.Network
-	(*PacketSyncPeer_RelayServer)(nil), // 13: PacketSyncPeer.RelayServer
-	(*PacketSyncPeer_PeerInfo)(nil),    // 14: PacketSyncPeer.PeerInfo
+	(*ResumptionTicket)(nil),           // 4: ResumptionTicket
+	(*PacketHeartbeat)(nil),            // 5: PacketHeartbeat
+	(*PacketProbeRequest)(nil),         // 6: PacketProbeRequest
+	(*PacketProbeResponse)(nil),        // 7: PacketProbeResponse
+	(*PacketSyncPeer)(nil),             // 8: PacketSyncPeer
+	(*PacketForward)(nil),              // 9: PacketForward
+	(*PacketRelayPeers)(nil),           // 10: PacketRelayPeers
+	(*PacketDiscovery)(nil),            // 11: PacketDiscovery
+	(*P_UnitTestRequest)(nil),          // 12: P_UnitTestRequest
+	(*P_UnitTestResponse)(nil),         // 13: P_UnitTestResponse
+	(*PacketSyncPeer_Network)(nil),     // 14: PacketSyncPeer.Network
+	(*PacketSyncPeer_RelayServer)(nil), // 15: PacketSyncPeer.RelayServer
+	(*PacketSyncPeer_PeerInfo)(nil),    // 16: PacketSyncPeer.PeerInfo
+	(*PacketSyncPeer_SessionKey)(nil),  // 17: PacketSyncPeer.SessionKey
 }
 var file_packet_proto_depIdxs = []int32{
 	1,  // 0: PacketSyncPeer.purpose:type_name -> PacketSyncPeer.Purpose
-	14, // 1: PacketSyncPeer.Peer:type_name -> PacketSyncPeer.PeerInfo
-	13, // 2: PacketSyncPeer.PeerInfo.PrimaryServer:type_name -> PacketSyncPeer.RelayServer
-	12, // 3: PacketSyncPeer.PeerInfo.Networks:type_name -> PacketSyncPeer.Network
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	16, // 1: PacketSyncPeer.Peer:type_name -> PacketSyncPeer.PeerInfo
+	17, // 2: PacketSyncPeer.Key:type_name -> PacketSyncPeer.SessionKey
+	15, // 3: PacketSyncPeer.PeerInfo.PrimaryServer:type_name -> PacketSyncPeer.RelayServer
+	14, // 4: PacketSyncPeer.PeerInfo.Networks:type_name -> PacketSyncPeer.Network
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_packet_proto_init() }
@@ -1148,7 +1438,7 @@ func file_packet_proto_init() {
 			}
 		}
 		file_packet_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PacketHeartbeat); i {
+			switch v := v.(*ResumptionTicket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1160,7 +1450,7 @@ func file_packet_proto_init() {
 			}
 		}
 		file_packet_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PacketProbeRequest); i {
+			switch v := v.(*PacketHeartbeat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1172,7 +1462,7 @@ func file_packet_proto_init() {
 			}
 		}
 		file_packet_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PacketProbeResponse); i {
+			switch v := v.(*PacketProbeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1184,7 +1474,7 @@ func file_packet_proto_init() {
 			}
 		}
 		file_packet_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PacketSyncPeer); i {
+			switch v := v.(*PacketProbeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1196,7 +1486,7 @@ func file_packet_proto_init() {
 			}
 		}
 		file_packet_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PacketForward); i {
+			switch v := v.(*PacketSyncPeer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1208,7 +1498,7 @@ func file_packet_proto_init() {
 			}
 		}
 		file_packet_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PacketDiscovery); i {
+			switch v := v.(*PacketForward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1220,7 +1510,7 @@ func file_packet_proto_init() {
 			}
 		}
 		file_packet_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*P_UnitTestRequest); i {
+			switch v := v.(*PacketRelayPeers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1232,7 +1522,7 @@ func file_packet_proto_init() {
 			}
 		}
 		file_packet_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*P_UnitTestResponse); i {
+			switch v := v.(*PacketDiscovery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1244,7 +1534,7 @@ func file_packet_proto_init() {
 			}
 		}
 		file_packet_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PacketSyncPeer_Network); i {
+			switch v := v.(*P_UnitTestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1256,7 +1546,7 @@ func file_packet_proto_init() {
 			}
 		}
 		file_packet_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PacketSyncPeer_RelayServer); i {
+			switch v := v.(*P_UnitTestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1268,6 +1558,30 @@ func file_packet_proto_init() {
 			}
 		}
 		file_packet_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketSyncPeer_Network); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_packet_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketSyncPeer_RelayServer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_packet_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PacketSyncPeer_PeerInfo); i {
 			case 0:
 				return &v.state
@@ -1279,6 +1593,18 @@ func file_packet_proto_init() {
 				return nil
 			}
 		}
+		file_packet_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketSyncPeer_SessionKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1286,7 +1612,7 @@ func file_packet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_packet_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -15,7 +15,9 @@
 package api
 
 import (
+	"net/url"
 	"sort"
+	"time"
 
 	"github.com/pairmesh/pairmesh/constant"
 	"github.com/pairmesh/pairmesh/internal/jsonapi"
@@ -23,6 +25,10 @@ import (
 	"go.uber.org/zap"
 )
 
+// peerGraphWatchTimeout is the timeout of the long polling request to watch
+// the peer graph, which must be longer than the timeout of the portal service.
+const peerGraphWatchTimeout = 70 * time.Second
+
 // Client is used to access with the remote gateway
 type Client struct {
 	restful *jsonapi.Client
@@ -50,10 +56,13 @@ func (c *Client) Logout() {
 }
 
 // Preflight request the prerequisite for bootup the current node
-func (c *Client) Preflight(os, hostname string) (*protocol.PreflightResponse, error) {
+func (c *Client) Preflight(os, hostname string, routes []string, exitNode bool, latencies []protocol.RelayLatency) (*protocol.PreflightResponse, error) {
 	req := &protocol.PreflightRequest{
-		OS:   os,
-		Host: hostname,
+		OS:        os,
+		Host:      hostname,
+		Routes:    routes,
+		ExitNode:  exitNode,
+		Latencies: latencies,
 	}
 	resp := &protocol.PreflightResponse{}
 
@@ -79,6 +88,37 @@ func (c *Client) PeerGraph(uniqueHash string) (*protocol.PeerGraphResponse, erro
 	return resp, nil
 }
 
+// WatchPeerGraph waits until the peer graph differs from the one identified by
+// the hash, and retrieves the changes of the peer graph.
+func (c *Client) WatchPeerGraph(uniqueHash string) (*protocol.PeerGraphDelta, error) {
+	resp := &protocol.PeerGraphDelta{}
+
+	api := constant.URIDevicePeerWatch + "?hash=" + url.QueryEscape(uniqueHash)
+	if err := c.restful.GetWithTimeout(api, peerGraphWatchTimeout, resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Full != nil {
+		sort.Slice(resp.Full.Peers, func(i, j int) bool {
+			return resp.Full.Peers[i].ID < resp.Full.Peers[j].ID
+		})
+	}
+	return resp, nil
+}
+
+// RelayLatency reports the latencies to the relay servers and retrieves the
+// primary relay server selected for the current node.
+func (c *Client) RelayLatency(latencies []protocol.RelayLatency) (*protocol.RelayLatencyResponse, error) {
+	req := &protocol.RelayLatencyRequest{
+		Latencies: latencies,
+	}
+	res := &protocol.RelayLatencyResponse{}
+	if err := c.restful.Post(constant.URIDeviceLatency, req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // KeyExchange use the auth key to exchange back a jwt token
 func (c *Client) KeyExchange() (*protocol.KeyExchangeResponse, error) {
 	var resp protocol.KeyExchangeResponse
@@ -89,6 +129,21 @@ func (c *Client) KeyExchange() (*protocol.KeyExchangeResponse, error) {
 	return &resp, nil
 }
 
+// VersionCheck checks whether a newer release of the platform `{os}/{arch}` is
+// available in the channel.
+func (c *Client) VersionCheck(version, platform, channel string) (*protocol.VersionCheckResponse, error) {
+	query := url.Values{
+		"version":  {version},
+		"platform": {platform},
+		"channel":  {channel},
+	}
+	res := &protocol.VersionCheckResponse{}
+	if err := c.restful.Get(constant.URIVersionCheck+"?"+query.Encode(), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // RenewCredential request the server to renew the credential.
 func (c *Client) RenewCredential(credential string) (*protocol.RenewCredentialResponse, error) {
 	req := &protocol.RenewCredentialRequest{
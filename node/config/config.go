@@ -47,6 +47,21 @@ type Config struct {
 	MachineID  string      `json:"machine_id"`
 	OnceAlert  bool        `json:"once_alert"`
 	LocaleName string      `json:"locale_name"`
+	// AdvertiseRoutes are the subnet routes advertised to the peers, and the
+	// current node will forward the traffics to the subnets for peers.
+	AdvertiseRoutes []string `json:"advertise_routes,omitempty"`
+	// AdvertiseExitNode offers the current node as an exit node, which will
+	// forward the internet traffics for peers.
+	AdvertiseExitNode bool `json:"advertise_exit_node,omitempty"`
+	// ExitNode is the name or address of the peer which is used to route all
+	// internet traffics. The internet traffics are not routed to peers if empty.
+	ExitNode string `json:"exit_node,omitempty"`
+	// DisableMagicDNS disables the resolver of the peer names, which resolves
+	// <device>.<user>.<network>.pairmesh to the address of peers.
+	DisableMagicDNS bool `json:"disable_magic_dns,omitempty"`
+	// UpdateChannel is the release channel to check the updates, which is
+	// either stable or beta. The stable channel is used if empty.
+	UpdateChannel string `json:"update_channel,omitempty"`
 }
 
 // SetConfigDir overrides the default configuration file path.
@@ -66,6 +81,11 @@ func (c *Config) path() string {
 	return filepath.Join(dir, configDirName, configFileName)
 }
 
+// Dir returns the directory of the configuration file
+func (c *Config) Dir() string {
+	return filepath.Dir(c.path())
+}
+
 // Load loads the configuration from disk
 func (c *Config) Load() error {
 	path := c.path()
@@ -156,13 +176,20 @@ func (c *Config) Clone() *Config {
 	}
 	copy(key.Public, c.DHKey.Public)
 	copy(key.Private, c.DHKey.Private)
+	routes := make([]string, len(c.AdvertiseRoutes))
+	copy(routes, c.AdvertiseRoutes)
 	return &Config{
-		Token:      c.Token,
-		DHKey:      key,
-		Port:       c.Port,
-		MachineID:  c.MachineID,
-		OnceAlert:  c.OnceAlert,
-		LocaleName: c.LocaleName,
+		Token:             c.Token,
+		DHKey:             key,
+		Port:              c.Port,
+		MachineID:         c.MachineID,
+		OnceAlert:         c.OnceAlert,
+		LocaleName:        c.LocaleName,
+		AdvertiseRoutes:   routes,
+		AdvertiseExitNode: c.AdvertiseExitNode,
+		ExitNode:          c.ExitNode,
+		DisableMagicDNS:   c.DisableMagicDNS,
+		UpdateChannel:     c.UpdateChannel,
 	}
 }
 
@@ -218,3 +245,9 @@ func (c *Config) SetLocaleName(name string) error {
 	c.LocaleName = name
 	return c.Save()
 }
+
+// SetExitNode sets the exit node of the config
+func (c *Config) SetExitNode(exitNode string) error {
+	c.ExitNode = exitNode
+	return c.Save()
+}
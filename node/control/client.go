@@ -0,0 +1,114 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pairmesh/pairmesh/node/driver"
+	"github.com/pkg/errors"
+)
+
+// requestTimeout is the timeout of the control API requests.
+const requestTimeout = 5 * time.Second
+
+// Client is used to access the control API of the running node.
+type Client struct {
+	http *http.Client
+}
+
+// NewClient returns the control API client connecting to the unix socket path.
+func NewClient(path string) *Client {
+	dialer := &net.Dialer{}
+	return &Client{
+		http: &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return dialer.DialContext(ctx, "unix", path)
+				},
+			},
+		},
+	}
+}
+
+// Status returns the summary of the running node.
+func (c *Client) Status() (*driver.Summary, error) {
+	res := &driver.Summary{}
+	if err := c.do(http.MethodGet, uriStatus, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Ping returns the path and the latency to the peer with the name or address.
+func (c *Client) Ping(peer string) (*PingResult, error) {
+	res := &PingResult{}
+	if err := c.do(http.MethodGet, uriPing+"?peer="+url.QueryEscape(peer), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Up enables the running node to serve the traffics.
+func (c *Client) Up() (*driver.Summary, error) {
+	res := &driver.Summary{}
+	if err := c.do(http.MethodPost, uriUp, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Down disables the running node and the traffics will be dropped.
+func (c *Client) Down() (*driver.Summary, error) {
+	res := &driver.Summary{}
+	if err := c.do(http.MethodPost, uriDown, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Logout logs out the running node and terminates it.
+func (c *Client) Logout() error {
+	return c.do(http.MethodPost, uriLogout, &struct{}{})
+}
+
+func (c *Client) do(method, api string, res interface{}) error {
+	// The host is ignored because the unix socket is dialed.
+	req, err := http.NewRequest(method, "http://pairmesh"+api, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return errors.WithMessage(err, "connect to the running PairMesh")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return json.NewDecoder(resp.Body).Decode(res)
+	}
+
+	result := &errorResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return errors.New(resp.Status)
+	}
+	return errors.New(result.Error)
+}
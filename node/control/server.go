@@ -0,0 +1,264 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pairmesh/pairmesh/node/driver"
+	"github.com/pairmesh/pairmesh/node/mesh"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// DefaultSocketPath is the path of the unix socket which the control API
+// listens on by default.
+const DefaultSocketPath = "/var/run/pairmesh.sock"
+
+const (
+	uriStatus = "/status"
+	uriPing   = "/ping"
+	uriUp     = "/up"
+	uriDown   = "/down"
+	uriLogout = "/logout"
+)
+
+// probeTimeout is the timeout waiting for the echo of the probe sent by ping.
+const probeTimeout = 2 * time.Second
+
+type (
+	// PingResult is the path and the latency to the peer. The latency is the
+	// round trip of a probe sent over the direct paths to the peer, half of it
+	// more precisely, and the endpoint is the direct path echoing the probe.
+	// The peer is relayed if no direct path echoes, whose latency is unknown.
+	PingResult struct {
+		Name     string        `json:"name"`
+		IPv4     string        `json:"ipv4"`
+		IPv6     string        `json:"ipv6,omitempty"`
+		Status   mesh.State    `json:"status"`
+		Endpoint string        `json:"endpoint,omitempty"`
+		Relay    string        `json:"relay,omitempty"`
+		Latency  time.Duration `json:"latency,omitempty"`
+	}
+
+	// errorResponse is the response of the failed requests.
+	errorResponse struct {
+		Error string `json:"error"`
+	}
+
+	// Server serves the local control API over the unix socket, which is used
+	// to query and change the state of the running node.
+	Server struct {
+		driver driver.Driver
+		logout func() error
+		mux    *http.ServeMux
+	}
+)
+
+// NewServer returns the control API server backed by the driver. The logout
+// function clears the token of the node and terminates the daemon.
+func NewServer(drv driver.Driver, logout func() error) *Server {
+	s := &Server{
+		driver: drv,
+		logout: logout,
+		mux:    http.NewServeMux(),
+	}
+	s.mux.HandleFunc(uriStatus, s.onStatus)
+	s.mux.HandleFunc(uriPing, s.onPing)
+	s.mux.HandleFunc(uriUp, s.onUp)
+	s.mux.HandleFunc(uriDown, s.onDown)
+	s.mux.HandleFunc(uriLogout, s.onLogout)
+	return s
+}
+
+// Serve listens on the unix socket path and serves the control API until the
+// context is canceled. The socket is only accessible by the owner because
+// the control API can disable or logout the node.
+func (s *Server) Serve(ctx context.Context, path string) error {
+	listener, err := listenPrivate(path)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+
+	srv := &http.Server{Handler: s.mux}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+
+	zap.L().Info("Control API is serving", zap.String("socket", path))
+	err = srv.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// listenPrivate listens on the unix socket path which is only accessible by the
+// owner. The socket is created in a private directory and changed mode before
+// moved to the path, so it's never exposed to others before the mode changed.
+func listenPrivate(path string) (net.Listener, error) {
+	// Remove the stale socket left by the previous process.
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, errors.WithMessage(err, "remove stale socket")
+	}
+	dir, err := os.MkdirTemp(filepath.Dir(path), ".pairmesh-control-")
+	if err != nil {
+		return nil, errors.WithMessage(err, "create control socket directory")
+	}
+	defer os.RemoveAll(dir)
+
+	tmp := filepath.Join(dir, filepath.Base(path))
+	listener, err := net.Listen("unix", tmp)
+	if err != nil {
+		return nil, errors.WithMessage(err, "listen control socket")
+	}
+	// The socket is removed by the caller after moved.
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	if err := os.Chmod(tmp, 0600); err != nil {
+		_ = listener.Close()
+		return nil, errors.WithMessage(err, "change mode of control socket")
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = listener.Close()
+		return nil, errors.WithMessage(err, "move control socket")
+	}
+	return listener, nil
+}
+
+func (s *Server) onStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	writeJSON(w, s.driver.Summarize())
+}
+
+func (s *Server) onPing(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	selector := r.URL.Query().Get("peer")
+	if selector == "" {
+		writeError(w, http.StatusBadRequest, errors.New("peer is required"))
+		return
+	}
+	device, found := findDevice(s.driver.Summarize(), selector)
+	if !found {
+		writeError(w, http.StatusNotFound, errors.Errorf("peer %s not found", selector))
+		return
+	}
+
+	res := &PingResult{
+		Name:   device.Name,
+		IPv4:   device.IPv4,
+		IPv6:   device.IPv6,
+		Status: device.Status,
+	}
+	if device.Status == mesh.StatePending {
+		writeJSON(w, res)
+		return
+	}
+	if device.Stats != nil {
+		res.Relay = device.Stats.Relay
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), probeTimeout)
+	defer cancel()
+	endpoint, latency, err := s.driver.Probe(ctx, device.IPv4)
+	if err != nil {
+		res.Status = mesh.StateRelay
+	} else {
+		res.Status = mesh.StateP2P
+		res.Endpoint = endpoint
+		res.Latency = latency
+	}
+	writeJSON(w, res)
+}
+
+func (s *Server) onUp(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	s.driver.Enable()
+	writeJSON(w, s.driver.Summarize())
+}
+
+func (s *Server) onDown(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	s.driver.Disable()
+	writeJSON(w, s.driver.Summarize())
+}
+
+func (s *Server) onLogout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	if err := s.logout(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, struct{}{})
+}
+
+// findDevice returns the device with the name or the address.
+func findDevice(summary *driver.Summary, selector string) (mesh.Device, bool) {
+	if summary.Mesh == nil {
+		return mesh.Device{}, false
+	}
+	match := func(devices []mesh.Device) (mesh.Device, bool) {
+		for _, d := range devices {
+			if d.Name == selector || d.IPv4 == selector || (d.IPv6 != "" && d.IPv6 == selector) {
+				return d, true
+			}
+		}
+		return mesh.Device{}, false
+	}
+	if d, found := match(summary.Mesh.MyDevices); found {
+		return d, true
+	}
+	for _, n := range summary.Mesh.Networks {
+		if d, found := match(n.Devices); found {
+			return d, true
+		}
+	}
+	return mesh.Device{}, false
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		zap.L().Error("Write control API response failed", zap.Error(err))
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(&errorResponse{Error: err.Error()})
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pairmesh/pairmesh/node/driver"
+	"github.com/pairmesh/pairmesh/node/mesh"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDriver struct {
+	driver.Driver
+
+	enabled bool
+	probed  []string
+}
+
+func (d *mockDriver) Enable()  { d.enabled = true }
+func (d *mockDriver) Disable() { d.enabled = false }
+
+func (d *mockDriver) Probe(_ context.Context, address string) (string, time.Duration, error) {
+	d.probed = append(d.probed, address)
+	if address != "10.0.0.2" {
+		return "", 0, errors.New("no direct path")
+	}
+	return "1.2.3.4:6", 3 * time.Millisecond, nil
+}
+
+func (d *mockDriver) Summarize() *driver.Summary {
+	return &driver.Summary{
+		Enabled: d.enabled,
+		Status:  "connected",
+		Profile: &driver.Profile{Name: "local", IPv4: "10.0.0.1"},
+		Mesh: &mesh.Summary{
+			Networks: []mesh.Network{{
+				ID:   1,
+				Name: "network",
+				Devices: []mesh.Device{{
+					Name:   "peer",
+					IPv4:   "10.0.0.2",
+					Status: mesh.StateP2P,
+					Stats:  &mesh.PeerStats{Endpoint: "1.2.3.4:5", Latency: 12 * time.Millisecond},
+				}, {
+					Name:   "relayed",
+					IPv4:   "10.0.0.3",
+					Status: mesh.StateP2P,
+					Stats:  &mesh.PeerStats{Endpoint: "1.2.3.4:7", Relay: "relay-1"},
+				}, {
+					Name:   "pending",
+					IPv4:   "10.0.0.4",
+					Status: mesh.StatePending,
+				}},
+			}},
+		},
+	}
+}
+
+func TestControlAPI(t *testing.T) {
+	a := assert.New(t)
+
+	drv := &mockDriver{enabled: true}
+	loggedOut := false
+	srv := NewServer(drv, func() error {
+		loggedOut = true
+		return nil
+	})
+
+	path := filepath.Join(t.TempDir(), "pairmesh.sock")
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- srv.Serve(ctx, path) }()
+	defer func() {
+		cancel()
+		a.Nil(<-done)
+	}()
+
+	client := NewClient(path)
+	var (
+		status *driver.Summary
+		err    error
+	)
+	a.Eventually(func() bool {
+		status, err = client.Status()
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	a.True(status.Enabled)
+	a.Equal("connected", status.Status)
+	a.Equal("10.0.0.1", status.Profile.IPv4)
+
+	status, err = client.Down()
+	a.Nil(err)
+	a.False(status.Enabled)
+	a.False(drv.enabled)
+
+	status, err = client.Up()
+	a.Nil(err)
+	a.True(status.Enabled)
+
+	// The latency is measured by a probe instead of the cached statistics.
+	for _, selector := range []string{"peer", "10.0.0.2"} {
+		res, err := client.Ping(selector)
+		a.Nil(err)
+		a.Equal("peer", res.Name)
+		a.Equal(mesh.StateP2P, res.Status)
+		a.Equal("1.2.3.4:6", res.Endpoint)
+		a.Equal(3*time.Millisecond, res.Latency)
+	}
+	res, err := client.Ping("relayed")
+	a.Nil(err)
+	a.Equal(mesh.StateRelay, res.Status)
+	a.Equal("", res.Endpoint)
+	a.Equal(time.Duration(0), res.Latency)
+	a.Equal("relay-1", res.Relay)
+
+	res, err = client.Ping("pending")
+	a.Nil(err)
+	a.Equal(mesh.StatePending, res.Status)
+	a.Equal([]string{"10.0.0.2", "10.0.0.2", "10.0.0.3"}, drv.probed)
+
+	_, err = client.Ping("unknown")
+	a.EqualError(err, "peer unknown not found")
+
+	a.Nil(client.Logout())
+	a.True(loggedOut)
+}
+
+func TestListenPrivate(t *testing.T) {
+	a := assert.New(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "pairmesh.sock")
+	a.Nil(ioutil.WriteFile(path, []byte("stale"), 0644))
+
+	listener, err := listenPrivate(path)
+	a.Nil(err)
+	defer listener.Close()
+
+	info, err := os.Stat(path)
+	a.Nil(err)
+	a.Equal(os.ModeSocket, info.Mode()&os.ModeSocket)
+	a.Equal(os.FileMode(0600), info.Mode().Perm())
+
+	// The private directory is removed after the socket moved.
+	entries, err := ioutil.ReadDir(dir)
+	a.Nil(err)
+	a.Len(entries, 1)
+}
@@ -32,8 +32,9 @@ type Device interface {
 	// Router returns the router of the current device.
 	Router() Router
 
-	// Up runs the device with the address of the virtual tunnel device.
-	Up(ipv4 string) error
+	// Up runs the device with the addresses of the virtual tunnel device.
+	// The IPv6 address is optional and will be ignored if empty.
+	Up(ipv4, ipv6 string) error
 
 	// Down closed the virtual device
 	Down() error
@@ -41,7 +41,7 @@ func (d device) Router() Router {
 }
 
 // Up implements the Device interface.
-func (d device) Up(address string) error {
+func (d device) Up(address, address6 string) error {
 	// Set the IP address for the virtual interface and enable the device
 	setAddressAndUp := []string{
 		"ifconfig",
@@ -51,7 +51,21 @@ func (d device) Up(address string) error {
 		address,
 		"up",
 	}
-	return runner.Run(setAddressAndUp)
+	err := runner.Run(setAddressAndUp)
+	if err != nil || address6 == "" {
+		return err
+	}
+
+	// Set the IPv6 address for the virtual interface
+	setAddress6 := []string{
+		"ifconfig",
+		d.Name(),
+		"inet6",
+		address6,
+		"prefixlen",
+		"128",
+	}
+	return runner.Run(setAddress6)
 }
 
 // Down implements the Device interface.
@@ -42,7 +42,7 @@ func (d device) Router() Router {
 }
 
 // Up implements the Device interface.
-func (d device) Up(address string) error {
+func (d device) Up(address, address6 string) error {
 	// Set the IP address for the virtual interface
 	setAddress := []string{
 		"ip",
@@ -57,6 +57,23 @@ func (d device) Up(address string) error {
 		return err
 	}
 
+	// Set the IPv6 address for the virtual interface
+	if address6 != "" {
+		setAddress6 := []string{
+			"ip",
+			"-6",
+			"addr",
+			"add",
+			fmt.Sprintf("%s/%d", address6, 128),
+			"dev",
+			d.Name(),
+		}
+		err := runner.Run(setAddress6)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Up the virtual interface device
 	upDevice := []string{
 		"ip",
@@ -43,7 +43,7 @@ func (d device) Router() Router {
 }
 
 // Up implements the Device interface.
-func (d device) Up(address string) error {
+func (d device) Up(address, address6 string) error {
 	// Set the IP address for the virtual interface
 	setAddress := []string{
 		"netsh",
@@ -60,6 +60,26 @@ func (d device) Up(address string) error {
 		return err
 	}
 
+	// Set the IPv6 address for the virtual interface
+	localAddresses := address
+	if address6 != "" {
+		setAddress6 := []string{
+			"netsh",
+			"interface",
+			"ipv6",
+			"add",
+			"address",
+			fmt.Sprintf(`interface="%s"`, d.Name()),
+			fmt.Sprintf("address=%s/128", address6),
+			"store=active",
+		}
+		err := runner.Run(setAddress6)
+		if err != nil {
+			return err
+		}
+		localAddresses = address + "," + address6
+	}
+
 	upDevice := []string{
 		"netsh",
 		"interface",
@@ -70,7 +90,7 @@ func (d device) Up(address string) error {
 	}
 
 	// Async setup firewall rules when process startup.
-	go firewall.Setup(address)
+	go firewall.Setup(localAddresses)
 
 	return runner.Run(upDevice)
 }
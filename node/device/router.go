@@ -1,6 +1,9 @@
 package device
 
 import (
+	"errors"
+	"strings"
+
 	"go.uber.org/zap"
 
 	"go.uber.org/atomic"
@@ -13,6 +16,14 @@ type (
 	Router interface {
 		Set(cfg *Config)
 		Add(cfg *Config)
+		// Forward enables the device to forward the traffics from the mesh
+		// network to the target prefixes, which is required by subnet routers
+		// and exit nodes.
+		Forward(targets []netaddr.IPPrefix) error
+		// SetExit routes the internet traffics to the device if an exit node
+		// is used, and keeps the traffics to the bypass addresses on the
+		// physical interface.
+		SetExit(cfg *ExitConfig)
 	}
 
 	router struct {
@@ -20,6 +31,9 @@ type (
 
 		// Cached previous routes result.
 		routes atomic.Value // []netaddr.IPPrefix
+		// Cached previous exit routes and bypass addresses result.
+		exit   atomic.Bool
+		bypass atomic.Value // []netaddr.IP
 	}
 
 	// Config represents the router configurations
@@ -27,8 +41,30 @@ type (
 		LocalAddress netaddr.IP         `json:"local_address"`
 		Routes       []netaddr.IPPrefix `json:"routes"`
 	}
+
+	// ExitConfig represents the exit routes configurations
+	ExitConfig struct {
+		LocalAddress netaddr.IP `json:"local_address"`
+		Enabled      bool       `json:"enabled"`
+		// Bypass are the IPv4 addresses which are kept on the physical interface
+		// when the internet traffics are routed to the device, e.g: the relay
+		// servers and the endpoints of the exit node.
+		Bypass []netaddr.IP `json:"bypass"`
+	}
 )
 
+// exitRoutes are the routes which cover all IPv4 internet traffics. The routes
+// are more specific than the default route of the physical interface, so that
+// the default route is preserved and can still be used by the bypass addresses.
+// The global unicast IPv6 traffics are routed to the device as well and dropped
+// there, because the exit node forwards IPv4 traffics only and they must not
+// leak through the physical interface.
+var exitRoutes = []netaddr.IPPrefix{
+	netaddr.MustParseIPPrefix("0.0.0.0/1"),
+	netaddr.MustParseIPPrefix("128.0.0.0/1"),
+	netaddr.MustParseIPPrefix("2000::/3"),
+}
+
 // newRouter return a router instance.
 func newRouter(dev Device) Router {
 	return &router{dev: dev}
@@ -73,6 +109,17 @@ func (r *router) Set(cfg *Config) {
 	r.routes.Store(cfg.Routes)
 }
 
+func (r *router) Forward(targets []netaddr.IPPrefix) error {
+	devName := r.dev.Name()
+	for _, target := range targets {
+		err := r.forward(devName, target)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *router) Add(cfg *Config) {
 	var old Set
 	if o := r.routes.Load(); o != nil {
@@ -104,3 +151,108 @@ func (r *router) Add(cfg *Config) {
 		r.routes.Store(cfg.Routes)
 	}
 }
+
+func (r *router) SetExit(cfg *ExitConfig) {
+	// The bypass routes must be installed before the exit routes and deleted
+	// after them, otherwise the traffics to the relay servers will be routed
+	// to the device.
+	var bypass []netaddr.IP
+	if cfg.Enabled {
+		bypass = cfg.Bypass
+		r.addBypass(bypass)
+	}
+
+	if r.exit.Load() != cfg.Enabled {
+		devName := r.dev.Name()
+		for _, target := range exitRoutes {
+			var err error
+			if cfg.Enabled {
+				err = r.add(devName, cfg.LocalAddress, target)
+			} else {
+				err = r.del(devName, cfg.LocalAddress, target)
+			}
+			if err != nil {
+				zap.L().Error("Set exit route failed", zap.Stringer("target", target), zap.Bool("enabled", cfg.Enabled), zap.Error(err))
+			}
+		}
+		r.exit.Store(cfg.Enabled)
+	}
+
+	r.delBypass(bypass)
+}
+
+// addBypass keeps the traffics to the bypass addresses on the physical interface
+// via host routes to the default gateway. The addresses failed to be installed
+// will be retried in the next call.
+func (r *router) addBypass(bypass []netaddr.IP) {
+	var installed []netaddr.IP
+	if o := r.bypass.Load(); o != nil {
+		installed = o.([]netaddr.IP)
+	}
+	old := map[netaddr.IP]struct{}{}
+	for _, ip := range installed {
+		old[ip] = struct{}{}
+	}
+
+	var gateway netaddr.IP
+	for _, ip := range bypass {
+		if _, found := old[ip]; found {
+			continue
+		}
+		if gateway.IsZero() {
+			gw, err := r.gateway()
+			if err != nil {
+				zap.L().Error("Retrieve default gateway failed", zap.Error(err))
+				break
+			}
+			gateway = gw
+		}
+		if err := r.bypassVia(gateway, ip); err != nil {
+			zap.L().Error("Add bypass route failed", zap.Stringer("target", ip), zap.Error(err))
+			continue
+		}
+		old[ip] = struct{}{}
+		installed = append(installed, ip)
+	}
+
+	// Update the cache.
+	r.bypass.Store(installed)
+}
+
+// delBypass deletes the bypass routes which are not in the latest addresses.
+func (r *router) delBypass(bypass []netaddr.IP) {
+	o := r.bypass.Load()
+	if o == nil {
+		return
+	}
+	cur := map[netaddr.IP]struct{}{}
+	for _, ip := range bypass {
+		cur[ip] = struct{}{}
+	}
+
+	var kept []netaddr.IP
+	for _, ip := range o.([]netaddr.IP) {
+		if _, found := cur[ip]; found {
+			kept = append(kept, ip)
+			continue
+		}
+		if err := r.unbypass(ip); err != nil {
+			zap.L().Error("Delete bypass route failed", zap.Stringer("target", ip), zap.Error(err))
+		}
+	}
+
+	// Update the cache.
+	r.bypass.Store(kept)
+}
+
+// parseGateway parses the default gateway address from the command output, and
+// the address is the field following the key.
+func parseGateway(out, key string) (netaddr.IP, error) {
+	fields := strings.Fields(out)
+	for i := 0; i < len(fields)-1; i++ {
+		if fields[i] == key {
+			return netaddr.ParseIP(fields[i+1])
+		}
+	}
+	return netaddr.IP{}, errors.New("default gateway not found")
+}
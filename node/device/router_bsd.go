@@ -35,6 +35,10 @@ func bsdAdd(devName string, _ netaddr.IP, target netaddr.IPPrefix) error {
 	return runner.Run(args)
 }
 
+func bsdForward(_ string, _ netaddr.IPPrefix) error {
+	return fmt.Errorf("forwarding is not supported on %s", runtime.GOOS)
+}
+
 func bsdDel(devName string, _ netaddr.IP, target netaddr.IPPrefix) error {
 	net := target.IPNet()
 	nip := net.IP.Mask(net.Mask)
@@ -57,3 +61,41 @@ func bsdDel(devName string, _ netaddr.IP, target netaddr.IPPrefix) error {
 
 	return runner.Run(args)
 }
+
+func bsdGateway() (netaddr.IP, error) {
+	out, err := runner.Output([]string{"route", "-n", "get", "default"})
+	if err != nil {
+		return netaddr.IP{}, err
+	}
+	return parseGateway(out, "gateway:")
+}
+
+func bsdBypassVia(gateway, target netaddr.IP) error {
+	args := []string{
+		"route",
+		"-q",
+		"-n",
+		"add",
+		"-inet",
+		netaddr.IPPrefixFrom(target, 32).String(),
+		gateway.String(),
+	}
+	return runner.Run(args)
+}
+
+func bsdUnbypass(target netaddr.IP) error {
+	del := "del"
+	if runtime.GOOS == "darwin" {
+		del = "delete"
+	}
+
+	args := []string{
+		"route",
+		"-q",
+		"-n",
+		del,
+		"-inet",
+		netaddr.IPPrefixFrom(target, 32).String(),
+	}
+	return runner.Run(args)
+}
@@ -9,3 +9,19 @@ func (r *router) add(devName string, localAddress netaddr.IP, target netaddr.IPP
 func (r *router) del(devName string, localAddress netaddr.IP, target netaddr.IPPrefix) error {
 	return bsdDel(devName, localAddress, target)
 }
+
+func (r *router) forward(devName string, target netaddr.IPPrefix) error {
+	return bsdForward(devName, target)
+}
+
+func (r *router) gateway() (netaddr.IP, error) {
+	return bsdGateway()
+}
+
+func (r *router) bypassVia(gateway, target netaddr.IP) error {
+	return bsdBypassVia(gateway, target)
+}
+
+func (r *router) unbypass(target netaddr.IP) error {
+	return bsdUnbypass(target)
+}
@@ -1,12 +1,27 @@
 package device
 
 import (
+	"github.com/pairmesh/pairmesh/constant"
 	"github.com/pairmesh/pairmesh/node/device/runner"
 
 	"inet.af/netaddr"
 )
 
 func (r *router) add(devName string, localAddress netaddr.IP, target netaddr.IPPrefix) error {
+	// The IPv6 routes are bound to the device directly.
+	if target.IP().Is6() {
+		args := []string{
+			"ip",
+			"-6",
+			"route",
+			"add",
+			target.Masked().String(),
+			"dev",
+			devName,
+		}
+		return runner.Run(args)
+	}
+
 	args := []string{
 		"ip",
 		"route",
@@ -19,6 +34,19 @@ func (r *router) add(devName string, localAddress netaddr.IP, target netaddr.IPP
 }
 
 func (r *router) del(devName string, localAddress netaddr.IP, target netaddr.IPPrefix) error {
+	if target.IP().Is6() {
+		args := []string{
+			"ip",
+			"-6",
+			"route",
+			"del",
+			target.Masked().String(),
+			"dev",
+			devName,
+		}
+		return runner.Run(args)
+	}
+
 	args := []string{
 		"ip",
 		"route",
@@ -29,3 +57,68 @@ func (r *router) del(devName string, localAddress netaddr.IP, target netaddr.IPP
 	}
 	return runner.Run(args)
 }
+
+// forward enables the IP forwarding and masquerades the traffics forwarded from
+// the device to the target prefix. Only the traffics from the mesh network are
+// forwarded, so the other traffics of the host are not rewritten.
+func (r *router) forward(devName string, target netaddr.IPPrefix) error {
+	sysctl, iptables, src := "net.ipv4.ip_forward=1", "iptables", constant.MeshIPv4Prefix
+	if target.IP().Is6() {
+		sysctl, iptables, src = "net.ipv6.conf.all.forwarding=1", "ip6tables", constant.MeshIPv6Prefix
+	}
+
+	err := runner.Run([]string{"sysctl", "-w", sysctl})
+	if err != nil {
+		return err
+	}
+
+	dst := target.Masked().String()
+	rules := [][]string{
+		{"filter", "FORWARD", "-i", devName, "-s", src, "-d", dst, "-j", "ACCEPT"},
+		{"filter", "FORWARD", "-o", devName, "-s", dst, "-d", src, "-m", "state", "--state", "RELATED,ESTABLISHED", "-j", "ACCEPT"},
+		{"nat", "POSTROUTING", "-s", src, "-d", dst, "!", "-o", devName, "-j", "MASQUERADE"},
+	}
+	for _, rule := range rules {
+		// Skip the rule if it exists already.
+		check := append([]string{iptables, "-t", rule[0], "-C"}, rule[1:]...)
+		if runner.Run(check) == nil {
+			continue
+		}
+		err := runner.Run(append([]string{iptables, "-t", rule[0], "-I"}, rule[1:]...))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gateway returns the gateway of the default route of the physical interface.
+func (r *router) gateway() (netaddr.IP, error) {
+	out, err := runner.Output([]string{"ip", "-4", "route", "show", "default"})
+	if err != nil {
+		return netaddr.IP{}, err
+	}
+	return parseGateway(out, "via")
+}
+
+func (r *router) bypassVia(gateway, target netaddr.IP) error {
+	args := []string{
+		"ip",
+		"route",
+		"replace",
+		netaddr.IPPrefixFrom(target, 32).String(),
+		"via",
+		gateway.String(),
+	}
+	return runner.Run(args)
+}
+
+func (r *router) unbypass(target netaddr.IP) error {
+	args := []string{
+		"ip",
+		"route",
+		"del",
+		netaddr.IPPrefixFrom(target, 32).String(),
+	}
+	return runner.Run(args)
+}
@@ -0,0 +1,42 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"inet.af/netaddr"
+)
+
+func TestParseGateway(t *testing.T) {
+	linux := "default via 192.168.1.1 dev eth0 proto dhcp metric 100\n"
+	gw, err := parseGateway(linux, "via")
+	assert.Nil(t, err)
+	assert.Equal(t, netaddr.MustParseIP("192.168.1.1"), gw)
+
+	darwin := `   route to: default
+destination: default
+       mask: default
+    gateway: 10.0.0.1
+  interface: en0
+`
+	gw, err = parseGateway(darwin, "gateway:")
+	assert.Nil(t, err)
+	assert.Equal(t, netaddr.MustParseIP("10.0.0.1"), gw)
+
+	_, err = parseGateway("", "via")
+	assert.NotNil(t, err)
+}
@@ -1,30 +1,109 @@
 package device
 
 import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
 	"github.com/pairmesh/pairmesh/node/device/runner"
 
 	"inet.af/netaddr"
 )
 
-func (r *router) add(_ string, localAddress netaddr.IP, target netaddr.IPPrefix) error {
+func (r *router) add(devName string, localAddress netaddr.IP, target netaddr.IPPrefix) error {
+	// The IPv6 routes are bound to the device directly.
+	if target.IP().Is6() {
+		args := []string{
+			"netsh",
+			"interface",
+			"ipv6",
+			"add",
+			"route",
+			"prefix=" + target.Masked().String(),
+			fmt.Sprintf(`interface="%s"`, devName),
+			"store=active",
+		}
+		return runner.Run(args)
+	}
+
 	args := []string{
 		"route",
 		"add",
 		target.IP().String(),
 		"mask",
-		target.Masked().String(),
+		netmask(target),
 		localAddress.String(),
 	}
 	return runner.Run(args)
 }
 
-func (r *router) del(_ string, _ netaddr.IP, target netaddr.IPPrefix) error {
+func (r *router) del(devName string, _ netaddr.IP, target netaddr.IPPrefix) error {
+	if target.IP().Is6() {
+		args := []string{
+			"netsh",
+			"interface",
+			"ipv6",
+			"delete",
+			"route",
+			"prefix=" + target.Masked().String(),
+			fmt.Sprintf(`interface="%s"`, devName),
+		}
+		return runner.Run(args)
+	}
+
 	args := []string{
 		"route",
 		"delete ",
 		target.IP().String(),
 		"mask",
-		target.Masked().String(),
+		netmask(target),
+	}
+	return runner.Run(args)
+}
+
+// netmask returns the dotted decimal netmask of the IPv4 prefix.
+func netmask(target netaddr.IPPrefix) string {
+	return net.IP(target.IPNet().Mask).String()
+}
+
+func (r *router) forward(_ string, _ netaddr.IPPrefix) error {
+	return errors.New("forwarding is not supported on windows")
+}
+
+// gateway returns the next hop of the default route with the lowest metric.
+func (r *router) gateway() (netaddr.IP, error) {
+	out, err := runner.Output([]string{
+		"powershell",
+		"-NoProfile",
+		"-Command",
+		"(Get-NetRoute -DestinationPrefix 0.0.0.0/0 | Sort-Object RouteMetric | Select-Object -First 1).NextHop",
+	})
+	if err != nil {
+		return netaddr.IP{}, err
+	}
+	return netaddr.ParseIP(strings.TrimSpace(out))
+}
+
+func (r *router) bypassVia(gateway, target netaddr.IP) error {
+	args := []string{
+		"route",
+		"add",
+		target.String(),
+		"mask",
+		"255.255.255.255",
+		gateway.String(),
+	}
+	return runner.Run(args)
+}
+
+func (r *router) unbypass(target netaddr.IP) error {
+	args := []string{
+		"route",
+		"delete",
+		target.String(),
+		"mask",
+		"255.255.255.255",
 	}
 	return runner.Run(args)
 }
@@ -43,3 +43,23 @@ func Run(args []string) error {
 
 	return nil
 }
+
+// Output runs the command with arguments and returns the standard output.
+func Output(args []string) (string, error) {
+	if len(args) == 0 {
+		return "", errors.New("cmd: no argv[0]")
+	}
+
+	start := time.Now()
+	defer func() {
+		zap.L().Debug("Run command", zap.Strings("cmd", args), zap.Duration("duration", time.Since(start)))
+	}()
+
+	cmd := attr(exec.Command(args[0], args[1:]...))
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("running %q failed: %w", strings.Join(args, " "), err)
+	}
+
+	return string(out), nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"strings"
+
+	"github.com/pairmesh/pairmesh/protocol"
+
+	"inet.af/netaddr"
+)
+
+// Domain is the top level domain of the peer names.
+const Domain = "pairmesh"
+
+// Records returns the name table of the peers. The peer is named as
+// <device>.<user>.<network>.pairmesh in every network it belongs to, and
+// the names are lower case fully qualified domain names.
+func Records(networks []protocol.Network, peers []protocol.Peer) map[string][]netaddr.IP {
+	byID := make(map[protocol.PeerID]protocol.Peer, len(peers))
+	for _, p := range peers {
+		byID[p.ID] = p
+	}
+
+	records := map[string][]netaddr.IP{}
+	for _, n := range networks {
+		network := label(n.Name)
+		if network == "" {
+			continue
+		}
+		for _, peerID := range n.Peers {
+			p, found := byID[peerID]
+			if !found {
+				continue
+			}
+			device, user := label(p.Name), label(p.UserName)
+			if device == "" || user == "" {
+				continue
+			}
+			name := strings.Join([]string{device, user, network, Domain}, ".") + "."
+			for _, addr := range []string{p.IPv4, p.IPv6} {
+				ip, err := netaddr.ParseIP(addr)
+				if err != nil {
+					continue
+				}
+				records[name] = appendIP(records[name], ip)
+			}
+		}
+	}
+	return records
+}
+
+func appendIP(ips []netaddr.IP, ip netaddr.IP) []netaddr.IP {
+	for _, i := range ips {
+		if i == ip {
+			return ips
+		}
+	}
+	return append(ips, ip)
+}
+
+// label converts the name to a valid DNS label, the letters are converted to
+// lower case and other characters are replaced by hyphens.
+func label(name string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(name) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+			b.WriteRune(c)
+		default:
+			b.WriteByte('-')
+		}
+	}
+	l := strings.Trim(b.String(), "-")
+	// The max length of a DNS label is 63.
+	if len(l) > 63 {
+		l = strings.TrimRight(l[:63], "-")
+	}
+	return l
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"inet.af/netaddr"
+)
+
+const (
+	resolvConfPath = "/etc/resolv.conf"
+	// resolvConfBackupPath saves the original resolv.conf while it is
+	// overwritten by the resolver.
+	resolvConfBackupPath = "/etc/resolv.conf.pairmesh"
+)
+
+// defaultUpstreams are used if there is no system nameserver found.
+var defaultUpstreams = []string{"1.1.1.1:53", "8.8.8.8:53"}
+
+// parseResolvConf parses the nameservers and search domains of resolv.conf.
+func parseResolvConf(content string) (nameservers []string, search []string) {
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") || strings.HasPrefix(fields[0], ";") {
+			continue
+		}
+		switch fields[0] {
+		case "nameserver":
+			nameservers = append(nameservers, fields[1])
+		case "search", "domain":
+			search = append(search, fields[1:]...)
+		}
+	}
+	return
+}
+
+// systemUpstreams returns the nameservers of the system resolver except the
+// address of the resolver itself. The backup of resolv.conf is preferred if
+// it was overwritten by the resolver.
+func systemUpstreams(self netaddr.IP) []string {
+	content, err := os.ReadFile(resolvConfBackupPath)
+	if err != nil {
+		content, err = os.ReadFile(resolvConfPath)
+	}
+	if err != nil {
+		return defaultUpstreams
+	}
+
+	var upstreams []string
+	nameservers, _ := parseResolvConf(string(content))
+	for _, ns := range nameservers {
+		ip, err := netaddr.ParseIP(ns)
+		if err != nil || ip == self {
+			continue
+		}
+		upstreams = append(upstreams, net.JoinHostPort(ip.String(), strconv.Itoa(port)))
+	}
+	if len(upstreams) == 0 {
+		return defaultUpstreams
+	}
+	return upstreams
+}
@@ -0,0 +1,280 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dns implements the resolver of the peer names, which is bound to the
+// virtual address and answers the queries of the mesh names and forwards other
+// queries to the upstream nameservers.
+package dns
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/net/dns/dnsmessage"
+	"inet.af/netaddr"
+)
+
+const (
+	// port is the listening port of the resolver.
+	port = 53
+
+	// ttl is the TTL of the answered records in seconds.
+	ttl = 60
+
+	// maxMessageSize is the max size of DNS messages over UDP.
+	maxMessageSize = 65535
+
+	forwardTimeout = 5 * time.Second
+
+	// maxInflight caps the queries being resolved concurrently, and the queries
+	// received beyond it are dropped.
+	maxInflight = 64
+
+	// forwardRate is the max number of queries forwarded to the upstream
+	// nameservers per second. The resolver is reachable by peers, and the
+	// queries beyond it are refused so that it cannot be abused as an open
+	// recursive resolver.
+	forwardRate = 50
+)
+
+// Resolver resolves the peer names and forwards other queries to the upstream
+// nameservers.
+type Resolver struct {
+	devName   string
+	addr      netaddr.IP
+	upstreams []string
+
+	mu      sync.RWMutex
+	records map[string][]netaddr.IP
+
+	inflight chan struct{}
+
+	// The fixed window of the forwarded queries rate limit.
+	forwardMu     sync.Mutex
+	forwardWindow time.Time
+	forwarded     int
+}
+
+// New returns a resolver which will be bound to the address of the device. The
+// upstream nameservers are retrieved from the system configuration.
+func New(devName string, addr netaddr.IP) *Resolver {
+	return &Resolver{
+		devName:   devName,
+		addr:      addr,
+		upstreams: systemUpstreams(addr),
+		records:   map[string][]netaddr.IP{},
+		inflight:  make(chan struct{}, maxInflight),
+	}
+}
+
+// SetRecords replaces the name table of the peers.
+func (r *Resolver) SetRecords(records map[string][]netaddr.IP) {
+	r.mu.Lock()
+	r.records = records
+	r.mu.Unlock()
+}
+
+// Lookup returns the addresses of the peer name.
+func (r *Resolver) Lookup(name string) ([]netaddr.IP, bool) {
+	name = strings.ToLower(name)
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ips, found := r.records[name]
+	return ips, found
+}
+
+// Serve serves the DNS queries and configures the system resolver to use the
+// resolver for the mesh domain until the context is done.
+func (r *Resolver) Serve(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: r.addr.IPAddr().IP, Port: port})
+	if err != nil {
+		zap.L().Error("Listen DNS resolver failed", zap.Stringer("address", r.addr), zap.Error(err))
+		return
+	}
+
+	err = configure(r.devName, r.addr)
+	if err != nil {
+		zap.L().Error("Configure system resolver failed", zap.Error(err))
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	zap.L().Info("DNS resolver started", zap.Stringer("address", r.addr), zap.Strings("upstreams", r.upstreams))
+
+	buffer := make([]byte, maxMessageSize)
+	for {
+		n, remote, err := conn.ReadFromUDP(buffer)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+
+		select {
+		case r.inflight <- struct{}{}:
+		default:
+			zap.L().Debug("Drop DNS query due to too many inflight queries", zap.Stringer("remote", remote))
+			continue
+		}
+
+		query := make([]byte, n)
+		copy(query, buffer[:n])
+		go func() {
+			defer func() { <-r.inflight }()
+			res, err := r.resolve(query)
+			if err != nil {
+				zap.L().Debug("Resolve DNS query failed", zap.Error(err))
+				return
+			}
+			_, _ = conn.WriteToUDP(res, remote)
+		}()
+	}
+
+	err = restore(r.devName)
+	if err != nil {
+		zap.L().Error("Restore system resolver failed", zap.Error(err))
+	}
+	zap.L().Info("DNS resolver stopped")
+}
+
+// resolve answers the query of mesh names and forwards others to upstream.
+func (r *Resolver) resolve(query []byte) ([]byte, error) {
+	var p dnsmessage.Parser
+	header, err := p.Start(query)
+	if err != nil {
+		return nil, errors.WithMessage(err, "parse header")
+	}
+	q, err := p.Question()
+	if err != nil {
+		return nil, errors.WithMessage(err, "parse question")
+	}
+
+	name := strings.ToLower(q.Name.String())
+	if !strings.HasSuffix(name, "."+Domain+".") {
+		if !r.allowForward(time.Now()) {
+			return respond(header, q, dnsmessage.RCodeRefused, nil)
+		}
+		return r.forward(query)
+	}
+
+	ips, found := r.Lookup(name)
+	if !found {
+		return respond(header, q, dnsmessage.RCodeNameError, nil)
+	}
+	return respond(header, q, dnsmessage.RCodeSuccess, ips)
+}
+
+// allowForward returns whether the query can be forwarded to the upstream
+// nameservers in the current second.
+func (r *Resolver) allowForward(now time.Time) bool {
+	r.forwardMu.Lock()
+	defer r.forwardMu.Unlock()
+
+	if now.Sub(r.forwardWindow) >= time.Second {
+		r.forwardWindow = now
+		r.forwarded = 0
+	}
+	if r.forwarded >= forwardRate {
+		return false
+	}
+	r.forwarded++
+	return true
+}
+
+// respond builds the response of the question with the addresses matched the
+// question type.
+func respond(header dnsmessage.Header, q dnsmessage.Question, rcode dnsmessage.RCode, ips []netaddr.IP) ([]byte, error) {
+	header.Response = true
+	header.Authoritative = rcode != dnsmessage.RCodeRefused
+	header.RecursionAvailable = true
+	header.RCode = rcode
+
+	b := dnsmessage.NewBuilder(nil, header)
+	b.EnableCompression()
+	if err := b.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := b.Question(q); err != nil {
+		return nil, err
+	}
+	if err := b.StartAnswers(); err != nil {
+		return nil, err
+	}
+
+	rh := dnsmessage.ResourceHeader{Name: q.Name, Class: dnsmessage.ClassINET, TTL: ttl}
+	for _, ip := range ips {
+		var err error
+		switch {
+		case q.Type == dnsmessage.TypeA && ip.Is4():
+			err = b.AResource(rh, dnsmessage.AResource{A: ip.As4()})
+		case q.Type == dnsmessage.TypeAAAA && ip.Is6():
+			err = b.AAAAResource(rh, dnsmessage.AAAAResource{AAAA: ip.As16()})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b.Finish()
+}
+
+// forward forwards the query to the upstream nameservers one by one until
+// any nameserver responds.
+func (r *Resolver) forward(query []byte) ([]byte, error) {
+	err := errors.New("no upstream nameserver")
+	for _, upstream := range r.upstreams {
+		var res []byte
+		res, err = exchange(upstream, query)
+		if err == nil {
+			return res, nil
+		}
+	}
+	return nil, err
+}
+
+func exchange(upstream string, query []byte) ([]byte, error) {
+	conn, err := net.DialTimeout("udp", upstream, forwardTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	_ = conn.SetDeadline(time.Now().Add(forwardTimeout))
+	_, err = conn.Write(query)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := make([]byte, maxMessageSize)
+	n, err := conn.Read(buffer)
+	if err != nil {
+		return nil, err
+	}
+	return buffer[:n], nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/dns/dnsmessage"
+	"inet.af/netaddr"
+)
+
+func TestLabel(t *testing.T) {
+	a := assert.New(t)
+	a.Equal("macbook-pro-local", label("MacBook-Pro.local"))
+	a.Equal("jane-doe", label(" Jane Doe "))
+	a.Equal("", label("---"))
+}
+
+func TestParseResolvConf(t *testing.T) {
+	a := assert.New(t)
+	nameservers, search := parseResolvConf(`# comment
+nameserver 10.0.0.1
+nameserver 8.8.8.8
+search example.com corp.example.com
+options edns0
+`)
+	a.Equal([]string{"10.0.0.1", "8.8.8.8"}, nameservers)
+	a.Equal([]string{"example.com", "corp.example.com"}, search)
+}
+
+func TestResolve(t *testing.T) {
+	a := assert.New(t)
+
+	networks := []protocol.Network{{ID: 1, Name: "Home Lab", Peers: []protocol.PeerID{1, 2}}}
+	peers := []protocol.Peer{
+		{ID: 1, UserName: "Alice", Name: "laptop", IPv4: "10.0.0.1", IPv6: "fd70:6169:726d::1"},
+		{ID: 2, UserName: "Bob", Name: "NAS", IPv4: "10.0.0.2"},
+	}
+	r := &Resolver{}
+	r.SetRecords(Records(networks, peers))
+
+	ips, found := r.Lookup("nas.bob.home-lab.pairmesh")
+	a.True(found)
+	a.Equal([]netaddr.IP{netaddr.MustParseIP("10.0.0.2")}, ips)
+
+	query := func(name string, typ dnsmessage.Type) dnsmessage.Message {
+		b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: 1234, RecursionDesired: true})
+		a.Nil(b.StartQuestions())
+		a.Nil(b.Question(dnsmessage.Question{
+			Name:  dnsmessage.MustNewName(name),
+			Type:  typ,
+			Class: dnsmessage.ClassINET,
+		}))
+		q, err := b.Finish()
+		a.Nil(err)
+
+		res, err := r.resolve(q)
+		a.Nil(err)
+		var msg dnsmessage.Message
+		a.Nil(msg.Unpack(res))
+		return msg
+	}
+
+	msg := query("Laptop.Alice.home-lab.pairmesh.", dnsmessage.TypeA)
+	a.Equal(uint16(1234), msg.Header.ID)
+	a.Equal(dnsmessage.RCodeSuccess, msg.Header.RCode)
+	a.Len(msg.Answers, 1)
+	a.Equal([4]byte{10, 0, 0, 1}, msg.Answers[0].Body.(*dnsmessage.AResource).A)
+
+	msg = query("laptop.alice.home-lab.pairmesh.", dnsmessage.TypeAAAA)
+	a.Len(msg.Answers, 1)
+	a.Equal(netaddr.MustParseIP("fd70:6169:726d::1").As16(), msg.Answers[0].Body.(*dnsmessage.AAAAResource).AAAA)
+
+	msg = query("unknown.alice.home-lab.pairmesh.", dnsmessage.TypeA)
+	a.Equal(dnsmessage.RCodeNameError, msg.Header.RCode)
+	a.Empty(msg.Answers)
+
+	// The queries beyond the forwarding rate are refused without forwarding.
+	r.forwardWindow = time.Now()
+	r.forwarded = forwardRate
+	msg = query("example.com.", dnsmessage.TypeA)
+	a.Equal(dnsmessage.RCodeRefused, msg.Header.RCode)
+	a.Equal(uint16(1234), msg.Header.ID)
+	a.Empty(msg.Answers)
+}
+
+func TestAllowForward(t *testing.T) {
+	a := assert.New(t)
+	r := &Resolver{}
+	now := time.Now()
+	for i := 0; i < forwardRate; i++ {
+		a.True(r.allowForward(now))
+	}
+	a.False(r.allowForward(now.Add(time.Second / 2)))
+	a.True(r.allowForward(now.Add(time.Second)))
+}
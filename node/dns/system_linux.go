@@ -0,0 +1,113 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pairmesh/pairmesh/node/device/runner"
+
+	"inet.af/netaddr"
+)
+
+// systemdResolved returns whether the system resolver is managed by the
+// systemd-resolved service.
+func systemdResolved() bool {
+	if _, err := exec.LookPath("resolvectl"); err != nil {
+		return false
+	}
+	content, err := os.ReadFile(resolvConfPath)
+	if err != nil {
+		return false
+	}
+	nameservers, _ := parseResolvConf(string(content))
+	for _, ns := range nameservers {
+		if ns == "127.0.0.53" {
+			return true
+		}
+	}
+	return false
+}
+
+// configure configures the system resolver to resolve the mesh domain via the
+// address. The per-link configuration is used if the systemd-resolved service
+// is running, otherwise the resolv.conf is overwritten.
+func configure(devName string, addr netaddr.IP) error {
+	if systemdResolved() {
+		err := runner.Run([]string{"resolvectl", "dns", devName, addr.String()})
+		if err != nil {
+			return err
+		}
+		return runner.Run([]string{"resolvectl", "domain", devName, Domain})
+	}
+
+	// Backup the original resolv.conf if it isn't backed up. The backup may
+	// exist if the previous process exited unexpectedly.
+	if _, err := os.Stat(resolvConfBackupPath); errors.Is(err, os.ErrNotExist) {
+		original, err := os.ReadFile(resolvConfPath)
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(resolvConfBackupPath, original, 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	original, err := os.ReadFile(resolvConfBackupPath)
+	if err != nil {
+		return err
+	}
+
+	// The original nameservers are kept after the resolver for fallback.
+	_, search := parseResolvConf(string(original))
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Generated by PairMesh, the original file is saved as %s\n", resolvConfBackupPath)
+	fmt.Fprintf(&b, "nameserver %s\n", addr)
+	fmt.Fprintf(&b, "search %s\n", strings.Join(append([]string{Domain}, search...), " "))
+	for _, line := range strings.Split(string(original), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && (fields[0] == "search" || fields[0] == "domain") {
+			continue
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return os.WriteFile(resolvConfPath, []byte(strings.TrimRight(b.String(), "\n")+"\n"), 0644)
+}
+
+// restore restores the system resolver configuration.
+func restore(devName string) error {
+	if _, err := os.Stat(resolvConfBackupPath); err == nil {
+		original, err := os.ReadFile(resolvConfBackupPath)
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(resolvConfPath, original, 0644)
+		if err != nil {
+			return err
+		}
+		return os.Remove(resolvConfBackupPath)
+	}
+
+	if systemdResolved() {
+		return runner.Run([]string{"resolvectl", "revert", devName})
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+//go:build !linux
+// +build !linux
+
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import "inet.af/netaddr"
+
+// configure is not supported on the current platform and the resolver can be
+// queried via the virtual address directly.
+func configure(_ string, _ netaddr.IP) error {
+	return nil
+}
+
+func restore(_ string) error {
+	return nil
+}
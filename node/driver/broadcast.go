@@ -0,0 +1,223 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"hash/fnv"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pairmesh/pairmesh/pkg/logutil"
+	"github.com/pairmesh/pairmesh/protocol"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// broadcastRate and broadcastBurst limit the broadcast/multicast packets
+	// per second sent by the current node or received from every peer.
+	broadcastRate  = 50
+	broadcastBurst = 100
+
+	// broadcastDedupWindow is the window to drop the duplicated broadcast
+	// packets which are looped back.
+	broadcastDedupWindow = 2 * time.Second
+)
+
+// isBroadcast returns whether the packet is destined to the limited broadcast
+// address or a multicast address.
+func isBroadcast(b []byte) bool {
+	if len(b) < 1 {
+		return false
+	}
+	switch b[0] >> 4 {
+	case 4:
+		if len(b) < 20 {
+			return false
+		}
+		return b[16]&0xf0 == 0xe0 || (b[16] == 0xff && b[17] == 0xff && b[18] == 0xff && b[19] == 0xff)
+	case 6:
+		return len(b) >= 40 && b[24] == 0xff
+	}
+	return false
+}
+
+// parseSrc parses the source address of the IPv4/IPv6 packet.
+func parseSrc(b []byte) net.IP {
+	if len(b) < 1 {
+		return nil
+	}
+
+	switch b[0] >> 4 {
+	case 4:
+		if len(b) < 20 {
+			return nil
+		}
+		return net.IPv4(b[12], b[13], b[14], b[15])
+	case 6:
+		if len(b) < 40 {
+			return nil
+		}
+		src := make(net.IP, net.IPv6len)
+		copy(src, b[8:24])
+		return src
+	}
+	return nil
+}
+
+// bucket is a token bucket to limit the packets rate.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (b *bucket) allow(now time.Time) bool {
+	if b.last.IsZero() {
+		b.tokens = broadcastBurst
+	} else {
+		b.tokens += now.Sub(b.last).Seconds() * broadcastRate
+		if b.tokens > broadcastBurst {
+			b.tokens = broadcastBurst
+		}
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// broadcaster limits the rate of broadcast packets of every peer and drops the
+// duplicated packets to prevent the broadcast loops.
+type broadcaster struct {
+	mu      sync.Mutex
+	buckets map[protocol.PeerID]*bucket
+	seen    map[uint64]time.Time
+}
+
+func newBroadcaster() *broadcaster {
+	return &broadcaster{
+		buckets: map[protocol.PeerID]*bucket{},
+		seen:    map[uint64]time.Time{},
+	}
+}
+
+// allow returns whether the broadcast packet from the peer can be forwarded.
+// The packet is rejected if the rate of the peer exceeds the limit or the same
+// packet has been forwarded recently.
+func (b *broadcaster) allow(peerID protocol.PeerID, packet []byte) bool {
+	now := time.Now()
+	sum := packetHash(packet)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if at, found := b.seen[sum]; found && now.Sub(at) < broadcastDedupWindow {
+		return false
+	}
+
+	bk, found := b.buckets[peerID]
+	if !found {
+		bk = &bucket{}
+		b.buckets[peerID] = bk
+	}
+	if !bk.allow(now) {
+		return false
+	}
+
+	b.seen[sum] = now
+	return true
+}
+
+// prune removes the expired packet hashes and idle buckets.
+func (b *broadcaster) prune() {
+	now := time.Now()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sum, at := range b.seen {
+		if now.Sub(at) >= broadcastDedupWindow {
+			delete(b.seen, sum)
+		}
+	}
+	for peerID, bk := range b.buckets {
+		if now.Sub(bk.last) > time.Minute {
+			delete(b.buckets, peerID)
+		}
+	}
+}
+
+// packetHash hashes the packet except the TTL/hop limit and the checksum of the
+// IP header, which may be changed if the packet is looped back by routers.
+func packetHash(b []byte) uint64 {
+	h := fnv.New64a()
+	switch {
+	case len(b) >= 20 && b[0]>>4 == 4:
+		_, _ = h.Write(b[:8])
+		_, _ = h.Write(b[9:10])
+		_, _ = h.Write(b[12:])
+	case len(b) >= 40 && b[0]>>4 == 6:
+		_, _ = h.Write(b[:7])
+		_, _ = h.Write(b[8:])
+	default:
+		_, _ = h.Write(b)
+	}
+	return h.Sum64()
+}
+
+// broadcast fans out the broadcast/multicast packet to the peers which share
+// the broadcast enabled networks with the current node. Only the packets sent
+// by the current node are forwarded to prevent the broadcast loops.
+func (d *NodeDriver) broadcast(data []byte) {
+	src := parseSrc(data)
+	if src == nil || (src.String() != d.credential.address && src.String() != d.credential.address6) {
+		return
+	}
+
+	tunnels := d.mm.BroadcastTunnels()
+	if len(tunnels) == 0 || !d.broadcaster.allow(d.peerID, data) {
+		return
+	}
+
+	if logutil.IsEnableDevice() {
+		zap.L().Debug("Broadcast packet to peers", zap.Int("peers", len(tunnels)))
+	}
+
+	for _, t := range tunnels {
+		if !d.filter.Outbound(t.PeerID(), data) {
+			continue
+		}
+		t.Write(data)
+	}
+}
+
+// acceptBroadcast returns whether the broadcast/multicast packet received from
+// the peer can be written into the device.
+func (d *NodeDriver) acceptBroadcast(peerID protocol.PeerID, data []byte) bool {
+	p := d.mm.BroadcastPeer(peerID)
+	if p == nil {
+		return false
+	}
+
+	// The peer can only broadcast the packets sent by itself.
+	src := parseSrc(data)
+	if src == nil || (src.String() != p.IPv4() && src.String() != p.IPv6()) {
+		return false
+	}
+	return d.broadcaster.allow(peerID, data)
+}
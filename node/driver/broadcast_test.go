@@ -0,0 +1,76 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBroadcast(t *testing.T) {
+	a := assert.New(t)
+
+	ipv4 := make([]byte, 20)
+	ipv4[0] = 0x45
+	for _, c := range []struct {
+		dst       []byte
+		broadcast bool
+	}{
+		{[]byte{239, 255, 255, 250}, true},
+		{[]byte{224, 0, 0, 251}, true},
+		{[]byte{255, 255, 255, 255}, true},
+		{[]byte{10, 1, 2, 3}, false},
+		{[]byte{10, 255, 255, 255}, false},
+	} {
+		copy(ipv4[16:], c.dst)
+		a.Equal(c.broadcast, isBroadcast(ipv4), net.IP(c.dst).String())
+	}
+	a.False(isBroadcast(ipv4[:19]))
+
+	ipv6 := make([]byte, 40)
+	ipv6[0] = 0x60
+	copy(ipv6[24:], net.ParseIP("ff02::fb"))
+	a.True(isBroadcast(ipv6))
+	copy(ipv6[24:], net.ParseIP("fd70:6169:726d::2a"))
+	a.False(isBroadcast(ipv6))
+}
+
+func TestBroadcaster(t *testing.T) {
+	a := assert.New(t)
+
+	b := newBroadcaster()
+	packet := make([]byte, 28)
+	packet[0] = 0x45
+	packet[8] = 64
+	copy(packet[12:], []byte{10, 1, 2, 3, 224, 0, 0, 251})
+
+	// The looped back packet is dropped even if the TTL is changed.
+	a.True(b.allow(1, packet))
+	packet[8] = 63
+	a.False(b.allow(2, packet))
+
+	// The packets exceeding the burst are dropped.
+	allowed := 0
+	for i := 0; i < broadcastBurst*2; i++ {
+		packet[20] = byte(i)
+		packet[21] = byte(i >> 8)
+		if b.allow(3, packet) {
+			allowed++
+		}
+	}
+	a.Equal(broadcastBurst, allowed)
+}
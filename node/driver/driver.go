@@ -27,6 +27,8 @@ import (
 	"github.com/pairmesh/pairmesh/node/api"
 	"github.com/pairmesh/pairmesh/node/config"
 	"github.com/pairmesh/pairmesh/node/device"
+	"github.com/pairmesh/pairmesh/node/dns"
+	"github.com/pairmesh/pairmesh/node/filter"
 	"github.com/pairmesh/pairmesh/node/mesh"
 	"github.com/pairmesh/pairmesh/node/mesh/tunnel"
 	"github.com/pairmesh/pairmesh/node/mesh/types"
@@ -62,10 +64,19 @@ type Driver interface {
 	// will be dropped if the device in disabled status.
 	Disable()
 
+	// SetExitNode selects the peer with the name or address as the exit node,
+	// and the empty exit node means to stop using exit node.
+	SetExitNode(exitNode string) error
+
 	// Summarize summarizes the driver current state and returns the state and
 	// mesh summary.
 	Summarize() *Summary
 
+	// Probe sends the discovery messages to the peer with the address over the
+	// direct paths, and returns the endpoint and the latency of the first echo.
+	// The error is returned if no direct path echoes before the context is done.
+	Probe(ctx context.Context, address string) (string, time.Duration, error)
+
 	// Terminate closes the PairMesh engine.
 	Terminate()
 }
@@ -75,7 +86,8 @@ type Driver interface {
 // peerly application.
 // 1. Initialize all resources in the Initialize function.
 // 2. Some long-running routines:
-//    - Renew credential goroutine will keep the credential up to date.
+//    - Renew credential goroutine will keep the credential up to date, and
+//      re-authenticate the node if the credential expired during suspended.
 //    - Read tunnel device data goroutine will read the data from tunnel device.
 //    - Write tunnel device data goroutine will write the data to the tunnel device.
 // 3. Filter out data via filter.
@@ -85,20 +97,26 @@ type NodeDriver struct {
 	running      atomic.Bool // indicates whether the driver has been initialized.
 	termed       atomic.Bool // indicates whether the driver has been terminated.
 	enable       atomic.Bool
+	needsLogin   atomic.Bool // indicates whether the token is rejected and the user needs to login again.
 	chDevWrite   chan []byte
 	externalAddr atomic.String
+	latencies    atomic.Value // An atomic value of type: []protocol.RelayLatency
 
 	// Read-only fields after initialized.
-	apiClient *api.Client
-	config    *config.Config
-	peerID    protocol.PeerID
-	userID    protocol.UserID
-	name      string
-	dialer    *net.Dialer
-	mm        *mesh.Manager
-	rm        *relay.Manager
-	device    device.Device
-	mon       *monitor.Monitor
+	apiClient   *api.Client
+	config      *config.Config
+	peerID      protocol.PeerID
+	userID      protocol.UserID
+	name        string
+	ephemeral   bool
+	dialer      *net.Dialer
+	mm          *mesh.Manager
+	rm          *relay.Manager
+	filter      *filter.Filter
+	broadcaster *broadcaster
+	resolver    *dns.Resolver
+	device      device.Device
+	mon         *monitor.Monitor
 
 	// Driver will keep updating local endpoints to the primary relay server.
 	// The field primaryServerConnected is used to indicate the status
@@ -106,6 +124,13 @@ type NodeDriver struct {
 	// Note: It is only accessed by update endpoints thread.
 	primaryServerConnected bool
 
+	// The credential is renewed through the portal client and passed to the
+	// relay manager by the following interfaces, and the wall clock is read
+	// from now, which are replaced by the fakes in tests.
+	credClient credentialClient
+	credHolder credentialHolder
+	now        func() time.Time
+
 	// mu is used to protect the following fields.
 	mu         sync.Mutex //nolint ; should be used somewhere
 	credential credential
@@ -114,12 +139,15 @@ type NodeDriver struct {
 // New constructs the engines instance.
 func New(cfg *config.Config, dev device.Device, apiClient *api.Client) Driver {
 	return &NodeDriver{
-		wg:         &sync.WaitGroup{},
-		enable:     *atomic.NewBool(true),
-		chDevWrite: make(chan []byte, 512),
-		apiClient:  apiClient,
-		config:     cfg,
-		device:     dev,
+		wg:          &sync.WaitGroup{},
+		enable:      *atomic.NewBool(true),
+		chDevWrite:  make(chan []byte, 512),
+		broadcaster: newBroadcaster(),
+		apiClient:   apiClient,
+		config:      cfg,
+		device:      dev,
+		credClient:  apiClient,
+		now:         wallClock,
 	}
 }
 
@@ -132,7 +160,7 @@ func (d *NodeDriver) Preflight() error {
 
 	// Send a request to the portal service Preflight interface to
 	// retrieve the initial data essential to initialize the driver.
-	res, err := d.apiClient.Preflight(runtime.GOOS, hostname)
+	res, err := d.apiClient.Preflight(runtime.GOOS, hostname, d.config.AdvertiseRoutes, d.config.AdvertiseExitNode, d.relayLatencies())
 	if err != nil {
 		return err
 	}
@@ -158,23 +186,51 @@ func (d *NodeDriver) Preflight() error {
 	}
 	d.credential = credential{
 		address:   res.IPv4,
+		address6:  res.IPv6,
 		RawBytes:  cred,
 		Base64:    res.Credential,
-		RenewedAt: time.Now(),
+		RenewedAt: d.now(),
 		Lease:     time.Duration(res.CredentialLease) * time.Second,
 	}
 	d.userID = res.UserID
 	d.peerID = res.ID
 	d.name = res.Name
+	d.ephemeral = res.Ephemeral
 
 	// Up the virtual device with the specified address which is allocated
 	// by the portal service.
-	err = d.device.Up(res.IPv4)
+	err = d.device.Up(res.IPv4, res.IPv6)
 	if err != nil {
 		return errors.WithMessage(err, "set device address")
 	}
 
-	zap.L().Info("Set virtual device address finished", zap.String("address", res.IPv4))
+	zap.L().Info("Set virtual device address finished", zap.String("address", res.IPv4), zap.String("address6", res.IPv6))
+
+	// Forward the traffics to the advertised subnets for peers.
+	if len(d.config.AdvertiseRoutes) > 0 {
+		var routes []netaddr.IPPrefix
+		for _, r := range d.config.AdvertiseRoutes {
+			prefix, err := netaddr.ParseIPPrefix(r)
+			if err != nil {
+				return errors.WithMessagef(err, "parse advertised route %s", r)
+			}
+			routes = append(routes, prefix)
+		}
+		err = d.device.Router().Forward(routes)
+		if err != nil {
+			return errors.WithMessage(err, "forward advertised routes")
+		}
+		zap.L().Info("Advertise subnet routes", zap.Strings("routes", d.config.AdvertiseRoutes))
+	}
+
+	// Forward and masquerade the internet traffics for peers.
+	if d.config.AdvertiseExitNode {
+		err = d.device.Router().Forward([]netaddr.IPPrefix{netaddr.IPPrefixFrom(netaddr.IPv4(0, 0, 0, 0), 0)})
+		if err != nil {
+			return errors.WithMessage(err, "forward internet traffics")
+		}
+		zap.L().Info("Advertise as exit node")
+	}
 
 	// Preflight the monitor service which is used to discover external address.
 	d.mon = monitor.New(d.dialer, res.PrimaryServer)
@@ -182,20 +238,39 @@ func (d *NodeDriver) Preflight() error {
 	// Register all relay clients into the relay manager
 	d.rm = relay.NewManager(d.config.DHKey, d)
 	d.rm.SetCredential(cred)
+	d.credHolder = d.rm
 
 	vIPV4Addr, err := netaddr.ParseIP(res.IPv4)
 	if err != nil {
 		return errors.WithMessage(err, "parse ipv4 address")
 	}
 
+	// The IPv6 address is absent if the portal service doesn't support IPv6.
+	var vIPV6Addr netaddr.IP
+	if res.IPv6 != "" {
+		vIPV6Addr, err = netaddr.ParseIP(res.IPv6)
+		if err != nil {
+			return errors.WithMessage(err, "parse ipv6 address")
+		}
+	}
+
 	nodeInfo := types.LocalPeer{
 		Name:   res.Name,
 		UserID: res.UserID,
 		PeerID: res.ID,
 		Key:    d.config.DHKey,
 		VIPv4:  vIPV4Addr,
+		VIPv6:  vIPV6Addr,
 	}
+	d.filter = filter.New(res.ID)
 	d.mm = mesh.NewManager(d.dialer, nodeInfo, d, d.rm, d.device.Router())
+	d.mm.SetExitNode(d.config.ExitNode)
+
+	// Resolve the peer names via the resolver bound to the virtual address.
+	if !d.config.DisableMagicDNS {
+		d.resolver = dns.New(d.device.Name(), vIPV4Addr)
+		d.mm.SetResolver(d.resolver)
+	}
 
 	zap.L().Info("Driver preflight finished")
 
@@ -231,6 +306,11 @@ func (d *NodeDriver) Drive(ctx context.Context) {
 	d.wg.Add(1)
 	go d.renewCredential(ctx)
 
+	if d.resolver != nil {
+		d.wg.Add(1)
+		go d.resolver.Serve(ctx, d.wg)
+	}
+
 	zap.L().Info("All background threads running")
 }
 
@@ -246,6 +326,35 @@ func (d *NodeDriver) Disable() {
 	d.enable.Store(false)
 }
 
+// Probe implements the Driver interface.
+func (d *NodeDriver) Probe(ctx context.Context, address string) (string, time.Duration, error) {
+	if !d.running.Load() {
+		return "", 0, errors.New("driver is not running")
+	}
+	t := d.mm.Tunnel(address)
+	if t == nil {
+		return "", 0, errors.Errorf("no tunnel to %s", address)
+	}
+	endpoint, latency, ok := t.Probe(ctx)
+	if !ok {
+		return "", 0, errors.Errorf("no direct path to %s", address)
+	}
+	return endpoint, latency, nil
+}
+
+// SetExitNode implements the Driver interface.
+func (d *NodeDriver) SetExitNode(exitNode string) error {
+	err := d.config.SetExitNode(exitNode)
+	if err != nil {
+		return err
+	}
+	if d.running.Load() {
+		d.mm.SetExitNode(exitNode)
+	}
+	zap.L().Info("Set exit node", zap.String("exit_node", exitNode))
+	return nil
+}
+
 // Summarize implements the Driver interface.
 func (d *NodeDriver) Summarize() *Summary {
 	if mockSummary {
@@ -253,12 +362,19 @@ func (d *NodeDriver) Summarize() *Summary {
 	}
 
 	status := "connecting"
-	if d.primaryServerConnected {
+	if d.needsLogin.Load() {
+		status = "needs_login"
+	} else if d.primaryServerConnected {
 		status = "connected"
 	}
-	var meshSummary *mesh.Summary
+	var (
+		meshSummary *mesh.Summary
+		drops       *filter.DropStats
+	)
 	if d.running.Load() {
 		meshSummary = d.mm.Summarize()
+		stats := d.filter.DropStats()
+		drops = &stats
 	} else {
 		meshSummary = &mesh.Summary{}
 	}
@@ -268,9 +384,11 @@ func (d *NodeDriver) Summarize() *Summary {
 		Profile: &Profile{
 			UserID: uint64(d.userID),
 			IPv4:   d.credential.address,
+			IPv6:   d.credential.address6,
 			Name:   d.name,
 		},
-		Mesh: meshSummary,
+		Mesh:  meshSummary,
+		Drops: drops,
 	}
 }
 
@@ -291,6 +409,19 @@ func (d *NodeDriver) Terminate() {
 	// Wait all goroutines exit
 	d.wg.Wait()
 
+	// Restore the routes of internet traffics.
+	d.mm.SetExitNode("")
+
+	// The device registered with an ephemeral key is removed immediately
+	// after logged out, instead of waiting for offline long enough.
+	if d.ephemeral && !d.config.IsGuest() {
+		d.apiClient.Logout()
+		d.config.Token = ""
+		if err := d.config.Save(); err != nil {
+			zap.L().Error("Save changed configuration failed", zap.Error(err))
+		}
+	}
+
 	zap.L().Info("The Driver is powered off, see you again")
 }
 
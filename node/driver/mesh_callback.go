@@ -14,7 +14,26 @@
 
 package driver
 
+import (
+	"github.com/pairmesh/pairmesh/pkg/logutil"
+	"github.com/pairmesh/pairmesh/protocol"
+
+	"go.uber.org/zap"
+)
+
 // OnFragment implements the mesh.PacketCallback
-func (d *NodeDriver) OnFragment(data []byte) {
+func (d *NodeDriver) OnFragment(peerID protocol.PeerID, data []byte) {
+	if isBroadcast(data) && !d.acceptBroadcast(peerID, data) {
+		if logutil.IsEnableDevice() {
+			zap.L().Debug("Drop broadcast packet", zap.Any("from", peerID))
+		}
+		return
+	}
+	if !d.filter.Inbound(peerID, data) {
+		if logutil.IsEnableDevice() {
+			zap.L().Debug("Drop packet by ACL policy", zap.Any("from", peerID))
+		}
+		return
+	}
 	d.chDevWrite <- data
 }
@@ -22,7 +22,8 @@ import (
 	"go.uber.org/zap"
 )
 
-// pullPeerGraph updates peers graph from portal service periodically.
+// pullPeerGraph updates peers graph from portal service periodically, and
+// applies the changes pushed by the portal service once received.
 func (d *NodeDriver) pullPeerGraph(ctx context.Context) {
 	defer d.wg.Done()
 
@@ -31,6 +32,72 @@ func (d *NodeDriver) pullPeerGraph(ctx context.Context) {
 	uniqHash := ""
 	pullTimer := time.After(0)
 	tickTimer := time.After(0)
+	latencyTimer := time.After(relayLatencyDelay)
+
+	deltas := make(chan *protocol.PeerGraphDelta)
+	d.wg.Add(1)
+	go d.watchPeerGraph(ctx, deltas)
+
+	// The previous primary relay server is released after a grace period when
+	// the primary relay server is switched.
+	var (
+		graph             *protocol.PeerGraphResponse
+		relayServers      []protocol.RelayServer
+		primary, previous protocol.RelayServer
+		releaseTimer      <-chan time.Time
+	)
+
+	// update applies the latest peer graph, and only the changed peers are
+	// applied to the mesh manager if the peer graph is updated by the delta.
+	update := func(res *protocol.PeerGraphResponse, delta *protocol.PeerGraphDelta) {
+		// Update the latest unique hash.
+		uniqHash = res.UniqueHash
+
+		// Since response is already formatted so that res.Peers is sorted by peerID,
+		// using binary search to find the serverID by matching peerID.
+		primaryServerID := d.findServerIDWithPeerID(res)
+
+		if primaryServerID == 0 {
+			zap.L().Error("Illegal peer graph response, cannot find primary server id")
+			return
+		}
+
+		var primaryServer protocol.RelayServer
+		for _, r := range res.RelayServers {
+			if r.ID == primaryServerID {
+				primaryServer = r
+				break
+			}
+		}
+		if primaryServer.ID == 0 {
+			zap.L().Error("Illegal peer graph response, cannot find primary server by id",
+				zap.Any("server_id", primaryServerID))
+			return
+		}
+
+		if primary.ID != 0 && primary.ID != primaryServer.ID {
+			zap.L().Info("Primary relay server changed", zap.String("from", primary.Name), zap.String("to", primaryServer.Name))
+			previous = primary
+			releaseTimer = time.After(primaryMigrationGrace)
+		}
+		graph = res
+		primary = primaryServer
+		relayServers = res.RelayServers
+		d.setPrimaryServer(ctx, primary, previous)
+		d.mm.SetRelayServers(res.RelayServers)
+		d.filter.Update(res.Networks, res.Peers, res.ACL)
+
+		var err error
+		if delta != nil {
+			err = d.mm.ApplyPeerEvents(res.Networks, delta.Events)
+		} else {
+			err = d.mm.Update(res.Networks, res.Peers)
+		}
+		if err != nil {
+			zap.L().Error("Error updating peers to network", zap.Error(err))
+		}
+	}
+
 	for {
 		select {
 		case <-pullTimer:
@@ -48,43 +115,49 @@ func (d *NodeDriver) pullPeerGraph(ctx context.Context) {
 			if res.NotModified {
 				continue
 			}
-
-			// Update the latest unique hash.
-			uniqHash = res.UniqueHash
-
-			// Since response is already formatted so that res.Peers is sorted by peerID,
-			// using binary search to find the serverID by matching peerID.
-			primaryServerID := d.findServerIDWithPeerID(res)
-
-			if primaryServerID == 0 {
-				zap.L().Error("Illegal peer graph response, cannot find primary server id")
-				continue
+			update(res, nil)
+
+		case delta := <-deltas:
+			switch {
+			case graph != nil && delta.UniqueHash == graph.UniqueHash:
+				// The peer graph had been pulled already.
+			case delta.Full != nil:
+				update(delta.Full, nil)
+			case graph == nil || delta.BaseHash != graph.UniqueHash:
+				// The changes are based on the unknown peer graph, and the
+				// complete peer graph is pulled instead.
+				zap.L().Info("Peer graph changes out of sync", zap.String("base", delta.BaseHash))
+				pullTimer = time.After(0)
+			default:
+				zap.L().Info("Peer graph changed", zap.Int("events", len(delta.Events)), zap.Bool("topology", delta.TopologyChanged))
+				update(applyPeerGraphDelta(graph, delta), delta)
 			}
 
-			var primaryServer protocol.RelayServer
-			for _, r := range res.RelayServers {
-				if r.ID == primaryServerID {
-					primaryServer = r
-					break
-				}
+		case <-latencyTimer:
+			latencyTimer = time.After(relayLatencyInterval)
+			selected, err := d.reportRelayLatency(ctx, relayServers)
+			if err != nil {
+				zap.L().Error("Report relay server latencies failed", zap.Error(err))
+				continue
 			}
-			if primaryServer.ID == 0 {
-				zap.L().Error("Illegal peer graph response, cannot find primary server by id",
-					zap.Any("server_id", primaryServerID))
+			if selected.ID == 0 || selected.ID == primary.ID {
 				continue
 			}
+			zap.L().Info("Switch primary relay server", zap.String("from", primary.Name), zap.String("to", selected.Name))
+			previous, primary = primary, selected
+			releaseTimer = time.After(primaryMigrationGrace)
+			d.setPrimaryServer(ctx, primary, previous)
 
-			d.mon.SetSTUNServer(primaryServer)
-			d.rm.SetPrimaryServerID(primaryServerID)
-			d.rm.Update(ctx, res.RelayServers)
-			err = d.mm.Update(res.Networks, res.Peers)
-			if err != nil {
-				zap.L().Error("Error updating peers to network", zap.Error(err))
-			}
+		case <-releaseTimer:
+			releaseTimer = nil
+			previous = protocol.RelayServer{}
+			d.setPrimaryServer(ctx, primary, previous)
 
 		case <-tickTimer:
 			d.rm.Tick(ctx)
 			d.mm.Tick()
+			d.filter.Prune()
+			d.broadcaster.prune()
 			tickTimer = time.After(tickInterval)
 
 		case <-ctx.Done():
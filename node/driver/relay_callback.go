@@ -49,16 +49,12 @@ func (d *NodeDriver) OnForward(_ *relay.Client, typ message.PacketType, msg prot
 		return fmt.Errorf("no peer catchup ack received (peer id: %d)", forward.SrcPeerID)
 	}
 
-	decrypted, err := t.Cipher().Decrypt(nil, uint64(forward.Nonce), nil, forward.Fragment)
+	// Write the forwarded message into the device pipeline.
+	err := t.OnForward(forward.Nonce, forward.Fragment)
 	if err != nil {
 		return errors.WithMessage(err, "decrypt fragment failed")
 	}
 
-	// Write the forwarded message into the device pipeline.
-	dataCopy := make([]byte, len(decrypted))
-	copy(dataCopy, decrypted)
-	d.chDevWrite <- dataCopy
-
 	return nil
 }
 
@@ -72,19 +68,6 @@ func (d *NodeDriver) OnSyncPeer(_ *relay.Client, _ message.PacketType, msg proto
 
 	switch syncPeer.Purpose {
 	case message.PacketSyncPeer_Catchup:
-		// If the message.PairRequest is called by the peer side, we may have no
-		// idea about its primary relay server.
-		if ps := syncPeer.Peer.PrimaryServer; ps != nil {
-			relayServer := protocol.RelayServer{
-				ID:        protocol.ServerID(ps.ID),
-				Name:      ps.Name,
-				Region:    ps.Region,
-				Host:      ps.Host,
-				Port:      int(ps.Port),
-				PublicKey: ps.PublicKey,
-			}
-			d.rm.AddServer(relayServer)
-		}
 		err := d.mm.PeerCatchup(syncPeer)
 		if err != nil {
 			zap.L().Error("Peer catchup failed", zap.Error(err))
@@ -97,14 +80,9 @@ func (d *NodeDriver) OnSyncPeer(_ *relay.Client, _ message.PacketType, msg proto
 	case message.PacketSyncPeer_PairRequest:
 		d.mm.PeerEndpoints(syncPeer)
 
-		peer := d.mm.Peer(protocol.PeerID(syncPeer.Peer.PeerID))
-
-		// Send the pair request packet to the remote peer.
-		relayClient := d.rm.RelayServerClient(peer.PrimaryServerID())
-		if relayClient == nil {
-			// Fallback to the local peer primary client
-			relayClient = d.rm.PrimaryRelayServerClient()
-		}
+		// Send the pair request packet to the remote peer via the primary relay
+		// server, which forwards it to the relay server of the remote peer.
+		relayClient := d.rm.PrimaryRelayServerClient()
 		if relayClient == nil {
 			zap.L().Error("Cannot find the relay server for remote peer", zap.Any("peer_id", syncPeer.Peer.PeerID))
 			return nil
@@ -137,6 +115,9 @@ func (d *NodeDriver) OnSyncPeer(_ *relay.Client, _ message.PacketType, msg proto
 
 	case message.PacketSyncPeer_PairResponse, message.PacketSyncPeer_EndpointsChanged:
 		d.mm.PeerEndpoints(syncPeer)
+
+	case message.PacketSyncPeer_Rekey, message.PacketSyncPeer_RekeyAck:
+		d.mm.PeerRekey(syncPeer)
 	}
 
 	return nil
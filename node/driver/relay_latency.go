@@ -0,0 +1,82 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"time"
+
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// relayLatencyDelay is the delay of the first latency measurement after the
+	// driver started, and the measurement is repeated every relayLatencyInterval.
+	relayLatencyDelay    = 30 * time.Second
+	relayLatencyInterval = 5 * time.Minute
+
+	// primaryMigrationGrace is the duration to keep the connection to the previous
+	// primary relay server after switched, which allows the packets in flight
+	// and the routes of other relay servers to converge.
+	primaryMigrationGrace = 10 * time.Second
+)
+
+// relayLatencies returns the latest measured latencies to the relay servers.
+func (d *NodeDriver) relayLatencies() []protocol.RelayLatency {
+	v := d.latencies.Load()
+	if v == nil {
+		return nil
+	}
+	return v.([]protocol.RelayLatency)
+}
+
+// reportRelayLatency measures the latencies to the candidate relay servers and
+// reports them to the portal service, and returns the primary relay server
+// selected by the portal service.
+func (d *NodeDriver) reportRelayLatency(ctx context.Context, relayServers []protocol.RelayServer) (protocol.RelayServer, error) {
+	latencies := d.mon.MeasureLatency(ctx, relayServers)
+	if len(latencies) == 0 {
+		return protocol.RelayServer{}, errors.New("no relay server is reachable")
+	}
+	d.latencies.Store(latencies)
+
+	for _, l := range latencies {
+		zap.L().Debug("Measured relay server latency", zap.Any("server_id", l.ServerID), zap.Duration("rtt", l.RTT))
+	}
+
+	res, err := d.apiClient.RelayLatency(latencies)
+	if err != nil {
+		return protocol.RelayServer{}, err
+	}
+	return res.PrimaryServer, nil
+}
+
+// setPrimaryServer sets the primary relay server and connects to it. The
+// previous primary relay server is kept connected if it isn't empty, so that
+// the node is always reachable during switching the primary relay server.
+func (d *NodeDriver) setPrimaryServer(ctx context.Context, primary, previous protocol.RelayServer) {
+	d.mon.SetSTUNServer(primary)
+	d.rm.SetPrimaryServerID(primary.ID)
+
+	// Only the primary relay server is connected, which forwards the packets
+	// to the relay servers of the remote peers.
+	relayServers := []protocol.RelayServer{primary}
+	if previous.ID != 0 && previous.ID != primary.ID {
+		relayServers = append(relayServers, previous)
+	}
+	d.rm.Update(ctx, relayServers)
+}
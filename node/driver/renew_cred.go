@@ -17,13 +17,56 @@ package driver
 import (
 	"context"
 	"encoding/base64"
+	"os"
+	"runtime"
 	"time"
 
+	"github.com/pairmesh/pairmesh/errcode"
+	"github.com/pairmesh/pairmesh/internal/jsonapi"
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
 
+const (
+	// credentialCheckInterval is the interval to check whether the credential
+	// needs to be renewed.
+	credentialCheckInterval = 10 * time.Second
+
+	// clockDriftThreshold is the drift between the wall clock and the monotonic
+	// clock, which is regarded as the system suspended or the clock jumped.
+	clockDriftThreshold = 30 * time.Second
+
+	// reauthRetryInterval is the interval to retry the re-authentication.
+	reauthRetryInterval = time.Minute
+)
+
+type (
+	// credentialClient is the portal API to renew the credential and
+	// re-authenticate the node, which is implemented by *api.Client.
+	credentialClient interface {
+		RenewCredential(credential string) (*protocol.RenewCredentialResponse, error)
+		KeyExchange() (*protocol.KeyExchangeResponse, error)
+		SetToken(key string)
+		Preflight(os, hostname string, routes []string, exitNode bool, latencies []protocol.RelayLatency) (*protocol.PreflightResponse, error)
+	}
+
+	// credentialHolder holds the credential to handshake with the relay
+	// servers, which is implemented by *relay.Manager.
+	credentialHolder interface {
+		SetCredential(credential []byte)
+		Reconnect()
+	}
+)
+
+// wallClock returns the wall clock reading of the current time.
+func wallClock() time.Time {
+	return time.Now().Round(0)
+}
+
 type credential struct {
-	address string
+	address  string
+	address6 string
 	// The raw bytes representation of credential
 	RawBytes []byte
 	// The BASE64 representation of credential
@@ -34,47 +77,157 @@ type credential struct {
 	Lease time.Duration
 }
 
-func (d *NodeDriver) renewCredential(ctx context.Context) {
-	defer d.wg.Done()
+// expiresAt returns the wall clock time when the credential expires. The
+// monotonic clock reading is stripped because it stops while the system is
+// suspended but the lease doesn't.
+func (c *credential) expiresAt() time.Time {
+	return c.RenewedAt.Round(0).Add(c.Lease)
+}
 
-	nextRenewTime := func() <-chan time.Time {
-		// Next retry renew time
-		expirationAt := d.credential.RenewedAt.Add(d.credential.Lease)
-		if expirationAt.Before(time.Now()) {
-			zap.L().Fatal("The credential is invalid due to expiration")
-		}
+// renewAt returns the wall clock time to renew the credential.
+func (c *credential) renewAt() time.Time {
+	return c.RenewedAt.Round(0).Add(c.Lease / 2)
+}
+
+// credentialTimer decides when to refresh the credential. The wall clock keeps
+// going while the monotonic clock stops when the system is suspended, e.g. a
+// laptop sleeps over a weekend, so the drift between the wall clock and the
+// ticks means the credential may have expired and is refreshed immediately.
+type credentialTimer struct {
+	now       func() time.Time
+	lastWall  time.Time
+	lastTick  time.Time
+	nextRenew time.Time
+}
+
+func newCredentialTimer(now func() time.Time, tick, nextRenew time.Time) *credentialTimer {
+	return &credentialTimer{now: now, lastWall: now(), lastTick: tick, nextRenew: nextRenew}
+}
 
-		return time.After(time.Until(expirationAt) / 2)
+// due reports whether the credential should be refreshed at the tick, which
+// carries the monotonic clock reading.
+func (t *credentialTimer) due(tick time.Time) bool {
+	wall := t.now()
+	drift := wall.Sub(t.lastWall) - tick.Sub(t.lastTick)
+	t.lastWall, t.lastTick = wall, tick
+	if drift > clockDriftThreshold || drift < -clockDriftThreshold {
+		zap.L().Info("System suspend or clock jump detected", zap.Duration("drift", drift))
+		t.nextRenew = wall
 	}
+	return !wall.Before(t.nextRenew)
+}
+
+func (d *NodeDriver) renewCredential(ctx context.Context) {
+	defer d.wg.Done()
+
+	ticker := time.NewTicker(credentialCheckInterval)
+	defer ticker.Stop()
 
-	renewCredTimer := nextRenewTime()
+	timer := newCredentialTimer(d.now, time.Now(), d.credential.renewAt())
 	for {
 		select {
 		case <-ctx.Done():
 			zap.L().Info("Heartbeat and renew goroutine stopped")
 			return
 
-		case <-renewCredTimer:
-			res, err := d.apiClient.RenewCredential(d.credential.Base64)
-			if err != nil {
-				zap.L().Error("Renew the credential failed", zap.Error(err))
-				renewCredTimer = nextRenewTime()
-				continue
+		case tick := <-ticker.C:
+			if timer.due(tick) {
+				timer.nextRenew = d.refreshCredential()
 			}
+		}
+	}
+}
 
-			// Parse the credential
-			credential, err := base64.RawStdEncoding.DecodeString(res.Credential)
-			if err != nil {
-				zap.L().Error("Decode base64 credential failed", zap.Error(err), zap.String("credential", res.Credential))
-				renewCredTimer = nextRenewTime()
-				continue
-			}
-			d.credential.RawBytes = credential
-			d.credential.Base64 = res.Credential
-			d.credential.Lease = time.Duration(res.CredentialLease) * time.Second
-			d.credential.RenewedAt = time.Now()
-			d.rm.SetCredential(credential)
-			renewCredTimer = nextRenewTime()
+// refreshCredential renews the credential, or re-authenticates the node if
+// the credential has expired, and returns the next time to refresh.
+func (d *NodeDriver) refreshCredential() time.Time {
+	if d.now().Before(d.credential.expiresAt()) {
+		res, err := d.credClient.RenewCredential(d.credential.Base64)
+		if err == nil {
+			err = d.setCredential(res.Credential, res.CredentialLease)
+		}
+		if err == nil {
+			// The revoked device may be restored later.
+			d.needsLogin.Store(false)
+			return d.credential.renewAt()
+		}
+		zap.L().Error("Renew the credential failed", zap.Error(err))
+		if jsonapi.IsErrCode(err, errcode.DeviceRevoked) {
+			d.needsLogin.Store(true)
+			return d.now().Add(reauthRetryInterval)
+		}
+
+		// Retry at the half of the remaining lease.
+		expiresAt := d.credential.expiresAt()
+		if remaining := expiresAt.Sub(d.now()); remaining > 0 {
+			return expiresAt.Add(-remaining / 2)
+		}
+	}
+
+	zap.L().Warn("The credential is invalid due to expiration, re-authenticate the node")
+	err := d.reauthenticate()
+	if err != nil {
+		zap.L().Error("Re-authenticate the node failed", zap.Error(err))
+		if jsonapi.IsErrCode(err, errcode.InvalidToken) ||
+			jsonapi.IsErrCode(err, errcode.InvalidSecretKey) ||
+			jsonapi.IsErrCode(err, errcode.DeviceRevoked) {
+			d.needsLogin.Store(true)
+		}
+		return d.now().Add(reauthRetryInterval)
+	}
+
+	d.needsLogin.Store(false)
+	zap.L().Info("Re-authenticate the node finished")
+
+	// The relay servers reject the expired credential, so the sessions cannot
+	// be resumed and have to be handshake again with the new credential.
+	d.credHolder.Reconnect()
+	return d.credential.renewAt()
+}
+
+// reauthenticate exchanges a fresh credential with the stored token through
+// the preflight procedure.
+func (d *NodeDriver) reauthenticate() error {
+	if d.config.IsAuthKey() {
+		res, err := d.credClient.KeyExchange()
+		if err != nil {
+			return errors.WithMessage(err, "exchange auth key")
+		}
+		if err := d.config.SetJWTToken(res.AccessToken); err != nil {
+			return errors.WithMessage(err, "save token")
 		}
+		d.credClient.SetToken(d.config.Token)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		zap.L().Error("Retrieve the host name failed", zap.Error(err))
+	}
+	res, err := d.credClient.Preflight(runtime.GOOS, hostname, d.config.AdvertiseRoutes, d.config.AdvertiseExitNode, d.relayLatencies())
+	if err != nil {
+		return err
+	}
+
+	// The virtual device cannot be re-addressed while running.
+	if res.ID != d.peerID || res.IPv4 != d.credential.address || res.IPv6 != d.credential.address6 {
+		d.needsLogin.Store(true)
+		return errors.Errorf("the device has been re-allocated as %d (%s)", res.ID, res.IPv4)
+	}
+
+	return d.setCredential(res.Credential, res.CredentialLease)
+}
+
+// setCredential replaces the credential with the BASE64 encoded one and
+// passes it to the relay manager.
+func (d *NodeDriver) setCredential(encoded string, lease uint64) error {
+	cred, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return errors.WithMessagef(err, "decode base64 credential %s", encoded)
 	}
+	d.credential.RawBytes = cred
+	d.credential.Base64 = encoded
+	d.credential.Lease = time.Duration(lease) * time.Second
+	d.credential.RenewedAt = d.now()
+	d.credHolder.SetCredential(cred)
+	return nil
 }
@@ -0,0 +1,239 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pairmesh/pairmesh/errcode"
+	"github.com/pairmesh/pairmesh/internal/jsonapi"
+	"github.com/pairmesh/pairmesh/node/config"
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCredentialClient struct {
+	renewErr     error
+	preflight    protocol.PreflightResponse
+	preflightErr error
+	renews       int
+	preflights   int
+}
+
+func (c *fakeCredentialClient) RenewCredential(string) (*protocol.RenewCredentialResponse, error) {
+	c.renews++
+	if c.renewErr != nil {
+		return nil, c.renewErr
+	}
+	return &protocol.RenewCredentialResponse{
+		Credential:      base64.RawStdEncoding.EncodeToString([]byte("renewed")),
+		CredentialLease: 3600,
+	}, nil
+}
+
+func (c *fakeCredentialClient) KeyExchange() (*protocol.KeyExchangeResponse, error) {
+	return nil, errors.New("unexpected key exchange")
+}
+
+func (c *fakeCredentialClient) SetToken(string) {}
+
+func (c *fakeCredentialClient) Preflight(string, string, []string, bool, []protocol.RelayLatency) (*protocol.PreflightResponse, error) {
+	c.preflights++
+	if c.preflightErr != nil {
+		return nil, c.preflightErr
+	}
+	res := c.preflight
+	return &res, nil
+}
+
+type fakeCredentialHolder struct {
+	credential []byte
+	reconnects int
+}
+
+func (h *fakeCredentialHolder) SetCredential(credential []byte) {
+	h.credential = credential
+}
+
+func (h *fakeCredentialHolder) Reconnect() {
+	h.reconnects++
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestCredentialExpiresAtWallClock(t *testing.T) {
+	a := assert.New(t)
+
+	now := time.Now()
+	cred := credential{RenewedAt: now, Lease: time.Hour}
+
+	a.Equal(now.Round(0).Add(time.Hour), cred.expiresAt())
+	a.Equal(now.Round(0).Add(30*time.Minute), cred.renewAt())
+
+	// The monotonic clock reading is stripped, so the comparison uses the
+	// wall clock which keeps going while the system is suspended.
+	a.Equal(cred.expiresAt(), cred.expiresAt().Round(0))
+	a.True(now.Add(2 * time.Hour).After(cred.expiresAt()))
+}
+
+func TestCredentialTimerDrift(t *testing.T) {
+	a := assert.New(t)
+
+	clock := &fakeClock{now: time.Unix(1600000000, 0)}
+	tick := time.Now()
+	timer := newCredentialTimer(clock.Now, tick, clock.now.Add(30*time.Minute))
+
+	// The wall clock goes with the ticks.
+	for i := 0; i < 3; i++ {
+		tick = tick.Add(credentialCheckInterval)
+		clock.Advance(credentialCheckInterval)
+		a.False(timer.due(tick))
+	}
+
+	// The system is suspended for a weekend, only one tick elapses.
+	tick = tick.Add(credentialCheckInterval)
+	clock.Advance(48 * time.Hour)
+	a.True(timer.due(tick))
+	a.Equal(clock.now, timer.nextRenew)
+
+	// The wall clock jumps backward.
+	timer.nextRenew = clock.now.Add(time.Hour)
+	tick = tick.Add(credentialCheckInterval)
+	clock.Advance(-time.Hour)
+	a.True(timer.due(tick))
+
+	// The refreshed schedule is followed after the drift is consumed.
+	timer.nextRenew = clock.now.Add(time.Hour)
+	tick = tick.Add(credentialCheckInterval)
+	clock.Advance(credentialCheckInterval)
+	a.False(timer.due(tick))
+}
+
+func TestRefreshCredential(t *testing.T) {
+	a := assert.New(t)
+
+	setup := func() (*NodeDriver, *fakeCredentialClient, *fakeCredentialHolder, *fakeClock) {
+		client := &fakeCredentialClient{preflight: protocol.PreflightResponse{
+			ID:              42,
+			IPv4:            "10.0.0.1",
+			IPv6:            "fd00::1",
+			Credential:      base64.RawStdEncoding.EncodeToString([]byte("fresh")),
+			CredentialLease: 3600,
+		}}
+		holder := &fakeCredentialHolder{}
+		clock := &fakeClock{now: time.Unix(1600000000, 0)}
+		d := &NodeDriver{
+			config:     &config.Config{},
+			peerID:     42,
+			credClient: client,
+			credHolder: holder,
+			now:        clock.Now,
+			credential: credential{
+				address:   "10.0.0.1",
+				address6:  "fd00::1",
+				RenewedAt: clock.now,
+				Lease:     time.Hour,
+			},
+		}
+		return d, client, holder, clock
+	}
+
+	// The credential is renewed before expired.
+	d, client, holder, clock := setup()
+	clock.Advance(30 * time.Minute)
+	a.Equal(clock.now.Add(30*time.Minute), d.refreshCredential())
+	a.Equal([]byte("renewed"), holder.credential)
+	a.Equal(1, client.renews)
+	a.Equal(0, client.preflights)
+
+	// The renewal is retried at the half of the remaining lease if failed.
+	d, client, holder, clock = setup()
+	client.renewErr = errors.New("network unreachable")
+	clock.Advance(40 * time.Minute)
+	a.Equal(clock.now.Add(10*time.Minute), d.refreshCredential())
+	a.Equal(0, client.preflights)
+	a.Nil(holder.credential)
+	a.False(d.needsLogin.Load())
+
+	// The revoked device needs to login again.
+	d, client, _, clock = setup()
+	client.renewErr = &jsonapi.Error{Code: errcode.DeviceRevoked}
+	a.Equal(clock.now.Add(reauthRetryInterval), d.refreshCredential())
+	a.True(d.needsLogin.Load())
+
+	// The device restored after revoked renews the credential again.
+	client.renewErr = nil
+	clock.Advance(reauthRetryInterval)
+	a.Equal(clock.now.Add(30*time.Minute), d.refreshCredential())
+	a.False(d.needsLogin.Load())
+
+	// The expired credential falls back to re-authentication, and the relay
+	// sessions are handshake again with the fresh credential.
+	d, client, holder, clock = setup()
+	d.needsLogin.Store(true)
+	clock.Advance(48 * time.Hour)
+	a.Equal(clock.now.Add(30*time.Minute), d.refreshCredential())
+	a.Equal(0, client.renews)
+	a.Equal(1, client.preflights)
+	a.Equal([]byte("fresh"), holder.credential)
+	a.Equal(1, holder.reconnects)
+	a.False(d.needsLogin.Load())
+
+	// The renewal failed until expired falls back to re-authentication too.
+	d, client, holder, clock = setup()
+	client.renewErr = errors.New("network unreachable")
+	clock.Advance(time.Hour)
+	d.refreshCredential()
+	a.Equal(0, client.renews)
+	a.Equal(1, holder.reconnects)
+
+	// The rejected token needs to login again.
+	d, client, holder, clock = setup()
+	client.preflightErr = &jsonapi.Error{Code: errcode.InvalidToken}
+	clock.Advance(48 * time.Hour)
+	a.Equal(clock.now.Add(reauthRetryInterval), d.refreshCredential())
+	a.True(d.needsLogin.Load())
+	a.Equal(0, holder.reconnects)
+
+	// The other re-authentication failures are retried without login.
+	d, client, holder, clock = setup()
+	client.preflightErr = errors.New("network unreachable")
+	clock.Advance(48 * time.Hour)
+	a.Equal(clock.now.Add(reauthRetryInterval), d.refreshCredential())
+	a.False(d.needsLogin.Load())
+	a.Equal(0, holder.reconnects)
+
+	// The re-allocated device needs to login again.
+	d, client, holder, clock = setup()
+	client.preflight.IPv4 = "10.0.0.2"
+	clock.Advance(48 * time.Hour)
+	a.Equal(clock.now.Add(reauthRetryInterval), d.refreshCredential())
+	a.True(d.needsLogin.Load())
+	a.Nil(holder.credential)
+	a.Equal(0, holder.reconnects)
+}
@@ -23,18 +23,32 @@ import (
 	"go.uber.org/zap"
 )
 
+// parseDst parses the destination address of the IPv4/IPv6 packet.
 func parseDst(b []byte) net.IP {
-	if len(b) < 20 {
+	if len(b) < 1 {
 		return nil
 	}
-	return net.IPv4(b[16], b[17], b[18], b[19])
+
+	switch b[0] >> 4 {
+	case 4:
+		if len(b) < 20 {
+			return nil
+		}
+		return net.IPv4(b[16], b[17], b[18], b[19])
+	case 6:
+		if len(b) < 40 {
+			return nil
+		}
+		dst := make(net.IP, net.IPv6len)
+		copy(dst, b[24:40])
+		return dst
+	}
+	return nil
 }
 
 func (d *NodeDriver) serveDevRead(ctx context.Context) {
 	defer d.wg.Done()
 
-	npnp := net.IPv4(239, 255, 255, 250)
-
 	buffer := make([]byte, constant.MaxBufferSize)
 	for {
 		select {
@@ -54,11 +68,7 @@ func (d *NodeDriver) serveDevRead(ctx context.Context) {
 
 			dst := parseDst(buffer[:c])
 			if dst == nil {
-				zap.L().Warn("Parse IPv4 header failed", zap.ByteString("data", buffer[:c]))
-				continue
-			}
-
-			if dst.Equal(npnp) {
+				zap.L().Warn("Parse IP header failed", zap.ByteString("data", buffer[:c]))
 				continue
 			}
 
@@ -66,7 +76,11 @@ func (d *NodeDriver) serveDevRead(ctx context.Context) {
 				zap.L().Debug("Read packet from device", zap.Stringer("to", dst))
 			}
 
-			// TODO: support broadcast
+			if isBroadcast(buffer[:c]) {
+				d.broadcast(buffer[:c])
+				continue
+			}
+
 			destination := dst.String()
 			t := d.mm.Tunnel(destination)
 			if t == nil {
@@ -77,11 +91,18 @@ func (d *NodeDriver) serveDevRead(ctx context.Context) {
 			copy(dataCopy, buffer[:c])
 
 			// Write pipeline back if the destination is the current virtual address (loopback)
-			if destination == d.credential.address {
+			if destination == d.credential.address || destination == d.credential.address6 {
 				d.chDevWrite <- dataCopy
 				continue
 			}
 
+			if !d.filter.Outbound(t.PeerID(), dataCopy) {
+				if logutil.IsEnableDevice() {
+					zap.L().Debug("Drop packet by ACL policy", zap.Stringer("to", dst))
+				}
+				continue
+			}
+
 			t.Write(dataCopy)
 		}
 	}
@@ -104,7 +125,7 @@ func (d *NodeDriver) serveDevWrite(ctx context.Context) {
 			if logutil.IsEnableDevice() {
 				dst := parseDst(data)
 				if dst == nil {
-					zap.L().Warn("Parse IPv4 header failed", zap.ByteString("data", data))
+					zap.L().Warn("Parse IP header failed", zap.ByteString("data", data))
 					continue
 				}
 				zap.L().Debug("Writing packet into device", zap.Stringer("to", dst))
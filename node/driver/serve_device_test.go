@@ -0,0 +1,41 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDst(t *testing.T) {
+	a := assert.New(t)
+
+	ipv4 := make([]byte, 20)
+	ipv4[0] = 0x45
+	copy(ipv4[16:], []byte{10, 1, 2, 3})
+	a.Equal("10.1.2.3", parseDst(ipv4).String())
+	a.Nil(parseDst(ipv4[:19]))
+
+	ipv6 := make([]byte, 40)
+	ipv6[0] = 0x60
+	copy(ipv6[24:], net.ParseIP("fd70:6169:726d::2a"))
+	a.Equal("fd70:6169:726d::2a", parseDst(ipv6).String())
+	a.Nil(parseDst(ipv6[:39]))
+
+	a.Nil(parseDst(nil))
+	a.Nil(parseDst([]byte{0x10, 0, 0, 0}))
+}
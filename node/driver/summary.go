@@ -19,6 +19,7 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/pairmesh/pairmesh/node/filter"
 	"github.com/pairmesh/pairmesh/node/mesh"
 )
 
@@ -26,10 +27,15 @@ const mockSummary = false
 
 // Summary is the summary instance with essential metadata
 type Summary struct {
-	Enabled bool          `json:"enabled"`
+	Enabled bool `json:"enabled"`
+	// Status is one of connecting, connected and needs_login. The needs_login
+	// status means the credential expired and the token is rejected by the
+	// portal service, so the user needs to login again.
 	Status  string        `json:"status"`
 	Profile *Profile      `json:"profile"`
 	Mesh    *mesh.Summary `json:"mesh"`
+	// Drops is the count of packets dropped by the ACL policy.
+	Drops *filter.DropStats `json:"drops,omitempty"`
 }
 
 // Profile is the profile of a user
@@ -37,6 +43,7 @@ type Profile struct {
 	UserID uint64 `json:"user_id"`
 	Name   string `json:"name"`
 	IPv4   string `json:"ipv4"`
+	IPv6   string `json:"ipv6,omitempty"`
 }
 
 // Equal returns true if the p equals rhs.
@@ -51,7 +58,7 @@ func (p *Profile) Equal(rhs *Profile) bool {
 	if p != nil && rhs == nil {
 		return false
 	}
-	return p.UserID == rhs.UserID && p.Name == rhs.Name && p.IPv4 == rhs.IPv4
+	return p.UserID == rhs.UserID && p.Name == rhs.Name && p.IPv4 == rhs.IPv4 && p.IPv6 == rhs.IPv6
 }
 
 // Equal returns true if the s equals rhs.
@@ -66,7 +73,7 @@ func (s *Summary) Equal(rhs *Summary) bool {
 	if s != nil && rhs == nil {
 		return false
 	}
-	return s.Enabled == rhs.Enabled && s.Profile.Equal(rhs.Profile) && s.Mesh.LastChangedAt == rhs.Mesh.LastChangedAt
+	return s.Enabled == rhs.Enabled && s.Status == rhs.Status && s.Profile.Equal(rhs.Profile) && s.Mesh.LastChangedAt == rhs.Mesh.LastChangedAt
 }
 
 // mockSummarize returns a mock summary for testing.
@@ -111,6 +118,7 @@ func (d *NodeDriver) mockSummarize() *Summary {
 		Profile: &Profile{
 			UserID: uint64(d.userID),
 			IPv4:   d.credential.address,
+			IPv6:   d.credential.address6,
 			Name:   d.name,
 		},
 		Mesh: meshSummary,
@@ -0,0 +1,110 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pairmesh/pairmesh/protocol"
+	"go.uber.org/zap"
+)
+
+const (
+	// watchRetryMin and watchRetryMax are the bounds of the backoff duration
+	// to retry watching the peer graph after failed.
+	watchRetryMin = 5 * time.Second
+	watchRetryMax = 2 * time.Minute
+)
+
+// watchPeerGraph watches the changes of the peer graph by long polling the
+// portal service, and sends the changes to the peer graph updater. The changes
+// are pushed in seconds and the periodical pulling is the fallback.
+func (d *NodeDriver) watchPeerGraph(ctx context.Context, deltas chan<- *protocol.PeerGraphDelta) {
+	defer d.wg.Done()
+
+	hash := ""
+	retry := watchRetryMin
+	for {
+		delta, err := d.apiClient.WatchPeerGraph(hash)
+		if ctx.Err() != nil {
+			zap.L().Info("Peer graph watcher stopped")
+			return
+		}
+		if err != nil {
+			zap.L().Warn("Watch the peer graph failed", zap.Duration("retry", retry), zap.Error(err))
+			select {
+			case <-time.After(retry):
+			case <-ctx.Done():
+				zap.L().Info("Peer graph watcher stopped")
+				return
+			}
+			retry *= 2
+			if retry > watchRetryMax {
+				retry = watchRetryMax
+			}
+			continue
+		}
+		retry = watchRetryMin
+
+		// The long polling request is timeout without any change.
+		if delta.UniqueHash == hash {
+			continue
+		}
+		hash = delta.UniqueHash
+
+		select {
+		case deltas <- delta:
+		case <-ctx.Done():
+			zap.L().Info("Peer graph watcher stopped")
+			return
+		}
+	}
+}
+
+// applyPeerGraphDelta returns the peer graph which applied the changes to the
+// base peer graph. The base peer graph is not modified.
+func applyPeerGraphDelta(base *protocol.PeerGraphResponse, delta *protocol.PeerGraphDelta) *protocol.PeerGraphResponse {
+	graph := *base
+	graph.NotModified = false
+	graph.UniqueHash = delta.UniqueHash
+	if delta.TopologyChanged {
+		graph.RelayServers = delta.RelayServers
+		graph.Networks = delta.Networks
+		graph.ACL = delta.ACL
+	}
+
+	peers := make(map[protocol.PeerID]protocol.Peer, len(base.Peers))
+	for _, p := range base.Peers {
+		peers[p.ID] = p
+	}
+	for _, e := range delta.Events {
+		switch e.Type {
+		case protocol.PeerEventAdd, protocol.PeerEventUpdate:
+			peers[e.Peer.ID] = e.Peer
+		case protocol.PeerEventRemove:
+			delete(peers, e.Peer.ID)
+		}
+	}
+	graph.Peers = make([]protocol.Peer, 0, len(peers))
+	for _, p := range peers {
+		graph.Peers = append(graph.Peers, p)
+	}
+	sort.Slice(graph.Peers, func(i, j int) bool {
+		return graph.Peers[i].ID < graph.Peers[j].ID
+	})
+	return &graph
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"testing"
+
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyPeerGraphDelta(t *testing.T) {
+	a := assert.New(t)
+
+	base := &protocol.PeerGraphResponse{
+		UniqueHash:   "base",
+		RelayServers: []protocol.RelayServer{{ID: 1}},
+		Peers: []protocol.Peer{
+			{ID: 1, ServerID: 1},
+			{ID: 2, ServerID: 1},
+			{ID: 3, ServerID: 1},
+		},
+	}
+	delta := &protocol.PeerGraphDelta{
+		BaseHash:   "base",
+		UniqueHash: "latest",
+		Events: []protocol.PeerEvent{
+			{Type: protocol.PeerEventUpdate, Peer: protocol.Peer{ID: 2, ServerID: 2}},
+			{Type: protocol.PeerEventAdd, Peer: protocol.Peer{ID: 0, ServerID: 2}},
+			{Type: protocol.PeerEventRemove, Peer: protocol.Peer{ID: 3}},
+		},
+	}
+
+	graph := applyPeerGraphDelta(base, delta)
+	a.Equal("latest", graph.UniqueHash)
+	a.Equal(base.RelayServers, graph.RelayServers)
+	a.Equal([]protocol.Peer{
+		{ID: 0, ServerID: 2},
+		{ID: 1, ServerID: 1},
+		{ID: 2, ServerID: 2},
+	}, graph.Peers)
+
+	// The base peer graph is kept untouched.
+	a.Equal("base", base.UniqueHash)
+	a.Len(base.Peers, 3)
+
+	delta.TopologyChanged = true
+	delta.RelayServers = []protocol.RelayServer{{ID: 1}, {ID: 2}}
+	graph = applyPeerGraphDelta(base, delta)
+	a.Equal(delta.RelayServers, graph.RelayServers)
+}
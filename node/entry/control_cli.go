@@ -0,0 +1,215 @@
+//go:build linux
+
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pairmesh/pairmesh/node/control"
+	"github.com/pairmesh/pairmesh/node/driver"
+	"github.com/pairmesh/pairmesh/node/mesh"
+	"github.com/spf13/cobra"
+)
+
+// newControlCommands returns the commands to query and change the running
+// PairMesh through the control API.
+func newControlCommands(socket *string) []*cobra.Command {
+	return []*cobra.Command{
+		newStatusCommand(socket),
+		newPeersCommand(socket),
+		newPingCommand(socket),
+		newUpCommand(socket),
+		newDownCommand(socket),
+		newLogoutCommand(socket),
+	}
+}
+
+func newStatusCommand(socket *string) *cobra.Command {
+	var asJSON bool
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Print the status of the running PairMesh",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			summary, err := control.NewClient(*socket).Status()
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(summary)
+			}
+			printStatus(summary)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&asJSON, "json", false, "Print the status in JSON format")
+	return cmd
+}
+
+func newPeersCommand(socket *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "peers",
+		Short: "List the peers of the running PairMesh",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			summary, err := control.NewClient(*socket).Status()
+			if err != nil {
+				return err
+			}
+			printPeers(summary)
+			return nil
+		},
+	}
+}
+
+func newPingCommand(socket *string) *cobra.Command {
+	var count int
+	cmd := &cobra.Command{
+		Use:   "ping <PEER>",
+		Short: "Probe the direct path to the peer with the name or address and print the latency",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := control.NewClient(*socket)
+			for i := 0; count <= 0 || i < count; i++ {
+				if i > 0 {
+					time.Sleep(time.Second)
+				}
+				res, err := client.Ping(args[0])
+				if err != nil {
+					return err
+				}
+				switch {
+				case res.Status == mesh.StatePending:
+					fmt.Printf("%s (%s) is pending\n", res.Name, res.IPv4)
+				case res.Endpoint != "":
+					fmt.Printf("pong from %s (%s) via %s in %s\n", res.Name, res.IPv4, res.Endpoint, res.Latency)
+				default:
+					fmt.Printf("no direct path to %s (%s), relayed by %s\n", res.Name, res.IPv4, res.Relay)
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().IntVarP(&count, "count", "c", 4, "Stop after the count of pings, and 0 means forever")
+	return cmd
+}
+
+func newUpCommand(socket *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "up",
+		Short: "Enable the running PairMesh to serve the traffics",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := control.NewClient(*socket).Up(); err != nil {
+				return err
+			}
+			fmt.Println("PairMesh is enabled")
+			return nil
+		},
+	}
+}
+
+func newDownCommand(socket *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "down",
+		Short: "Disable the running PairMesh and drop all traffics",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := control.NewClient(*socket).Down(); err != nil {
+				return err
+			}
+			fmt.Println("PairMesh is disabled")
+			return nil
+		},
+	}
+}
+
+func newLogoutCommand(socket *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "logout",
+		Short: "Logout the running PairMesh and terminate it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := control.NewClient(*socket).Logout(); err != nil {
+				return err
+			}
+			fmt.Println("PairMesh is logged out")
+			return nil
+		},
+	}
+}
+
+func printStatus(summary *driver.Summary) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Status:\t%s\n", summary.Status)
+	fmt.Fprintf(w, "Enabled:\t%t\n", summary.Enabled)
+	if p := summary.Profile; p != nil {
+		fmt.Fprintf(w, "Device:\t%s\n", p.Name)
+		fmt.Fprintf(w, "IPv4:\t%s\n", p.IPv4)
+		if p.IPv6 != "" {
+			fmt.Fprintf(w, "IPv6:\t%s\n", p.IPv6)
+		}
+	}
+	if summary.Mesh != nil && summary.Mesh.ExitNode != "" {
+		fmt.Fprintf(w, "Exit node:\t%s\n", summary.Mesh.ExitNode)
+	}
+	if summary.Drops != nil {
+		total := summary.Drops.Default + summary.Drops.Spoofed
+		for _, n := range summary.Drops.Rules {
+			total += n
+		}
+		fmt.Fprintf(w, "Dropped:\t%d\n", total)
+	}
+	_ = w.Flush()
+}
+
+func printPeers(summary *driver.Summary) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tIPV4\tSTATUS\tPATH\tLATENCY\tTX\tRX")
+	if summary.Mesh != nil {
+		// The devices of the user may also present in the networks.
+		seen := map[string]struct{}{}
+		devices := summary.Mesh.MyDevices
+		for _, n := range summary.Mesh.Networks {
+			devices = append(devices, n.Devices...)
+		}
+		for _, d := range devices {
+			if _, found := seen[d.IPv4]; found {
+				continue
+			}
+			seen[d.IPv4] = struct{}{}
+
+			path, latency, tx, rx := "-", "-", "-", "-"
+			if s := d.Stats; s != nil {
+				if s.Endpoint != "" {
+					path, latency = s.Endpoint, s.Latency.String()
+				} else if s.Relay != "" {
+					path = "relay " + s.Relay
+				}
+				tx, rx = fmt.Sprint(s.TxBytes), fmt.Sprint(s.RxBytes)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", d.Name, d.IPv4, d.Status, path, latency, tx, rx)
+		}
+	}
+	_ = w.Flush()
+}
@@ -22,13 +22,16 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 
 	"github.com/pairmesh/pairmesh/constant"
 	"github.com/pairmesh/pairmesh/node/api"
 	"github.com/pairmesh/pairmesh/node/config"
+	"github.com/pairmesh/pairmesh/node/control"
 	"github.com/pairmesh/pairmesh/node/device"
 	"github.com/pairmesh/pairmesh/node/driver"
+	"github.com/pairmesh/pairmesh/node/updater"
 	"github.com/pairmesh/pairmesh/pkg/cmdutil"
 	"github.com/pairmesh/pairmesh/pkg/logutil"
 	"github.com/pairmesh/pairmesh/version"
@@ -40,9 +43,14 @@ import (
 // Run startups the linux version of PairMesh
 func Run() {
 	var (
-		authKey     string
-		apiEndpoint string
-		examples    = cmdutil.Examples{
+		authKey         string
+		apiEndpoint     string
+		advertiseRoutes []string
+		advertiseExit   bool
+		exitNode        string
+		updateChannel   string
+		socket          string
+		examples        = cmdutil.Examples{
 			{
 				Example: "pairmesh -k <AUTH_KEY>",
 				Comment: "Start PairMesh with the specified auth key",
@@ -51,6 +59,34 @@ func Run() {
 				Example: "pairmesh -a <API_ENDPOINT> -k <AUTH_KEY>",
 				Comment: "Start PairMesh with customized api endpoint and specified auth key",
 			},
+			{
+				Example: "pairmesh -k <AUTH_KEY> --advertise-routes 192.168.10.0/24",
+				Comment: "Start PairMesh as a subnet router of the specified LAN",
+			},
+			{
+				Example: "pairmesh -k <AUTH_KEY> --advertise-exit-node",
+				Comment: "Start PairMesh as an exit node which forwards internet traffics for peers",
+			},
+			{
+				Example: "pairmesh -k <AUTH_KEY> --exit-node <PEER_NAME_OR_ADDRESS>",
+				Comment: "Start PairMesh and route all internet traffics through the specified exit node",
+			},
+			{
+				Example: "pairmesh update",
+				Comment: "Check and install the update of PairMesh client, which takes effect after restarting",
+			},
+			{
+				Example: "pairmesh status --json",
+				Comment: "Print the status of the running PairMesh in JSON format",
+			},
+			{
+				Example: "pairmesh ping <PEER_NAME_OR_ADDRESS>",
+				Comment: "Print the path and latency to the specified peer",
+			},
+			{
+				Example: "pairmesh down",
+				Comment: "Disable the running PairMesh and drop all traffics until `pairmesh up`",
+			},
 			{
 				Example: "pairmesh --version",
 				Comment: "Print the version of PairMesh client",
@@ -97,6 +133,32 @@ P2P traffic are encrypted by %[1]s. Every tunnel has different secret key.
 			if authKey != "" {
 				cfg.Token = constant.PrefixAuthKey + " " + authKey
 			}
+			// Overwrite the advertised subnet routes and exit node settings
+			changed := false
+			if cmd.Flags().Changed("advertise-routes") {
+				cfg.AdvertiseRoutes = advertiseRoutes
+				changed = true
+			}
+			if cmd.Flags().Changed("advertise-exit-node") {
+				cfg.AdvertiseExitNode = advertiseExit
+				changed = true
+			}
+			if cmd.Flags().Changed("exit-node") {
+				cfg.ExitNode = exitNode
+				changed = true
+			}
+			if cmd.Flags().Changed("update-channel") {
+				if updateChannel != "" && updateChannel != "stable" && updateChannel != "beta" {
+					return fmt.Errorf("unknown update channel %s", updateChannel)
+				}
+				cfg.UpdateChannel = updateChannel
+				changed = true
+			}
+			if changed {
+				if err := cfg.Save(); err != nil {
+					return err
+				}
+			}
 			if cfg.IsGuest() {
 				return fmt.Errorf("please use `-k <AUTH_KEY>` to specify the pre-authentication key")
 			}
@@ -118,6 +180,17 @@ P2P traffic are encrypted by %[1]s. Every tunnel has different secret key.
 			}
 			apiClient := api.New(gateway, cfg.Token, cfg.MachineID)
 
+			// Install the update staged before restarting, and restart with it.
+			upd := updater.New(apiClient, cfg.Dir(), cfg.UpdateChannel)
+			if state := upd.State(); state.Staged {
+				if err := upd.Apply(); err != nil {
+					zap.L().Error("Install the staged update failed", zap.Error(err))
+				} else if exe, err := os.Executable(); err == nil {
+					zap.L().Info("Restart to update", zap.String("version", state.Version))
+					return syscall.Exec(exe, os.Args, os.Environ())
+				}
+			}
+
 			err = exchangeAuthKeyIfNeed(apiClient, cfg)
 			if err != nil {
 				return errors.WithMessage(err, "exchange key failed")
@@ -137,14 +210,37 @@ P2P traffic are encrypted by %[1]s. Every tunnel has different secret key.
 
 			ctx, cancel := context.WithCancel(context.Background())
 			go drv.Drive(ctx)
+			go upd.Run(ctx)
+
+			// Serve the control API and terminate the driver after logged out.
+			loggedOut := make(chan struct{})
+			var logoutOnce sync.Once
+			ctl := control.NewServer(drv, func() error {
+				apiClient.Logout()
+				cfg.Token = ""
+				if err := cfg.Save(); err != nil {
+					return err
+				}
+				logoutOnce.Do(func() { close(loggedOut) })
+				return nil
+			})
+			go func() {
+				if err := ctl.Serve(ctx, socket); err != nil {
+					zap.L().Error("Serve control API failed", zap.Error(err))
+				}
+			}()
 
 			zap.L().Info("Driver initialized successfully")
 
 			sc := make(chan os.Signal, 1)
 			signal.Notify(sc, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 
-			sg := <-sc
-			zap.L().Info("Got signal and prepare to terminate", zap.Stringer("signal", sg))
+			select {
+			case sg := <-sc:
+				zap.L().Info("Got signal and prepare to terminate", zap.Stringer("signal", sg))
+			case <-loggedOut:
+				zap.L().Info("Logged out and prepare to terminate")
+			}
 
 			cancel()
 			drv.Terminate()
@@ -156,7 +252,16 @@ P2P traffic are encrypted by %[1]s. Every tunnel has different secret key.
 	}
 
 	rootCmd.Flags().StringVarP(&authKey, "key", "k", "", "The pre-authentication key of the node")
-	rootCmd.Flags().StringVarP(&apiEndpoint, "api-endpoint", "a", "", "Specify the path of api endpoint")
+	rootCmd.PersistentFlags().StringVarP(&apiEndpoint, "api-endpoint", "a", "", "Specify the path of api endpoint")
+	rootCmd.Flags().StringSliceVar(&advertiseRoutes, "advertise-routes", nil, "The subnet routes advertised to peers (e.g. 192.168.10.0/24)")
+	rootCmd.Flags().BoolVar(&advertiseExit, "advertise-exit-node", false, "Offer the current node as an exit node for peers")
+	rootCmd.Flags().StringVar(&exitNode, "exit-node", "", "The name or address of the peer to route all internet traffics through (empty to disable)")
+	rootCmd.Flags().StringVar(&updateChannel, "update-channel", "", "The release channel to check the updates, either stable or beta")
+
+	rootCmd.PersistentFlags().StringVar(&socket, "socket", control.DefaultSocketPath, "The unix socket path of the control API")
+
+	rootCmd.AddCommand(newUpdateCommand(&apiEndpoint))
+	rootCmd.AddCommand(newControlCommands(&socket)...)
 
 	cmdutil.Run(rootCmd)
 }
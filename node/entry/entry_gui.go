@@ -40,6 +40,7 @@ import (
 	"github.com/pairmesh/pairmesh/node/config"
 	"github.com/pairmesh/pairmesh/node/device"
 	"github.com/pairmesh/pairmesh/node/driver"
+	"github.com/pairmesh/pairmesh/node/updater"
 	"github.com/pairmesh/pairmesh/pkg/logutil"
 	"github.com/pairmesh/pairmesh/version"
 	"github.com/skratchdot/open-golang/open"
@@ -53,12 +54,16 @@ type (
 		dev      device.Device
 		api      *api.Client
 		driver   driver.Driver
+		updater  *updater.Updater
 		auto     *autostart.App
 		events   chan struct{}
 
 		cancel context.CancelFunc
 
 		initialized atomic.Bool
+		// relaunch indicates the application restarts after quit, which is used
+		// to restart to update.
+		relaunch bool
 	}
 
 	LoginNodeInfo struct {
@@ -109,6 +114,7 @@ func Run() {
 	}
 	app.cfg = cfg
 	app.api = api.New(config.APIGateway(), cfg.Token, cfg.MachineID)
+	app.updater = updater.New(app.api, cfg.Dir(), cfg.UpdateChannel)
 
 	// Set locale name
 	localName := app.cfg.LocaleName
@@ -178,6 +184,7 @@ func (app *osApp) Run() error {
 
 	// Refresh UI dynamically.
 	go app.refreshTray()
+	go app.updater.Run(context.Background())
 
 	app.run()
 
@@ -189,7 +196,10 @@ func (app *osApp) refreshEvent() {
 }
 
 func (app *osApp) refreshTray() {
-	var cachedSummary *driver.Summary
+	var (
+		cachedSummary *driver.Summary
+		cachedUpdate  updater.State
+	)
 
 	timer := time.After(0)
 
@@ -203,12 +213,14 @@ func (app *osApp) refreshTray() {
 		}
 
 		summary := app.driver.Summarize()
-		isEqual := cachedSummary != nil && cachedSummary.Equal(summary)
+		update := app.updater.State()
+		isEqual := cachedSummary != nil && cachedSummary.Equal(summary) && cachedUpdate == update
 		if isEqual {
 			return
 		}
 
 		cachedSummary = summary
+		cachedUpdate = update
 		app.render(summary)
 
 		// Workaround for MacOS calculate menu item tabstop location
@@ -263,6 +275,12 @@ func (app *osApp) onQuit() {
 	zap.L().Info("Device down")
 
 	app.driver.Terminate()
+
+	if app.relaunch {
+		if err := updater.Relaunch(); err != nil {
+			zap.L().Error("Relaunch application failed", zap.Error(err))
+		}
+	}
 	app.dispose()
 }
 
@@ -303,6 +321,47 @@ func (app *osApp) switchDriverEnable(currentEnabled bool) {
 	app.refreshEvent()
 }
 
+func (app *osApp) switchExitNode(exitNode string) func() {
+	return func() {
+		err := app.driver.SetExitNode(exitNode)
+		if err != nil {
+			zap.L().Error("Switch exit node failed", zap.String("exit_node", exitNode), zap.Error(err))
+			return
+		}
+		app.refreshEvent()
+	}
+}
+
+// onUpdate installs the staged update and restarts the application, or opens
+// the download page if the update can't be installed automatically.
+func (app *osApp) onUpdate() {
+	state := app.updater.State()
+	if !state.Staged {
+		_ = open.Run(state.DownloadAddress)
+		return
+	}
+
+	if err := app.updater.Apply(); err != nil {
+		zap.L().Error("Install update failed", zap.Error(err))
+		app.showMessage(i18n.L("message.update_failed"), err.Error())
+		return
+	}
+	app.relaunch = true
+	app.onQuit()
+}
+
+// updateTitle returns the title of the update menu item, empty if no update
+func updateTitle(state updater.State) string {
+	switch {
+	case state.Version == "":
+		return ""
+	case state.Staged:
+		return i18n.L("tray.update.restart", state.Version)
+	default:
+		return i18n.L("tray.update.available", state.Version)
+	}
+}
+
 func (app *osApp) onOpenAbout() {
 	app.showMessage(i18n.L("message.about"), i18n.L("message.version", version.NewVersion().FullInfo()))
 }
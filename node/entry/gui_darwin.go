@@ -36,11 +36,13 @@ type osApp struct {
 	device     *systray.MenuItem
 	status     *systray.MenuItem
 	enable     *systray.MenuItem
+	exitNode   *systray.MenuItem
 	console    *systray.MenuItem
 	myDevices  *systray.MenuItem
 	myNetworks *systray.MenuItem
 	start      *systray.MenuItem
 	logout     *systray.MenuItem
+	update     *systray.MenuItem
 	about      *systray.MenuItem
 	language   *systray.MenuItem
 	quit       *systray.MenuItem
@@ -74,6 +76,7 @@ func (app *osApp) createTray() {
 	app.status = app.addMenuItemWithTK("tray.unknown")
 	app.status.SetDisabled(true)
 	app.enable = app.addMenuItemWithTK("tray.enable")
+	app.exitNode = app.addMenuItemWithTK("tray.exit_node.title")
 	app.console = app.addMenuItemWithActionWithTK("tray.profile.console", app.onOpenConsole)
 
 	app.seps = append(app.seps, app.addSeparator())
@@ -92,6 +95,8 @@ func (app *osApp) createTray() {
 	app.start.SetChecked(app.auto.IsEnabled())
 
 	app.logout = app.addMenuItemWithActionWithTK("tray.profile.logout", app.onLogout)
+	app.update = app.addMenuItemWithAction("", app.onUpdate)
+	app.update.SetHidden(true)
 	app.about = app.addMenuItemWithActionWithTK("tray.about", app.onOpenAbout)
 	app.addSeparator()
 
@@ -129,6 +134,7 @@ func (app *osApp) setMenuVisibility(isGuest bool) {
 	app.status.SetHidden(isGuest)
 	app.console.SetHidden(isGuest)
 	app.enable.SetHidden(isGuest)
+	app.exitNode.SetHidden(isGuest)
 	app.myDevices.SetHidden(isGuest)
 	app.myNetworks.SetHidden(isGuest)
 	app.logout.SetHidden(isGuest)
@@ -167,6 +173,9 @@ func (app *osApp) render(summary *driver.Summary) {
 		app.enable.SetChecked(summary.Enabled)
 		app.enable.SetAction(func() { app.switchDriverEnable(summary.Enabled) })
 
+		// Exit nodes list
+		app.displayExitNodeList(summary)
+
 		// My devices list
 		devicesShowCount := len(app.myDevicesList)
 		for i, d := range summary.Mesh.MyDevices {
@@ -240,6 +249,11 @@ func (app *osApp) render(summary *driver.Summary) {
 		}
 	}
 
+	// Update available
+	title := updateTitle(app.updater.State())
+	app.update.SetTitle(title)
+	app.update.SetHidden(title == "")
+
 	app.displayLanguageList()
 	app.start.SetDisabled(!app.auto.IsEnabled())
 }
@@ -351,6 +365,36 @@ func (app *osApp) displayLanguageList() {
 	}
 }
 
+func (app *osApp) displayExitNodeList(summary *driver.Summary) {
+	children := app.exitNode.Children()
+	showCount := len(children)
+	for i := 0; i <= len(summary.Mesh.ExitNodes); i++ {
+		// The first item is used to stop using exit node.
+		title, name := i18n.L("tray.exit_node.none"), ""
+		if i > 0 {
+			d := summary.Mesh.ExitNodes[i-1]
+			title, name = fmt.Sprintf("%s\t%s", d.Name, d.IPv4), d.Name
+		}
+		var item *systray.MenuItem
+		if i < showCount {
+			item = children[i]
+			item.SetTitle(title)
+			item.SetHidden(false)
+		} else {
+			item = systray.NewMenuItem(title)
+			systray.AddMenuItem(app.exitNode, item)
+		}
+		item.SetChecked(summary.Mesh.ExitNode == name)
+		item.SetAction(app.switchExitNode(name))
+	}
+	// remove extra menu items
+	if actualCount := len(summary.Mesh.ExitNodes) + 1; actualCount < showCount {
+		for _, item := range children[actualCount:] {
+			item.SetHidden(true)
+		}
+	}
+}
+
 func (app *osApp) setLocale(name string) func() {
 	return func() {
 		err := i18n.SetLocale(name)
@@ -35,11 +35,13 @@ type osApp struct {
 	device     *walk.Action
 	status     *walk.Action
 	enable     *walk.Action
+	exitNode   *walk.Action
 	console    *walk.Action
 	myDevices  *walk.Action
 	myNetworks *walk.Action
 	start      *walk.Action
 	logout     *walk.Action
+	update     *walk.Action
 	about      *walk.Action
 	language   *walk.Action
 	exit       *walk.Action
@@ -105,6 +107,8 @@ func (app *osApp) createTray() error {
 	app.status = app.addActionWithTK(nil, "tray.unknown")
 	app.status.SetEnabled(false)
 	app.enable = app.addActionWithTK(nil, "tray.enable")
+	app.exitNode = app.addMenuAction(nil, i18n.L("tray.exit_node.title"))
+	app.actionLocaleNameMap[app.exitNode] = "tray.exit_node.title"
 	app.console = app.addActionWithActionWithTK(nil, "tray.profile.console", app.onOpenConsole)
 
 	app.seps = append(app.seps, app.addSeparator())
@@ -122,6 +126,8 @@ func (app *osApp) createTray() error {
 	app.start = app.addActionWithActionWithTK(nil, "tray.autorun", app.onAutoStart)
 	app.start.SetChecked(app.auto.IsEnabled())
 	app.logout = app.addActionWithActionWithTK(nil, "tray.profile.logout", app.onLogout)
+	app.update = app.addActionWithAction(nil, "", app.onUpdate)
+	app.update.SetVisible(false)
 	app.about = app.addActionWithActionWithTK(nil, "tray.about", app.onOpenAbout)
 	app.addSeparator()
 
@@ -151,6 +157,7 @@ func (app *osApp) setMenuVisibility(isGuest bool) {
 	app.status.SetVisible(!isGuest)
 	app.console.SetVisible(!isGuest)
 	app.enable.SetVisible(!isGuest)
+	app.exitNode.SetVisible(!isGuest)
 	app.myDevices.SetVisible(!isGuest)
 	app.myNetworks.SetVisible(!isGuest)
 	app.logout.SetVisible(!isGuest)
@@ -190,6 +197,9 @@ func (app *osApp) render(summary *driver.Summary) {
 		app.enable.SetChecked(summary.Enabled)
 		app.replaceHandler(app.enable, func() { app.switchDriverEnable(summary.Enabled) })
 
+		// Exit nodes list
+		app.displayExitNodeList(summary)
+
 		contextMenu := app.tray.ContextMenu()
 
 		// My devices list
@@ -264,6 +274,11 @@ func (app *osApp) render(summary *driver.Summary) {
 		}
 	}
 
+	// Update available
+	title := updateTitle(app.updater.State())
+	app.update.SetText(title)
+	app.update.SetVisible(title != "")
+
 	app.displayLanguageList()
 	app.start.SetChecked(app.auto.IsEnabled())
 }
@@ -397,6 +412,30 @@ func (app *osApp) displayLanguageList() {
 	}
 }
 
+func (app *osApp) displayExitNodeList(summary *driver.Summary) {
+	submenu := app.exitNode.Menu()
+	showCount := submenu.Actions().Len()
+	for i := 0; i <= len(summary.Mesh.ExitNodes); i++ {
+		// The first action is used to stop using exit node.
+		title, name := i18n.L("tray.exit_node.none"), ""
+		if i > 0 {
+			d := summary.Mesh.ExitNodes[i-1]
+			title, name = fmt.Sprintf("%s\t%s", d.Name, d.IPv4), d.Name
+		}
+		var action *walk.Action
+		if i < showCount {
+			action = submenu.Actions().At(i)
+			action.SetText(title)
+			app.replaceHandler(action, app.switchExitNode(name))
+		} else {
+			action = app.addAction(submenu, title)
+			action.Triggered().Attach(app.switchExitNode(name))
+		}
+		action.SetChecked(summary.Mesh.ExitNode == name)
+	}
+	app.removeExtraItem(submenu, len(summary.Mesh.ExitNodes)+1)
+}
+
 func (app *osApp) setLocale(name string) func() {
 	return func() {
 		err := i18n.SetLocale(name)
@@ -0,0 +1,68 @@
+//go:build linux
+
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pairmesh/pairmesh/node/api"
+	"github.com/pairmesh/pairmesh/node/config"
+	"github.com/pairmesh/pairmesh/node/updater"
+	"github.com/pairmesh/pairmesh/version"
+	"github.com/spf13/cobra"
+)
+
+// newUpdateCommand returns the command to check and install the update, which
+// takes effect after the running PairMesh restarted.
+func newUpdateCommand(apiEndpoint *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update",
+		Short: "Check and install the update of PairMesh client",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := &config.Config{}
+			if err := cfg.Load(); err != nil {
+				return err
+			}
+			gateway := config.APIGateway()
+			if *apiEndpoint != "" {
+				gateway = *apiEndpoint
+			}
+
+			upd := updater.New(api.New(gateway, "", cfg.MachineID), cfg.Dir(), cfg.UpdateChannel)
+			state, err := upd.Check(context.Background())
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case state.Version == "":
+				fmt.Printf("PairMesh %s is up to date\n", version.NewVersion().SemVer())
+				return nil
+			case !state.Staged:
+				fmt.Printf("PairMesh %s is available, download it from %s\n", state.Version, state.DownloadAddress)
+				return nil
+			}
+			if err := upd.Apply(); err != nil {
+				return err
+			}
+			fmt.Printf("PairMesh %s is installed, restart pairmesh to update\n", state.Version)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filter implements the stateful packet filter which enforces the ACL
+// policy of networks on the traffics between peers.
+package filter
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pairmesh/pairmesh/constant"
+	"github.com/pairmesh/pairmesh/protocol"
+
+	"go.uber.org/atomic"
+	"inet.af/netaddr"
+)
+
+const (
+	// FlowTimeout is the idle timeout of the tracked connections.
+	FlowTimeout = 2 * time.Minute
+
+	// maxFlows limits the size of the connection tracking table, and the new
+	// connections are still accepted but not tracked if exceeded.
+	maxFlows = 65536
+
+	actionAccept = "accept"
+)
+
+var (
+	meshIPv4Prefix = netaddr.MustParseIPPrefix(constant.MeshIPv4Prefix)
+	meshIPv6Prefix = netaddr.MustParseIPPrefix(constant.MeshIPv6Prefix)
+)
+
+type (
+	// DropStats represents the dropped packets counted by the ACL rules, and the
+	// packets which match no rule are counted as Default. The inbound packets
+	// whose source address doesn't belong to the remote peer are counted as
+	// Spoofed.
+	DropStats struct {
+		Rules   map[uint64]uint64 `json:"rules,omitempty"`
+		Default uint64            `json:"default"`
+		Spoofed uint64            `json:"spoofed,omitempty"`
+	}
+
+	// policy is the ACL policy indexed by networks and peers.
+	policy struct {
+		acl     []protocol.ACLRule
+		rules   map[protocol.NetworkID][]protocol.ACLRule
+		members map[protocol.PeerID]map[protocol.NetworkID]struct{}
+		users   map[protocol.PeerID]protocol.UserID
+		// sources are the addresses and approved subnet routes of the peers,
+		// and the exit nodes can send packets from the internet addresses,
+		// which are neither in the mesh prefixes nor owned by any peer.
+		sources map[protocol.PeerID][]netaddr.IPPrefix
+		owned   []netaddr.IPPrefix
+		exits   map[protocol.PeerID]struct{}
+	}
+
+	// Filter is a stateful packet filter. The packets between two peers are
+	// evaluated by the ACL rules of the networks which both peers belong to,
+	// and the packets are accepted if any of the networks accepts it. The
+	// networks without any rule accept all packets. The reply packets of the
+	// accepted connections are accepted by the connection tracking table.
+	Filter struct {
+		localPeer protocol.PeerID
+		policy    atomic.Value // An atomic value of: *policy
+
+		mu           sync.Mutex
+		flows        map[flow]time.Time // The expired time of the tracked flows.
+		drops        map[uint64]uint64
+		defaultDrops uint64
+		spoofed      uint64
+	}
+)
+
+// New returns a packet filter for the local peer.
+func New(localPeer protocol.PeerID) *Filter {
+	f := &Filter{
+		localPeer: localPeer,
+		flows:     map[flow]time.Time{},
+		drops:     map[uint64]uint64{},
+	}
+	f.policy.Store(&policy{})
+	return f
+}
+
+// Update updates the ACL policy with the latest networks, peers and rules. The
+// tracked connections are flushed if the rules are changed.
+func (f *Filter) Update(networks []protocol.Network, peers []protocol.Peer, acl []protocol.ACLRule) {
+	p := &policy{
+		acl:     acl,
+		rules:   map[protocol.NetworkID][]protocol.ACLRule{},
+		members: map[protocol.PeerID]map[protocol.NetworkID]struct{}{},
+		users:   map[protocol.PeerID]protocol.UserID{},
+		sources: map[protocol.PeerID][]netaddr.IPPrefix{},
+		exits:   map[protocol.PeerID]struct{}{},
+	}
+	for _, r := range acl {
+		p.rules[r.NetworkID] = append(p.rules[r.NetworkID], r)
+	}
+	for _, n := range networks {
+		for _, peerID := range n.Peers {
+			if p.members[peerID] == nil {
+				p.members[peerID] = map[protocol.NetworkID]struct{}{}
+			}
+			p.members[peerID][n.ID] = struct{}{}
+		}
+	}
+	for _, peer := range peers {
+		p.users[peer.ID] = peer.UserID
+		p.sources[peer.ID] = peerSources(peer)
+		p.owned = append(p.owned, p.sources[peer.ID]...)
+		if peer.ExitNode {
+			p.exits[peer.ID] = struct{}{}
+		}
+	}
+
+	prev := f.policy.Load().(*policy)
+	f.policy.Store(p)
+
+	if !reflect.DeepEqual(prev.acl, acl) {
+		f.mu.Lock()
+		f.flows = map[flow]time.Time{}
+		f.mu.Unlock()
+	}
+}
+
+// Outbound checks whether the packet sent to the remote peer is accepted.
+func (f *Filter) Outbound(remote protocol.PeerID, packet []byte) bool {
+	return f.check(f.localPeer, remote, packet)
+}
+
+// Inbound checks whether the packet received from the remote peer is accepted.
+// The packets whose source address doesn't belong to the remote peer are dropped
+// regardless of the ACL rules.
+func (f *Filter) Inbound(remote protocol.PeerID, packet []byte) bool {
+	return f.check(remote, f.localPeer, packet)
+}
+
+func (f *Filter) check(src, dst protocol.PeerID, packet []byte) bool {
+	p := f.policy.Load().(*policy)
+	inbound := dst == f.localPeer
+	// Fast path for the networks without ACL policy.
+	if len(p.rules) == 0 && !inbound {
+		return true
+	}
+
+	fl, ok := parseFlow(packet)
+	fl.srcPeer, fl.dstPeer = src, dst
+	now := time.Now()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !ok {
+		f.defaultDrops++
+		return false
+	}
+	if inbound && !p.ownSource(src, fl.src) {
+		f.spoofed++
+		return false
+	}
+	if len(p.rules) == 0 {
+		return true
+	}
+
+	// Accept the packets of the tracked connections in both directions.
+	for _, key := range []flow{fl, fl.reverse()} {
+		if expiredAt, found := f.flows[key]; found && now.Before(expiredAt) {
+			f.flows[key] = now.Add(FlowTimeout)
+			return true
+		}
+	}
+
+	accepted, ruleID := p.evaluate(src, dst, fl)
+	if !accepted {
+		if ruleID == 0 {
+			f.defaultDrops++
+		} else {
+			f.drops[ruleID]++
+		}
+		return false
+	}
+
+	if len(f.flows) >= maxFlows {
+		f.prune(now)
+	}
+	if len(f.flows) < maxFlows {
+		f.flows[fl] = now.Add(FlowTimeout)
+	}
+	return true
+}
+
+// Prune removes the expired connections from the connection tracking table.
+func (f *Filter) Prune() {
+	f.mu.Lock()
+	f.prune(time.Now())
+	f.mu.Unlock()
+}
+
+func (f *Filter) prune(now time.Time) {
+	for key, expiredAt := range f.flows {
+		if !now.Before(expiredAt) {
+			delete(f.flows, key)
+		}
+	}
+}
+
+// DropStats returns the dropped packets counters.
+func (f *Filter) DropStats() DropStats {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stats := DropStats{
+		Rules:   make(map[uint64]uint64, len(f.drops)),
+		Default: f.defaultDrops,
+		Spoofed: f.spoofed,
+	}
+	for id, count := range f.drops {
+		stats.Rules[id] = count
+	}
+	return stats
+}
+
+// peerSources returns the prefixes which the packets sent by the peer may come
+// from, and the malformed addresses are ignored.
+func peerSources(peer protocol.Peer) []netaddr.IPPrefix {
+	var prefixes []netaddr.IPPrefix
+	for _, addr := range []string{peer.IPv4, peer.IPv6} {
+		ip, err := netaddr.ParseIP(addr)
+		if err != nil {
+			continue
+		}
+		prefixes = append(prefixes, netaddr.IPPrefixFrom(ip, ip.BitLen()))
+	}
+	for _, r := range peer.Routes {
+		prefix, err := netaddr.ParseIPPrefix(r)
+		if err != nil {
+			continue
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes
+}
+
+// ownSource returns whether the source address belongs to the peer. The exit
+// nodes are self-advertised, so they can't send packets from the addresses of
+// the mesh, other peers or the subnet routes of them.
+func (p *policy) ownSource(peerID protocol.PeerID, src netaddr.IP) bool {
+	for _, prefix := range p.sources[peerID] {
+		if prefix.Contains(src) {
+			return true
+		}
+	}
+	if _, found := p.exits[peerID]; !found {
+		return false
+	}
+	if meshIPv4Prefix.Contains(src) || meshIPv6Prefix.Contains(src) {
+		return false
+	}
+	for _, prefix := range p.owned {
+		if prefix.Contains(src) {
+			return false
+		}
+	}
+	return true
+}
+
+// evaluate returns whether the flow from the source peer to the destination peer
+// is accepted, and the ID of the rule which drops the flow (zero for default).
+func (p *policy) evaluate(src, dst protocol.PeerID, fl flow) (bool, uint64) {
+	var (
+		shared bool
+		dropBy uint64
+	)
+	dstNetworks := p.members[dst]
+	for networkID := range p.members[src] {
+		if _, found := dstNetworks[networkID]; !found {
+			continue
+		}
+		shared = true
+
+		rules, found := p.rules[networkID]
+		if !found {
+			return true, 0
+		}
+		accepted, ruleID := p.match(rules, src, dst, fl)
+		if accepted {
+			return true, 0
+		}
+		// Prefer to count the drops by the explicit rules.
+		if dropBy == 0 {
+			dropBy = ruleID
+		}
+	}
+
+	// The devices of the same user are always reachable to each other.
+	if !shared {
+		srcUser, ok1 := p.users[src]
+		dstUser, ok2 := p.users[dst]
+		return ok1 && ok2 && srcUser == dstUser, 0
+	}
+	return false, dropBy
+}
+
+// match returns the action of the first matched rule and the rule ID. The flow
+// is dropped if no rule matched.
+func (p *policy) match(rules []protocol.ACLRule, src, dst protocol.PeerID, fl flow) (bool, uint64) {
+	for _, r := range rules {
+		if r.SrcUserID != 0 && r.SrcUserID != p.users[src] {
+			continue
+		}
+		if r.SrcPeerID != 0 && r.SrcPeerID != src {
+			continue
+		}
+		if r.DstPeerID != 0 && r.DstPeerID != dst {
+			continue
+		}
+		if r.Protocol != "" && r.Protocol != fl.protocol() {
+			continue
+		}
+		if len(r.Ports) > 0 && !matchPorts(r.Ports, fl.dstPort) {
+			continue
+		}
+		return r.Action == actionAccept, r.ID
+	}
+	return false, 0
+}
+
+func matchPorts(ranges []protocol.PortRange, port uint16) bool {
+	for _, r := range ranges {
+		if port >= r.First && port <= r.Last {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/stretchr/testify/assert"
+	"inet.af/netaddr"
+)
+
+func ipv4Packet(proto uint8, src, dst string, srcPort, dstPort uint16) []byte {
+	b := make([]byte, 28)
+	b[0] = 0x45
+	b[9] = proto
+	s := netaddr.MustParseIP(src).As4()
+	d := netaddr.MustParseIP(dst).As4()
+	copy(b[12:16], s[:])
+	copy(b[16:20], d[:])
+	binary.BigEndian.PutUint16(b[20:22], srcPort)
+	binary.BigEndian.PutUint16(b[22:24], dstPort)
+	return b
+}
+
+func TestParseFlow(t *testing.T) {
+	a := assert.New(t)
+
+	f, ok := parseFlow(ipv4Packet(protoTCP, "100.64.0.1", "100.64.0.2", 40000, 22))
+	a.True(ok)
+	a.Equal("tcp", f.protocol())
+	a.Equal(netaddr.MustParseIP("100.64.0.1"), f.src)
+	a.Equal(netaddr.MustParseIP("100.64.0.2"), f.dst)
+	a.Equal(uint16(40000), f.srcPort)
+	a.Equal(uint16(22), f.dstPort)
+	a.Equal(f, f.reverse().reverse())
+
+	// Non-first fragment
+	b := ipv4Packet(protoUDP, "100.64.0.1", "100.64.0.2", 53, 53)
+	binary.BigEndian.PutUint16(b[6:8], 100)
+	f, ok = parseFlow(b)
+	a.True(ok)
+	a.Zero(f.dstPort)
+
+	_, ok = parseFlow([]byte{0x45, 0x00})
+	a.False(ok)
+	_, ok = parseFlow([]byte{0x10})
+	a.False(ok)
+}
+
+func TestFilter(t *testing.T) {
+	a := assert.New(t)
+
+	const (
+		alice protocol.PeerID = 1
+		bob   protocol.PeerID = 2
+		carol protocol.PeerID = 3
+	)
+	networks := []protocol.Network{
+		{ID: 1, Peers: []protocol.PeerID{alice, bob, carol}},
+	}
+	peers := []protocol.Peer{
+		{ID: alice, UserID: 10, IPv4: "100.64.0.1"},
+		{ID: bob, UserID: 20, IPv4: "100.64.0.2"},
+		{ID: carol, UserID: 30, IPv4: "100.64.0.3"},
+	}
+
+	f := New(alice)
+	f.Update(networks, peers, nil)
+
+	// All packets are accepted without rules.
+	a.True(f.Inbound(bob, ipv4Packet(protoTCP, "100.64.0.2", "100.64.0.1", 40000, 22)))
+
+	acl := []protocol.ACLRule{
+		{ID: 1, NetworkID: 1, Action: "drop", SrcPeerID: carol},
+		{ID: 2, NetworkID: 1, Action: "accept", Protocol: "tcp", DstPeerID: alice, Ports: []protocol.PortRange{{First: 22, Last: 22}}},
+		{ID: 3, NetworkID: 1, Action: "accept", SrcUserID: 10},
+	}
+	f.Update(networks, peers, acl)
+
+	// Accepted by rule 2 and rejected by default.
+	a.True(f.Inbound(bob, ipv4Packet(protoTCP, "100.64.0.2", "100.64.0.1", 40000, 22)))
+	a.False(f.Inbound(bob, ipv4Packet(protoTCP, "100.64.0.2", "100.64.0.1", 40000, 80)))
+	a.False(f.Inbound(bob, ipv4Packet(protoUDP, "100.64.0.2", "100.64.0.1", 40000, 22)))
+
+	// Rejected by rule 1.
+	a.False(f.Inbound(carol, ipv4Packet(protoTCP, "100.64.0.3", "100.64.0.1", 40000, 22)))
+
+	// The reply packets of the tracked connections are accepted.
+	a.True(f.Outbound(bob, ipv4Packet(protoTCP, "100.64.0.1", "100.64.0.2", 22, 40000)))
+	a.False(f.Inbound(bob, ipv4Packet(protoTCP, "100.64.0.2", "100.64.0.1", 40001, 23)))
+
+	// Outbound packets are accepted by rule 3, and the replies are accepted.
+	a.True(f.Outbound(carol, ipv4Packet(protoUDP, "100.64.0.1", "100.64.0.3", 50000, 53)))
+	a.True(f.Inbound(carol, ipv4Packet(protoUDP, "100.64.0.3", "100.64.0.1", 53, 50000)))
+
+	stats := f.DropStats()
+	a.Equal(uint64(1), stats.Rules[1])
+	a.Equal(uint64(3), stats.Default)
+
+	// The tracked connections are flushed if the rules are changed.
+	f.Update(networks, peers, acl[:1])
+	a.False(f.Inbound(carol, ipv4Packet(protoUDP, "100.64.0.3", "100.64.0.1", 53, 50000)))
+}
+
+func TestFilterSource(t *testing.T) {
+	a := assert.New(t)
+
+	const (
+		alice protocol.PeerID = 1
+		bob   protocol.PeerID = 2
+		carol protocol.PeerID = 3
+		dave  protocol.PeerID = 4
+	)
+	networks := []protocol.Network{
+		{ID: 1, Peers: []protocol.PeerID{alice, bob, carol, dave}},
+	}
+	peers := []protocol.Peer{
+		{ID: alice, UserID: 10, IPv4: "100.64.0.1"},
+		{ID: bob, UserID: 20, IPv4: "100.64.0.2", Routes: []string{"192.168.1.0/24"}},
+		{ID: carol, UserID: 30, IPv4: "100.64.0.3"},
+		{ID: dave, UserID: 40, IPv4: "100.64.0.4", ExitNode: true},
+	}
+
+	f := New(alice)
+	f.Update(networks, peers, nil)
+
+	// The packets from the peer addresses and approved routes are accepted
+	// even if there is no rule, and the exit nodes forward any address.
+	a.True(f.Inbound(bob, ipv4Packet(protoTCP, "100.64.0.2", "100.64.0.1", 40000, 22)))
+	a.True(f.Inbound(bob, ipv4Packet(protoTCP, "192.168.1.7", "100.64.0.1", 40000, 22)))
+	a.True(f.Inbound(dave, ipv4Packet(protoTCP, "8.8.8.8", "100.64.0.1", 53, 40000)))
+	a.False(f.Inbound(carol, ipv4Packet(protoTCP, "100.64.0.2", "100.64.0.1", 40000, 22)))
+	a.False(f.Inbound(carol, ipv4Packet(protoTCP, "192.168.1.7", "100.64.0.1", 40000, 22)))
+	a.False(f.Inbound(5, ipv4Packet(protoTCP, "100.64.0.5", "100.64.0.1", 40000, 22)))
+	a.Equal(uint64(3), f.DropStats().Spoofed)
+
+	// The exit nodes can't spoof the mesh addresses or the routes of others.
+	a.False(f.Inbound(dave, ipv4Packet(protoTCP, "10.0.0.2", "100.64.0.1", 40000, 22)))
+	a.False(f.Inbound(dave, ipv4Packet(protoTCP, "100.64.0.3", "100.64.0.1", 40000, 22)))
+	a.False(f.Inbound(dave, ipv4Packet(protoTCP, "192.168.1.7", "100.64.0.1", 40000, 22)))
+	a.Equal(uint64(6), f.DropStats().Spoofed)
+
+	// The connections tracked for a peer cannot be used by the others.
+	acl := []protocol.ACLRule{
+		{ID: 1, NetworkID: 1, Action: "accept", SrcPeerID: alice},
+	}
+	f.Update(networks, peers, acl)
+	a.True(f.Outbound(bob, ipv4Packet(protoUDP, "100.64.0.1", "192.168.1.7", 50000, 53)))
+	a.True(f.Inbound(bob, ipv4Packet(protoUDP, "192.168.1.7", "100.64.0.1", 53, 50000)))
+	f.Update(networks, []protocol.Peer{peers[0], peers[1], {ID: carol, UserID: 30, IPv4: "100.64.0.3", Routes: []string{"192.168.1.0/24"}}}, acl)
+	a.False(f.Inbound(carol, ipv4Packet(protoUDP, "192.168.1.7", "100.64.0.1", 53, 50000)))
+	a.True(f.Inbound(bob, ipv4Packet(protoUDP, "192.168.1.7", "100.64.0.1", 53, 50000)))
+}
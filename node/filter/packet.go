@@ -0,0 +1,121 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"encoding/binary"
+
+	"github.com/pairmesh/pairmesh/protocol"
+
+	"inet.af/netaddr"
+)
+
+// IP protocol numbers which can be matched by the ACL rules.
+const (
+	protoICMP   uint8 = 1
+	protoTCP    uint8 = 6
+	protoUDP    uint8 = 17
+	protoICMPv6 uint8 = 58
+)
+
+// flow represents the 5-tuple of a packet and the peers which send and receive
+// it, which is used as the key of the connection tracking table. The peers are
+// part of the key so that a peer cannot hit the connections tracked for others
+// by forging the addresses.
+type flow struct {
+	proto   uint8
+	src     netaddr.IP
+	dst     netaddr.IP
+	srcPort uint16
+	dstPort uint16
+	srcPeer protocol.PeerID
+	dstPeer protocol.PeerID
+}
+
+// reverse returns the flow of the reply packets.
+func (f flow) reverse() flow {
+	return flow{
+		proto:   f.proto,
+		src:     f.dst,
+		dst:     f.src,
+		srcPort: f.dstPort,
+		dstPort: f.srcPort,
+		srcPeer: f.dstPeer,
+		dstPeer: f.srcPeer,
+	}
+}
+
+// protocol returns the protocol name used by the ACL rules.
+func (f flow) protocol() string {
+	switch f.proto {
+	case protoTCP:
+		return "tcp"
+	case protoUDP:
+		return "udp"
+	case protoICMP, protoICMPv6:
+		return "icmp"
+	}
+	return ""
+}
+
+// parseFlow parses the 5-tuple of the IPv4/IPv6 packet. The IPv6 extension
+// headers are not supported and the ports of the non-first IPv4 fragments are
+// zero because they are absent.
+func parseFlow(b []byte) (flow, bool) {
+	var (
+		f      flow
+		header int
+	)
+	if len(b) < 1 {
+		return f, false
+	}
+
+	switch b[0] >> 4 {
+	case 4:
+		if len(b) < 20 {
+			return f, false
+		}
+		header = int(b[0]&0x0f) * 4
+		if header < 20 || len(b) < header {
+			return f, false
+		}
+		f.proto = b[9]
+		f.src = netaddr.IPv4(b[12], b[13], b[14], b[15])
+		f.dst = netaddr.IPv4(b[16], b[17], b[18], b[19])
+		// Non-first fragments don't carry the transport header.
+		if binary.BigEndian.Uint16(b[6:8])&0x1fff != 0 {
+			return f, true
+		}
+	case 6:
+		if len(b) < 40 {
+			return f, false
+		}
+		header = 40
+		f.proto = b[6]
+		var src, dst [16]byte
+		copy(src[:], b[8:24])
+		copy(dst[:], b[24:40])
+		f.src = netaddr.IPv6Raw(src)
+		f.dst = netaddr.IPv6Raw(dst)
+	default:
+		return f, false
+	}
+
+	if (f.proto == protoTCP || f.proto == protoUDP) && len(b) >= header+4 {
+		f.srcPort = binary.BigEndian.Uint16(b[header : header+2])
+		f.dstPort = binary.BigEndian.Uint16(b[header+2 : header+4])
+	}
+	return f, true
+}
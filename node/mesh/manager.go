@@ -15,7 +15,9 @@
 package mesh
 
 import (
+	"context"
 	"net"
+	"reflect"
 	"sort"
 	"sync"
 	"time"
@@ -23,6 +25,7 @@ import (
 	"github.com/pairmesh/pairmesh/internal/relay"
 	"github.com/pairmesh/pairmesh/message"
 	"github.com/pairmesh/pairmesh/node/device"
+	"github.com/pairmesh/pairmesh/node/dns"
 	"github.com/pairmesh/pairmesh/node/mesh/peer"
 	"github.com/pairmesh/pairmesh/node/mesh/tunnel"
 	"github.com/pairmesh/pairmesh/node/mesh/types"
@@ -36,6 +39,20 @@ import (
 	"inet.af/netaddr"
 )
 
+const (
+	// bypassRefreshInterval is the interval to resolve the hosts of the relay
+	// servers again while an exit node is selected.
+	bypassRefreshInterval = 5 * time.Minute
+	// bypassResolveTimeout is the timeout to resolve a host of relay servers.
+	bypassResolveTimeout = 5 * time.Second
+)
+
+// subnetRoute represents a subnet route advertised by the peer.
+type subnetRoute struct {
+	prefix netaddr.IPPrefix
+	peer   *peer.Peer
+}
+
 // Manager is used to manage all tunnels connected to the current node.
 type Manager struct {
 	dialer    *net.Dialer
@@ -45,15 +62,39 @@ type Manager struct {
 	callback  tunnel.FragmentCallback
 	networks  atomic.Value // An atomic value of: []protocol.Network
 	endpoints atomic.Value // An atomic value of: []string
+	relays    atomic.Value // An atomic value of: []protocol.RelayServer
+	resolver  *dns.Resolver
+
+	// The name or address of the selected exit node, and the remote endpoints
+	// of the exit node which need to bypass the exit routes.
+	exitNode      atomic.String
+	exitEndpoints atomic.Value // An atomic value of: []string
+	// exitMu serializes the applying of exit routes.
+	exitMu sync.Mutex
+	// The IPv4 addresses of the relay server hosts which bypass the exit routes.
+	// They are resolved out of band, so applying the exit routes never waits
+	// for the resolver.
+	bypassIPs        atomic.Value // An atomic value of: map[string][]netaddr.IP
+	bypassResolving  atomic.Bool
+	bypassResolvedAt atomic.Int64
 
 	// Peers table.
 	mu    sync.RWMutex
 	peers map[protocol.PeerID]*peer.Peer
 	index map[string]*peer.Peer // index by address.
-
-	// Cache the summary
+	// Subnet routes advertised by peers and sorted by the prefix length
+	// in descending order for the longest prefix match.
+	subnets []subnetRoute
+	// The peers which can be used as exit nodes and the exit node which is
+	// forwarding the internet traffics.
+	exitNodes map[protocol.PeerID]struct{}
+	exit      *peer.Peer
+	// The peers which share the broadcast enabled networks with the current node.
+	broadcastPeers map[protocol.PeerID]*peer.Peer
+
+	// The time when the peers or the exit node changed last, which is reported
+	// by the summary.
 	lastChangedAt time.Time
-	cachedSummary *Summary
 }
 
 // NewManager generates a manager struct with given parameters
@@ -71,15 +112,29 @@ func NewManager(dialer *net.Dialer, localPeer types.LocalPeer, callback tunnel.F
 	return m
 }
 
+// SetResolver sets the resolver which resolves the peer names, and the names are
+// updated when the peers are updated. It must be called before the first Update.
+func (m *Manager) SetResolver(resolver *dns.Resolver) {
+	m.resolver = resolver
+}
+
 func (m *Manager) markChanged() {
 	m.lastChangedAt = time.Now()
 }
 
-// Tunnel returns a tunnel according to given dest if exists.
+// Tunnel returns a tunnel according to given dest if exists. The peer addresses
+// are matched exactly first, and then the subnet routes advertised by peers are
+// matched by the longest prefix. The internet traffics are forwarded to the exit
+// node if there is an exit node being used.
 func (m *Manager) Tunnel(dest string) *tunnel.Tunnel {
-	// TODO: support subnet NAT.
 	m.mu.RLock()
 	p, found := m.index[dest]
+	if !found && len(m.subnets) > 0 {
+		p, found = m.matchSubnet(dest)
+	}
+	if !found && m.exit != nil && isInternet(dest) {
+		p, found = m.exit, true
+	}
 	m.mu.RUnlock()
 	if !found {
 		return nil
@@ -88,6 +143,56 @@ func (m *Manager) Tunnel(dest string) *tunnel.Tunnel {
 	return p.Tunnel()
 }
 
+// BroadcastTunnels returns the tunnels of the peers which share the broadcast
+// enabled networks with the current node.
+func (m *Manager) BroadcastTunnels() []*tunnel.Tunnel {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	tunnels := make([]*tunnel.Tunnel, 0, len(m.broadcastPeers))
+	for _, p := range m.broadcastPeers {
+		if t := p.Tunnel(); t != nil {
+			tunnels = append(tunnels, t)
+		}
+	}
+	return tunnels
+}
+
+// BroadcastPeer returns the peer if it shares any broadcast enabled network
+// with the current node.
+func (m *Manager) BroadcastPeer(peerID protocol.PeerID) *peer.Peer {
+	m.mu.RLock()
+	p := m.broadcastPeers[peerID]
+	m.mu.RUnlock()
+	return p
+}
+
+// matchSubnet returns the peer which advertised the longest prefix matched
+// subnet route. The caller should hold the read lock.
+func (m *Manager) matchSubnet(dest string) (*peer.Peer, bool) {
+	addr, err := netaddr.ParseIP(dest)
+	if err != nil {
+		return nil, false
+	}
+	for _, r := range m.subnets {
+		if r.prefix.Contains(addr) {
+			return r.peer, true
+		}
+	}
+	return nil, false
+}
+
+// isInternet returns whether the destination is an IPv4 unicast address which can
+// be forwarded by the exit node.
+func isInternet(dest string) bool {
+	addr, err := netaddr.ParseIP(dest)
+	if err != nil || !addr.Is4() {
+		return false
+	}
+	return !addr.IsMulticast() && !addr.IsLinkLocalUnicast() && !addr.IsLoopback() &&
+		!addr.IsUnspecified() && addr != netaddr.IPv4(255, 255, 255, 255)
+}
+
 // Peer returns the communication Tunnel corresponding to the destination.
 func (m *Manager) Peer(peerID protocol.PeerID) *peer.Peer {
 	m.mu.RLock()
@@ -99,29 +204,24 @@ func (m *Manager) Peer(peerID protocol.PeerID) *peer.Peer {
 	return p
 }
 
-// Summarize returns the mesh network summary.
+// Summarize returns the mesh network summary. The statistics are collected on
+// every call, and the LastChangedAt only changes if the peers or networks change.
 func (m *Manager) Summarize() *Summary {
-	if m.cachedSummary != nil && m.cachedSummary.LastChangedAt == m.lastChangedAt {
-		return m.cachedSummary
-	}
-
 	var myDevices []Device
 	selfUserID := m.localPeer.UserID
+	relayNames := map[protocol.ServerID]string{}
+	if v := m.relays.Load(); v != nil {
+		for _, r := range v.([]protocol.RelayServer) {
+			relayNames[r.ID] = r.Name
+		}
+	}
+
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	for _, p := range m.peers {
-		peerInfo := p.PeerInfo()
-		if peerInfo.UserID == selfUserID {
-			state := StateRelay
-			if t := p.Tunnel(); t != nil && t.ReachableEndpoint() != nil {
-				state = StateP2P
-			}
-			myDevices = append(myDevices, Device{
-				Name:   peerInfo.Name,
-				IPv4:   peerInfo.IPv4,
-				Status: state,
-			})
+		if p.PeerInfo().UserID == selfUserID {
+			myDevices = append(myDevices, summarizePeer(p, relayNames))
 		}
 	}
 
@@ -141,33 +241,97 @@ func (m *Manager) Summarize() *Summary {
 			if !ok {
 				continue
 			}
-			state := StateRelay
-			if t := p.Tunnel(); t != nil && t.ReachableEndpoint() != nil {
-				state = StateP2P
-			}
-			peerInfo := p.PeerInfo()
-			network.Devices = append(network.Devices, Device{
-				Name:   peerInfo.Name,
-				IPv4:   peerInfo.IPv4,
-				Status: state,
-			})
+			network.Devices = append(network.Devices, summarizePeer(p, relayNames))
 		}
 		myNetworks = append(myNetworks, network)
 	}
 
+	// Exit nodes
+	var exitNodes []Device
+	for id := range m.exitNodes {
+		p, ok := m.peers[id]
+		if !ok || id == m.localPeer.PeerID {
+			continue
+		}
+		exitNodes = append(exitNodes, summarizePeer(p, relayNames))
+	}
+	sort.Slice(exitNodes, func(i, j int) bool {
+		return exitNodes[i].Name < exitNodes[j].Name
+	})
+	var exitNode string
+	if m.exit != nil {
+		exitNode = m.exit.PeerInfo().Name
+	}
+
 	summary := &Summary{
 		LastChangedAt: m.lastChangedAt,
 		MyDevices:     myDevices,
 		Networks:      myNetworks,
+		ExitNodes:     exitNodes,
+		ExitNode:      exitNode,
 	}
-	m.cachedSummary = summary
 
 	return summary
 }
 
-// Tick proceeds with probing peers
+// summarizePeer returns the device summary of the peer with the statistics of
+// the tunnel if established.
+func summarizePeer(p *peer.Peer, relayNames map[protocol.ServerID]string) Device {
+	peerInfo := p.PeerInfo()
+	device := Device{
+		Name:   peerInfo.Name,
+		IPv4:   peerInfo.IPv4,
+		IPv6:   peerInfo.IPv6,
+		Status: StateRelay,
+	}
+
+	t := p.Tunnel()
+	if t == nil {
+		return device
+	}
+	stats := t.Stats()
+	if stats.Endpoint != "" {
+		device.Status = StateP2P
+	}
+	device.Stats = &PeerStats{
+		TxBytes:         stats.TxBytes,
+		TxPackets:       stats.TxPackets,
+		RxBytes:         stats.RxBytes,
+		RxPackets:       stats.RxPackets,
+		LastHandshakeAt: stats.LastHandshakeAt,
+		Endpoint:        stats.Endpoint,
+		Latency:         stats.Latency,
+		Relay:           relayNames[p.PrimaryServerID()],
+		Dropped:         stats.Dropped,
+		Replayed:        stats.Rejected.Replayed,
+		Invalid:         stats.Rejected.Invalid,
+	}
+	return device
+}
+
+// Tick proceeds with probing peers and rotating session keys
 func (m *Manager) Tick() {
 	m.probePeers()
+	m.rekeyPeers()
+	if m.exitNode.Load() != "" && time.Since(time.Unix(0, m.bypassResolvedAt.Load())) > bypassRefreshInterval {
+		m.refreshBypass()
+	}
+}
+
+// rekeyPeers rotates the expired session keys of the tunnels.
+func (m *Manager) rekeyPeers() {
+	m.mu.RLock()
+	var tunnels []*tunnel.Tunnel
+	for _, p := range m.peers {
+		if t := p.Tunnel(); t != nil {
+			tunnels = append(tunnels, t)
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, t := range tunnels {
+		t.Tick()
+	}
 }
 
 // Update updates the latest networks and peers information.
@@ -176,19 +340,7 @@ func (m *Manager) Update(latestNetworks []protocol.Network, latestPeers []protoc
 		zap.L().Debug("Update peers", zap.Any("peers", latestPeers))
 	}
 
-	// Belongs to all the networks
-	var selfNetworks []*message.PacketSyncPeer_Network
-	for _, n := range latestNetworks {
-		selfNetworks = append(selfNetworks, &message.PacketSyncPeer_Network{
-			Name: n.Name,
-			ID:   uint64(n.ID),
-		})
-	}
-
-	m.networks.Store(latestNetworks)
-	m.localPeer.Networks = selfNetworks
-
-	routerCfg := &device.Config{LocalAddress: m.localPeer.VIPv4}
+	m.setNetworks(latestNetworks)
 
 	// NOTE: We must merge the peer information before sending probe request to
 	// the relay server because we may receive the probe response when the `Update`
@@ -200,48 +352,183 @@ func (m *Manager) Update(latestNetworks []protocol.Network, latestPeers []protoc
 	peers := map[protocol.PeerID]*peer.Peer{}
 	for _, latestPeer := range latestPeers {
 		p, ok := m.peers[latestPeer.ID]
-		if !ok {
+		if ok {
+			p.SetPeerInfo(latestPeer)
+		} else {
 			p = peer.New(latestPeer)
 		}
 		peers[latestPeer.ID] = p
+	}
+
+	// Close outdated remote peers.
+	var outdated []*peer.Peer
+	for _, p := range m.peers {
+		if _, ok := peers[p.ID()]; !ok {
+			outdated = append(outdated, p)
+		}
+	}
+
+	routerCfg, err := m.rebuildPeers(latestNetworks, peers)
+	m.mu.Unlock()
+	if err != nil {
+		return errors.WithMessage(err, "parse address in Update")
+	}
+
+	for _, p := range outdated {
+		p.Close()
+	}
+	m.applyPeers(routerCfg)
+	return nil
+}
+
+// ApplyPeerEvents applies the changes of peers pushed by the portal service.
+// The peers which aren't changed are kept untouched, includes the tunnels.
+func (m *Manager) ApplyPeerEvents(latestNetworks []protocol.Network, events []protocol.PeerEvent) error {
+	if logutil.IsEnableRelay() {
+		zap.L().Debug("Apply peer events", zap.Any("events", events))
+	}
+
+	m.setNetworks(latestNetworks)
+
+	m.mu.Lock()
+	peers := make(map[protocol.PeerID]*peer.Peer, len(m.peers))
+	for id, p := range m.peers {
+		peers[id] = p
+	}
+	var removed []*peer.Peer
+	for _, e := range events {
+		switch e.Type {
+		case protocol.PeerEventAdd, protocol.PeerEventUpdate:
+			if p, ok := peers[e.Peer.ID]; ok {
+				p.SetPeerInfo(e.Peer)
+			} else {
+				peers[e.Peer.ID] = peer.New(e.Peer)
+			}
+		case protocol.PeerEventRemove:
+			if p, ok := peers[e.Peer.ID]; ok {
+				delete(peers, e.Peer.ID)
+				removed = append(removed, p)
+			}
+		}
+	}
+
+	routerCfg, err := m.rebuildPeers(latestNetworks, peers)
+	m.mu.Unlock()
+	if err != nil {
+		return errors.WithMessage(err, "parse address in ApplyPeerEvents")
+	}
+
+	for _, p := range removed {
+		p.Close()
+	}
+	m.applyPeers(routerCfg)
+	return nil
+}
+
+// setNetworks stores the networks which the current node belongs to.
+func (m *Manager) setNetworks(latestNetworks []protocol.Network) {
+	// Belongs to all the networks
+	var selfNetworks []*message.PacketSyncPeer_Network
+	for _, n := range latestNetworks {
+		selfNetworks = append(selfNetworks, &message.PacketSyncPeer_Network{
+			Name: n.Name,
+			ID:   uint64(n.ID),
+		})
+	}
+
+	m.networks.Store(latestNetworks)
+	m.localPeer.Networks = selfNetworks
+}
+
+// rebuildPeers replaces the peers table and rebuilds the indexes, routes and
+// name records of the peers, and returns the router configuration. The peers
+// table is kept if any peer address is illegal. The caller should hold the lock.
+func (m *Manager) rebuildPeers(latestNetworks []protocol.Network, peers map[protocol.PeerID]*peer.Peer) (*device.Config, error) {
+	routerCfg := &device.Config{LocalAddress: m.localPeer.VIPv4}
+	exitNodes := map[protocol.PeerID]struct{}{}
+	infos := make([]protocol.Peer, 0, len(peers))
+	var subnets []subnetRoute
+	for _, p := range peers {
+		info := p.PeerInfo()
+		infos = append(infos, info)
+		if info.ExitNode {
+			exitNodes[info.ID] = struct{}{}
+		}
 
 		// Skip the current device.
-		if p.ID() != m.localPeer.PeerID {
-			addr, err := netaddr.ParseIP(latestPeer.IPv4)
+		if info.ID == m.localPeer.PeerID {
+			continue
+		}
+		routes, err := peerRoutes(info.IPv4, info.IPv6)
+		if err != nil {
+			return nil, err
+		}
+		routerCfg.Routes = append(routerCfg.Routes, routes...)
+
+		// Install the subnet routes advertised by the peer.
+		for _, r := range info.Routes {
+			prefix, err := netaddr.ParseIPPrefix(r)
 			if err != nil {
-				return errors.WithMessage(err, "parse ipv4 address in Update")
+				zap.L().Warn("Illegal subnet route", zap.String("route", r), zap.Error(err))
+				continue
 			}
-			prefix := netaddr.IPPrefixFrom(addr, 32)
 			routerCfg.Routes = append(routerCfg.Routes, prefix)
+			subnets = append(subnets, subnetRoute{prefix: prefix, peer: p})
 		}
 	}
+	sort.SliceStable(subnets, func(i, j int) bool {
+		if subnets[i].prefix.Bits() != subnets[j].prefix.Bits() {
+			return subnets[i].prefix.Bits() > subnets[j].prefix.Bits()
+		}
+		return subnets[i].peer.ID() < subnets[j].peer.ID()
+	})
+
+	broadcastPeers := map[protocol.PeerID]*peer.Peer{}
+	for _, n := range latestNetworks {
+		if !n.Broadcast {
+			continue
+		}
+		for _, peerID := range n.Peers {
+			if p, found := peers[peerID]; found && peerID != m.localPeer.PeerID {
+				broadcastPeers[peerID] = p
+			}
+		}
+	}
+
 	index := map[string]*peer.Peer{}
 	for _, p := range peers {
 		index[p.IPv4()] = p
+		if ipv6 := p.IPv6(); ipv6 != "" {
+			index[ipv6] = p
+		}
 	}
 
-	// Close outdated remote peers.
-	for _, p := range m.peers {
-		_, ok := peers[p.ID()]
-		if !ok {
-			p.Close()
-		}
+	if m.resolver != nil {
+		m.resolver.SetRecords(dns.Records(latestNetworks, infos))
 	}
 
 	// Update the local peers cache.
 	m.peers = peers
 	m.index = index
-	m.mu.Unlock()
+	m.subnets = subnets
+	m.exitNodes = exitNodes
+	m.broadcastPeers = broadcastPeers
+	return routerCfg, nil
+}
 
+// applyPeers applies the router configuration and the exit node after the
+// peers table is changed, and probes the new peers.
+func (m *Manager) applyPeers(routerCfg *device.Config) {
 	// Update the router configuration to allow traffics to the remote peers.
 	m.router.Set(routerCfg)
 
+	// The exit node may be changed or go offline.
+	m.applyExitNode()
+
 	// TODO: check peers changed more accurately.
 	m.markChanged()
 
 	m.probePeers()
-
-	return nil
 }
 
 func (m *Manager) probePeers() {
@@ -264,7 +551,7 @@ func (m *Manager) probePeers() {
 
 	// Probe all peers.
 	for serverID, peerIDs := range probeGroup {
-		client := m.rm.RelayServerClient(serverID)
+		client := m.relayClientGetter(serverID)()
 		if client == nil {
 			continue
 		}
@@ -336,6 +623,7 @@ func (m *Manager) ProbeResult(probe *message.PacketProbeResponse) {
 		UserID:    uint64(m.localPeer.UserID),
 		PeerID:    uint64(m.localPeer.PeerID),
 		IPv4:      m.localPeer.VIPv4.String(),
+		IPv6:      m.localIPv6(),
 		Name:      m.localPeer.Name,
 		PublicKey: m.localPeer.Key.Public,
 		PrimaryServer: &message.PacketSyncPeer_RelayServer{
@@ -350,18 +638,25 @@ func (m *Manager) ProbeResult(probe *message.PacketProbeResponse) {
 	}
 
 	for _, catchupPeer := range catchupPeers {
-		client := m.rm.RelayServerClient(catchupPeer.PrimaryServerID())
+		client := m.relayClientGetter(catchupPeer.PrimaryServerID())()
 		if client == nil {
 			continue
 		}
 
+		hs, err := catchupPeer.CatchupHandshake()
+		if err != nil {
+			zap.L().Error("Generate catchup handshake failed", zap.Error(err))
+			continue
+		}
+
 		syncPeer := &message.PacketSyncPeer{
 			DstPeerID: uint64(catchupPeer.ID()),
 			Purpose:   message.PacketSyncPeer_Catchup,
 			Peer:      peerInfo,
+			Key:       &message.PacketSyncPeer_SessionKey{PublicKey: hs.Public},
 		}
 
-		err := client.Send(message.PacketType_SyncPeer, syncPeer)
+		err = client.Send(message.PacketType_SyncPeer, syncPeer)
 		if err != nil {
 			zap.L().Error("Send catchup failed", zap.Error(err))
 			continue
@@ -378,64 +673,54 @@ func (m *Manager) PeerCatchup(syncPeer *message.PacketSyncPeer) error {
 		zap.L().Debug("Received request to do PeerCatchup but peerInfo in packet is empty")
 		return nil
 	}
+	if syncPeer.Key == nil {
+		return errors.New("catchup without handshake key")
+	}
 
-	routerCfg := &device.Config{LocalAddress: m.localPeer.VIPv4}
-
-	// Update the latest peer information.
-	m.mu.Lock()
+	// Only the peers in the peer graph, which share any approved network with
+	// the local peer, are allowed to catch up.
+	m.mu.RLock()
 	peerID := protocol.PeerID(peerInfo.PeerID)
 	p, ok := m.peers[peerID]
+	m.mu.RUnlock()
 	if !ok {
-		p = peer.New(protocol.Peer{
-			ID:       protocol.PeerID(peerInfo.PeerID),
-			UserID:   protocol.UserID(peerInfo.UserID),
-			Name:     peerInfo.Name,
-			IPv4:     peerInfo.IPv4,
-			ServerID: protocol.ServerID(peerInfo.PrimaryServer.ID),
-			Active:   true,
-		})
-		addr, err := netaddr.ParseIP(peerInfo.IPv4)
-		if err != nil {
-			return errors.WithMessage(err, "parse ipv4 address in PeerCatchup")
-		}
-		prefix := netaddr.IPPrefixFrom(addr, 32)
-		routerCfg.Routes = append(routerCfg.Routes, prefix)
-		m.peers[peerID] = p
-		m.index[peerInfo.IPv4] = p
+		return errors.Errorf("catchup from unknown peer %d", peerID)
+	}
 
-		// We treat the newly added peer as probed one.
-		p.SetProbeStatus(true)
+	// Both peers send catchup simultaneously, and the catchup sent by the peer
+	// with the lower peer id wins.
+	if p.IsCatchupInFlight() && m.localPeer.PeerID < peerID {
+		return nil
 	}
-	m.mu.Unlock()
+	p.TakeCatchupHandshake()
 
-	// The encrypt/decrypt cipher of tunnels are the same.
-	sharedKey, err := noise.DH25519.DH(m.localPeer.Key.Private, peerInfo.PublicKey)
+	staticKey, err := m.staticKey(peerInfo.PublicKey)
 	if err != nil {
 		zap.L().Error("Exchange shared key failed", zap.Error(err))
 		return err
 	}
-	fixSizeKey := [32]byte{}
-	copy(fixSizeKey[:], sharedKey)
-	cipher := noise.CipherChaChaPoly.Cipher(fixSizeKey)
+	hs, err := tunnel.NewHandshake()
+	if err != nil {
+		return errors.WithMessage(err, "generate catchup handshake")
+	}
 	rcGetter := m.relayClientGetter(protocol.ServerID(peerInfo.PrimaryServer.ID))
 
-	p.SetTunnel(tunnel.New(rcGetter, m.dialer, m.localPeer, protocol.PeerID(peerInfo.PeerID), m.callback, cipher))
+	t, err := tunnel.New(rcGetter, m.dialer, m.localPeer, peerID, m.callback, staticKey, hs, syncPeer.Key.PublicKey)
+	if err != nil {
+		return err
+	}
+	p.SetTunnel(t)
 	p.SetCatchupAt(time.Now())
 	if cached := m.endpoints.Load(); cached != nil {
 		p.Tunnel().SetLocalEndpoints(cached.([]string))
 	}
 
-	// Add to router if the peer is newly added.
-	if len(routerCfg.Routes) > 0 {
-		m.router.Add(routerCfg)
-	}
-
 	// Update the network topology information.
 	m.updateNetworkTopologyWithPeer(peerInfo)
 
-	relayClient := m.rm.RelayServerClient(protocol.ServerID(peerInfo.PrimaryServer.ID))
+	relayClient := m.relayClientGetter(protocol.ServerID(peerInfo.PrimaryServer.ID))()
 	if relayClient == nil {
-		zap.L().Error("The remote peer primary relay server doesn't connect", zap.Any("peer_id", peerID))
+		zap.L().Error("The relay server doesn't connect", zap.Any("peer_id", peerID))
 		return err
 	}
 
@@ -446,6 +731,7 @@ func (m *Manager) PeerCatchup(syncPeer *message.PacketSyncPeer) error {
 			PeerID:    uint64(m.localPeer.PeerID),
 			PublicKey: m.localPeer.Key.Public,
 		},
+		Key: &message.PacketSyncPeer_SessionKey{PublicKey: hs.Public},
 	}
 	err = relayClient.Send(message.PacketType_SyncPeer, ack)
 	if err != nil {
@@ -459,7 +745,7 @@ func (m *Manager) PeerCatchup(syncPeer *message.PacketSyncPeer) error {
 // PeerCatchupAck acks back to message.PacketSyncPeer
 func (m *Manager) PeerCatchupAck(syncPeer *message.PacketSyncPeer) {
 	peerInfo := syncPeer.Peer
-	if peerInfo == nil {
+	if peerInfo == nil || syncPeer.Key == nil {
 		return
 	}
 
@@ -473,24 +759,97 @@ func (m *Manager) PeerCatchupAck(syncPeer *message.PacketSyncPeer) {
 		return
 	}
 
-	// The encrypt/decrypt cipher of tunnels are the same.
-	sharedKey, err := noise.DH25519.DH(m.localPeer.Key.Private, peerInfo.PublicKey)
+	// The acknowledgement is ignored if the catchup is superseded by the one
+	// sent by the remote peer.
+	hs := p.TakeCatchupHandshake()
+	if hs == nil {
+		zap.L().Warn("Unexpected catchup ack", zap.Any("peer_id", peerID))
+		return
+	}
+
+	staticKey, err := m.staticKey(peerInfo.PublicKey)
 	if err != nil {
 		zap.L().Error("Exchange shared key failed", zap.Error(err))
 		return
 	}
-	fixSizeKey := [32]byte{}
-	copy(fixSizeKey[:], sharedKey)
-	cipher := noise.CipherChaChaPoly.Cipher(fixSizeKey)
 	rcGetter := m.relayClientGetter(p.PrimaryServerID())
 
-	p.SetTunnel(tunnel.New(rcGetter, m.dialer, m.localPeer, protocol.PeerID(peerInfo.PeerID), m.callback, cipher))
+	t, err := tunnel.New(rcGetter, m.dialer, m.localPeer, peerID, m.callback, staticKey, *hs, syncPeer.Key.PublicKey)
+	if err != nil {
+		zap.L().Error("Create tunnel failed", zap.Any("peer_id", peerID), zap.Error(err))
+		return
+	}
+	p.SetTunnel(t)
 	p.SetCatchupAt(time.Now())
 	if cached := m.endpoints.Load(); cached != nil {
 		p.Tunnel().SetLocalEndpoints(cached.([]string))
 	}
 }
 
+// localIPv6 returns the IPv6 address of local peer or empty string if absent.
+func (m *Manager) localIPv6() string {
+	if m.localPeer.VIPv6.IsZero() {
+		return ""
+	}
+	return m.localPeer.VIPv6.String()
+}
+
+// peerRoutes returns the host routes of the peer addresses. The IPv6 address
+// is optional because the peers of previous versions have no IPv6 address.
+func peerRoutes(ipv4, ipv6 string) ([]netaddr.IPPrefix, error) {
+	addr, err := netaddr.ParseIP(ipv4)
+	if err != nil {
+		return nil, err
+	}
+	routes := []netaddr.IPPrefix{netaddr.IPPrefixFrom(addr, 32)}
+	if ipv6 == "" {
+		return routes, nil
+	}
+
+	addr6, err := netaddr.ParseIP(ipv6)
+	if err != nil {
+		return nil, err
+	}
+	return append(routes, netaddr.IPPrefixFrom(addr6, 128)), nil
+}
+
+// staticKey returns the shared key exchanged by the static keys of local peer and
+// remote peer, which is the initial session key of the tunnel. The encrypt/decrypt
+// cipher of tunnels are the same.
+func (m *Manager) staticKey(publicKey []byte) ([32]byte, error) {
+	fixSizeKey := [32]byte{}
+	sharedKey, err := noise.DH25519.DH(m.localPeer.Key.Private, publicKey)
+	if err != nil {
+		return fixSizeKey, err
+	}
+	copy(fixSizeKey[:], sharedKey)
+	return fixSizeKey, nil
+}
+
+// PeerRekey handles the session key rotation messages of the tunnel.
+func (m *Manager) PeerRekey(syncPeer *message.PacketSyncPeer) {
+	peerInfo := syncPeer.Peer
+	if peerInfo == nil {
+		return
+	}
+
+	m.mu.RLock()
+	p, ok := m.peers[protocol.PeerID(peerInfo.PeerID)]
+	m.mu.RUnlock()
+
+	if !ok {
+		zap.L().Error("Peer not found", zap.Any("peer_id", peerInfo.PeerID))
+		return
+	}
+
+	t := p.Tunnel()
+	if t == nil {
+		return
+	}
+
+	t.OnRekey(syncPeer)
+}
+
 // PeerEndpoints sets up a tunnel given input message.PacketSyncPeer
 func (m *Manager) PeerEndpoints(syncPeer *message.PacketSyncPeer) {
 	peerInfo := syncPeer.Peer
@@ -513,6 +872,16 @@ func (m *Manager) PeerEndpoints(syncPeer *message.PacketSyncPeer) {
 		return
 	}
 
+	// The endpoints of the exit node must bypass the exit routes before
+	// discovering, otherwise the P2P traffics will be routed to the device.
+	m.mu.RLock()
+	isExit := p == m.exit
+	m.mu.RUnlock()
+	if isExit {
+		m.exitEndpoints.Store(syncPeer.Endpoints)
+		m.applyExitNode()
+	}
+
 	t.SetRemoteEndpoints(syncPeer.Endpoints)
 }
 
@@ -561,11 +930,7 @@ func (m *Manager) SyncEndpoints(endpoints []string) {
 
 	for _, p := range needSyncPeers {
 		// Send the pair request packet to the remote peer.
-		relayClient := m.rm.RelayServerClient(p.PrimaryServerID())
-		if relayClient == nil {
-			// Fallback to the local peer primary client
-			relayClient = m.rm.PrimaryRelayServerClient()
-		}
+		relayClient := m.relayClientGetter(p.PrimaryServerID())()
 		if relayClient == nil {
 			zap.L().Error("Cannot find the relay server for remote peer", zap.Any("peer_id", p.ID()))
 			continue
@@ -585,12 +950,204 @@ func (m *Manager) SyncEndpoints(endpoints []string) {
 	}
 }
 
+// SetRelayServers sets the latest relay servers, which are kept on the physical
+// interface if there is an exit node being used.
+func (m *Manager) SetRelayServers(relayServers []protocol.RelayServer) {
+	m.relays.Store(relayServers)
+	if m.unresolvedBypass() {
+		m.refreshBypass()
+	}
+}
+
+// SetExitNode selects the peer with the name or address as the exit node, and
+// the internet traffics will not be forwarded to peers if the selector is empty.
+// The exit node is applied once the peer is found in the peers graph.
+func (m *Manager) SetExitNode(selector string) {
+	if m.exitNode.Load() == selector {
+		return
+	}
+	// The hosts of relay servers are resolved before installing the exit
+	// routes, so the lookups don't go through the exit node.
+	if selector != "" && m.unresolvedBypass() {
+		m.resolveBypass()
+	}
+	m.exitNode.Store(selector)
+	m.applyExitNode()
+}
+
+// matchExitNode returns the peer matched the exit node selector. The caller
+// should hold the lock.
+func (m *Manager) matchExitNode(selector string) *peer.Peer {
+	if selector == "" {
+		return nil
+	}
+	for id := range m.exitNodes {
+		p, found := m.peers[id]
+		if !found || id == m.localPeer.PeerID {
+			continue
+		}
+		if p.PeerInfo().Name == selector || p.IPv4() == selector {
+			return p
+		}
+	}
+	return nil
+}
+
+// applyExitNode resolves the selected exit node and updates the exit routes.
+func (m *Manager) applyExitNode() {
+	m.exitMu.Lock()
+	defer m.exitMu.Unlock()
+
+	selector := m.exitNode.Load()
+	m.mu.Lock()
+	exit := m.matchExitNode(selector)
+	changed := exit != m.exit
+	m.exit = exit
+	m.mu.Unlock()
+
+	if changed {
+		// The endpoints of the previous exit node are not bypassed anymore, and
+		// the endpoints of the new one are known already if the tunnel has been
+		// discovered before.
+		var endpoints []string
+		if exit != nil {
+			if t := exit.Tunnel(); t != nil {
+				endpoints = t.RemoteEndpoints()
+			}
+		}
+		m.exitEndpoints.Store(endpoints)
+
+		m.markChanged()
+		if exit != nil {
+			zap.L().Info("Use exit node", zap.String("name", exit.PeerInfo().Name), zap.String("address", exit.IPv4()))
+		} else if selector != "" {
+			zap.L().Warn("Exit node not available", zap.String("exit_node", selector))
+		}
+	}
+
+	cfg := &device.ExitConfig{
+		LocalAddress: m.localPeer.VIPv4,
+		Enabled:      exit != nil,
+	}
+	if exit != nil {
+		cfg.Bypass = m.bypassAddresses()
+	}
+	m.router.SetExit(cfg)
+}
+
+// bypassAddresses returns the IPv4 addresses of the relay servers and the remote
+// endpoints of the exit node, which must not be routed to the exit node. The
+// hosts of relay servers are taken from the addresses resolved out of band.
+func (m *Manager) bypassAddresses() []netaddr.IP {
+	var addrs []netaddr.IP
+	seen := map[netaddr.IP]struct{}{}
+	add := func(addr netaddr.IP) {
+		if _, found := seen[addr]; found || !addr.Is4() {
+			return
+		}
+		seen[addr] = struct{}{}
+		addrs = append(addrs, addr)
+	}
+
+	resolved, _ := m.bypassIPs.Load().(map[string][]netaddr.IP)
+	if v := m.relays.Load(); v != nil {
+		for _, r := range v.([]protocol.RelayServer) {
+			if addr, err := netaddr.ParseIP(r.Host); err == nil {
+				add(addr)
+				continue
+			}
+			for _, addr := range resolved[r.Host] {
+				add(addr)
+			}
+		}
+	}
+	if v := m.exitEndpoints.Load(); v != nil {
+		for _, ep := range v.([]string) {
+			host, _, err := net.SplitHostPort(ep)
+			if err != nil {
+				continue
+			}
+			if addr, err := netaddr.ParseIP(host); err == nil {
+				add(addr)
+			}
+		}
+	}
+	return addrs
+}
+
+// bypassHosts returns the hosts of the relay servers which need to be resolved.
+func (m *Manager) bypassHosts() []string {
+	var hosts []string
+	if v := m.relays.Load(); v != nil {
+		for _, r := range v.([]protocol.RelayServer) {
+			if _, err := netaddr.ParseIP(r.Host); err != nil {
+				hosts = append(hosts, r.Host)
+			}
+		}
+	}
+	return hosts
+}
+
+// unresolvedBypass returns whether any host of the relay servers isn't resolved.
+func (m *Manager) unresolvedBypass() bool {
+	resolved, _ := m.bypassIPs.Load().(map[string][]netaddr.IP)
+	for _, host := range m.bypassHosts() {
+		if _, found := resolved[host]; !found {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveBypass resolves the hosts of the relay servers and caches the IPv4
+// addresses of them, and returns whether the addresses are changed. The previous
+// addresses of a host are kept if it fails to be resolved.
+func (m *Manager) resolveBypass() bool {
+	prev, _ := m.bypassIPs.Load().(map[string][]netaddr.IP)
+	resolved := map[string][]netaddr.IP{}
+	for _, host := range m.bypassHosts() {
+		ctx, cancel := context.WithTimeout(context.Background(), bypassResolveTimeout)
+		ips, err := net.DefaultResolver.LookupIP(ctx, "ip4", host)
+		cancel()
+		if err != nil {
+			zap.L().Error("Resolve bypass address failed", zap.String("host", host), zap.Error(err))
+			if addrs, found := prev[host]; found {
+				resolved[host] = addrs
+			}
+			continue
+		}
+		for _, ip := range ips {
+			if addr, ok := netaddr.FromStdIP(ip); ok {
+				resolved[host] = append(resolved[host], addr)
+			}
+		}
+	}
+	m.bypassIPs.Store(resolved)
+	m.bypassResolvedAt.Store(time.Now().UnixNano())
+	return !reflect.DeepEqual(prev, resolved)
+}
+
+// refreshBypass resolves the hosts of the relay servers in background, and the
+// exit routes are applied again if the addresses are changed.
+func (m *Manager) refreshBypass() {
+	if m.bypassResolving.Swap(true) {
+		return
+	}
+	go func() {
+		defer m.bypassResolving.Store(false)
+		if m.resolveBypass() && m.exitNode.Load() != "" {
+			m.applyExitNode()
+		}
+	}()
+}
+
 func (m *Manager) relayClientGetter(serverID protocol.ServerID) tunnel.RelayClientGetter {
 	return func() *relay.Client {
-		// Send the pair request packet to the remote peer.
+		// The packets are forwarded by the primary relay server of the current
+		// node if the relay server of the remote peer isn't connected, and the
+		// relay servers forward them to each other.
 		relayClient := m.rm.RelayServerClient(serverID)
 		if relayClient == nil {
-			// Fallback to the local peer primary client
 			relayClient = m.rm.PrimaryRelayServerClient()
 		}
 		return relayClient
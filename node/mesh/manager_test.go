@@ -15,11 +15,17 @@
 package mesh
 
 import (
+	"net"
 	"testing"
 
 	"github.com/pairmesh/pairmesh/message"
+	"github.com/pairmesh/pairmesh/node/device"
+	"github.com/pairmesh/pairmesh/node/mesh/peer"
+	"github.com/pairmesh/pairmesh/node/mesh/tunnel"
+	"github.com/pairmesh/pairmesh/node/mesh/types"
 	"github.com/pairmesh/pairmesh/protocol"
 	"github.com/stretchr/testify/assert"
+	"inet.af/netaddr"
 )
 
 func setupManager() *Manager {
@@ -163,3 +169,136 @@ func TestUpdateNetworkTopologyWithPeerEmptyPeerInfo(t *testing.T) {
 	a.True(findPeerInNetwork(manager, 3, 41))
 	a.False(findPeerInNetwork(manager, 3, 42))
 }
+
+func TestMatchSubnet(t *testing.T) {
+	a := assert.New(t)
+	manager := setupManager()
+
+	office := peer.New(protocol.Peer{ID: 1})
+	lab := peer.New(protocol.Peer{ID: 2})
+	manager.subnets = []subnetRoute{
+		{prefix: netaddr.MustParseIPPrefix("192.168.10.128/25"), peer: lab},
+		{prefix: netaddr.MustParseIPPrefix("192.168.10.0/24"), peer: office},
+		{prefix: netaddr.MustParseIPPrefix("fd12:3456::/32"), peer: office},
+	}
+
+	p, found := manager.matchSubnet("192.168.10.200")
+	a.True(found)
+	a.Equal(lab, p)
+
+	p, found = manager.matchSubnet("192.168.10.20")
+	a.True(found)
+	a.Equal(office, p)
+
+	p, found = manager.matchSubnet("fd12:3456::1")
+	a.True(found)
+	a.Equal(office, p)
+
+	_, found = manager.matchSubnet("192.168.11.1")
+	a.False(found)
+
+	_, found = manager.matchSubnet("illegal")
+	a.False(found)
+}
+
+func TestIsInternet(t *testing.T) {
+	a := assert.New(t)
+	a.True(isInternet("8.8.8.8"))
+	a.True(isInternet("10.1.2.3"))
+	a.False(isInternet("224.0.0.251"))
+	a.False(isInternet("255.255.255.255"))
+	a.False(isInternet("169.254.1.1"))
+	a.False(isInternet("127.0.0.1"))
+	a.False(isInternet("2001:db8::1"))
+	a.False(isInternet("illegal"))
+}
+
+type exitRecorder struct {
+	device.Router
+	cfg *device.ExitConfig
+}
+
+func (r *exitRecorder) SetExit(cfg *device.ExitConfig) {
+	r.cfg = cfg
+}
+
+func TestSetExitNodeBypass(t *testing.T) {
+	a := assert.New(t)
+	router := &exitRecorder{}
+	manager := setupManager()
+	manager.router = router
+	manager.localPeer = types.LocalPeer{PeerID: 1, VIPv4: netaddr.MustParseIP("10.0.0.1")}
+
+	exit := peer.New(protocol.Peer{ID: 2, Name: "exit", IPv4: "10.0.0.2", ExitNode: true})
+	manager.peers = map[protocol.PeerID]*peer.Peer{2: exit}
+	manager.exitNodes = map[protocol.PeerID]struct{}{2: {}}
+
+	hsa, err := tunnel.NewHandshake()
+	a.Nil(err)
+	hsb, err := tunnel.NewHandshake()
+	a.Nil(err)
+	tun, err := tunnel.New(nil, &net.Dialer{}, manager.localPeer, 2, nil, [32]byte{}, hsa, hsb.Public)
+	a.Nil(err)
+	exit.SetTunnel(tun)
+	defer tun.Close()
+	tun.SetRemoteEndpoints([]string{"203.0.113.1:41641"})
+
+	// The endpoints discovered before selecting the exit node are bypassed.
+	manager.SetExitNode("exit")
+	a.True(router.cfg.Enabled)
+	a.Equal([]netaddr.IP{netaddr.MustParseIP("203.0.113.1")}, router.cfg.Bypass)
+
+	manager.SetExitNode("")
+	a.False(router.cfg.Enabled)
+	a.Empty(router.cfg.Bypass)
+	a.Empty(manager.exitEndpoints.Load())
+}
+
+func TestPeerCatchupUnknownPeer(t *testing.T) {
+	a := assert.New(t)
+	manager := setupManager()
+	manager.localPeer = types.LocalPeer{PeerID: 1, VIPv4: netaddr.MustParseIP("10.0.0.1")}
+	manager.peers = map[protocol.PeerID]*peer.Peer{}
+	manager.index = map[string]*peer.Peer{}
+
+	hs, err := tunnel.NewHandshake()
+	a.Nil(err)
+	syncPeer := &message.PacketSyncPeer{
+		DstPeerID: 1,
+		Purpose:   message.PacketSyncPeer_Catchup,
+		Peer: &message.PacketSyncPeer_PeerInfo{
+			PeerID:   2,
+			IPv4:     "10.0.0.2",
+			Networks: []*message.PacketSyncPeer_Network{{ID: 1}},
+		},
+		Key: &message.PacketSyncPeer_SessionKey{PublicKey: hs.Public},
+	}
+
+	// The peers absent from the peer graph share no approved network with
+	// the local peer, and they are never added by the catchup.
+	a.NotNil(manager.PeerCatchup(syncPeer))
+	a.Empty(manager.peers)
+	a.Empty(manager.index)
+	a.False(findPeerInNetwork(manager, 1, 2))
+}
+
+func TestResolveBypass(t *testing.T) {
+	a := assert.New(t)
+	manager := setupManager()
+	manager.relays.Store([]protocol.RelayServer{{Host: "127.0.0.2"}, {Host: "localhost"}})
+
+	// The hosts are only taken from the cache, which is resolved out of band.
+	a.True(manager.unresolvedBypass())
+	a.Equal([]netaddr.IP{netaddr.MustParseIP("127.0.0.2")}, manager.bypassAddresses())
+
+	a.True(manager.resolveBypass())
+	a.False(manager.unresolvedBypass())
+	a.Contains(manager.bypassAddresses(), netaddr.MustParseIP("127.0.0.1"))
+	a.False(manager.resolveBypass())
+
+	// The previous addresses are kept if the host fails to be resolved.
+	manager.bypassIPs.Store(map[string][]netaddr.IP{"unresolvable.invalid": {netaddr.MustParseIP("192.0.2.1")}})
+	manager.relays.Store([]protocol.RelayServer{{Host: "unresolvable.invalid"}})
+	a.False(manager.resolveBypass())
+	a.Equal([]netaddr.IP{netaddr.MustParseIP("192.0.2.1")}, manager.bypassAddresses())
+}
@@ -19,8 +19,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pairmesh/pairmesh/constant"
 	"github.com/pairmesh/pairmesh/node/mesh/tunnel"
 	"github.com/pairmesh/pairmesh/protocol"
+
+	"github.com/flynn/noise"
 )
 
 const (
@@ -46,13 +49,14 @@ type (
 
 	// Peer represents the peer node of the mesh network.
 	Peer struct {
-		// Readonly fields and no lock protection.
-		info   protocol.Peer
-		tunnel *tunnel.Tunnel
-
 		mu      sync.RWMutex
+		info    protocol.Peer
+		tunnel  *tunnel.Tunnel
 		probe   ProbeInfo
 		catchup CatchupInfo
+		// handshake is the ephemeral key sent by the catchup which is not
+		// acknowledged yet.
+		handshake *noise.DHKey
 	}
 )
 
@@ -63,19 +67,45 @@ func New(peerInfo protocol.Peer) *Peer {
 
 // IPv4 returns p.info.IPv4
 func (p *Peer) IPv4() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
 	return p.info.IPv4
 }
 
+// IPv6 returns p.info.IPv6
+func (p *Peer) IPv6() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.info.IPv6
+}
+
 // ID returns p.info.ID
 func (p *Peer) ID() protocol.PeerID {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
 	return p.info.ID
 }
 
 // PrimaryServerID returns p.info.ServerID
 func (p *Peer) PrimaryServerID() protocol.ServerID {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
 	return p.info.ServerID
 }
 
+// SetPeerInfo sets p.info to the latest peer information, and the tunnel and
+// the probe/catchup states are kept.
+func (p *Peer) SetPeerInfo(info protocol.Peer) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.info = info
+}
+
 // SetProbeStatus sets p.probe.IsOnline to isOnline, and
 // sets p.probe.LastProbeResponseAt to now
 func (p *Peer) SetProbeStatus(isOnline bool) {
@@ -138,6 +168,44 @@ func (p *Peer) SetCatchupAt(now time.Time) {
 	p.catchup.CatchupAt = now
 }
 
+// CatchupHandshake returns the ephemeral key of the catchup sent to the peer.
+// The key is kept across retries until the catchup is acknowledged, so that a
+// late acknowledgement still matches the key.
+func (p *Peer) CatchupHandshake() (noise.DHKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.handshake != nil {
+		return *p.handshake, nil
+	}
+	hs, err := tunnel.NewHandshake()
+	if err != nil {
+		return hs, err
+	}
+	p.handshake = &hs
+	return hs, nil
+}
+
+// IsCatchupInFlight returns whether the catchup sent to the peer is waiting
+// for the acknowledgement.
+func (p *Peer) IsCatchupInFlight() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.handshake != nil && time.Since(p.catchup.LastSendCatchupAt) < constant.RekeyTimeout
+}
+
+// TakeCatchupHandshake returns and clears the ephemeral key of the catchup
+// sent to the peer, and returns nil if there is no catchup sent.
+func (p *Peer) TakeCatchupHandshake() *noise.DHKey {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	hs := p.handshake
+	p.handshake = nil
+	return hs
+}
+
 // SetLastProbeRequestAt sets p.probe.LastProbeRequestAt
 func (p *Peer) SetLastProbeRequestAt(at time.Time) {
 	p.mu.Lock()
@@ -168,10 +236,15 @@ func (p *Peer) Tunnel() *tunnel.Tunnel {
 
 // PeerInfo returns p.info
 func (p *Peer) PeerInfo() protocol.Peer {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
 	return p.info
 }
 
 // Close destroy the remote peer resources.
 func (p *Peer) Close() {
-	p.tunnel.Close()
+	if t := p.Tunnel(); t != nil {
+		t.Close()
+	}
 }
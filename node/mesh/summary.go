@@ -32,9 +32,26 @@ func (s State) String() string {
 type (
 	// Device is the struct of a device
 	Device struct {
-		Name   string `json:"name"`
-		IPv4   string `json:"ipv4"`
-		Status State  `json:"status"`
+		Name   string     `json:"name"`
+		IPv4   string     `json:"ipv4"`
+		IPv6   string     `json:"ipv6,omitempty"`
+		Status State      `json:"status"`
+		Stats  *PeerStats `json:"stats,omitempty"`
+	}
+
+	// PeerStats is the traffic and latency statistics of the tunnel to a peer.
+	PeerStats struct {
+		TxBytes         uint64        `json:"tx_bytes"`
+		TxPackets       uint64        `json:"tx_packets"`
+		RxBytes         uint64        `json:"rx_bytes"`
+		RxPackets       uint64        `json:"rx_packets"`
+		LastHandshakeAt time.Time     `json:"last_handshake_at"`
+		Endpoint        string        `json:"endpoint,omitempty"`
+		Latency         time.Duration `json:"latency,omitempty"`
+		Relay           string        `json:"relay,omitempty"`
+		Dropped         uint64        `json:"dropped"`
+		Replayed        uint64        `json:"replayed"`
+		Invalid         uint64        `json:"invalid"`
 	}
 
 	// Network is the struct of a network
@@ -49,5 +66,9 @@ type (
 		LastChangedAt time.Time `json:"-"`
 		MyDevices     []Device  `json:"my_devices"`
 		Networks      []Network `json:"networks"`
+		// ExitNodes are the peers which can be used as exit nodes, and ExitNode
+		// is the name of the exit node being used.
+		ExitNodes []Device `json:"exit_nodes,omitempty"`
+		ExitNode  string   `json:"exit_node,omitempty"`
 	}
 )
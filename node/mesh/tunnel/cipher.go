@@ -0,0 +1,55 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tunnel
+
+import (
+	"github.com/pairmesh/pairmesh/protocol"
+
+	"github.com/flynn/noise"
+)
+
+// directionBit is used to separate the nonce spaces of both sides of a tunnel,
+// because the two peers share the same key and count nonce from the same value.
+const directionBit = uint64(1) << 32
+
+// directionalCipher wraps the shared cipher of the tunnel and mixes the direction
+// bit into the 64-bit nonce, which makes sure the same nonce will never be used
+// by both sides.
+type directionalCipher struct {
+	noise.Cipher
+	mask uint64
+}
+
+// newDirectionalCiphers returns the send and receive ciphers of the tunnel between
+// the local peer and remote peer.
+func newDirectionalCiphers(cipher noise.Cipher, local, remote protocol.PeerID) (send, recv noise.Cipher) {
+	var sendMask, recvMask uint64
+	if local < remote {
+		sendMask = directionBit
+	} else {
+		recvMask = directionBit
+	}
+	return &directionalCipher{Cipher: cipher, mask: sendMask}, &directionalCipher{Cipher: cipher, mask: recvMask}
+}
+
+// Encrypt implements the noise.Cipher interface.
+func (c *directionalCipher) Encrypt(out []byte, n uint64, ad, plaintext []byte) []byte {
+	return c.Cipher.Encrypt(out, n|c.mask, ad, plaintext)
+}
+
+// Decrypt implements the noise.Cipher interface.
+func (c *directionalCipher) Decrypt(out []byte, n uint64, ad, ciphertext []byte) ([]byte, error) {
+	return c.Cipher.Decrypt(out, n|c.mask, ad, ciphertext)
+}
@@ -14,13 +14,17 @@
 
 package tunnel
 
-import "net"
+import (
+	"net"
+
+	"github.com/pairmesh/pairmesh/protocol"
+)
 
 // FragmentCallback represents the callback of receiving fragment data.
 type FragmentCallback interface {
 	// OnFragment will be called if there are fragments received from the
-	// low-level mesh network.
-	OnFragment(data []byte)
+	// low-level mesh network, and the peerID is the sender of the fragment.
+	OnFragment(peerID protocol.PeerID, data []byte)
 }
 
 // UDPPacketCallback represents the callback of UDP packets.
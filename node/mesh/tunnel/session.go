@@ -0,0 +1,113 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tunnel
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/pairmesh/pairmesh/constant"
+	"github.com/pairmesh/pairmesh/internal/codec"
+	"github.com/pairmesh/pairmesh/protocol"
+
+	"github.com/flynn/noise"
+	"go.uber.org/atomic"
+	"golang.org/x/crypto/hkdf"
+)
+
+const sessionKeyInfo = "pairmesh tunnel session key"
+
+type (
+	// session represents a session key of the tunnel. The session 0 is derived
+	// from the ephemeral keys exchanged by the catchup messages and the
+	// subsequence sessions are negotiated by the rekey handshake.
+	session struct {
+		id        uint32
+		send      noise.Cipher
+		recv      noise.Cipher
+		counter   atomic.Uint64
+		replay    codec.ReplayFilter
+		createdAt time.Time
+	}
+
+	// rekeyHandshake represents the rekey handshake initiated by the local peer.
+	rekeyHandshake struct {
+		id     uint32
+		key    noise.DHKey
+		sentAt time.Time
+	}
+)
+
+func newSession(id uint32, key [32]byte, local, remote protocol.PeerID) *session {
+	send, recv := newDirectionalCiphers(noise.CipherChaChaPoly.Cipher(key), local, remote)
+	return &session{
+		id:        id,
+		send:      send,
+		recv:      recv,
+		createdAt: time.Now(),
+	}
+}
+
+// nextNonce returns the next nonce of the session and whether the session
+// key can still be used to send packets.
+func (s *session) nextNonce() (uint32, bool) {
+	nonce := s.counter.Inc()
+	if nonce >= constant.RejectAfterMessages {
+		return 0, false
+	}
+	return uint32(nonce), true
+}
+
+// needRekey returns whether the session key should be rotated.
+func (s *session) needRekey() bool {
+	return s.counter.Load() >= constant.RekeyAfterMessages || time.Since(s.createdAt) >= constant.RekeyAfterTime
+}
+
+// NewHandshake generates the ephemeral key sent by the catchup messages, which
+// is used to derive the session 0 of the tunnel.
+func NewHandshake() (noise.DHKey, error) {
+	return noise.DH25519.GenerateKeypair(rand.Reader)
+}
+
+// newRekeyHandshake generates the ephemeral key of the rekey handshake.
+func newRekeyHandshake(id uint32) (*rekeyHandshake, error) {
+	key, err := noise.DH25519.GenerateKeypair(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &rekeyHandshake{id: id, key: key}, nil
+}
+
+// deriveSessionKey derives the session key from the static shared key of the
+// peers and the ephemeral keys exchanged by the catchup or rekey handshake. Mixing the
+// static shared key makes sure only the two peers can derive the session key
+// even if the handshake messages are tampered by the relay server.
+func deriveSessionKey(staticKey [32]byte, private, remotePublic []byte, id uint32) ([32]byte, error) {
+	var key [32]byte
+	ephemeral, err := noise.DH25519.DH(private, remotePublic)
+	if err != nil {
+		return key, err
+	}
+
+	info := make([]byte, len(sessionKeyInfo)+4)
+	copy(info, sessionKeyInfo)
+	binary.BigEndian.PutUint32(info[len(sessionKeyInfo):], id)
+
+	_, err = io.ReadFull(hkdf.New(sha256.New, ephemeral, staticKey[:], info), key[:])
+	return key, err
+}
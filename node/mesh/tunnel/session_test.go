@@ -0,0 +1,235 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tunnel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pairmesh/pairmesh/constant"
+	"github.com/pairmesh/pairmesh/internal/relay"
+	"github.com/pairmesh/pairmesh/message"
+	"github.com/pairmesh/pairmesh/node/mesh/types"
+	"github.com/pairmesh/pairmesh/protocol"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTunnels() (*Tunnel, *Tunnel) {
+	staticKey := [32]byte{1, 2, 3, 4}
+	hsa, _ := NewHandshake()
+	hsb, _ := NewHandshake()
+	a, _ := New(nil, nil, types.LocalPeer{PeerID: 1}, protocol.PeerID(2), nil, staticKey, hsa, hsb.Public)
+	b, _ := New(nil, nil, types.LocalPeer{PeerID: 2}, protocol.PeerID(1), nil, staticKey, hsb, hsa.Public)
+	return a, b
+}
+
+func seal(t *Tunnel, data []byte) (uint32, []byte) {
+	cipher, nonce, _ := t.nextNonce()
+	return nonce, cipher.Encrypt(nil, uint64(nonce), nil, data)
+}
+
+func TestTunnelReplay(t *testing.T) {
+	a := assert.New(t)
+	x, y := newTestTunnels()
+
+	nonce, encrypted := seal(x, []byte("hello"))
+	decrypted, err := y.Decrypt(nonce, encrypted)
+	a.Nil(err)
+	a.Equal([]byte("hello"), decrypted)
+
+	_, err = y.Decrypt(nonce, encrypted)
+	a.NotNil(err)
+	a.Equal(RejectStats{Replayed: 1}, y.RejectStats())
+
+	// The packet sent by self cannot be decrypted by self.
+	_, err = x.Decrypt(nonce, encrypted)
+	a.NotNil(err)
+	a.Equal(RejectStats{Invalid: 1}, x.RejectStats())
+}
+
+func TestTunnelInitialSession(t *testing.T) {
+	a := assert.New(t)
+	x, y := newTestTunnels()
+	x2, y2 := newTestTunnels()
+
+	// Both sides derive the same session 0 key.
+	nonce, encrypted := seal(x, []byte("hello"))
+	decrypted, err := y.Decrypt(nonce, encrypted)
+	a.Nil(err)
+	a.Equal([]byte("hello"), decrypted)
+
+	// The tunnels created again between the same peers use a different key,
+	// so the same nonce never encrypts with the same key twice.
+	nonce2, encrypted2 := seal(x2, []byte("hello"))
+	a.Equal(nonce, nonce2)
+	a.NotEqual(encrypted, encrypted2)
+	_, err = y2.Decrypt(nonce, encrypted)
+	a.NotNil(err)
+
+	// The tunnel cannot be created with an invalid ephemeral key.
+	hs, err := NewHandshake()
+	a.Nil(err)
+	_, err = New(nil, nil, types.LocalPeer{PeerID: 1}, protocol.PeerID(2), nil, [32]byte{}, hs, []byte{1, 2, 3})
+	a.NotNil(err)
+}
+
+func TestTunnelRekey(t *testing.T) {
+	a := assert.New(t)
+	x, y := newTestTunnels()
+
+	// The packet encrypted by the session 0 and still in flight.
+	oldNonce, oldEncrypted := seal(y, []byte("old"))
+	expiredNonce, expiredEncrypted := seal(y, []byte("expired"))
+
+	hs, err := newRekeyHandshake(x.KeyID() + 1)
+	a.Nil(err)
+	x.handshake = hs
+
+	ack, err := y.onRekeyRequest(&message.PacketSyncPeer_SessionKey{KeyID: hs.id, PublicKey: hs.key.Public})
+	a.Nil(err)
+	a.NotNil(ack)
+	a.Equal(uint32(0), y.KeyID())
+
+	// Resend the acknowledgement if the request is retransmitted.
+	ack2, err := y.onRekeyRequest(&message.PacketSyncPeer_SessionKey{KeyID: hs.id, PublicKey: hs.key.Public})
+	a.Nil(err)
+	a.Equal(ack, ack2)
+
+	a.Nil(x.onRekeyAck(ack))
+	a.Equal(uint32(1), x.KeyID())
+	a.NotNil(x.onRekeyAck(ack))
+
+	// The responder switches to the new session after receiving the first
+	// packet encrypted by the new session key.
+	nonce, encrypted := seal(x, []byte("new"))
+	decrypted, err := y.Decrypt(nonce, encrypted)
+	a.Nil(err)
+	a.Equal([]byte("new"), decrypted)
+	a.Equal(uint32(1), y.KeyID())
+
+	nonce, encrypted = seal(y, []byte("reply"))
+	decrypted, err = x.Decrypt(nonce, encrypted)
+	a.Nil(err)
+	a.Equal([]byte("reply"), decrypted)
+
+	// The previous session key is accepted in the grace period.
+	decrypted, err = x.Decrypt(oldNonce, oldEncrypted)
+	a.Nil(err)
+	a.Equal([]byte("old"), decrypted)
+
+	x.previousExpireAt = time.Now().Add(-constant.RekeyGracePeriod)
+	x.Tick()
+	_, err = x.Decrypt(expiredNonce, expiredEncrypted)
+	a.NotNil(err)
+
+	// Stale rekey request is rejected.
+	_, err = y.onRekeyRequest(&message.PacketSyncPeer_SessionKey{KeyID: 1, PublicKey: hs.key.Public})
+	a.NotNil(err)
+}
+
+func TestTunnelRekeyAckLost(t *testing.T) {
+	a := assert.New(t)
+	x, y := newTestTunnels()
+	y.rcGetter = func() *relay.Client { return nil }
+
+	hs, err := newRekeyHandshake(x.KeyID() + 1)
+	a.Nil(err)
+	x.handshake = hs
+
+	// The acknowledgement is lost, and the responder cannot initiate a new
+	// handshake while the next session is pending.
+	_, err = y.onRekeyRequest(&message.PacketSyncPeer_SessionKey{KeyID: hs.id, PublicKey: hs.key.Public})
+	a.Nil(err)
+	a.NotNil(y.next)
+	y.rekey()
+	a.Nil(y.handshake)
+
+	// The pending session is discarded after the timeout.
+	y.nextAt = time.Now().Add(-constant.RekeyTimeout)
+	y.Tick()
+	a.Nil(y.next)
+	a.Nil(y.nextAck)
+	a.Equal(uint32(0), y.KeyID())
+
+	// The request resent by the initiator negotiates the session again.
+	ack, err := y.onRekeyRequest(&message.PacketSyncPeer_SessionKey{KeyID: hs.id, PublicKey: hs.key.Public})
+	a.Nil(err)
+	a.Nil(x.onRekeyAck(ack))
+	nonce, encrypted := seal(x, []byte("new"))
+	decrypted, err := y.Decrypt(nonce, encrypted)
+	a.Nil(err)
+	a.Equal([]byte("new"), decrypted)
+	a.Equal(uint32(1), y.KeyID())
+
+	// The responder can initiate the handshake by itself after the timeout.
+	_, err = x.onRekeyRequest(&message.PacketSyncPeer_SessionKey{KeyID: 2, PublicKey: hs.key.Public})
+	a.Nil(err)
+	x.rcGetter = y.rcGetter
+	x.nextAt = time.Now().Add(-constant.RekeyTimeout)
+	x.rekey()
+	a.Nil(x.next)
+	a.NotNil(x.handshake)
+}
+
+func TestTunnelRekeySimultaneously(t *testing.T) {
+	a := assert.New(t)
+	x, y := newTestTunnels()
+
+	hsx, err := newRekeyHandshake(1)
+	a.Nil(err)
+	x.handshake = hsx
+	hsy, err := newRekeyHandshake(1)
+	a.Nil(err)
+	y.handshake = hsy
+
+	// The handshake initiated by the lower peer id wins.
+	ack, err := x.onRekeyRequest(&message.PacketSyncPeer_SessionKey{KeyID: hsy.id, PublicKey: hsy.key.Public})
+	a.Nil(err)
+	a.Nil(ack)
+
+	// The request failed to derive the session key doesn't cancel the pending
+	// handshake.
+	_, err = y.onRekeyRequest(&message.PacketSyncPeer_SessionKey{KeyID: hsx.id, PublicKey: make([]byte, 32)})
+	a.NotNil(err)
+	a.Equal(hsy, y.handshake)
+	a.Nil(y.next)
+
+	ack, err = y.onRekeyRequest(&message.PacketSyncPeer_SessionKey{KeyID: hsx.id, PublicKey: hsx.key.Public})
+	a.Nil(err)
+	a.NotNil(ack)
+	a.Nil(y.handshake)
+
+	a.Nil(x.onRekeyAck(ack))
+	nonce, encrypted := seal(x, []byte("new"))
+	_, err = y.Decrypt(nonce, encrypted)
+	a.Nil(err)
+	a.Equal(x.KeyID(), y.KeyID())
+}
+
+func TestSessionExhausted(t *testing.T) {
+	a := assert.New(t)
+	s := newSession(0, [32]byte{}, 1, 2)
+	a.False(s.needRekey())
+
+	s.counter.Store(constant.RekeyAfterMessages)
+	a.True(s.needRekey())
+
+	s.counter.Store(constant.RejectAfterMessages - 2)
+	_, ok := s.nextNonce()
+	a.True(ok)
+	_, ok = s.nextNonce()
+	a.False(ok)
+}
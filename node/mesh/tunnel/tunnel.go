@@ -15,10 +15,11 @@
 package tunnel
 
 import (
+	"context"
 	"math"
-	"math/rand"
 	"net"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/pairmesh/pairmesh/constant"
@@ -31,6 +32,7 @@ import (
 	"github.com/pairmesh/pairmesh/protocol"
 
 	"github.com/flynn/noise"
+	"github.com/pkg/errors"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
@@ -38,10 +40,40 @@ import (
 // ZeroTime is the default time initialized with zero value
 var ZeroTime = time.Time{}
 
+// probePollInterval is the interval to check whether the probed endpoints echo.
+const probePollInterval = 10 * time.Millisecond
+
 type (
 	// RelayClientGetter is a function to get relay client
 	RelayClientGetter func() *relay.Client
 
+	// RejectStats represents the count of packets rejected by the tunnel.
+	RejectStats struct {
+		// Replayed is the count of packets rejected by the replay window.
+		Replayed uint64
+		// Invalid is the count of packets failed to be authenticated.
+		Invalid uint64
+	}
+
+	// Stats represents the traffic statistics of the tunnel.
+	Stats struct {
+		TxPackets uint64
+		TxBytes   uint64
+		RxPackets uint64
+		RxBytes   uint64
+		// Dropped is the count of packets dropped due to no available path or
+		// session key exhausted.
+		Dropped  uint64
+		Rejected RejectStats
+		// LastHandshakeAt is the time when the current session key was
+		// established.
+		LastHandshakeAt time.Time
+		// Endpoint is the address of the reachable endpoint and Latency is the
+		// measured latency of it. The endpoint is empty if relayed.
+		Endpoint string
+		Latency  time.Duration
+	}
+
 	// Tunnel represents the remote conn and maintain the conn state.
 	Tunnel struct {
 		// Read-only fields
@@ -50,14 +82,38 @@ type (
 		callback  FragmentCallback
 		localPeer types.LocalPeer
 		peerID    protocol.PeerID
-		cipher    noise.Cipher
-
-		disco          atomic.Bool
-		closed         atomic.Bool
-		localEndpoints atomic.Value // An atomic value of type []string
-		endpoints      atomic.Value // An atomic value of type []*Endpoint
-		endpointsCh    chan []string
-		die            chan struct{}
+		staticKey [32]byte
+
+		// Session keys of the tunnel. The current session is used to send packets.
+		// The next session is negotiated by the remote peer and will become the
+		// current one after receiving the first packet encrypted by it, or be
+		// discarded if the remote peer doesn't resend the request in RekeyTimeout.
+		// And the previous session is still accepted in the grace period.
+		keyMu            sync.RWMutex
+		current          *session
+		next             *session
+		nextAck          *message.PacketSyncPeer_SessionKey
+		nextAt           time.Time
+		previous         *session
+		previousExpireAt time.Time
+		handshake        *rekeyHandshake
+
+		replayed    atomic.Uint64
+		invalid     atomic.Uint64
+		txPackets   atomic.Uint64
+		txBytes     atomic.Uint64
+		rxPackets   atomic.Uint64
+		rxBytes     atomic.Uint64
+		dropped     atomic.Uint64
+		handshakeAt atomic.Int64 // Unix nanoseconds.
+
+		disco           atomic.Bool
+		closed          atomic.Bool
+		localEndpoints  atomic.Value // An atomic value of type []string
+		remoteEndpoints atomic.Value // An atomic value of type []string
+		endpoints       atomic.Value // An atomic value of type []*Endpoint
+		endpointsCh     chan []string
+		die             chan struct{}
 
 		pairCounter int
 		nextPairAt  time.Time
@@ -67,23 +123,311 @@ type (
 )
 
 // New generates and returns a Tunnel struct with given parameters
-func New(rcGetter RelayClientGetter, dialer *net.Dialer, localPeer types.LocalPeer, peerID protocol.PeerID, callback FragmentCallback, cipher noise.Cipher) *Tunnel {
+// The staticKey is the shared key exchanged by the static keys of peers, and
+// the session 0 is derived from it and the ephemeral keys exchanged by the
+// catchup messages, so each tunnel between the same peers uses a fresh key.
+func New(rcGetter RelayClientGetter, dialer *net.Dialer, localPeer types.LocalPeer, peerID protocol.PeerID, callback FragmentCallback, staticKey [32]byte, ephemeral noise.DHKey, remotePublic []byte) (*Tunnel, error) {
+	sessionKey, err := deriveSessionKey(staticKey, ephemeral.Private, remotePublic, 0)
+	if err != nil {
+		return nil, errors.WithMessage(err, "derive initial session key")
+	}
+
 	t := &Tunnel{
 		rcGetter:    rcGetter,
 		dialer:      dialer,
 		callback:    callback,
 		localPeer:   localPeer,
 		peerID:      peerID,
-		cipher:      cipher,
+		staticKey:   staticKey,
+		current:     newSession(0, sessionKey, localPeer.PeerID, peerID),
 		endpointsCh: make(chan []string, 2),
 		die:         make(chan struct{}),
 	}
-	return t
+	t.handshakeAt.Store(time.Now().UnixNano())
+	return t, nil
 }
 
-// Cipher returns the noise.Cipher.
-func (t *Tunnel) Cipher() noise.Cipher {
-	return t.cipher
+// nextNonce returns the send cipher and the next nonce of the current session.
+// The current session key cannot be used anymore if the ok is false.
+func (t *Tunnel) nextNonce() (cipher noise.Cipher, nonce uint32, ok bool) {
+	t.keyMu.RLock()
+	s := t.current
+	t.keyMu.RUnlock()
+
+	nonce, ok = s.nextNonce()
+	if !ok || s.needRekey() {
+		t.rekey()
+	}
+	return s.send, nonce, ok
+}
+
+// Decrypt decrypts the payload with the nonce and rejects the replayed packets.
+func (t *Tunnel) Decrypt(nonce uint32, payload []byte) ([]byte, error) {
+	t.keyMu.RLock()
+	sessions := []*session{t.current, t.next}
+	if t.previous != nil && time.Now().Before(t.previousExpireAt) {
+		sessions = append(sessions, t.previous)
+	}
+	t.keyMu.RUnlock()
+
+	for _, s := range sessions {
+		if s == nil {
+			continue
+		}
+		decrypted, err := s.recv.Decrypt(nil, uint64(nonce), nil, payload)
+		if err != nil {
+			continue
+		}
+
+		if !s.replay.ValidateCounter(nonce) {
+			t.replayed.Inc()
+			return nil, errors.Errorf("replayed packet rejected (nonce: %d)", nonce)
+		}
+
+		// The remote peer has switched to the next session.
+		if s == sessions[1] {
+			t.confirmNext(s)
+		}
+		return decrypted, nil
+	}
+
+	t.invalid.Inc()
+	return nil, errors.Errorf("authenticate packet failed (nonce: %d)", nonce)
+}
+
+// KeyID returns the identifier of the current session key.
+func (t *Tunnel) KeyID() uint32 {
+	t.keyMu.RLock()
+	defer t.keyMu.RUnlock()
+
+	return t.current.id
+}
+
+// Tick rotates the session key if it is expired and prunes the previous
+// session key after the grace period.
+func (t *Tunnel) Tick() {
+	t.keyMu.Lock()
+	if t.previous != nil && time.Now().After(t.previousExpireAt) {
+		t.previous = nil
+	}
+	t.expireNext()
+	s := t.current
+	t.keyMu.Unlock()
+
+	if s.needRekey() {
+		t.rekey()
+	}
+}
+
+// rekey initiates the rekey handshake with the remote peer. The handshake
+// message will be resent if no acknowledgement received in constant.RekeyTimeout.
+func (t *Tunnel) rekey() {
+	t.keyMu.Lock()
+	// The handshake initiated by the remote peer is in progress.
+	t.expireNext()
+	if t.next != nil {
+		t.keyMu.Unlock()
+		return
+	}
+	if t.handshake != nil && time.Since(t.handshake.sentAt) < constant.RekeyTimeout {
+		t.keyMu.Unlock()
+		return
+	}
+	if t.handshake == nil {
+		hs, err := newRekeyHandshake(t.current.id + 1)
+		if err != nil {
+			t.keyMu.Unlock()
+			zap.L().Error("Generate rekey handshake failed", zap.Error(err))
+			return
+		}
+		t.handshake = hs
+	}
+	t.handshake.sentAt = time.Now()
+	key := &message.PacketSyncPeer_SessionKey{
+		KeyID:     t.handshake.id,
+		PublicKey: t.handshake.key.Public,
+	}
+	t.keyMu.Unlock()
+
+	zap.L().Info("Rekey tunnel", zap.Any("peer_id", t.peerID), zap.Uint32("key_id", key.KeyID))
+	t.sendSessionKey(message.PacketSyncPeer_Rekey, key)
+}
+
+// OnRekey handles the rekey handshake messages from the remote peer.
+func (t *Tunnel) OnRekey(syncPeer *message.PacketSyncPeer) {
+	key := syncPeer.Key
+	if key == nil {
+		return
+	}
+
+	switch syncPeer.Purpose {
+	case message.PacketSyncPeer_Rekey:
+		ack, err := t.onRekeyRequest(key)
+		if err != nil {
+			zap.L().Error("Handle rekey request failed", zap.Any("peer_id", t.peerID), zap.Error(err))
+			return
+		}
+		if ack != nil {
+			t.sendSessionKey(message.PacketSyncPeer_RekeyAck, ack)
+		}
+
+	case message.PacketSyncPeer_RekeyAck:
+		err := t.onRekeyAck(key)
+		if err != nil {
+			zap.L().Error("Handle rekey ack failed", zap.Any("peer_id", t.peerID), zap.Error(err))
+		}
+	}
+}
+
+func (t *Tunnel) onRekeyRequest(key *message.PacketSyncPeer_SessionKey) (*message.PacketSyncPeer_SessionKey, error) {
+	t.keyMu.Lock()
+	defer t.keyMu.Unlock()
+
+	// Both peers initiate the handshake simultaneously, and the handshake
+	// initiated by the peer with the lower peer id wins. The pending handshake
+	// is discarded only if the request is valid, so a forged request can't
+	// cancel it.
+	if t.handshake != nil && t.localPeer.PeerID < t.peerID {
+		return nil, nil
+	}
+
+	// The acknowledgement is lost and the remote peer resends the request.
+	if t.next != nil && t.next.id == key.KeyID {
+		t.handshake = nil
+		t.nextAt = time.Now()
+		return t.nextAck, nil
+	}
+
+	if key.KeyID <= t.current.id {
+		return nil, errors.Errorf("stale session key %d (current: %d)", key.KeyID, t.current.id)
+	}
+
+	hs, err := newRekeyHandshake(key.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	sessionKey, err := deriveSessionKey(t.staticKey, hs.key.Private, key.PublicKey, key.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make before break: accept the packets encrypted by the next session key,
+	// but keep sending packets with the current one until the remote peer
+	// switches to the next session key.
+	t.handshake = nil
+	t.next = newSession(key.KeyID, sessionKey, t.localPeer.PeerID, t.peerID)
+	t.nextAck = &message.PacketSyncPeer_SessionKey{
+		KeyID:     key.KeyID,
+		PublicKey: hs.key.Public,
+	}
+	t.nextAt = time.Now()
+	return t.nextAck, nil
+}
+
+func (t *Tunnel) onRekeyAck(key *message.PacketSyncPeer_SessionKey) error {
+	t.keyMu.Lock()
+	defer t.keyMu.Unlock()
+
+	hs := t.handshake
+	if hs == nil || hs.id != key.KeyID {
+		return errors.Errorf("unexpected session key %d", key.KeyID)
+	}
+
+	sessionKey, err := deriveSessionKey(t.staticKey, hs.key.Private, key.PublicKey, key.KeyID)
+	if err != nil {
+		return err
+	}
+
+	t.handshake = nil
+	t.rotate(newSession(key.KeyID, sessionKey, t.localPeer.PeerID, t.peerID))
+	return nil
+}
+
+// expireNext discards the next session if the remote peer neither switches to
+// it nor resends the request in RekeyTimeout, e.g. the acknowledgement is lost
+// and the remote peer gives up the handshake. The caller should hold the keyMu
+// lock.
+func (t *Tunnel) expireNext() {
+	if t.next == nil || time.Since(t.nextAt) < constant.RekeyTimeout {
+		return
+	}
+	zap.L().Warn("Discard unconfirmed tunnel session key", zap.Any("peer_id", t.peerID), zap.Uint32("key_id", t.next.id))
+	t.next = nil
+	t.nextAck = nil
+}
+
+// confirmNext switches to the next session if it is still the next one.
+func (t *Tunnel) confirmNext(s *session) {
+	t.keyMu.Lock()
+	defer t.keyMu.Unlock()
+
+	if t.next != s {
+		return
+	}
+	t.next = nil
+	t.nextAck = nil
+	t.rotate(s)
+}
+
+// rotate switches to the new session and keeps the current session as the
+// previous one in the grace period. The caller should hold the keyMu lock.
+func (t *Tunnel) rotate(s *session) {
+	zap.L().Info("Rotate tunnel session key", zap.Any("peer_id", t.peerID), zap.Uint32("key_id", s.id))
+
+	t.previous = t.current
+	t.previousExpireAt = time.Now().Add(constant.RekeyGracePeriod)
+	t.current = s
+	t.handshakeAt.Store(time.Now().UnixNano())
+}
+
+func (t *Tunnel) sendSessionKey(purpose message.PacketSyncPeer_Purpose, key *message.PacketSyncPeer_SessionKey) {
+	relayClient := t.rcGetter()
+	if relayClient == nil {
+		zap.L().Warn("Drop rekey message due to relay server client not ready", zap.Any("peer_id", t.peerID))
+		return
+	}
+
+	syncPeer := &message.PacketSyncPeer{
+		DstPeerID: uint64(t.peerID),
+		Purpose:   purpose,
+		Peer:      &message.PacketSyncPeer_PeerInfo{PeerID: uint64(t.localPeer.PeerID)},
+		Key:       key,
+	}
+	err := relayClient.Send(message.PacketType_SyncPeer, syncPeer)
+	if err != nil {
+		zap.L().Error("Send rekey message failed", zap.Error(err))
+	}
+}
+
+// PeerID returns the remote peer ID of the tunnel.
+func (t *Tunnel) PeerID() protocol.PeerID {
+	return t.peerID
+}
+
+// RejectStats returns the count of packets rejected by the tunnel.
+func (t *Tunnel) RejectStats() RejectStats {
+	return RejectStats{
+		Replayed: t.replayed.Load(),
+		Invalid:  t.invalid.Load(),
+	}
+}
+
+// Stats returns the traffic statistics of the tunnel.
+func (t *Tunnel) Stats() Stats {
+	stats := Stats{
+		TxPackets:       t.txPackets.Load(),
+		TxBytes:         t.txBytes.Load(),
+		RxPackets:       t.rxPackets.Load(),
+		RxBytes:         t.rxBytes.Load(),
+		Dropped:         t.dropped.Load(),
+		Rejected:        t.RejectStats(),
+		LastHandshakeAt: time.Unix(0, t.handshakeAt.Load()),
+	}
+	if endpoint := t.ReachableEndpoint(); endpoint != nil {
+		stats.Endpoint = endpoint.address
+		stats.Latency = endpoint.latency
+	}
+	return stats
 }
 
 // IsDisco returns whether the tunnel is discovered
@@ -98,12 +442,32 @@ func (t *Tunnel) SetLocalEndpoints(endpoints []string) {
 
 // SetRemoteEndpoints sets given remote endpoints to the tunnel
 func (t *Tunnel) SetRemoteEndpoints(endpoints []string) {
+	t.remoteEndpoints.Store(endpoints)
 	t.endpointsCh <- endpoints
 	if !t.disco.Load() {
 		go t.discovery()
 	}
 }
 
+// RemoteEndpoints returns the endpoints advertised by the remote peer and the
+// addresses which the remote peer is discovered from.
+func (t *Tunnel) RemoteEndpoints() []string {
+	var endpoints []string
+	if v := t.remoteEndpoints.Load(); v != nil {
+		endpoints = append(endpoints, v.([]string)...)
+	}
+	seen := map[string]struct{}{}
+	for _, ep := range endpoints {
+		seen[ep] = struct{}{}
+	}
+	for _, ep := range t.cloneEndpoints() {
+		if _, found := seen[ep.address]; !found {
+			endpoints = append(endpoints, ep.address)
+		}
+	}
+	return endpoints
+}
+
 // Write writes input data through the tunnel
 func (t *Tunnel) Write(data []byte) {
 	if logutil.IsEnablePeer() {
@@ -114,8 +478,16 @@ func (t *Tunnel) Write(data []byte) {
 
 	endpoint := t.ReachableEndpoint()
 	if endpoint != nil {
-		encoded := codec.Encode(message.PacketType_Fragment, t.cipher, t.localPeer.PeerID, data)
+		cipher, nonce, ok := t.nextNonce()
+		if !ok {
+			t.dropped.Inc()
+			zap.L().Warn("Drop data due to session key exhausted", zap.Any("peer", t.peerID))
+			return
+		}
+		encoded := codec.Encode(message.PacketType_Fragment, cipher, nonce, t.localPeer.PeerID, data)
 		endpoint.Write(encoded)
+		t.txPackets.Inc()
+		t.txBytes.Add(uint64(len(data)))
 		return
 	}
 
@@ -123,12 +495,18 @@ func (t *Tunnel) Write(data []byte) {
 
 	relayClient := t.rcGetter()
 	if relayClient == nil {
+		t.dropped.Inc()
 		zap.L().Warn("Drop data due to primary relay server client not ready", zap.Reflect("peer", t.peerID))
 		return
 	}
 
-	nonce := rand.Uint32()
-	encrypted := t.cipher.Encrypt(nil, uint64(nonce), nil, data)
+	cipher, nonce, ok := t.nextNonce()
+	if !ok {
+		t.dropped.Inc()
+		zap.L().Warn("Drop data due to session key exhausted", zap.Any("peer", t.peerID))
+		return
+	}
+	encrypted := cipher.Encrypt(nil, uint64(nonce), nil, data)
 	if logutil.IsEnableRelay() {
 		zap.L().Debug("Relay data due to no UDP endpoint", zap.Int("length", len(encrypted)))
 	}
@@ -142,7 +520,11 @@ func (t *Tunnel) Write(data []byte) {
 
 	err := relayClient.Send(message.PacketType_Forward, packet)
 	if err != nil {
+		t.dropped.Inc()
 		zap.L().Error("Relay message failed", zap.Error(err))
+	} else {
+		t.txPackets.Inc()
+		t.txBytes.Add(uint64(len(data)))
 	}
 
 	// No endpoints available if the tunnel is discoverying means all endpoints cannot reachable
@@ -202,7 +584,7 @@ func (t *Tunnel) OnUDPPacket(udpConn *net.UDPConn, data []byte) {
 		return
 	}
 
-	decrypted, err := t.cipher.Decrypt(nil, uint64(nonce), nil, payload)
+	decrypted, err := t.Decrypt(nonce, payload)
 	if err != nil {
 		zap.L().Error("Decrypt fragment failed", zap.Any("peer_id", peerID), zap.Error(err))
 		return
@@ -218,12 +600,29 @@ func (t *Tunnel) OnUDPPacket(udpConn *net.UDPConn, data []byte) {
 		t.onDiscovery(udpConn, discovery.(*message.PacketDiscovery))
 
 	case message.PacketType_Fragment:
-		dataCopy := make([]byte, len(decrypted))
-		copy(dataCopy, decrypted)
-		t.callback.OnFragment(dataCopy)
+		t.onFragment(decrypted)
 	}
 }
 
+// OnForward handles the fragment forwarded by the relay server.
+func (t *Tunnel) OnForward(nonce uint32, payload []byte) error {
+	decrypted, err := t.Decrypt(nonce, payload)
+	if err != nil {
+		return err
+	}
+	t.onFragment(decrypted)
+	return nil
+}
+
+func (t *Tunnel) onFragment(decrypted []byte) {
+	t.rxPackets.Inc()
+	t.rxBytes.Add(uint64(len(decrypted)))
+
+	dataCopy := make([]byte, len(decrypted))
+	copy(dataCopy, decrypted)
+	t.callback.OnFragment(t.peerID, dataCopy)
+}
+
 // ReachableEndpoint returns reachable endpoint, based on last discovery time
 func (t *Tunnel) ReachableEndpoint() *Endpoint {
 	val := t.endpoints.Load()
@@ -335,7 +734,11 @@ func (t *Tunnel) discoveryEndpoint(udpConn *net.UDPConn) {
 		SenderPeerID: uint64(t.localPeer.PeerID),
 		Timestamp:    time.Now().UnixMicro(),
 	}
-	encoded, err := codec.EncodeMessage(message.PacketType_Discovery, t.cipher, t.localPeer.PeerID, msg)
+	cipher, nonce, ok := t.nextNonce()
+	if !ok {
+		return
+	}
+	encoded, err := codec.EncodeMessage(message.PacketType_Discovery, cipher, nonce, t.localPeer.PeerID, msg)
 	if err != nil {
 		zap.L().Error("Encode discovery message failed", zap.Error(err))
 		return
@@ -360,7 +763,11 @@ func (t *Tunnel) onDiscovery(udpConn *net.UDPConn, discovery *message.PacketDisc
 
 	if protocol.PeerID(discovery.SenderPeerID) != t.localPeer.PeerID {
 		// Echo the discovery packet to the sender peer.
-		data, err := codec.EncodeMessage(message.PacketType_Discovery, t.cipher, t.localPeer.PeerID, discovery)
+		cipher, nonce, ok := t.nextNonce()
+		if !ok {
+			return
+		}
+		data, err := codec.EncodeMessage(message.PacketType_Discovery, cipher, nonce, t.localPeer.PeerID, discovery)
 		if err != nil {
 			zap.L().Error("Encode discovery echo message failed", zap.Error(err))
 			return
@@ -405,6 +812,35 @@ func (t *Tunnel) onDiscovery(udpConn *net.UDPConn, discovery *message.PacketDisc
 	t.storeEndpoints(endpoints)
 }
 
+// Probe sends the discovery messages to the endpoints of the remote peer, and
+// returns the address and the latency of the first endpoint echoing after sent.
+// The false is returned if no endpoint echoes before the context is done.
+func (t *Tunnel) Probe(ctx context.Context) (string, time.Duration, bool) {
+	sentAt := time.Now()
+	endpoints := t.cloneEndpoints()
+	if len(endpoints) == 0 {
+		return "", 0, false
+	}
+	for _, e := range endpoints {
+		t.discoveryEndpoint(e.udpConn)
+	}
+
+	ticker := time.NewTicker(probePollInterval)
+	defer ticker.Stop()
+	for {
+		for _, e := range endpoints {
+			if e.lastSeen.After(sentAt) {
+				return e.address, e.latency, true
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return "", 0, false
+		case <-ticker.C:
+		}
+	}
+}
+
 // Close closes the current conn and clear status.
 func (t *Tunnel) Close() {
 	if t.closed.Swap(true) {
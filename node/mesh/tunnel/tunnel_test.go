@@ -0,0 +1,51 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tunnel
+
+import (
+	"testing"
+
+	"github.com/pairmesh/pairmesh/protocol"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fragmentRecorder struct {
+	fragments [][]byte
+}
+
+func (r *fragmentRecorder) OnFragment(_ protocol.PeerID, data []byte) {
+	r.fragments = append(r.fragments, data)
+}
+
+func TestTunnelStats(t *testing.T) {
+	a := assert.New(t)
+	x, y := newTestTunnels()
+	recorder := &fragmentRecorder{}
+	y.callback = recorder
+
+	nonce, encrypted := seal(x, []byte("hello"))
+	a.Nil(y.OnForward(nonce, encrypted))
+	a.NotNil(y.OnForward(nonce, encrypted))
+	a.Equal([][]byte{[]byte("hello")}, recorder.fragments)
+
+	stats := y.Stats()
+	a.Equal(uint64(1), stats.RxPackets)
+	a.Equal(uint64(5), stats.RxBytes)
+	a.Equal(uint64(0), stats.TxPackets)
+	a.Equal(RejectStats{Replayed: 1}, stats.Rejected)
+	a.False(stats.LastHandshakeAt.IsZero())
+	a.Empty(stats.Endpoint)
+}
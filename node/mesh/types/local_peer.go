@@ -29,5 +29,6 @@ type LocalPeer struct {
 	PeerID   protocol.PeerID
 	Key      noise.DHKey
 	VIPv4    netaddr.IP
+	VIPv6    netaddr.IP
 	Networks []*message.PacketSyncPeer_Network
 }
@@ -136,6 +136,74 @@ func (m *Monitor) DetectExternalAddress(ctx context.Context, persistent bool) (s
 		return "", ErrNoSTUNServer
 	}
 
+	externalAddr, _, err := m.probe(ctx, val.(protocol.RelayServer))
+	if err != nil {
+		return "", err
+	}
+	if persistent {
+		m.externalAddr.Store(externalAddr)
+	}
+	return externalAddr, nil
+}
+
+// MeasureLatency measures the round-trip time to the STUN service of the relay
+// servers concurrently, and the minimum of several probes is used to filter out
+// the jitter. The unreachable relay servers are omitted in the result.
+func (m *Monitor) MeasureLatency(ctx context.Context, relayServers []protocol.RelayServer) []protocol.RelayLatency {
+	const (
+		probeCount   = 3
+		probeTimeout = 3 * time.Second
+	)
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		latencies []protocol.RelayLatency
+	)
+	for _, r := range relayServers {
+		// The STUN service is disabled on the relay server.
+		if r.STUNPort < 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(r protocol.RelayServer) {
+			defer wg.Done()
+
+			var best time.Duration
+			for i := 0; i < probeCount; i++ {
+				probeCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+				_, rtt, err := m.probe(probeCtx, r)
+				cancel()
+				if err != nil {
+					if logutil.IsEnableRelay() {
+						zap.L().Debug("Probe relay server failed", zap.String("name", r.Name), zap.Error(err))
+					}
+					continue
+				}
+				if best == 0 || rtt < best {
+					best = rtt
+				}
+			}
+			if best == 0 {
+				return
+			}
+
+			mu.Lock()
+			latencies = append(latencies, protocol.RelayLatency{ServerID: r.ID, RTT: best})
+			mu.Unlock()
+		}(r)
+	}
+	wg.Wait()
+
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i].ServerID < latencies[j].ServerID
+	})
+	return latencies
+}
+
+// probe sends the STUN binding requests to the STUN server and returns the
+// external address and the round-trip time of the request.
+func (m *Monitor) probe(ctx context.Context, stunServer protocol.RelayServer) (string, time.Duration, error) {
 	type inflightRecord struct {
 		txID stun.TxID
 		time time.Time
@@ -162,11 +230,10 @@ func (m *Monitor) DetectExternalAddress(ctx context.Context, persistent bool) (s
 		}
 	}
 
-	stunServer := val.(protocol.RelayServer)
 	address := fmt.Sprintf("%s:%d", stunServer.Host, stunServer.STUNPort)
 	stunConn, err := m.dialer.DialContext(ctx, "udp", address)
 	if err != nil {
-		return "", err
+		return "", 0, err
 	}
 	defer stunConn.Close()
 
@@ -179,27 +246,35 @@ func (m *Monitor) DetectExternalAddress(ctx context.Context, persistent bool) (s
 		return err
 	}
 
+	if err := writeSTUNPacket(); err != nil {
+		return "", 0, err
+	}
+
 	buffer := make([]byte, constant.MaxBufferSize)
 	for {
-		_ = udpConn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		deadline := time.Now().Add(5 * time.Second)
+		if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+			deadline = d
+		}
+		_ = udpConn.SetReadDeadline(deadline)
 		n, remote, err := udpConn.ReadFromUDP(buffer)
 		if errors.Is(err, os.ErrDeadlineExceeded) {
 			select {
 			case <-ctx.Done():
-				return "", ctx.Err()
+				return "", 0, ctx.Err()
 
 			default:
 				// Write STUN packet again if the STUN response cannot be received in
 				// a reasonable duration.
 				if werr := writeSTUNPacket(); werr != nil {
-					return "", werr
+					return "", 0, werr
 				}
 				continue
 			}
 		}
 
 		if err != nil {
-			return "", err
+			return "", 0, err
 		}
 		if remote.String() != address {
 			zap.L().Warn("Ignore STUN packet due to remote address mismatch", zap.String("remote", remote.String()))
@@ -212,18 +287,13 @@ func (m *Monitor) DetectExternalAddress(ctx context.Context, persistent bool) (s
 			continue
 		}
 
-		_, found := inflight[txID]
+		record, found := inflight[txID]
 		if !found {
 			zap.L().Warn("Receive unrecognized STUN message")
 			continue
 		}
 
 		externalAddr := fmt.Sprintf("%s:%d", net.IP(addr).String(), port)
-
-		if persistent {
-			m.externalAddr.Store(externalAddr)
-		}
-
-		return externalAddr, nil
+		return externalAddr, time.Since(record.time), nil
 	}
 }
@@ -0,0 +1,413 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package updater
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/pairmesh/pairmesh/security"
+	"github.com/pairmesh/pairmesh/version"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// checkDelay is the delay of the first version check after started, and
+	// the check is repeated every checkInterval.
+	checkDelay    = time.Minute
+	checkInterval = 6 * time.Hour
+
+	downloadTimeout = 10 * time.Minute
+	stagedFileName  = "staged.json"
+
+	// maxReleaseSize is the limit of the release downloaded, which is far
+	// larger than the executable to stop an endless response early.
+	maxReleaseSize = 256 << 20
+
+	// The beta channel is offered the stable releases too.
+	channelStable = "stable"
+	channelBeta   = "beta"
+)
+
+type (
+	// Checker checks whether a newer release is available
+	Checker interface {
+		VersionCheck(version, platform, channel string) (*protocol.VersionCheckResponse, error)
+	}
+
+	// State represents the newer release available, and the version is empty if
+	// the current node is the latest.
+	State struct {
+		Version         string
+		DownloadAddress string
+		ReleaseNotes    string
+		// Staged indicates the release has been downloaded and verified, which
+		// is installed by Apply.
+		Staged bool
+	}
+
+	// staged is the metadata of the staged release
+	staged struct {
+		Version   string `json:"version"`
+		Arch      string `json:"arch"`
+		Channel   string `json:"channel"`
+		Path      string `json:"path"`
+		SHA256    string `json:"sha256"`
+		Signature string `json:"signature"`
+	}
+
+	// Updater checks the releases periodically, and downloads the newer release
+	// and stages it after the signature is verified. The staged release replaces
+	// the current executable after the user confirms restarting.
+	Updater struct {
+		checker Checker
+		channel string
+		dir     string
+		key     ed25519.PublicKey
+		client  *http.Client
+		current *semver.Version
+		maxSize int64
+		// executable returns the path of the current executable
+		executable func() (string, error)
+
+		mu    sync.Mutex
+		state State
+	}
+)
+
+// New returns the updater which stages the releases in the directory. The
+// releases are only checked but not staged if no release key is built in.
+func New(checker Checker, dir, channel string) *Updater {
+	if channel == "" {
+		channel = channelStable
+	}
+	u := &Updater{
+		checker:    checker,
+		channel:    channel,
+		dir:        filepath.Join(dir, "updates"),
+		client:     &http.Client{Timeout: downloadTimeout},
+		current:    semver.New(version.NewVersion().SemVer()),
+		maxSize:    maxReleaseSize,
+		executable: os.Executable,
+	}
+	if version.ReleaseKey != "" {
+		key, err := base64.StdEncoding.DecodeString(version.ReleaseKey)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			zap.L().Error("Invalid release key built in", zap.String("key", version.ReleaseKey))
+		} else {
+			u.key = key
+		}
+	}
+
+	// Restore the release staged before restarting.
+	if s, err := u.staged(); err == nil && s != nil {
+		u.state = State{Version: s.Version, Staged: true}
+	}
+	return u
+}
+
+// State returns the state of the newer release
+func (u *Updater) State() State {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.state
+}
+
+// Run checks the releases periodically until the context is canceled.
+func (u *Updater) Run(ctx context.Context) {
+	timer := time.NewTimer(checkDelay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			state, err := u.Check(ctx)
+			if err != nil {
+				zap.L().Error("Check updates failed", zap.Error(err))
+			} else if state.Staged {
+				zap.L().Info("New version is staged, restart to update", zap.String("version", state.Version))
+			} else if state.Version != "" {
+				zap.L().Info("New version is available", zap.String("version", state.Version), zap.String("download", state.DownloadAddress))
+			}
+			timer.Reset(checkInterval)
+		}
+	}
+}
+
+// Check checks whether a newer release is available, and downloads and stages
+// the release if the release key is built in.
+func (u *Updater) Check(ctx context.Context) (State, error) {
+	res, err := u.checker.VersionCheck(u.current.String(), runtime.GOOS+"/"+runtime.GOARCH, u.channel)
+	if err != nil {
+		return State{}, err
+	}
+
+	s, err := u.staged()
+	if err != nil {
+		return State{}, err
+	}
+	state := State{}
+	if res.NewVersion {
+		if err := u.checkRelease(res); err != nil {
+			return State{}, err
+		}
+		state = State{
+			Version:         res.NewVersionCode,
+			DownloadAddress: res.DownloadAddress,
+			ReleaseNotes:    res.ReleaseNotes,
+			Staged:          s != nil && s.Version == res.NewVersionCode,
+		}
+		if !state.Staged && u.key != nil {
+			if err := u.stage(ctx, res); err != nil {
+				return State{}, err
+			}
+			state.Staged = true
+		}
+	}
+
+	u.mu.Lock()
+	u.state = state
+	u.mu.Unlock()
+	return state, nil
+}
+
+func (u *Updater) stagedPath() string {
+	return filepath.Join(u.dir, stagedFileName)
+}
+
+// staged returns the staged release which is newer than the current version,
+// nil if not found.
+func (u *Updater) staged() (*staged, error) {
+	data, err := ioutil.ReadFile(u.stagedPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s := &staged{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	v, err := semver.NewVersion(s.Version)
+	if err != nil || !u.current.LessThan(*v) {
+		return nil, nil
+	}
+	return s, nil
+}
+
+// checkRelease checks whether the release offered is a newer version of the
+// current platform in the subscribed channel.
+func (u *Updater) checkRelease(res *protocol.VersionCheckResponse) error {
+	v, err := semver.NewVersion(res.NewVersionCode)
+	if err != nil {
+		return errors.WithMessagef(err, "invalid release version %q", res.NewVersionCode)
+	}
+	if !u.current.LessThan(*v) {
+		return errors.Errorf("release %s is not newer than %s", v, u.current)
+	}
+	if res.Arch != "" && res.Arch != runtime.GOARCH {
+		return errors.Errorf("release %s is built for %s", v, res.Arch)
+	}
+	if res.Channel != u.channel && !(u.channel == channelBeta && res.Channel == channelStable) {
+		return errors.Errorf("release %s is not offered to the %s channel", v, u.channel)
+	}
+	return nil
+}
+
+// release returns the release record of the staged or downloaded release.
+func release(version, arch, channel string, digest []byte) *security.Release {
+	return &security.Release{
+		Version: version,
+		OS:      runtime.GOOS,
+		Arch:    arch,
+		Channel: channel,
+		SHA256:  digest,
+	}
+}
+
+// stage downloads the release and stages it after verified.
+func (u *Updater) stage(ctx context.Context, res *protocol.VersionCheckResponse) error {
+	if err := os.MkdirAll(u.dir, 0700); err != nil {
+		return err
+	}
+	path := filepath.Join(u.dir, "pairmesh-"+semver.New(res.NewVersionCode).String())
+	if runtime.GOOS == "windows" {
+		path += ".exe"
+	}
+	tmp := path + ".download"
+	defer os.Remove(tmp)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, res.DownloadAddress, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("download release %s failed: %s", res.NewVersionCode, resp.Status)
+	}
+	if resp.ContentLength > u.maxSize {
+		return errors.Errorf("release %s exceeds the size limit %d", res.NewVersionCode, u.maxSize)
+	}
+
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0700)
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, h), io.LimitReader(resp.Body, u.maxSize+1))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if n > u.maxSize {
+		return errors.Errorf("release %s exceeds the size limit %d", res.NewVersionCode, u.maxSize)
+	}
+	r := release(res.NewVersionCode, res.Arch, res.Channel, h.Sum(nil))
+	if err := security.VerifyRelease(u.key, r, res.SHA256, res.Signature); err != nil {
+		return errors.WithMessagef(err, "verify release %s failed", res.NewVersionCode)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(&staged{
+		Version:   res.NewVersionCode,
+		Arch:      res.Arch,
+		Channel:   res.Channel,
+		Path:      path,
+		SHA256:    hex.EncodeToString(h.Sum(nil)),
+		Signature: res.Signature,
+	})
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(u.stagedPath(), data, 0600)
+}
+
+// Apply replaces the current executable with the staged release, which takes
+// effect after restarting. The staged release is verified again since it may
+// be modified after staged.
+func (u *Updater) Apply() error {
+	s, err := u.staged()
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return errors.New("no staged release")
+	}
+
+	f, err := os.Open(s.Path)
+	if err != nil {
+		return err
+	}
+	digest, err := security.ReleaseDigest(f)
+	_ = f.Close()
+	if err != nil {
+		return err
+	}
+	if err := security.VerifyRelease(u.key, release(s.Version, s.Arch, s.Channel, digest), s.SHA256, s.Signature); err != nil {
+		return err
+	}
+
+	exe, err := u.executable()
+	if err != nil {
+		return err
+	}
+	if exe, err = filepath.EvalSymlinks(exe); err != nil {
+		return err
+	}
+	if err := replaceFile(s.Path, exe); err != nil {
+		return errors.WithMessage(err, "replace executable failed")
+	}
+
+	_ = os.Remove(s.Path)
+	_ = os.Remove(u.stagedPath())
+	u.mu.Lock()
+	u.state = State{}
+	u.mu.Unlock()
+
+	zap.L().Info("Update installed successfully", zap.String("version", s.Version), zap.String("path", exe))
+	return nil
+}
+
+// replaceFile replaces the dst file with the src file. The dst file is renamed
+// instead of removed, because the running executable can't be removed on some
+// platforms, and the renamed file is removed on the next replacement.
+func replaceFile(src, dst string) error {
+	info, err := os.Stat(dst)
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	tmp, old := dst+".new", dst+".old"
+	if err := ioutil.WriteFile(tmp, data, info.Mode()); err != nil {
+		return err
+	}
+	_ = os.Remove(old)
+	if err := os.Rename(dst, old); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		_ = os.Rename(old, dst)
+		return err
+	}
+	_ = os.Remove(old)
+	return nil
+}
+
+// Relaunch starts a new process of the current executable with the same
+// arguments, and the caller should exit after that.
+func Relaunch() error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(exe, os.Args[1:]...)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("relaunch %s failed: %w", exe, err)
+	}
+	return cmd.Process.Release()
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package updater
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/pairmesh/pairmesh/security"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeChecker struct {
+	res *protocol.VersionCheckResponse
+}
+
+func (c *fakeChecker) VersionCheck(_, _, _ string) (*protocol.VersionCheckResponse, error) {
+	return c.res, nil
+}
+
+func TestUpdater(t *testing.T) {
+	a := assert.New(t)
+
+	release := []byte("pairmesh-99.0.0")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(release)
+	}))
+	defer srv.Close()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	a.Nil(err)
+	digest := sha256.Sum256(release)
+	sig, err := security.SignRelease(privateKey, &security.Release{
+		Version: "99.0.0",
+		OS:      runtime.GOOS,
+		Arch:    runtime.GOARCH,
+		Channel: "stable",
+		SHA256:  digest[:],
+	})
+	a.Nil(err)
+	checker := &fakeChecker{res: &protocol.VersionCheckResponse{
+		NewVersion:      true,
+		NewVersionCode:  "99.0.0",
+		DownloadAddress: srv.URL,
+		Arch:            runtime.GOARCH,
+		Channel:         "stable",
+		SHA256:          hex.EncodeToString(digest[:]),
+		Signature:       sig,
+	}}
+
+	dir := t.TempDir()
+	exe := filepath.Join(dir, "pairmesh")
+	a.Nil(ioutil.WriteFile(exe, []byte("pairmesh-0.1.0"), 0755))
+
+	// The release is only checked without the release key.
+	u := New(checker, dir, "stable")
+	u.executable = func() (string, error) { return exe, nil }
+	state, err := u.Check(context.Background())
+	a.Nil(err)
+	a.Equal(State{Version: "99.0.0", DownloadAddress: srv.URL}, state)
+	a.NotNil(u.Apply())
+
+	// The release signed by another key is rejected.
+	otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+	a.Nil(err)
+	u.key = otherKey
+	_, err = u.Check(context.Background())
+	a.NotNil(err)
+
+	// The signature doesn't cover another version, platform or channel, and
+	// the older or invalid versions are rejected before downloading.
+	u.key = publicKey
+	for _, modify := range []func(res *protocol.VersionCheckResponse){
+		func(res *protocol.VersionCheckResponse) { res.NewVersionCode = "99.0.1" },
+		func(res *protocol.VersionCheckResponse) { res.Arch = "" },
+		func(res *protocol.VersionCheckResponse) { res.Arch = "unknown" },
+		func(res *protocol.VersionCheckResponse) { res.Channel = "beta" },
+		func(res *protocol.VersionCheckResponse) { res.NewVersionCode = "0.0.1" },
+		func(res *protocol.VersionCheckResponse) { res.NewVersionCode = "99.0.0-../../pairmesh" },
+	} {
+		res := *checker.res
+		modify(&res)
+		other := New(&fakeChecker{res: &res}, dir, "stable")
+		other.key = publicKey
+		_, err = other.Check(context.Background())
+		a.NotNil(err)
+	}
+
+	// The release larger than the limit is rejected.
+	u.maxSize = int64(len(release)) - 1
+	_, err = u.Check(context.Background())
+	a.NotNil(err)
+	u.maxSize = maxReleaseSize
+
+	// The stable release is offered to the beta channel too.
+	u.channel = "beta"
+	a.Nil(u.checkRelease(checker.res))
+	u.channel = "stable"
+
+	state, err = u.Check(context.Background())
+	a.Nil(err)
+	a.True(state.Staged)
+
+	// The staged release is restored after restarting.
+	u = New(checker, dir, "stable")
+	u.key = publicKey
+	u.executable = func() (string, error) { return exe, nil }
+	a.Equal(State{Version: "99.0.0", Staged: true}, u.State())
+
+	a.Nil(u.Apply())
+	data, err := ioutil.ReadFile(exe)
+	a.Nil(err)
+	a.Equal(release, data)
+	a.Equal(State{}, u.State())
+	a.NotNil(u.Apply())
+}
@@ -0,0 +1,273 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics implements the minimal metrics types which can be exposed in
+// the Prometheus text exposition format.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/atomic"
+)
+
+// ContentType is the content type of the Prometheus text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+type (
+	// Label represents a label pair of the metric series.
+	Label struct {
+		Name  string
+		Value string
+	}
+
+	// Counter is a monotonically increasing counter.
+	Counter struct {
+		value atomic.Uint64
+	}
+
+	// Histogram counts the observations in the configurable buckets.
+	Histogram struct {
+		mu      sync.Mutex
+		buckets []float64
+		counts  []uint64
+		count   uint64
+		sum     float64
+	}
+
+	// Registry is a collection of the metric families.
+	Registry struct {
+		mu       sync.Mutex
+		families map[string]*family
+	}
+
+	family struct {
+		name   string
+		help   string
+		typ    string
+		series []series
+	}
+
+	series struct {
+		labels []Label
+		write  func(w io.Writer, name string, labels []Label)
+	}
+)
+
+// DefBuckets are the default buckets of histograms in seconds.
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Inc increases the counter by 1.
+func (c *Counter) Inc() {
+	c.value.Inc()
+}
+
+// Add increases the counter by delta.
+func (c *Counter) Add(delta uint64) {
+	c.value.Add(delta)
+}
+
+// Value returns the current value of the counter.
+func (c *Counter) Value() uint64 {
+	return c.value.Load()
+}
+
+// Observe adds an observation to the histogram.
+func (h *Histogram) Observe(v float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for i, upper := range h.buckets {
+		if v <= upper {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{families: map[string]*family{}}
+}
+
+// register adds the series into the family, and the family is created if it
+// doesn't exist. It panics if the type of family is mismatched.
+func (r *Registry) register(name, help, typ string, s series) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	f, found := r.families[name]
+	if !found {
+		f = &family{name: name, help: help, typ: typ}
+		r.families[name] = f
+	}
+	if f.typ != typ {
+		panic(fmt.Sprintf("metric %s registered as %s and %s", name, f.typ, typ))
+	}
+	f.series = append(f.series, s)
+}
+
+// NewCounter registers a counter with the labels.
+func (r *Registry) NewCounter(name, help string, labels ...Label) *Counter {
+	c := &Counter{}
+	r.register(name, help, "counter", series{
+		labels: labels,
+		write: func(w io.Writer, name string, labels []Label) {
+			writeSample(w, name, labels, float64(c.Value()))
+		},
+	})
+	return c
+}
+
+// NewCounterFunc registers a counter whose value is retrieved by the function
+// while collecting.
+func (r *Registry) NewCounterFunc(name, help string, fn func() uint64, labels ...Label) {
+	r.register(name, help, "counter", series{
+		labels: labels,
+		write: func(w io.Writer, name string, labels []Label) {
+			writeSample(w, name, labels, float64(fn()))
+		},
+	})
+}
+
+// NewGaugeFunc registers a gauge whose value is retrieved by the function while
+// collecting.
+func (r *Registry) NewGaugeFunc(name, help string, fn func() float64, labels ...Label) {
+	r.register(name, help, "gauge", series{
+		labels: labels,
+		write: func(w io.Writer, name string, labels []Label) {
+			writeSample(w, name, labels, fn())
+		},
+	})
+}
+
+// NewHistogram registers a histogram with the upper bounds of buckets, and the
+// DefBuckets are used if the buckets are empty.
+func (r *Registry) NewHistogram(name, help string, buckets []float64, labels ...Label) *Histogram {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	h := &Histogram{buckets: buckets, counts: make([]uint64, len(buckets))}
+	r.register(name, help, "histogram", series{
+		labels: labels,
+		write: func(w io.Writer, name string, labels []Label) {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			for i, upper := range h.buckets {
+				le := Label{Name: "le", Value: formatFloat(upper)}
+				writeSample(w, name+"_bucket", append(labels[:len(labels):len(labels)], le), float64(h.counts[i]))
+			}
+			inf := Label{Name: "le", Value: "+Inf"}
+			writeSample(w, name+"_bucket", append(labels[:len(labels):len(labels)], inf), float64(h.count))
+			writeSample(w, name+"_sum", labels, h.sum)
+			writeSample(w, name+"_count", labels, float64(h.count))
+		},
+	})
+	return h
+}
+
+// WriteTo writes all metrics in the text exposition format.
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	families := make([]*family, 0, len(r.families))
+	for _, f := range r.families {
+		families = append(families, f)
+	}
+	r.mu.Unlock()
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].name < families[j].name
+	})
+
+	bw := bufio.NewWriter(w)
+	cw := &countWriter{w: bw}
+	for _, f := range families {
+		fmt.Fprintf(cw, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+		fmt.Fprintf(cw, "# TYPE %s %s\n", f.name, f.typ)
+		for _, s := range f.series {
+			s.write(cw, f.name, s.labels)
+		}
+	}
+	if cw.err != nil {
+		return cw.n, cw.err
+	}
+	return cw.n, bw.Flush()
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+	_, _ = r.WriteTo(w)
+}
+
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
+
+func writeSample(w io.Writer, name string, labels []Label, value float64) {
+	if len(labels) == 0 {
+		fmt.Fprintf(w, "%s %s\n", name, formatFloat(value))
+		return
+	}
+	pairs := make([]string, 0, len(labels))
+	for _, l := range labels {
+		pairs = append(pairs, l.Name+`="`+labelReplacer.Replace(l.Value)+`"`)
+	}
+	fmt.Fprintf(w, "%s{%s} %s\n", name, strings.Join(pairs, ","), formatFloat(value))
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	helpReplacer  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpReplacer.Replace(s)
+}
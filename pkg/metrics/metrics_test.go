@@ -0,0 +1,60 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	a := assert.New(t)
+
+	r := NewRegistry()
+	in := r.NewCounter("bytes_total", "Bytes by direction.", Label{Name: "direction", Value: "in"})
+	out := r.NewCounter("bytes_total", "Bytes by direction.", Label{Name: "direction", Value: "out"})
+	r.NewGaugeFunc("sessions", "Active sessions.", func() float64 { return 3 })
+	h := r.NewHistogram("latency_seconds", "Latency.", []float64{0.5, 0.1})
+
+	in.Add(10)
+	out.Inc()
+	h.Observe(0.05)
+	h.Observe(0.3)
+	h.Observe(2)
+
+	var buf bytes.Buffer
+	n, err := r.WriteTo(&buf)
+	a.Nil(err)
+	a.Equal(int64(buf.Len()), n)
+	a.Equal(`# HELP bytes_total Bytes by direction.
+# TYPE bytes_total counter
+bytes_total{direction="in"} 10
+bytes_total{direction="out"} 1
+# HELP latency_seconds Latency.
+# TYPE latency_seconds histogram
+latency_seconds_bucket{le="0.1"} 1
+latency_seconds_bucket{le="0.5"} 2
+latency_seconds_bucket{le="+Inf"} 3
+latency_seconds_sum 2.35
+latency_seconds_count 3
+# HELP sessions Active sessions.
+# TYPE sessions gauge
+sessions 3
+`, buf.String())
+
+	a.Panics(func() { r.NewGaugeFunc("bytes_total", "", func() float64 { return 0 }) })
+}
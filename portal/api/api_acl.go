@@ -0,0 +1,321 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pairmesh/pairmesh/errcode"
+	"github.com/pairmesh/pairmesh/pkg/jwt"
+	"github.com/pairmesh/pairmesh/portal/db"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+	"github.com/pairmesh/pairmesh/protocol"
+
+	"github.com/gorilla/mux"
+	"gorm.io/gorm"
+)
+
+type (
+	// ACLRuleItem is the ACL rule information item struct
+	ACLRuleItem struct {
+		RuleID      models.ID          `json:"rule_id"`
+		NetworkID   models.ID          `json:"network_id"`
+		Priority    int                `json:"priority"`
+		Action      models.ACLAction   `json:"action"`
+		SrcUserID   models.ID          `json:"src_user_id"`
+		SrcDeviceID models.ID          `json:"src_device_id"`
+		DstDeviceID models.ID          `json:"dst_device_id"`
+		Protocol    models.ACLProtocol `json:"protocol"`
+		Ports       string             `json:"ports"`
+		CreatedAt   int64              `json:"created_at"`
+	}
+
+	// ACLRuleListResponse is the response of the ACL rules of the network
+	ACLRuleListResponse struct {
+		Rules []ACLRuleItem `json:"rules"`
+	}
+
+	// ACLRuleRequest is the request to create or update an ACL rule. The ports
+	// are comma separated ports or port ranges, e.g: 22,8000-8080.
+	ACLRuleRequest struct {
+		Priority    int                `json:"priority"`
+		Action      models.ACLAction   `json:"action"`
+		SrcUserID   models.ID          `json:"src_user_id"`
+		SrcDeviceID models.ID          `json:"src_device_id"`
+		DstDeviceID models.ID          `json:"dst_device_id"`
+		Protocol    models.ACLProtocol `json:"protocol"`
+		Ports       string             `json:"ports"`
+	}
+
+	// ACLRuleResponse is the response of the ACL rule operations
+	ACLRuleResponse struct {
+		Rule ACLRuleItem `json:"rule"`
+	}
+
+	// DeleteACLRuleResponse is the response to deleting an ACL rule
+	DeleteACLRuleResponse struct {
+	}
+)
+
+func aclRuleItem(r *models.ACLRule) ACLRuleItem {
+	return ACLRuleItem{
+		RuleID:      r.ID,
+		NetworkID:   r.NetworkID,
+		Priority:    r.Priority,
+		Action:      r.Action,
+		SrcUserID:   r.SrcUserID,
+		SrcDeviceID: r.SrcDeviceID,
+		DstDeviceID: r.DstDeviceID,
+		Protocol:    r.Protocol,
+		Ports:       r.Ports,
+		CreatedAt:   r.CreatedAt.Unix(),
+	}
+}
+
+// parsePortRanges parses the comma separated ports or port ranges.
+func parsePortRanges(ports string) ([]protocol.PortRange, error) {
+	var ranges []protocol.PortRange
+	for _, s := range strings.Split(ports, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		first, last := s, s
+		if i := strings.IndexByte(s, '-'); i > 0 {
+			first, last = s[:i], s[i+1:]
+		}
+		f, err := strconv.ParseUint(first, 10, 16)
+		if err != nil {
+			return nil, err
+		}
+		l, err := strconv.ParseUint(last, 10, 16)
+		if err != nil {
+			return nil, err
+		}
+		if f > l {
+			return nil, errcode.ErrIllegalRequest
+		}
+		ranges = append(ranges, protocol.PortRange{First: uint16(f), Last: uint16(l)})
+	}
+	return ranges, nil
+}
+
+// validateACLRule validates the ACL rule request and normalizes the default
+// values of the request.
+func validateACLRule(tx *gorm.DB, networkID models.ID, req *ACLRuleRequest) error {
+	if req.Action != models.ACLActionAccept && req.Action != models.ACLActionDrop {
+		return errcode.ErrIllegalRequest
+	}
+	if req.Protocol == "" {
+		req.Protocol = models.ACLProtocolAny
+	}
+	switch req.Protocol {
+	case models.ACLProtocolAny, models.ACLProtocolICMP:
+		// Ports are meaningless without TCP/UDP protocol.
+		if strings.TrimSpace(req.Ports) != "" {
+			return errcode.ErrIllegalRequest
+		}
+	case models.ACLProtocolTCP, models.ACLProtocolUDP:
+		if _, err := parsePortRanges(req.Ports); err != nil {
+			return errcode.ErrIllegalRequest
+		}
+	default:
+		return errcode.ErrIllegalRequest
+	}
+
+	// The source user and devices must belong to the network.
+	var networkUsers []models.NetworkUser
+	if err := models.NewNetworkUserQuerySet(tx).NetworkIDEq(networkID).All(&networkUsers); err != nil {
+		return err
+	}
+	members := map[models.ID]struct{}{}
+	for _, u := range networkUsers {
+		members[u.UserID] = struct{}{}
+	}
+	if _, found := members[req.SrcUserID]; req.SrcUserID != 0 && !found {
+		return errcode.ErrIllegalRequest
+	}
+	for _, deviceID := range []models.ID{req.SrcDeviceID, req.DstDeviceID} {
+		if deviceID == 0 {
+			continue
+		}
+		device := models.Device{}
+		if err := models.NewDeviceQuerySet(tx).IDEq(deviceID).One(&device); err != nil {
+			return errcode.ErrIllegalRequest
+		}
+		if _, found := members[device.UserID]; !found {
+			return errcode.ErrIllegalRequest
+		}
+	}
+	return nil
+}
+
+// NetworkACL returns the ACL rules of the network
+func (s *server) NetworkACL(r *http.Request) (*ACLRuleListResponse, error) {
+	vars := Vars(mux.Vars(r))
+	networkID := vars.ModelID("network_id")
+	if networkID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	res := &ACLRuleListResponse{Rules: []ACLRuleItem{}}
+	err := db.Tx(func(tx *gorm.DB) error {
+		var rules []models.ACLRule
+		err := models.NewACLRuleQuerySet(tx).
+			NetworkIDEq(networkID).
+			OrderAscByPriority().
+			OrderAscByID().
+			All(&rules)
+		if err != nil {
+			return err
+		}
+		for i := range rules {
+			res.Rules = append(res.Rules, aclRuleItem(&rules[i]))
+		}
+		return nil
+	})
+	return res, err
+}
+
+// CreateACLRule creates an ACL rule of the network
+func (s *server) CreateACLRule(ctx context.Context, r *http.Request, req *ACLRuleRequest) (*ACLRuleResponse, error) {
+	vars := Vars(mux.Vars(r))
+	networkID := vars.ModelID("network_id")
+	if networkID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+
+	var res *ACLRuleResponse
+	var affected []models.ID
+	err := db.Tx(func(tx *gorm.DB) error {
+		if err := validateACLRule(tx, networkID, req); err != nil {
+			return err
+		}
+
+		rule := &models.ACLRule{
+			NetworkID:   networkID,
+			Priority:    req.Priority,
+			Action:      req.Action,
+			SrcUserID:   req.SrcUserID,
+			SrcDeviceID: req.SrcDeviceID,
+			DstDeviceID: req.DstDeviceID,
+			Protocol:    req.Protocol,
+			Ports:       req.Ports,
+			CreatedByID: userID,
+		}
+		if err := tx.Create(rule).Error; err != nil {
+			return err
+		}
+
+		res = &ACLRuleResponse{Rule: aclRuleItem(rule)}
+
+		var err error
+		affected, err = models.NetworkMemberIDs(tx, networkID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
+
+	return res, nil
+}
+
+// UpdateACLRule updates the ACL rule of the network
+func (s *server) UpdateACLRule(r *http.Request, req *ACLRuleRequest) (*ACLRuleResponse, error) {
+	vars := Vars(mux.Vars(r))
+	networkID := vars.ModelID("network_id")
+	ruleID := vars.ModelID("rule_id")
+	if networkID == 0 || ruleID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	var res *ACLRuleResponse
+	var affected []models.ID
+	err := db.Tx(func(tx *gorm.DB) error {
+		if err := validateACLRule(tx, networkID, req); err != nil {
+			return err
+		}
+
+		rule := &models.ACLRule{}
+		if err := models.NewACLRuleQuerySet(tx).NetworkIDEq(networkID).IDEq(ruleID).One(rule); err != nil {
+			return err
+		}
+
+		err := models.NewACLRuleQuerySet(tx).
+			IDEq(ruleID).
+			GetUpdater().
+			SetPriority(req.Priority).
+			SetAction(req.Action).
+			SetSrcUserID(req.SrcUserID).
+			SetSrcDeviceID(req.SrcDeviceID).
+			SetDstDeviceID(req.DstDeviceID).
+			SetProtocol(req.Protocol).
+			SetPorts(req.Ports).
+			Update()
+		if err != nil {
+			return err
+		}
+
+		rule.Priority = req.Priority
+		rule.Action = req.Action
+		rule.SrcUserID = req.SrcUserID
+		rule.SrcDeviceID = req.SrcDeviceID
+		rule.DstDeviceID = req.DstDeviceID
+		rule.Protocol = req.Protocol
+		rule.Ports = req.Ports
+		res = &ACLRuleResponse{Rule: aclRuleItem(rule)}
+
+		affected, err = models.NetworkMemberIDs(tx, networkID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
+
+	return res, nil
+}
+
+// DeleteACLRule deletes the ACL rule of the network
+func (s *server) DeleteACLRule(r *http.Request) (*DeleteACLRuleResponse, error) {
+	vars := Vars(mux.Vars(r))
+	networkID := vars.ModelID("network_id")
+	ruleID := vars.ModelID("rule_id")
+	if networkID == 0 || ruleID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	var affected []models.ID
+	err := db.Tx(func(tx *gorm.DB) error {
+		if err := models.NewACLRuleQuerySet(tx).NetworkIDEq(networkID).IDEq(ruleID).Delete(); err != nil {
+			return err
+		}
+
+		var err error
+		affected, err = models.NetworkMemberIDs(tx, networkID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
+
+	return &DeleteACLRuleResponse{}, nil
+}
@@ -0,0 +1,357 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/pairmesh/pairmesh/constant"
+	"github.com/pairmesh/pairmesh/errcode"
+	"github.com/pairmesh/pairmesh/pkg/jwt"
+	"github.com/pairmesh/pairmesh/portal/db"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+
+	"github.com/pingcap/fn"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	auditTargetNetwork            = "network"
+	auditTargetMember             = "network_user"
+	auditTargetInvitation         = "invitation"
+	auditTargetKey                = "key"
+	auditTargetDevice             = "device"
+	auditTargetOrganization       = "organization"
+	auditTargetOrganizationMember = "organization_user"
+
+	auditDefaultPageSize = 20
+	auditMaxPageSize     = 100
+	auditExportBatchSize = 500
+)
+
+// auditState is the state of the audit target before or after the action.
+type auditState map[string]interface{}
+
+// audit appends the audit log of the action made by the current request in the
+// transaction of the action. Only the changed fields are recorded if the target
+// has both states.
+func audit(ctx context.Context, tx *gorm.DB, log *models.AuditLog, before, after auditState) error {
+	if before != nil && after != nil {
+		for k, v := range before {
+			if reflect.DeepEqual(v, after[k]) {
+				delete(before, k)
+				delete(after, k)
+			}
+		}
+	}
+	for _, s := range []struct {
+		state auditState
+		field *string
+	}{{before, &log.Before}, {after, &log.After}} {
+		if len(s.state) == 0 {
+			continue
+		}
+		data, err := json.Marshal(s.state)
+		if err != nil {
+			return err
+		}
+		*s.field = string(data)
+	}
+
+	log.ActorID = models.ID(jwt.UserIDFromContext(ctx))
+	if r, ok := ctx.Value(constant.KeyRawRequest).(*http.Request); ok {
+		log.RemoteAddr = r.RemoteAddr
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			log.RemoteAddr = host
+		}
+		log.UserAgent = r.UserAgent()
+		if len(log.UserAgent) > 512 {
+			log.UserAgent = log.UserAgent[:512]
+		}
+	}
+	return models.CreateAuditLog(tx, log)
+}
+
+// auditFilter is the filter of the audit logs query.
+type auditFilter struct {
+	actorID        models.ID
+	action         models.AuditAction
+	targetType     string
+	targetID       models.ID
+	organizationID models.ID
+	networkID      models.ID
+	since          time.Time
+	until          time.Time
+	afterID        models.ID
+}
+
+func parseAuditFilter(values url.Values) (*auditFilter, error) {
+	ids := map[string]models.ID{}
+	for _, key := range []string{"actor_id", "target_id", "organization_id", "network_id", "after_id"} {
+		if v := values.Get(key); v != "" {
+			n, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil, errcode.ErrIllegalRequest
+			}
+			ids[key] = models.ID(n)
+		}
+	}
+	times := map[string]time.Time{}
+	for _, key := range []string{"since", "until"} {
+		if v := values.Get(key); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, errcode.ErrIllegalRequest
+			}
+			times[key] = time.Unix(n, 0)
+		}
+	}
+
+	return &auditFilter{
+		actorID:        ids["actor_id"],
+		action:         models.AuditAction(values.Get("action")),
+		targetType:     values.Get("target_type"),
+		targetID:       ids["target_id"],
+		organizationID: ids["organization_id"],
+		networkID:      ids["network_id"],
+		since:          times["since"],
+		until:          times["until"],
+		afterID:        ids["after_id"],
+	}, nil
+}
+
+// authorize checks whether the user can read the audit logs of the filter. The
+// audit logs of an organization are visible to its admins and auditors, the
+// audit logs of a network are visible to its owner and admins (including the
+// network admins of its organization) and the organization auditors, otherwise
+// only the actions made by the user self are visible.
+func (f *auditFilter) authorize(tx *gorm.DB, userID models.ID) error {
+	switch {
+	case f.organizationID != 0:
+		return checkOrganizationRole(tx, f.organizationID, userID,
+			models.OrganizationRoleAdmin,
+			models.OrganizationRoleAuditor)
+
+	case f.networkID != 0:
+		role, orgRole, err := networkRole(tx, userID, f.networkID)
+		if err != nil {
+			return err
+		}
+		if networkRoleRank[role] < networkRoleRank[models.RoleTypeAdmin] && orgRole != models.OrganizationRoleAuditor {
+			return errcode.ErrIllegalOperation
+		}
+		return nil
+
+	case f.actorID == 0:
+		f.actorID = userID
+		return nil
+
+	case f.actorID != userID:
+		return errcode.ErrIllegalOperation
+	}
+	return nil
+}
+
+func (f *auditFilter) querySet(tx *gorm.DB) models.AuditLogQuerySet {
+	qs := models.NewAuditLogQuerySet(tx)
+	if f.actorID != 0 {
+		qs = qs.ActorIDEq(f.actorID)
+	}
+	if f.action != "" {
+		qs = qs.ActionEq(f.action)
+	}
+	if f.targetType != "" {
+		qs = qs.TargetTypeEq(f.targetType)
+	}
+	if f.targetID != 0 {
+		qs = qs.TargetIDEq(f.targetID)
+	}
+	if f.organizationID != 0 {
+		qs = qs.OrganizationIDEq(f.organizationID)
+	}
+	if f.networkID != 0 {
+		qs = qs.NetworkIDEq(f.networkID)
+	}
+	if !f.since.IsZero() {
+		qs = qs.CreatedAtGte(f.since)
+	}
+	if !f.until.IsZero() {
+		qs = qs.CreatedAtLt(f.until)
+	}
+	if f.afterID != 0 {
+		qs = qs.IDGt(f.afterID)
+	}
+	return qs
+}
+
+type (
+	// AuditLogItem is the single item of the audit log list
+	AuditLogItem struct {
+		ID             models.ID          `json:"id"`
+		CreatedAt      int64              `json:"created_at"`
+		ActorID        models.ID          `json:"actor_id"`
+		Action         models.AuditAction `json:"action"`
+		TargetType     string             `json:"target_type"`
+		TargetID       models.ID          `json:"target_id"`
+		OrganizationID models.ID          `json:"organization_id"`
+		NetworkID      models.ID          `json:"network_id"`
+		Before         json.RawMessage    `json:"before,omitempty"`
+		After          json.RawMessage    `json:"after,omitempty"`
+		RemoteAddr     string             `json:"remote_addr"`
+		UserAgent      string             `json:"user_agent"`
+	}
+
+	// AuditLogListResponse is the response of the audit log list
+	AuditLogListResponse struct {
+		Total    int64          `json:"total"`
+		Page     int            `json:"page"`
+		PageSize int            `json:"page_size"`
+		Logs     []AuditLogItem `json:"logs"`
+	}
+)
+
+func auditLogItem(log *models.AuditLog) AuditLogItem {
+	item := AuditLogItem{
+		ID:             log.ID,
+		CreatedAt:      log.CreatedAt.Unix(),
+		ActorID:        log.ActorID,
+		Action:         log.Action,
+		TargetType:     log.TargetType,
+		TargetID:       log.TargetID,
+		OrganizationID: log.OrganizationID,
+		NetworkID:      log.NetworkID,
+		RemoteAddr:     log.RemoteAddr,
+		UserAgent:      log.UserAgent,
+	}
+	if log.Before != "" {
+		item.Before = json.RawMessage(log.Before)
+	}
+	if log.After != "" {
+		item.After = json.RawMessage(log.After)
+	}
+	return item
+}
+
+// AuditLogs returns the audit logs matched the filter, the latest logs first
+func (s *server) AuditLogs(ctx context.Context, form *fn.Form) (*AuditLogListResponse, error) {
+	filter, err := parseAuditFilter(form.Values)
+	if err != nil {
+		return nil, err
+	}
+	page := form.IntOrDefault("page", 1)
+	pageSize := form.IntOrDefault("page_size", auditDefaultPageSize)
+	if page < 1 || pageSize < 1 || pageSize > auditMaxPageSize {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+	res := &AuditLogListResponse{
+		Page:     page,
+		PageSize: pageSize,
+		Logs:     []AuditLogItem{},
+	}
+	err = db.Tx(func(tx *gorm.DB) error {
+		if err := filter.authorize(tx, userID); err != nil {
+			return err
+		}
+		total, err := filter.querySet(tx).Count()
+		if err != nil {
+			return err
+		}
+		res.Total = total
+
+		var logs []models.AuditLog
+		err = filter.querySet(tx).
+			OrderDescByID().
+			Offset((page - 1) * pageSize).
+			Limit(pageSize).
+			All(&logs)
+		if err != nil {
+			return err
+		}
+		for i := range logs {
+			res.Logs = append(res.Logs, auditLogItem(&logs[i]))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ExportAuditLogs exports the audit logs matched the filter as JSON lines, the
+// earliest logs first. The SIEM systems can export the logs incrementally with
+// the `after_id` parameter set to the last exported ID.
+func (s *server) ExportAuditLogs(w http.ResponseWriter, r *http.Request) {
+	ctx, err := tokenValidator(r.Context(), r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filter, err := parseAuditFilter(r.Form)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+	err = db.Tx(func(tx *gorm.DB) error {
+		return filter.authorize(tx, userID)
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	encoder := json.NewEncoder(w)
+	for {
+		var logs []models.AuditLog
+		err := db.Tx(func(tx *gorm.DB) error {
+			return filter.querySet(tx).OrderAscByID().Limit(auditExportBatchSize).All(&logs)
+		})
+		if err != nil {
+			// The response has been started, so the error can only be logged.
+			zap.L().Error("Export audit logs failed", zap.Error(err))
+			return
+		}
+		for i := range logs {
+			if err := encoder.Encode(auditLogItem(&logs[i])); err != nil {
+				return
+			}
+		}
+		if len(logs) < auditExportBatchSize {
+			return
+		}
+		filter.afterID = logs[len(logs)-1].ID
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+	}
+}
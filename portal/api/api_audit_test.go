@@ -0,0 +1,120 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/pairmesh/pairmesh/constant"
+	"github.com/pairmesh/pairmesh/errcode"
+	"github.com/pairmesh/pairmesh/pkg/jwt"
+	"github.com/pairmesh/pairmesh/portal/db/dbtest"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudit(t *testing.T) {
+	a := assert.New(t)
+	tx := dbtest.SQLite(t)
+
+	var alice, bob, carol, dave models.User
+	for _, u := range []*models.User{&alice, &bob, &carol, &dave} {
+		a.Nil(u.Create(tx))
+	}
+	org := &models.Organization{CreatedByID: alice.ID, Name: "acme"}
+	a.Nil(org.Create(tx))
+	a.Nil((&models.OrganizationUser{OrganizationID: org.ID, UserID: alice.ID, Role: models.OrganizationRoleAdmin}).Create(tx))
+	a.Nil((&models.OrganizationUser{OrganizationID: org.ID, UserID: bob.ID, Role: models.OrganizationRoleAuditor}).Create(tx))
+	a.Nil((&models.OrganizationUser{OrganizationID: org.ID, UserID: dave.ID, Role: models.OrganizationRoleNetworkAdmin}).Create(tx))
+	network := &models.Network{CreatedByID: alice.ID, OrganizationID: org.ID, Name: "office"}
+	a.Nil(network.Create(tx))
+	a.Nil((&models.NetworkUser{NetworkID: network.ID, UserID: carol.ID, Role: models.RoleTypeMember}).Create(tx))
+
+	r := httptest.NewRequest("PUT", "/api/v1/device/1", nil)
+	r.RemoteAddr = "10.0.0.1:5678"
+	r.Header.Set("User-Agent", "pairmesh-test")
+	ctx := context.WithValue(context.Background(), constant.KeyRawRequest, r)
+	ctx = jwt.ContextWithUserID(ctx, uint64(alice.ID))
+
+	// Only the changed fields are recorded, and the organization is inherited
+	// from the network.
+	log := &models.AuditLog{
+		Action:     models.AuditActionChangeMemberRole,
+		TargetType: auditTargetMember,
+		TargetID:   carol.ID,
+		NetworkID:  network.ID,
+	}
+	a.Nil(audit(ctx, tx, log,
+		auditState{"role": models.RoleTypeMember, "origin": ""},
+		auditState{"role": models.RoleTypeAdmin, "origin": ""}))
+
+	var logs []models.AuditLog
+	a.Nil(models.NewAuditLogQuerySet(tx).All(&logs))
+	a.Len(logs, 1)
+	a.Equal(alice.ID, logs[0].ActorID)
+	a.Equal(org.ID, logs[0].OrganizationID)
+	a.Equal(`{"role":"member"}`, logs[0].Before)
+	a.Equal(`{"role":"admin"}`, logs[0].After)
+	a.Equal("10.0.0.1", logs[0].RemoteAddr)
+	a.Equal("pairmesh-test", logs[0].UserAgent)
+
+	// The audit logs are append-only.
+	a.NotNil(models.NewAuditLogQuerySet(tx).IDEq(logs[0].ID).GetUpdater().SetAction("").Update())
+	a.NotNil(models.NewAuditLogQuerySet(tx).IDEq(logs[0].ID).Delete())
+
+	filter := func(values url.Values) *auditFilter {
+		f, err := parseAuditFilter(values)
+		a.Nil(err)
+		return f
+	}
+	orgFilter := url.Values{"organization_id": {"1"}}
+	networkFilter := url.Values{"network_id": {"1"}}
+	cases := []struct {
+		user   models.ID
+		values url.Values
+		err    error
+		count  int64
+	}{
+		{bob.ID, orgFilter, nil, 1},
+		{carol.ID, orgFilter, errcode.ErrIllegalOperation, 0},
+		{dave.ID, orgFilter, errcode.ErrIllegalOperation, 0},
+		{alice.ID, networkFilter, nil, 1},
+		{bob.ID, networkFilter, nil, 1},
+		{carol.ID, networkFilter, errcode.ErrIllegalOperation, 0},
+		{dave.ID, networkFilter, nil, 1},
+		{alice.ID, url.Values{}, nil, 1},
+		{carol.ID, url.Values{}, nil, 0},
+		{carol.ID, url.Values{"actor_id": {"1"}}, errcode.ErrIllegalOperation, 0},
+		{alice.ID, url.Values{"action": {"key.create"}}, nil, 0},
+	}
+	for i, c := range cases {
+		f := filter(c.values)
+		err := f.authorize(tx, c.user)
+		a.Equal(c.err, err, "case %d", i)
+		if err != nil {
+			continue
+		}
+		count, err := f.querySet(tx).Count()
+		a.Nil(err)
+		a.Equal(c.count, count, "case %d", i)
+	}
+
+	_, err := parseAuditFilter(url.Values{"since": {"yesterday"}})
+	a.Equal(errcode.ErrIllegalRequest, err)
+}
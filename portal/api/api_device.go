@@ -30,13 +30,15 @@ import (
 type (
 	// DeviceListItem is the single item struct of a device in a device list
 	DeviceListItem struct {
-		DeviceID models.ID               `json:"device_id"`
-		Name     string                  `json:"name"`
-		OS       string                  `json:"os"`
-		Version  string                  `json:"version"`
-		Address  string                  `json:"address"`
-		LastSeen time.Time               `json:"last_seen"`
-		Status   models.DeviceStatusType `json:"status"`
+		DeviceID  models.ID               `json:"device_id"`
+		Name      string                  `json:"name"`
+		OS        string                  `json:"os"`
+		Version   string                  `json:"version"`
+		Address   string                  `json:"address"`
+		LastSeen  time.Time               `json:"last_seen"`
+		Status    models.DeviceStatusType `json:"status"`
+		Ephemeral bool                    `json:"ephemeral"`
+		Revoked   bool                    `json:"revoked"`
 	}
 
 	// DeviceListResponse is the response to a device list request
@@ -45,6 +47,25 @@ type (
 	}
 )
 
+func deviceListItem(d *models.Device) DeviceListItem {
+	item := DeviceListItem{
+		DeviceID:  d.ID,
+		Name:      d.Name,
+		OS:        d.OS,
+		Version:   d.Version,
+		Address:   d.Address,
+		LastSeen:  d.LastSeen,
+		Ephemeral: d.Ephemeral,
+		Revoked:   d.RevokedAt != nil,
+	}
+	if d.LastSeen.After(time.Now().Add(-models.AssumeOnlineDuration)) {
+		item.Status = models.DeviceStatusTypeOnline
+	} else {
+		item.Status = models.DeviceStatusTypeOffline
+	}
+	return item
+}
+
 func (s *server) deviceList(userID models.ID) (*DeviceListResponse, error) {
 	var res *DeviceListResponse
 
@@ -54,21 +75,8 @@ func (s *server) deviceList(userID models.ID) (*DeviceListResponse, error) {
 			return err
 		}
 		res = &DeviceListResponse{}
-		for _, d := range devices {
-			item := DeviceListItem{
-				DeviceID: d.ID,
-				Name:     d.Name,
-				OS:       d.OS,
-				Version:  d.Version,
-				Address:  d.Address,
-				LastSeen: d.LastSeen,
-			}
-			if d.LastSeen.After(time.Now().Add(-models.AssumeOnlineDuration)) {
-				item.Status = models.DeviceStatusTypeOnline
-			} else {
-				item.Status = models.DeviceStatusTypeOffline
-			}
-			res.Devices = append(res.Devices, item)
+		for i := range devices {
+			res.Devices = append(res.Devices, deviceListItem(&devices[i]))
 		}
 		return nil
 	})
@@ -117,16 +125,294 @@ func (s *server) DeviceUpdate(ctx context.Context, r *http.Request, req *DeviceU
 	if deviceID == 0 {
 		return nil, errcode.ErrIllegalRequest
 	}
-	userID := models.ID(jwt.UserIDFromContext(ctx))
+	var affected []models.ID
 	err := db.Tx(func(tx *gorm.DB) error {
-		return models.NewDeviceQuerySet(tx).
-			UserIDEq(userID).
+		var device models.Device
+		if err := models.NewDeviceQuerySet(tx).IDEq(deviceID).One(&device); err != nil {
+			return err
+		}
+		err := models.NewDeviceQuerySet(tx).
 			IDEq(deviceID).
 			GetUpdater().SetName(req.Name).Update()
+		if err != nil {
+			return err
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionUpdateDevice,
+			TargetType:     auditTargetDevice,
+			TargetID:       deviceID,
+			OrganizationID: device.OrganizationID,
+		}
+		if err := audit(ctx, tx, log, auditState{"name": device.Name}, auditState{"name": req.Name}); err != nil {
+			return err
+		}
+		affected, err = models.PeerUserIDs(tx, device.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
+
+	res := &DeviceOperationResponse{
+		Success: true,
+	}
+
+	return res, nil
+}
+
+type (
+	// DeviceRouteItem is the single item struct of a subnet route advertised by a device
+	DeviceRouteItem struct {
+		RouteID  models.ID `json:"route_id"`
+		Prefix   string    `json:"prefix"`
+		Approved bool      `json:"approved"`
+	}
+
+	// DeviceRouteListResponse is the response to a device route list request
+	DeviceRouteListResponse struct {
+		Routes []DeviceRouteItem `json:"routes"`
+	}
+
+	// DeviceRouteApproveRequest is the request struct to approve/reject a subnet route
+	DeviceRouteApproveRequest struct {
+		Approved bool `json:"approved"`
+	}
+)
+
+// DeviceRouteList returns the subnet routes advertised by the device
+func (s *server) DeviceRouteList(r *http.Request) (*DeviceRouteListResponse, error) {
+	vars := Vars(mux.Vars(r))
+	deviceID := vars.ModelID("device_id")
+	if deviceID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	res := &DeviceRouteListResponse{}
+	err := db.Tx(func(tx *gorm.DB) error {
+		var routes []models.DeviceRoute
+		if err := models.NewDeviceRouteQuerySet(tx).DeviceIDEq(deviceID).OrderAscByPrefix().All(&routes); err != nil {
+			return err
+		}
+		for _, route := range routes {
+			res.Routes = append(res.Routes, DeviceRouteItem{
+				RouteID:  route.ID,
+				Prefix:   route.Prefix,
+				Approved: route.Approved,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeviceRevoke revokes the device, and the credential of the device is neither
+// renewed nor accepted by the relay servers. The revoked device is removed from
+// the peer graph and can't register again until restored or deleted. Only the
+// owner and the organization admin can revoke the device, and the network admins
+// reject the device in their networks instead.
+func (s *server) DeviceRevoke(ctx context.Context, r *http.Request) (*DeviceOperationResponse, error) {
+	vars := Vars(mux.Vars(r))
+	deviceID := vars.ModelID("device_id")
+	if deviceID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	var affected []models.ID
+	err := db.Tx(func(tx *gorm.DB) error {
+		var device models.Device
+		if err := models.NewDeviceQuerySet(tx).IDEq(deviceID).One(&device); err != nil {
+			return err
+		}
+		if device.RevokedAt != nil {
+			return nil
+		}
+
+		now := time.Now()
+		err := models.NewDeviceQuerySet(tx).
+			IDEq(deviceID).
+			GetUpdater().
+			SetRevokedAt(&now).
+			Update()
+		if err != nil {
+			return err
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionRevokeDevice,
+			TargetType:     auditTargetDevice,
+			TargetID:       deviceID,
+			OrganizationID: device.OrganizationID,
+		}
+		if err := audit(ctx, tx, log, nil, auditState{"name": device.Name}); err != nil {
+			return err
+		}
+		affected, err = models.PeerUserIDs(tx, device.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
+
+	res := &DeviceOperationResponse{
+		Success: true,
+	}
+
+	return res, nil
+}
+
+// DeviceRestore restores the revoked device, and the device rejoins the peer
+// graph after registering again. The device of an organization is restored by
+// the organization admin only, so the owner can't undo the revocation.
+func (s *server) DeviceRestore(ctx context.Context, r *http.Request) (*DeviceOperationResponse, error) {
+	vars := Vars(mux.Vars(r))
+	deviceID := vars.ModelID("device_id")
+	if deviceID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+
+	var affected []models.ID
+	err := db.Tx(func(tx *gorm.DB) error {
+		var device models.Device
+		if err := models.NewDeviceQuerySet(tx).IDEq(deviceID).One(&device); err != nil {
+			return err
+		}
+		if device.RevokedAt == nil {
+			return nil
+		}
+		if device.OrganizationID != 0 {
+			if err := checkOrganizationRole(tx, device.OrganizationID, userID, models.OrganizationRoleAdmin); err != nil {
+				return err
+			}
+		}
+
+		err := models.NewDeviceQuerySet(tx).
+			IDEq(deviceID).
+			GetUpdater().
+			SetRevokedAt(nil).
+			Update()
+		if err != nil {
+			return err
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionRestoreDevice,
+			TargetType:     auditTargetDevice,
+			TargetID:       deviceID,
+			OrganizationID: device.OrganizationID,
+		}
+		if err := audit(ctx, tx, log, nil, auditState{"name": device.Name}); err != nil {
+			return err
+		}
+		affected, err = models.PeerUserIDs(tx, device.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
+
+	res := &DeviceOperationResponse{
+		Success: true,
+	}
+
+	return res, nil
+}
+
+// DeviceDelete deletes the revoked device, which frees its address and the device
+// quota of the owner, and the machine registers as a new device afterwards.
+func (s *server) DeviceDelete(ctx context.Context, r *http.Request) (*DeviceOperationResponse, error) {
+	vars := Vars(mux.Vars(r))
+	deviceID := vars.ModelID("device_id")
+	if deviceID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	var affected []models.ID
+	err := db.Tx(func(tx *gorm.DB) error {
+		var device models.Device
+		if err := models.NewDeviceQuerySet(tx).IDEq(deviceID).One(&device); err != nil {
+			return err
+		}
+		// The device must be revoked first, otherwise it registers again.
+		if device.RevokedAt == nil {
+			return errcode.ErrIllegalOperation
+		}
+		if err := models.DeleteDevice(tx, deviceID); err != nil {
+			return err
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionDeleteDevice,
+			TargetType:     auditTargetDevice,
+			TargetID:       deviceID,
+			OrganizationID: device.OrganizationID,
+		}
+		if err := audit(ctx, tx, log, auditState{"name": device.Name}, nil); err != nil {
+			return err
+		}
+		var err error
+		affected, err = models.PeerUserIDs(tx, device.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.relayCandidates.Delete(deviceID)
+	s.graphNotifier.notify(affected...)
+
+	res := &DeviceOperationResponse{
+		Success: true,
+	}
+
+	return res, nil
+}
+
+// DeviceRouteApprove approves or rejects the subnet route advertised by the device
+func (s *server) DeviceRouteApprove(ctx context.Context, r *http.Request, req *DeviceRouteApproveRequest) (*DeviceOperationResponse, error) {
+	vars := Vars(mux.Vars(r))
+	deviceID := vars.ModelID("device_id")
+	routeID := vars.ModelID("route_id")
+	if deviceID == 0 || routeID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+
+	var affected []models.ID
+	err := db.Tx(func(tx *gorm.DB) error {
+		var device models.Device
+		if err := models.NewDeviceQuerySet(tx).IDEq(deviceID).One(&device); err != nil {
+			return err
+		}
+
+		approvedByID := models.ID(0)
+		if req.Approved {
+			approvedByID = userID
+		}
+		err := models.NewDeviceRouteQuerySet(tx).
+			DeviceIDEq(deviceID).
+			IDEq(routeID).
+			GetUpdater().
+			SetApproved(req.Approved).
+			SetApprovedByID(approvedByID).
+			Update()
+		if err != nil {
+			return err
+		}
+		affected, err = models.PeerUserIDs(tx, device.UserID)
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
+	s.graphNotifier.notify(affected...)
 
 	res := &DeviceOperationResponse{
 		Success: true,
@@ -20,12 +20,16 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/coreos/go-semver/semver"
 	"github.com/pairmesh/pairmesh/errcode"
 	"github.com/pairmesh/pairmesh/pkg/jwt"
 	"github.com/pairmesh/pairmesh/portal/db"
 	"github.com/pairmesh/pairmesh/portal/db/models"
+	"github.com/pairmesh/pairmesh/portal/release"
 	"github.com/pairmesh/pairmesh/protocol"
 	"github.com/pairmesh/pairmesh/security"
 	"github.com/pingcap/fn"
@@ -33,27 +37,125 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"inet.af/netaddr"
 )
 
-// PeerGraph respond the peer graph of the device which send the request.
-func (s *server) PeerGraph(ctx context.Context) (*protocol.PeerGraphResponse, error) {
+// PeerGraph respond the peer graph of the device which send the request, and
+// only the unique hash is responded if the hash of the request is the latest.
+func (s *server) PeerGraph(ctx context.Context, r *http.Request) (*protocol.PeerGraphResponse, error) {
 	userID := models.ID(jwt.UserIDFromContext(ctx))
+	deviceID, err := requestDeviceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the last seen time
+	err = db.Tx(func(tx *gorm.DB) error {
+		return models.NewDeviceQuerySet(tx).
+			IDEq(deviceID).
+			GetUpdater().
+			SetLastSeen(time.Now()).
+			Update()
+	})
+	if err != nil {
+		zap.L().Error("Update the last seen time of device failed", zap.Error(err))
+	}
+
+	graph, err := s.cachedPeerGraph(userID)
+	if err != nil {
+		return nil, err
+	}
+	graph = devicePeerGraph(graph, userID, deviceID)
 
+	// Respond the unique hash only if the peer graph known by the node is
+	// the latest one.
+	if hash := r.URL.Query().Get("hash"); hash != "" && hash == graph.UniqueHash {
+		return &protocol.PeerGraphResponse{NotModified: true, UniqueHash: hash}, nil
+	}
+	return graph, nil
+}
+
+// requestDeviceID returns the ID of the device which sends the request.
+func requestDeviceID(ctx context.Context) (models.ID, error) {
+	var device models.Device
+	err := db.Tx(func(tx *gorm.DB) error {
+		return models.NewDeviceQuerySet(tx).
+			UserIDEq(models.ID(jwt.UserIDFromContext(ctx))).
+			MachineIDEq(jwt.MachineIDFromContext(ctx)).
+			One(&device)
+	})
+	if err == gorm.ErrRecordNotFound {
+		return 0, errcode.ErrNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	return device.ID, nil
+}
+
+// devicePeerGraph returns the peer graph of the device from the peer graph of
+// its user. The networks which the device doesn't join, i.e: the networks which
+// require approval and the device is pending or rejected, are removed with the
+// ACL rules of them, and the peers are limited to the devices of the user and
+// the peers of the remaining networks.
+func devicePeerGraph(graph *protocol.PeerGraphResponse, userID, deviceID models.ID) *protocol.PeerGraphResponse {
+	var (
+		networks []protocol.Network
+		joined   = map[protocol.NetworkID]struct{}{}
+		visible  = map[protocol.PeerID]struct{}{}
+	)
+	for _, n := range graph.Networks {
+		for _, id := range n.Peers {
+			if id == protocol.PeerID(deviceID) {
+				networks = append(networks, n)
+				joined[n.ID] = struct{}{}
+				break
+			}
+		}
+	}
+	if len(networks) == len(graph.Networks) {
+		return graph
+	}
+	for _, n := range networks {
+		for _, id := range n.Peers {
+			visible[id] = struct{}{}
+		}
+	}
+
+	var peers []protocol.Peer
+	for _, p := range graph.Peers {
+		if _, found := visible[p.ID]; found || p.UserID == protocol.UserID(userID) {
+			peers = append(peers, p)
+		}
+	}
+	var acl []protocol.ACLRule
+	for _, r := range graph.ACL {
+		if _, found := joined[r.NetworkID]; found {
+			acl = append(acl, r)
+		}
+	}
+
+	res := &protocol.PeerGraphResponse{
+		RelayServers: graph.RelayServers,
+		Peers:        peers,
+		Networks:     networks,
+		ACL:          acl,
+	}
+	res.UniqueHash = peerGraphHash(res)
+	return res
+}
+
+// peerGraph returns the peer graph of the user, and the peers, networks and
+// relay servers are sorted by the ID to make the peer graph comparable.
+func (s *server) peerGraph(userID models.ID) (*protocol.PeerGraphResponse, error) {
 	var (
 		peers          []protocol.Peer
 		relayServerIDs = map[models.ID]struct{}{}
 		networks       []protocol.Network
+		acl            []protocol.ACLRule
 	)
 
 	err := db.Tx(func(tx *gorm.DB) error {
-		// Update the last seen time
-		_ = models.NewDeviceQuerySet(tx).
-			UserIDEq(userID).
-			MachineIDEq(jwt.MachineIDFromContext(ctx)).
-			GetUpdater().
-			SetLastSeen(time.Now()).
-			Update()
-
 		userDevices := map[models.ID][]models.ID{}
 
 		devices, err := models.PeerDevices(tx, userID)
@@ -69,22 +171,19 @@ func (s *server) PeerGraph(ctx context.Context) (*protocol.PeerGraphResponse, er
 			}
 			devices = selfDevices
 		}
+		// The revoked devices are removed from the peer graph.
+		var deviceIDs []models.ID
 		for _, d := range devices {
-			peers = append(peers, protocol.Peer{
-				ID:       protocol.PeerID(d.ID),
-				UserID:   protocol.UserID(d.UserID),
-				Name:     d.Name,
-				IPv4:     d.Address,
-				ServerID: protocol.ServerID(d.RelayServerID),
-				Active:   d.LastSeen.After(time.Now().Add(-600 * time.Second)), // Last seen in 10 minutes.
-			})
-
-			relayServerIDs[d.RelayServerID] = struct{}{}
+			if d.RevokedAt != nil {
+				continue
+			}
+			deviceIDs = append(deviceIDs, d.ID)
 			userDevices[d.UserID] = append(userDevices[d.UserID], d.ID)
 		}
 
 		// Retrieve all networks
 		var networkIDs []models.ID
+		var approvalNetworkIDs []models.ID
 		var userNetworks []models.NetworkUser
 		err = models.NewNetworkUserQuerySet(tx).PreloadNetwork().UserIDEq(userID).All(&userNetworks)
 		if err != nil {
@@ -95,35 +194,157 @@ func (s *server) PeerGraph(ctx context.Context) (*protocol.PeerGraphResponse, er
 		for _, n := range userNetworks {
 			networkIDs = append(networkIDs, n.NetworkID)
 			networsByID[n.NetworkID] = &protocol.Network{
-				ID:   protocol.NetworkID(n.ID),
-				Name: n.Network.Name,
+				ID:        protocol.NetworkID(n.NetworkID),
+				Name:      n.Network.Name,
+				Broadcast: n.Network.Broadcast,
+			}
+			if n.Network.RequireApproval {
+				approvalNetworkIDs = append(approvalNetworkIDs, n.NetworkID)
 			}
 		}
 
-		if len(networkIDs) < 1 {
-			return nil
+		// The devices are visible to the user only if they are the devices of
+		// the user or the peers of any network.
+		visible := map[models.ID]struct{}{}
+		for _, id := range userDevices[userID] {
+			visible[id] = struct{}{}
 		}
 
-		// Retrieve all peers
-		// TODO: use SQL to improve the performance
-		var topology []models.NetworkUser
-		err = models.NewNetworkUserQuerySet(tx).NetworkIDIn(networkIDs...).All(&topology)
+		if len(networkIDs) > 0 {
+			// Only the approved devices join the networks which require approval,
+			// and the rejected devices never join any network.
+			approved := map[models.ID]map[models.ID]struct{}{}
+			for _, id := range approvalNetworkIDs {
+				approved[id] = map[models.ID]struct{}{}
+			}
+			rejected := map[models.ID]map[models.ID]struct{}{}
+			var approvals []models.DeviceApproval
+			err = models.NewDeviceApprovalQuerySet(tx).NetworkIDIn(networkIDs...).All(&approvals)
+			if err != nil {
+				return err
+			}
+			for _, a := range approvals {
+				if !a.Approved {
+					if rejected[a.NetworkID] == nil {
+						rejected[a.NetworkID] = map[models.ID]struct{}{}
+					}
+					rejected[a.NetworkID][a.DeviceID] = struct{}{}
+				} else if approvedDevices, found := approved[a.NetworkID]; found {
+					approvedDevices[a.DeviceID] = struct{}{}
+				}
+			}
+
+			// Retrieve all peers
+			// TODO: use SQL to improve the performance
+			var topology []models.NetworkUser
+			err = models.NewNetworkUserQuerySet(tx).NetworkIDIn(networkIDs...).All(&topology)
+			if err != nil {
+				return err
+			}
+			for _, nu := range topology {
+				approvedDevices, requireApproval := approved[nu.NetworkID]
+				var peers []protocol.PeerID
+				for _, d := range userDevices[nu.UserID] {
+					if _, found := approvedDevices[d]; requireApproval && !found {
+						continue
+					}
+					if _, found := rejected[nu.NetworkID][d]; found {
+						continue
+					}
+					peers = append(peers, protocol.PeerID(d))
+					visible[d] = struct{}{}
+				}
+				networsByID[nu.NetworkID].Peers = append(networsByID[nu.NetworkID].Peers, peers...)
+			}
+		}
+
+		// Retrieve the approved subnet routes of devices.
+		var routes []models.DeviceRoute
+		if len(deviceIDs) > 0 {
+			err = models.NewDeviceRouteQuerySet(tx).DeviceIDIn(deviceIDs...).ApprovedEq(true).All(&routes)
+			if err != nil {
+				return err
+			}
+		}
+		deviceRoutes := map[models.ID][]string{}
+		for _, r := range routes {
+			deviceRoutes[r.DeviceID] = append(deviceRoutes[r.DeviceID], r.Prefix)
+		}
+
+		// Retrieve the names of users which are used to name the peers.
+		var userIDs []models.ID
+		for _, d := range devices {
+			userIDs = append(userIDs, d.UserID)
+		}
+		var users []models.User
+		err = models.NewUserQuerySet(tx).IDIn(userIDs...).All(&users)
 		if err != nil {
 			return err
 		}
-		for _, d := range topology {
-			devices := userDevices[d.UserID]
-			var peers []protocol.PeerID
-			for _, d := range devices {
-				peers = append(peers, protocol.PeerID(d))
+		userNames := map[models.ID]string{}
+		for _, u := range users {
+			userNames[u.ID] = u.Name
+		}
+
+		for _, d := range devices {
+			if _, found := visible[d.ID]; !found {
+				continue
 			}
-			networsByID[d.NetworkID].Peers = append(networsByID[d.NetworkID].Peers, peers...)
+			peers = append(peers, protocol.Peer{
+				ID:       protocol.PeerID(d.ID),
+				UserID:   protocol.UserID(d.UserID),
+				UserName: userNames[d.UserID],
+				Name:     d.Name,
+				IPv4:     d.Address,
+				IPv6:     models.DeviceIPv6(d.ID),
+				ServerID: protocol.ServerID(d.RelayServerID),
+				Active:   d.LastSeen.After(time.Now().Add(-600 * time.Second)), // Last seen in 10 minutes.
+				Routes:   deviceRoutes[d.ID],
+				ExitNode: d.ExitNode,
+			})
+
+			relayServerIDs[d.RelayServerID] = struct{}{}
+		}
+
+		if len(networkIDs) < 1 {
+			return nil
 		}
 
 		for _, n := range networsByID {
 			networks = append(networks, *n)
 		}
 
+		// Retrieve the ACL rules of all networks
+		var rules []models.ACLRule
+		err = models.NewACLRuleQuerySet(tx).
+			NetworkIDIn(networkIDs...).
+			OrderAscByPriority().
+			OrderAscByID().
+			All(&rules)
+		if err != nil {
+			return err
+		}
+		for _, r := range rules {
+			ports, err := parsePortRanges(r.Ports)
+			if err != nil {
+				zap.L().Error("Illegal ports of ACL rule", zap.Any("rule_id", r.ID), zap.String("ports", r.Ports), zap.Error(err))
+				continue
+			}
+			rule := protocol.ACLRule{
+				ID:        uint64(r.ID),
+				NetworkID: protocol.NetworkID(r.NetworkID),
+				Action:    r.Action.String(),
+				SrcUserID: protocol.UserID(r.SrcUserID),
+				SrcPeerID: protocol.PeerID(r.SrcDeviceID),
+				DstPeerID: protocol.PeerID(r.DstDeviceID),
+				Ports:     ports,
+			}
+			if r.Protocol != models.ACLProtocolAny {
+				rule.Protocol = r.Protocol.String()
+			}
+			acl = append(acl, rule)
+		}
+
 		return nil
 	})
 
@@ -131,6 +352,15 @@ func (s *server) PeerGraph(ctx context.Context) (*protocol.PeerGraphResponse, er
 		return nil, err
 	}
 
+	// All active relay servers are candidates of the primary relay server,
+	// and the node measures the latencies to them.
+	s.relayServers.byID.Range(func(key, value interface{}) bool {
+		if id := key.(models.ID); s.isActiveRelayServer(id) {
+			relayServerIDs[id] = struct{}{}
+		}
+		return true
+	})
+
 	var relayServers []protocol.RelayServer
 	for id := range relayServerIDs {
 		v, ok := s.relayServers.byID.Load(id)
@@ -138,15 +368,21 @@ func (s *server) PeerGraph(ctx context.Context) (*protocol.PeerGraphResponse, er
 			zap.L().Error("Relay server not found", zap.Any("relay_id", id))
 			continue
 		}
-		relayServer := v.(*models.RelayServer)
-		relayServers = append(relayServers, protocol.RelayServer{
-			ID:        protocol.ServerID(relayServer.ID),
-			Name:      relayServer.Name,
-			Region:    relayServer.Region,
-			Host:      relayServer.Host,
-			Port:      relayServer.Port,
-			STUNPort:  relayServer.STUNPort,
-			PublicKey: relayServer.PublicKey,
+		relayServers = append(relayServers, relayServerInfo(v.(*models.RelayServer)))
+	}
+
+	sort.Slice(relayServers, func(i, j int) bool {
+		return relayServers[i].ID < relayServers[j].ID
+	})
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].ID < peers[j].ID
+	})
+	sort.Slice(networks, func(i, j int) bool {
+		return networks[i].ID < networks[j].ID
+	})
+	for _, n := range networks {
+		sort.Slice(n.Peers, func(i, j int) bool {
+			return n.Peers[i] < n.Peers[j]
 		})
 	}
 
@@ -154,19 +390,42 @@ func (s *server) PeerGraph(ctx context.Context) (*protocol.PeerGraphResponse, er
 		RelayServers: relayServers,
 		Peers:        peers,
 		Networks:     networks,
+		ACL:          acl,
 	}
 
 	return resp, nil
 }
 
-func (s *server) randomRelayServerID() models.ID {
-	// Assign a new relay server for it.
-	var randomRelayID models.ID
+// normalizeRoutes validates the subnet routes advertised by the node and returns
+// the masked prefixes. The default routes and the prefixes overlapped with the
+// device addresses are not allowed.
+func normalizeRoutes(routes []string) ([]string, error) {
+	var prefixes []string
+	for _, r := range routes {
+		prefix, err := netaddr.ParseIPPrefix(r)
+		if err != nil {
+			return nil, errcode.ErrIllegalRequest
+		}
+		if prefix.Bits() == 0 || prefix.Overlaps(models.IPv4Prefix) || prefix.Overlaps(models.ULAPrefix) {
+			return nil, errcode.ErrIllegalRequest
+		}
+		prefixes = append(prefixes, prefix.Masked().String())
+	}
+	return prefixes, nil
+}
+
+// activeRelayServers returns the relay servers which keep alive recently.
+func (s *server) activeRelayServers() []protocol.RelayServer {
+	var relayServers []protocol.RelayServer
 	s.relayServers.byID.Range(func(key, value interface{}) bool {
-		randomRelayID = value.(*models.RelayServer).ID
-		return false
+		relayServer := value.(*models.RelayServer)
+		if time.Since(relayServer.KeepaliveAt) > relayServerExpiration {
+			return true
+		}
+		relayServers = append(relayServers, relayServerInfo(relayServer))
+		return true
 	})
-	return randomRelayID
+	return relayServers
 }
 
 // Preflight returns the parameters for startup a node
@@ -174,8 +433,14 @@ func (s *server) Preflight(ctx context.Context, r *http.Request, req *protocol.P
 	userID := models.ID(jwt.UserIDFromContext(ctx))
 	machineID := jwt.MachineIDFromContext(ctx)
 
+	routes, err := normalizeRoutes(req.Routes)
+	if err != nil {
+		return nil, err
+	}
+
 	device := &models.Device{}
-	err := db.Tx(func(tx *gorm.DB) error {
+	var affected []models.ID
+	err = db.Tx(func(tx *gorm.DB) error {
 		err := models.NewDeviceQuerySet(tx).
 			UserIDEq(userID).
 			MachineIDEq(machineID).
@@ -185,6 +450,25 @@ func (s *server) Preflight(ctx context.Context, r *http.Request, req *protocol.P
 		}
 
 		deviceNotExists := err == gorm.ErrRecordNotFound
+		if !deviceNotExists && device.RevokedAt != nil {
+			return errcode.ErrDeviceRevoked
+		}
+		// The new device belongs to the organization of the auth key.
+		if deviceNotExists {
+			if keyID := models.ID(jwt.AuthKeyIDFromContext(ctx)); keyID != 0 {
+				var authKey models.AuthKey
+				if err := models.NewAuthKeyQuerySet(tx).IDEq(keyID).One(&authKey); err != nil {
+					return err
+				}
+				device.OrganizationID = authKey.OrganizationID
+				device.Ephemeral = authKey.Type == models.KeyTypeEphemeral
+			}
+		}
+		assigned, err := models.OrganizationRelayServerIDs(tx, device.OrganizationID)
+		if err != nil {
+			return err
+		}
+
 		// Insert device if
 		if deviceNotExists {
 			count, err := models.NewDeviceQuerySet(tx).UserIDEq(userID).Count()
@@ -202,34 +486,58 @@ func (s *server) Preflight(ctx context.Context, r *http.Request, req *protocol.P
 			}
 
 			device = &models.Device{
-				UserID:        userID,
-				RelayServerID: s.randomRelayServerID(),
-				OS:            req.OS,
-				Version:       versionFromContext(ctx),
-				Name:          req.Host,
-				MachineID:     machineID,
-				LastSeen:      time.Now(),
-				Address:       address,
+				UserID:         userID,
+				RelayServerID:  s.selectRelayServerID(assigned, req.Latencies),
+				OS:             req.OS,
+				Version:        versionFromContext(ctx),
+				Name:           req.Host,
+				MachineID:      machineID,
+				LastSeen:       time.Now(),
+				Address:        address,
+				ExitNode:       req.ExitNode,
+				OrganizationID: device.OrganizationID,
+				Ephemeral:      device.Ephemeral,
 			}
 
-			return tx.Create(device).Error
+			if err := tx.Create(device).Error; err != nil {
+				return err
+			}
+		} else {
+			updater := models.NewDeviceQuerySet(tx).IDEq(device.ID).GetUpdater()
+			changed := false
+			// Update relay server if previous dead or no longer assigned.
+			if !s.isActiveRelayServer(device.RelayServerID) || !relayAssigned(assigned, device.RelayServerID) {
+				serverID := s.selectRelayServerID(assigned, req.Latencies)
+				if serverID != device.RelayServerID && s.isActiveRelayServer(serverID) {
+					device.RelayServerID = serverID
+					updater.SetRelayServerID(serverID)
+					changed = true
+				}
+			}
+			if device.ExitNode != req.ExitNode {
+				device.ExitNode = req.ExitNode
+				updater.SetExitNode(req.ExitNode)
+				changed = true
+			}
+			if changed {
+				if err := updater.SetLastSeen(time.Now()).Update(); err != nil {
+					return err
+				}
+			}
 		}
 
-		// Update relay server if previous dead.
-		_, found := s.relayServers.byID.Load(device.RelayServerID)
-		if !found {
-			updater := models.NewDeviceQuerySet(tx).IDEq(device.ID).GetUpdater()
-			// Check if relay server alive?
-			device.RelayServerID = s.randomRelayServerID()
-			updater.SetRelayServerID(device.RelayServerID)
-			return updater.SetLastSeen(time.Now()).Update()
+		if err := models.SyncDeviceRoutes(tx, device.ID, routes); err != nil {
+			return err
 		}
 
-		return nil
+		// The device may be created or its routes may be changed.
+		affected, err = models.PeerUserIDs(tx, userID)
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
+	s.graphNotifier.notify(affected...)
 
 	val, found := s.relayServers.byID.Load(device.RelayServerID)
 	if !found {
@@ -238,27 +546,22 @@ func (s *server) Preflight(ctx context.Context, r *http.Request, req *protocol.P
 	relayServer := val.(*models.RelayServer)
 
 	// Sign the node id  to prevent the client counterfeit.
-	credential, err := security.Credential(s.privateKey, protocol.UserID(userID), protocol.PeerID(device.ID), net.ParseIP(device.Address), credentialLease)
+	ipv6 := models.DeviceIPv6(device.ID)
+	credential, err := security.Credential(s.keyRing.Active(), protocol.UserID(userID), protocol.PeerID(device.ID), net.ParseIP(device.Address), net.ParseIP(ipv6), credentialLease)
 	if err != nil {
 		return nil, err
 	}
 
 	resp := &protocol.PreflightResponse{
-		ID:     protocol.PeerID(device.ID),
-		UserID: protocol.UserID(device.UserID),
-		Name:   device.Name,
-		IPv4:   device.Address,
-		PrimaryServer: protocol.RelayServer{
-			ID:        protocol.ServerID(relayServer.ID),
-			Name:      relayServer.Name,
-			Region:    relayServer.Region,
-			Host:      relayServer.Host,
-			Port:      relayServer.Port,
-			STUNPort:  relayServer.STUNPort,
-			PublicKey: relayServer.PublicKey,
-		},
+		ID:              protocol.PeerID(device.ID),
+		UserID:          protocol.UserID(device.UserID),
+		Name:            device.Name,
+		IPv4:            device.Address,
+		IPv6:            ipv6,
+		PrimaryServer:   relayServerInfo(relayServer),
 		Credential:      base64.RawStdEncoding.EncodeToString(credential),
 		CredentialLease: uint64(credentialLease / time.Second),
+		Ephemeral:       device.Ephemeral,
 	}
 
 	return resp, nil
@@ -270,6 +573,7 @@ func (s *server) RelayKeepalive(req *protocol.RelayKeepaliveRequest) (*protocol.
 		return nil, errcode.ErrIllegalRequest
 	}
 
+	var serverID models.ID
 	v, ok := s.relayServers.byAddr.Load(fmt.Sprintf("%s:%d", req.Host, req.Port))
 	// New relay servers
 	if !ok {
@@ -291,9 +595,12 @@ func (s *server) RelayKeepalive(req *protocol.RelayKeepaliveRequest) (*protocol.
 
 		s.relayServers.byID.Store(relayServer.ID, relayServer)
 		s.relayServers.byAddr.Store(fmt.Sprintf("%s:%d", relayServer.Host, relayServer.Port), relayServer)
+		serverID = relayServer.ID
+		s.graphNotifier.notifyAll()
 	} else {
 		relayServer := v.(*models.RelayServer)
 		relayServer.KeepaliveAt = time.Now()
+		serverID = relayServer.ID
 
 		err := db.Tx(func(tx *gorm.DB) error {
 			updater := models.NewRelayServerQuerySet(tx).
@@ -325,7 +632,9 @@ func (s *server) RelayKeepalive(req *protocol.RelayKeepaliveRequest) (*protocol.
 	}
 
 	res := &protocol.RelayKeepaliveResponse{
-		PublicKey: s.publicKey.base64,
+		PublicKeys:   s.publicKeys,
+		ServerID:     protocol.ServerID(serverID),
+		RelayServers: s.activeRelayServers(),
 	}
 
 	err := db.Tx(func(tx *gorm.DB) error {
@@ -343,21 +652,96 @@ func (s *server) RelayKeepalive(req *protocol.RelayKeepaliveRequest) (*protocol.
 		res.SyncFailed = true
 	}
 
+	// The credentials of the devices revoked within a lease may be still
+	// valid, and the relay servers reject them. The revoked peers are left
+	// null if failed, so the relay servers keep rejecting the previous ones
+	// instead of accepting all.
+	revokedPeers := []protocol.PeerID{}
+	err = db.Tx(func(tx *gorm.DB) error {
+		var revoked []models.Device
+		err := models.NewDeviceQuerySet(tx).
+			RevokedAtGt(time.Now().Add(-credentialLease)).
+			All(&revoked)
+		if err != nil {
+			return err
+		}
+		for _, d := range revoked {
+			revokedPeers = append(revokedPeers, protocol.PeerID(d.ID))
+		}
+		return nil
+	})
+	if err != nil {
+		zap.L().Error("Retrieve the revoked devices failed", zap.Error(err))
+	} else {
+		res.RevokedPeers = revokedPeers
+	}
+
+	// The reachable peers are left null if failed for the same reason.
+	reachablePeers, err := s.reachablePeers(req.Sessions)
+	if err != nil {
+		zap.L().Error("Retrieve the reachable peers failed", zap.Error(err))
+	} else {
+		res.ReachablePeers = reachablePeers
+	}
+
 	return res, nil
 }
 
+// reachablePeers returns the peers which each peer is allowed to reach over the
+// relay servers, which are the peers in the peer graph of the device.
+func (s *server) reachablePeers(peerIDs []protocol.PeerID) (map[protocol.PeerID][]protocol.PeerID, error) {
+	var devices []models.Device
+	if len(peerIDs) > 0 {
+		var ids []models.ID
+		for _, id := range peerIDs {
+			ids = append(ids, models.ID(id))
+		}
+		err := db.Tx(func(tx *gorm.DB) error {
+			return models.NewDeviceQuerySet(tx).IDIn(ids...).All(&devices)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reachable := make(map[protocol.PeerID][]protocol.PeerID, len(devices))
+	for _, d := range devices {
+		graph, err := s.cachedPeerGraph(d.UserID)
+		if err != nil {
+			return nil, err
+		}
+		peers := []protocol.PeerID{}
+		for _, p := range devicePeerGraph(graph, d.UserID, d.ID).Peers {
+			peers = append(peers, p.ID)
+		}
+		reachable[protocol.PeerID(d.ID)] = peers
+	}
+	return reachable, nil
+}
+
 // RenewCredential handles the `RenewCredentialRequest` POST request.
 func (s *server) RenewCredential(req *protocol.RenewCredentialRequest) (*protocol.RenewCredentialResponse, error) {
 	credential, err := base64.RawStdEncoding.DecodeString(req.Credential)
 	if err != nil {
 		return nil, err
 	}
-	userID, peerID, ip, valid := security.VerifyCredential(s.publicKey.raw, credential)
+	userID, peerID, ip, ip6, valid := security.VerifyCredential(s.keyRing.VerifyingKeys(), credential)
 	if !valid {
 		return nil, fmt.Errorf("invalid credential: %v", req.Credential)
 	}
 
-	newCredential, err := security.Credential(s.privateKey, userID, peerID, ip, credentialLease)
+	var device models.Device
+	err = db.Tx(func(tx *gorm.DB) error {
+		return models.NewDeviceQuerySet(tx).IDEq(models.ID(peerID)).One(&device)
+	})
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	if device.RevokedAt != nil {
+		return nil, errcode.ErrDeviceRevoked
+	}
+
+	newCredential, err := security.Credential(s.keyRing.Active(), userID, peerID, ip, ip6, credentialLease)
 	if err != nil {
 		return nil, err
 	}
@@ -369,20 +753,49 @@ func (s *server) RenewCredential(req *protocol.RenewCredentialRequest) (*protoco
 	return res, nil
 }
 
-// VersionCheckResponse is the response to a version check request
-type VersionCheckResponse struct {
-	NewVersion      bool   `json:"new_version"`
-	NewVersionCode  string `json:"new_version_code"`
-	DownloadAddress string `json:"download_address"`
-}
-
-// VersionCheck gets, checks and validates the version
-func (s *server) VersionCheck(form *fn.Form) (*VersionCheckResponse, error) {
+// VersionCheck checks whether a newer release of the platform is available in
+// the channel. The platform is `{os}/{arch}`, and the stable channel is checked
+// if the channel is not specified.
+func (s *server) VersionCheck(form *fn.Form) (*protocol.VersionCheckResponse, error) {
 	version := form.Get("version")
 	platform := form.Get("platform")
 	if version == "" || platform == "" {
 		return nil, errcode.ErrIllegalRequest
 	}
-	//TODO
-	return nil, nil
+	current, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, errcode.ErrIllegalRequest
+	}
+	channel := release.Channel(form.Get("channel"))
+	if channel == "" {
+		channel = release.ChannelStable
+	}
+	if !channel.Valid() {
+		return nil, errcode.ErrIllegalRequest
+	}
+	goos, arch := platform, ""
+	if i := strings.Index(platform, "/"); i >= 0 {
+		goos, arch = platform[:i], platform[i+1:]
+	}
+
+	manifest, err := s.releases.Manifest()
+	if err != nil {
+		return nil, err
+	}
+	latest := manifest.Latest(goos, arch, channel)
+	if latest == nil || !current.LessThan(*latest.SemVer()) {
+		return &protocol.VersionCheckResponse{NewVersionCode: current.String()}, nil
+	}
+
+	res := &protocol.VersionCheckResponse{
+		NewVersion:      true,
+		NewVersionCode:  latest.Version,
+		DownloadAddress: latest.URL,
+		Arch:            latest.Arch,
+		Channel:         string(latest.Channel),
+		SHA256:          latest.SHA256,
+		Signature:       latest.Signature,
+		ReleaseNotes:    latest.Notes,
+	}
+	return res, nil
 }
@@ -0,0 +1,79 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/pairmesh/pairmesh/errcode"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+	"github.com/pairmesh/pairmesh/protocol"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeRoutes(t *testing.T) {
+	a := assert.New(t)
+
+	routes, err := normalizeRoutes([]string{"192.168.1.1/24", "fd00::1/64"})
+	a.Nil(err)
+	a.Equal([]string{"192.168.1.0/24", "fd00::/64"}, routes)
+
+	for _, r := range []string{"illegal", "0.0.0.0/0", "::/0", "10.1.0.0/16", "8.0.0.0/6", "fd70:6169:726d:1::/64", "fd00::/8"} {
+		_, err := normalizeRoutes([]string{r})
+		a.Equal(errcode.ErrIllegalRequest, err, r)
+	}
+}
+
+func TestDevicePeerGraph(t *testing.T) {
+	a := assert.New(t)
+
+	// Device 1 and 2 belong to user 1, and device 2 is pending in network 2
+	// which requires approval.
+	graph := &protocol.PeerGraphResponse{
+		RelayServers: []protocol.RelayServer{{ID: 1}},
+		Peers: []protocol.Peer{
+			{ID: 1, UserID: 1},
+			{ID: 2, UserID: 1},
+			{ID: 3, UserID: 2},
+			{ID: 4, UserID: 3},
+		},
+		Networks: []protocol.Network{
+			{ID: 1, Peers: []protocol.PeerID{1, 2, 3}},
+			{ID: 2, Peers: []protocol.PeerID{1, 4}},
+		},
+		ACL: []protocol.ACLRule{
+			{ID: 1, NetworkID: 1},
+			{ID: 2, NetworkID: 2},
+		},
+	}
+	graph.UniqueHash = peerGraphHash(graph)
+
+	a.Equal(graph, devicePeerGraph(graph, models.ID(1), models.ID(1)))
+
+	res := devicePeerGraph(graph, models.ID(1), models.ID(2))
+	a.Equal([]protocol.Peer{{ID: 1, UserID: 1}, {ID: 2, UserID: 1}, {ID: 3, UserID: 2}}, res.Peers)
+	a.Equal(graph.Networks[:1], res.Networks)
+	a.Equal(graph.ACL[:1], res.ACL)
+	a.Equal(graph.RelayServers, res.RelayServers)
+	a.Equal(peerGraphHash(res), res.UniqueHash)
+	a.NotEqual(graph.UniqueHash, res.UniqueHash)
+
+	// The revoked devices are absent from all networks.
+	res = devicePeerGraph(graph, models.ID(1), models.ID(5))
+	a.Equal(graph.Peers[:2], res.Peers)
+	a.Empty(res.Networks)
+	a.Empty(res.ACL)
+}
@@ -104,6 +104,7 @@ func (s *server) HandleInvitation(ctx context.Context, raw *http.Request, req *H
 
 	userID := models.ID(jwt.UserIDFromContext(ctx))
 	var res *HandleInvitationResponse
+	var affected []models.ID
 	err = db.Tx(func(tx *gorm.DB) error {
 		var invitation models.Invitation
 		err := models.NewInvitationQuerySet(tx).
@@ -124,6 +125,12 @@ func (s *server) HandleInvitation(ctx context.Context, raw *http.Request, req *H
 			return err
 		}
 
+		log := &models.AuditLog{
+			Action:     models.AuditActionDeclineNetwork,
+			TargetType: auditTargetInvitation,
+			TargetID:   invitation.ID,
+			NetworkID:  invitation.NetworkID,
+		}
 		if req.Action == "join" {
 			teamUser := &models.NetworkUser{
 				NetworkID: invitation.NetworkID,
@@ -134,6 +141,19 @@ func (s *server) HandleInvitation(ctx context.Context, raw *http.Request, req *H
 			if tx.Error != nil {
 				return tx.Error
 			}
+			log.Action = models.AuditActionJoinNetwork
+
+			affected, err = models.NetworkMemberIDs(tx, invitation.NetworkID)
+			if err != nil {
+				return err
+			}
+		}
+		before := auditState{
+			"invited_by_id": invitation.InvitedByID,
+			"role":          invitation.Role,
+		}
+		if err := audit(ctx, tx, log, before, nil); err != nil {
+			return err
 		}
 
 		res = &HandleInvitationResponse{
@@ -141,5 +161,10 @@ func (s *server) HandleInvitation(ctx context.Context, raw *http.Request, req *H
 		}
 		return nil
 	})
-	return res, err
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
+
+	return res, nil
 }
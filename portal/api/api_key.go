@@ -34,12 +34,13 @@ import (
 type (
 	// KeyListItem is the single item struct for a key in a key list
 	KeyListItem struct {
-		KeyID   models.ID      `json:"key_id"`
-		Type    models.KeyType `json:"type"`
-		Key     string         `json:"key"`
-		Created time.Time      `json:"created"`
-		Expiry  time.Time      `json:"expiry"`
-		Enabled bool           `json:"enabled"`
+		KeyID          models.ID      `json:"key_id"`
+		Type           models.KeyType `json:"type"`
+		Key            string         `json:"key"`
+		Created        time.Time      `json:"created"`
+		Expiry         time.Time      `json:"expiry"`
+		Enabled        bool           `json:"enabled"`
+		OrganizationID models.ID      `json:"organization_id"`
 	}
 
 	// KeyListResponse is the response to a key list request
@@ -48,6 +49,18 @@ type (
 	}
 )
 
+func keyListItem(key *models.AuthKey) KeyListItem {
+	return KeyListItem{
+		KeyID:          key.ID,
+		Type:           key.Type,
+		Key:            key.Key[:12] + "...",
+		Created:        key.CreatedAt,
+		Expiry:         key.ExpiredAt,
+		Enabled:        key.Enabled,
+		OrganizationID: key.OrganizationID,
+	}
+}
+
 // KeyList returns a key list in format of KeyListResponse
 func (s *server) KeyList(ctx context.Context) (*KeyListResponse, error) {
 	userID := models.ID(jwt.UserIDFromContext(ctx))
@@ -61,15 +74,8 @@ func (s *server) KeyList(ctx context.Context) (*KeyListResponse, error) {
 	}
 
 	res := &KeyListResponse{}
-	for _, key := range keys {
-		res.Keys = append(res.Keys, KeyListItem{
-			KeyID:   key.ID,
-			Type:    key.Type,
-			Key:     key.Key[:12] + "...",
-			Created: key.CreatedAt,
-			Expiry:  key.ExpiredAt,
-			Enabled: key.Enabled,
-		})
+	for i := range keys {
+		res.Keys = append(res.Keys, keyListItem(&keys[i]))
 	}
 
 	return res, nil
@@ -80,48 +86,78 @@ type (
 	KeyType string
 
 	// CreateKeyRequest is the request to create a key
+	// The devices registered with the key belong to the organization if the
+	// organization is specified.
 	CreateKeyRequest struct {
-		Type models.KeyType `json:"type"`
+		Type           models.KeyType `json:"type"`
+		OrganizationID models.ID      `json:"organization_id"`
 	}
 
 	// CreateKeyResponse is the response to the request to create a key
 	CreateKeyResponse struct {
-		KeyID   models.ID      `json:"key_id"`
-		Type    models.KeyType `json:"type"`
-		Key     string         `json:"key"`
-		Created time.Time      `json:"created"`
-		Expiry  time.Time      `json:"expiry"`
-		Enabled bool           `json:"enabled"`
+		KeyID          models.ID      `json:"key_id"`
+		Type           models.KeyType `json:"type"`
+		Key            string         `json:"key"`
+		Created        time.Time      `json:"created"`
+		Expiry         time.Time      `json:"expiry"`
+		Enabled        bool           `json:"enabled"`
+		OrganizationID models.ID      `json:"organization_id"`
 	}
 )
 
 // CreateKey handles key creation
 func (s *server) CreateKey(ctx context.Context, req *CreateKeyRequest) (*CreateKeyResponse, error) {
-	if req.Type != models.KeyTypeOneOff && req.Type != models.KeyTypeReusable {
+	if req.Type != models.KeyTypeOneOff && req.Type != models.KeyTypeReusable && req.Type != models.KeyTypeEphemeral {
 		return nil, errcode.ErrIllegalRequest
 	}
 
 	userID := models.ID(jwt.UserIDFromContext(ctx))
+	if req.OrganizationID != 0 {
+		// The auditor has read-only access to the organization.
+		err := db.Tx(func(tx *gorm.DB) error {
+			return checkOrganizationRole(tx, req.OrganizationID, userID,
+				models.OrganizationRoleAdmin,
+				models.OrganizationRoleNetworkAdmin,
+				models.OrganizationRoleMember)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	newKey := uuid.New()
 	key := &models.AuthKey{
-		UserID:    userID,
-		Key:       fmt.Sprintf("pmkey-%s", hex.EncodeToString(newKey[:])),
-		Type:      req.Type,
-		ExpiredAt: time.Now().Add(90 * 24 * time.Hour),
+		UserID:         userID,
+		Key:            fmt.Sprintf("pmkey-%s", hex.EncodeToString(newKey[:])),
+		Type:           req.Type,
+		ExpiredAt:      time.Now().Add(90 * 24 * time.Hour),
+		OrganizationID: req.OrganizationID,
 	}
 
-	err := db.Create(key)
+	err := db.Tx(func(tx *gorm.DB) error {
+		if err := tx.Create(key).Error; err != nil {
+			return err
+		}
+		log := &models.AuditLog{
+			Action:         models.AuditActionCreateKey,
+			TargetType:     auditTargetKey,
+			TargetID:       key.ID,
+			OrganizationID: key.OrganizationID,
+		}
+		return audit(ctx, tx, log, nil, auditState{"type": key.Type})
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	res := &CreateKeyResponse{
-		KeyID:   key.ID,
-		Type:    key.Type,
-		Key:     key.Key,
-		Created: key.CreatedAt,
-		Expiry:  key.ExpiredAt,
-		Enabled: true,
+		KeyID:          key.ID,
+		Type:           key.Type,
+		Key:            key.Key,
+		Created:        key.CreatedAt,
+		Expiry:         key.ExpiredAt,
+		Enabled:        true,
+		OrganizationID: key.OrganizationID,
 	}
 
 	return res, nil
@@ -156,9 +192,23 @@ func (s *server) ChangeKey(ctx context.Context, r *http.Request, req *ChangeKeyR
 		return nil, nil
 	}
 
-	userID := models.ID(jwt.UserIDFromContext(ctx))
+	enabled := req.Op == "enable"
 	return &ChangeKeyResponse{}, db.Tx(func(tx *gorm.DB) error {
-		return models.NewAuthKeyQuerySet(tx).UserIDEq(userID).IDEq(keyID).GetUpdater().SetEnabled(req.Op == "enable").Update()
+		var key models.AuthKey
+		if err := models.NewAuthKeyQuerySet(tx).IDEq(keyID).One(&key); err != nil {
+			return err
+		}
+		if err := models.NewAuthKeyQuerySet(tx).IDEq(keyID).GetUpdater().SetEnabled(enabled).Update(); err != nil {
+			return err
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionChangeKey,
+			TargetType:     auditTargetKey,
+			TargetID:       key.ID,
+			OrganizationID: key.OrganizationID,
+		}
+		return audit(ctx, tx, log, auditState{"enabled": key.Enabled}, auditState{"enabled": enabled})
 	})
 }
 
@@ -176,9 +226,26 @@ func (s *server) DeleteKey(ctx context.Context, r *http.Request) (*DeleteKeyResp
 		return nil, nil
 	}
 
-	userID := models.ID(jwt.UserIDFromContext(ctx))
 	return &DeleteKeyResponse{}, db.Tx(func(tx *gorm.DB) error {
-		return models.NewAuthKeyQuerySet(tx).UserIDEq(userID).IDEq(keyID).Delete()
+		var key models.AuthKey
+		if err := models.NewAuthKeyQuerySet(tx).IDEq(keyID).One(&key); err != nil {
+			return err
+		}
+		if err := models.NewAuthKeyQuerySet(tx).IDEq(keyID).Delete(); err != nil {
+			return err
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionDeleteKey,
+			TargetType:     auditTargetKey,
+			TargetID:       key.ID,
+			OrganizationID: key.OrganizationID,
+		}
+		before := auditState{
+			"type":    key.Type,
+			"enabled": key.Enabled,
+		}
+		return audit(ctx, tx, log, before, nil)
 	})
 }
 
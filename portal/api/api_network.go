@@ -29,21 +29,31 @@ import (
 )
 
 type (
-	// NetworkRequest is the request for information of a given network
+	// NetworkRequest is the request for information of a given network. The
+	// organization of a network can only be specified on creation.
 	NetworkRequest struct {
-		Name        string `json:"name"`
-		Description string `json:"description"`
+		Name           string    `json:"name"`
+		Description    string    `json:"description"`
+		Broadcast      bool      `json:"broadcast"`
+		OrganizationID models.ID `json:"organization_id"`
+		// RequireApproval keeps the new devices out of the network until
+		// approved by the admin.
+		RequireApproval bool `json:"require_approval"`
 	}
 
 	// NetworkItem is the network information item struct
 	NetworkItem struct {
-		NetworkID   models.ID       `json:"network_id"`
-		Name        string          `json:"name"`
-		Description string          `json:"description"`
-		CreatedAt   int64           `json:"created_at"`
-		MemberCount int64           `json:"member_count"`
-		DeviceCount int64           `json:"device_count"`
-		Role        models.RoleType `json:"role"`
+		NetworkID       models.ID       `json:"network_id"`
+		Name            string          `json:"name"`
+		Description     string          `json:"description"`
+		Broadcast       bool            `json:"broadcast"`
+		RequireApproval bool            `json:"require_approval"`
+		CreatedAt       int64           `json:"created_at"`
+		MemberCount     int64           `json:"member_count"`
+		DeviceCount     int64           `json:"device_count"`
+		Role            models.RoleType `json:"role"`
+
+		OrganizationID models.ID `json:"organization_id"`
 	}
 
 	// NetworkResponse is the response the network request
@@ -54,14 +64,25 @@ type (
 
 // CreateNetwork creates network
 func (s *server) CreateNetwork(ctx context.Context, req *NetworkRequest) (*NetworkResponse, error) {
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+
 	var res *NetworkResponse
 	err := db.Tx(func(tx *gorm.DB) error {
-		userID := models.ID(jwt.UserIDFromContext(ctx))
+		if req.OrganizationID != 0 {
+			err := checkOrganizationRole(tx, req.OrganizationID, userID,
+				models.OrganizationRoleAdmin, models.OrganizationRoleNetworkAdmin)
+			if err != nil {
+				return err
+			}
+		}
 
 		network := &models.Network{
-			Name:        req.Name,
-			Description: req.Description,
-			CreatedByID: userID,
+			Name:            req.Name,
+			Description:     req.Description,
+			Broadcast:       req.Broadcast,
+			CreatedByID:     userID,
+			OrganizationID:  req.OrganizationID,
+			RequireApproval: req.RequireApproval,
 		}
 		if err := db.Create(network); err != nil {
 			return err
@@ -75,6 +96,37 @@ func (s *server) CreateNetwork(ctx context.Context, req *NetworkRequest) (*Netwo
 		if err := db.Create(networkUser); err != nil {
 			return err
 		}
+		// The existing devices of the creator are approved.
+		if network.RequireApproval {
+			var devices []models.Device
+			if err := models.NewDeviceQuerySet(tx).UserIDEq(userID).RevokedAtIsNull().All(&devices); err != nil {
+				return err
+			}
+			for _, d := range devices {
+				if err := models.DecideDeviceApproval(tx, network.ID, d.ID, userID, true); err != nil {
+					return err
+				}
+			}
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionCreateNetwork,
+			TargetType:     auditTargetNetwork,
+			TargetID:       network.ID,
+			OrganizationID: network.OrganizationID,
+			NetworkID:      network.ID,
+		}
+		after := auditState{
+			"name":        network.Name,
+			"description": network.Description,
+			"broadcast":   network.Broadcast,
+		}
+		if network.RequireApproval {
+			after["require_approval"] = true
+		}
+		if err := audit(ctx, tx, log, nil, after); err != nil {
+			return err
+		}
 
 		uc, err := models.NewDeviceQuerySet(tx).UserIDEq(userID).Count()
 		if err != nil {
@@ -82,21 +134,29 @@ func (s *server) CreateNetwork(ctx context.Context, req *NetworkRequest) (*Netwo
 		}
 
 		item := NetworkItem{
-			NetworkID:   network.ID,
-			Name:        network.Name,
-			Description: network.Description,
-			CreatedAt:   network.CreatedAt.Unix(),
-			MemberCount: 1,
-			DeviceCount: uc,
-			Role:        models.RoleTypeOwner,
+			NetworkID:       network.ID,
+			Name:            network.Name,
+			Description:     network.Description,
+			Broadcast:       network.Broadcast,
+			RequireApproval: network.RequireApproval,
+			CreatedAt:       network.CreatedAt.Unix(),
+			MemberCount:     1,
+			DeviceCount:     uc,
+			Role:            models.RoleTypeOwner,
+
+			OrganizationID: network.OrganizationID,
 		}
 		res = &NetworkResponse{
 			Network: item,
 		}
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(userID)
 
-	return res, err
+	return res, nil
 }
 
 //UpdateNetwork update the network
@@ -106,9 +166,13 @@ func (s *server) UpdateNetwork(ctx context.Context, r *http.Request, req *Networ
 	if networkID == 0 {
 		return nil, errcode.ErrIllegalRequest
 	}
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+
 	var res *NetworkResponse
+	var affected []models.ID
 	err := db.Tx(func(tx *gorm.DB) error {
-		if err := s.networkUserOperationCheck(ctx, networkID, models.RoleTypeAdmin); err != nil {
+		var network models.Network
+		if err := models.NewNetworkQuerySet(tx).IDEq(networkID).One(&network); err != nil {
 			return err
 		}
 
@@ -117,22 +181,41 @@ func (s *server) UpdateNetwork(ctx context.Context, r *http.Request, req *Networ
 			GetUpdater().
 			SetName(req.Name).
 			SetDescription(req.Description).
+			SetBroadcast(req.Broadcast).
+			SetRequireApproval(req.RequireApproval).
 			Update()
 		if err != nil {
 			return err
 		}
 
+		// The existing devices are kept in the network when the approval
+		// becomes required, and only the new devices are pending.
+		if req.RequireApproval && !network.RequireApproval {
+			if err := models.ApproveNetworkDevices(tx, networkID, userID); err != nil {
+				return err
+			}
+		}
+
 		item := NetworkItem{
-			NetworkID:   networkID,
-			Name:        req.Name,
-			Description: req.Description,
+			NetworkID:       networkID,
+			Name:            req.Name,
+			Description:     req.Description,
+			Broadcast:       req.Broadcast,
+			RequireApproval: req.RequireApproval,
 		}
 		res = &NetworkResponse{
 			Network: item,
 		}
-		return nil
+
+		affected, err = models.NetworkMemberIDs(tx, networkID)
+		return err
 	})
-	return res, err
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
+
+	return res, nil
 }
 
 type (
@@ -161,13 +244,17 @@ func (s *server) NetworkList(ctx context.Context) (*NetworkInfoResponse, error)
 			}
 
 			item := NetworkItem{
-				NetworkID:   networkUser.NetworkID,
-				Name:        networkUser.Network.Name,
-				Description: networkUser.Network.Description,
-				CreatedAt:   networkUser.CreatedAt.UnixNano() / 1e6,
-				MemberCount: uc,
-				DeviceCount: dc,
-				Role:        networkUser.Role,
+				NetworkID:       networkUser.NetworkID,
+				Name:            networkUser.Network.Name,
+				Description:     networkUser.Network.Description,
+				Broadcast:       networkUser.Network.Broadcast,
+				RequireApproval: networkUser.Network.RequireApproval,
+				CreatedAt:       networkUser.CreatedAt.UnixNano() / 1e6,
+				MemberCount:     uc,
+				DeviceCount:     dc,
+				Role:            networkUser.Role,
+
+				OrganizationID: networkUser.Network.OrganizationID,
 			}
 
 			res.Networks = append(res.Networks, item)
@@ -203,23 +290,18 @@ func (s *server) NetworkMembers(ctx context.Context, r *http.Request) (*NetworkM
 	if networkID == 0 {
 		return nil, errcode.ErrIllegalRequest
 	}
-	userID := models.ID(jwt.UserIDFromContext(ctx))
+	role := grantFromContext(ctx).networkRole
 
 	var res *NetworkMemberResponse
 	err := db.Tx(func(tx *gorm.DB) error {
-		currentUser := models.NetworkUser{}
-		if err := models.NewNetworkUserQuerySet(tx).NetworkIDEq(networkID).UserIDEq(userID).One(&currentUser); err != nil {
-			return errcode.ErrIllegalOperation
-		}
-
 		var networkUsers []models.NetworkUser
 		if err := models.NewNetworkUserQuerySet(tx).PreloadUser().NetworkIDEq(networkID).All(&networkUsers); err != nil {
 			return err
 		}
 
 		res = &NetworkMemberResponse{
-			Owner: currentUser.Role == models.RoleTypeOwner,
-			Admin: currentUser.Role == models.RoleTypeAdmin || currentUser.Role == models.RoleTypeOwner,
+			Owner: role == models.RoleTypeOwner,
+			Admin: role == models.RoleTypeAdmin || role == models.RoleTypeOwner,
 		}
 		for _, user := range networkUsers {
 			item := NetworkMemberItem{
@@ -237,6 +319,194 @@ func (s *server) NetworkMembers(ctx context.Context, r *http.Request) (*NetworkM
 	return res, err
 }
 
+type (
+	// ExitNodeItem is the exit node information item struct
+	ExitNodeItem struct {
+		DeviceID models.ID `json:"device_id"`
+		Name     string    `json:"name"`
+		UserID   models.ID `json:"user_id"`
+		UserName string    `json:"user_name"`
+		Address  string    `json:"address"`
+		LastSeen int64     `json:"last_seen"`
+	}
+
+	// ExitNodeListResponse is the response of the exit nodes of the network
+	ExitNodeListResponse struct {
+		ExitNodes []ExitNodeItem `json:"exit_nodes"`
+	}
+)
+
+// NetworkExitNodes returns the available exit nodes of the network
+func (s *server) NetworkExitNodes(r *http.Request) (*ExitNodeListResponse, error) {
+	vars := Vars(mux.Vars(r))
+	networkID := vars.ModelID("network_id")
+	if networkID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	res := &ExitNodeListResponse{ExitNodes: []ExitNodeItem{}}
+	err := db.Tx(func(tx *gorm.DB) error {
+		var networkUsers []models.NetworkUser
+		if err := models.NewNetworkUserQuerySet(tx).NetworkIDEq(networkID).All(&networkUsers); err != nil {
+			return err
+		}
+		var userIDs []models.ID
+		for _, u := range networkUsers {
+			userIDs = append(userIDs, u.UserID)
+		}
+
+		var devices []models.Device
+		err := models.NewDeviceQuerySet(tx).
+			PreloadUser().
+			UserIDIn(userIDs...).
+			ExitNodeEq(true).
+			RevokedAtIsNull().
+			All(&devices)
+		if err != nil {
+			return err
+		}
+		for _, d := range devices {
+			res.ExitNodes = append(res.ExitNodes, ExitNodeItem{
+				DeviceID: d.ID,
+				Name:     d.Name,
+				UserID:   d.UserID,
+				UserName: d.User.Name,
+				Address:  d.Address,
+				LastSeen: d.LastSeen.Unix(),
+			})
+		}
+		return nil
+	})
+	return res, err
+}
+
+type (
+	// PendingDeviceItem is the item struct of a device pending for approval
+	PendingDeviceItem struct {
+		DeviceListItem
+		UserID   models.ID `json:"user_id"`
+		UserName string    `json:"user_name"`
+	}
+
+	// PendingDeviceListResponse is the response of the pending devices of the network
+	PendingDeviceListResponse struct {
+		Devices []PendingDeviceItem `json:"devices"`
+	}
+
+	// NetworkDeviceApproveRequest is the request to approve or reject a device
+	// joining the network
+	NetworkDeviceApproveRequest struct {
+		Approved bool `json:"approved"`
+	}
+)
+
+// NetworkPendingDevices returns the devices pending for approval to join the network
+func (s *server) NetworkPendingDevices(r *http.Request) (*PendingDeviceListResponse, error) {
+	vars := Vars(mux.Vars(r))
+	networkID := vars.ModelID("network_id")
+	if networkID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	res := &PendingDeviceListResponse{Devices: []PendingDeviceItem{}}
+	err := db.Tx(func(tx *gorm.DB) error {
+		var network models.Network
+		if err := models.NewNetworkQuerySet(tx).IDEq(networkID).One(&network); err != nil {
+			return err
+		}
+		if !network.RequireApproval {
+			return nil
+		}
+
+		devices, err := models.PendingDevices(tx, networkID)
+		if err != nil {
+			return err
+		}
+		var userIDs []models.ID
+		for _, d := range devices {
+			userIDs = append(userIDs, d.UserID)
+		}
+		var users []models.User
+		if err := models.NewUserQuerySet(tx).IDIn(userIDs...).All(&users); err != nil {
+			return err
+		}
+		userNames := map[models.ID]string{}
+		for _, u := range users {
+			userNames[u.ID] = u.Name
+		}
+
+		for i := range devices {
+			d := &devices[i]
+			res.Devices = append(res.Devices, PendingDeviceItem{
+				DeviceListItem: deviceListItem(d),
+				UserID:         d.UserID,
+				UserName:       userNames[d.UserID],
+			})
+		}
+		return nil
+	})
+	return res, err
+}
+
+// NetworkDeviceApprove approves or rejects the device joining the network, and
+// the decision can be changed later. The rejected device is kept out of the
+// network even if the network doesn't require approval, which is the way for
+// the network admins to exclude a device without revoking it globally.
+func (s *server) NetworkDeviceApprove(ctx context.Context, r *http.Request, req *NetworkDeviceApproveRequest) (*NetworkOperationResponse, error) {
+	vars := Vars(mux.Vars(r))
+	networkID := vars.ModelID("network_id")
+	deviceID := vars.ModelID("device_id")
+	if networkID == 0 || deviceID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+
+	var affected []models.ID
+	err := db.Tx(func(tx *gorm.DB) error {
+		var device models.Device
+		if err := models.NewDeviceQuerySet(tx).IDEq(deviceID).One(&device); err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errcode.ErrNotFound
+			}
+			return err
+		}
+		// Only the devices of the members can join the network.
+		count, err := models.NewNetworkUserQuerySet(tx).NetworkIDEq(networkID).UserIDEq(device.UserID).Count()
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return errcode.ErrIllegalOperation
+		}
+
+		if err := models.DecideDeviceApproval(tx, networkID, deviceID, userID, req.Approved); err != nil {
+			return err
+		}
+
+		action := models.AuditActionRejectDevice
+		if req.Approved {
+			action = models.AuditActionApproveDevice
+		}
+		log := &models.AuditLog{
+			Action:     action,
+			TargetType: auditTargetDevice,
+			TargetID:   deviceID,
+			NetworkID:  networkID,
+		}
+		if err := audit(ctx, tx, log, nil, auditState{"name": device.Name, "approved": req.Approved}); err != nil {
+			return err
+		}
+		affected, err = models.NetworkMemberIDs(tx, networkID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
+
+	return &NetworkOperationResponse{Success: true}, nil
+}
+
 type (
 	// ChangeNetworkStatusRequest is request to change network status
 	ChangeNetworkStatusRequest struct {
@@ -255,42 +525,22 @@ type (
 	}
 )
 
-func (s *server) networkUserOperationCheck(ctx context.Context, networkID models.ID, allowRole models.RoleType) error {
-	return db.Tx(func(tx *gorm.DB) error {
-		userID := models.ID(jwt.UserIDFromContext(ctx))
-		var networkUser models.NetworkUser
-		if err := models.NewNetworkUserQuerySet(tx).NetworkIDEq(networkID).UserIDEq(userID).One(&networkUser); err != nil {
-			return err
-		}
-		if allowRole == models.RoleTypeOwner && networkUser.Role != models.RoleTypeOwner {
-			return errcode.ErrIllegalOperation
-		}
-		if allowRole == models.RoleTypeAdmin && (networkUser.Role != models.RoleTypeOwner && networkUser.Role != models.RoleTypeAdmin) {
-			return errcode.ErrIllegalOperation
-		}
-		return nil
-	})
-}
-
 //DeleteNetwork delete the network
-func (s *server) DeleteNetwork(ctx context.Context, r *http.Request) (*NetworkOperationResponse, error) {
+func (s *server) DeleteNetwork(r *http.Request) (*NetworkOperationResponse, error) {
 	vars := Vars(mux.Vars(r))
 	networkID := vars.ModelID("network_id")
 	if networkID == 0 {
 		return nil, errcode.ErrIllegalRequest
 	}
-	userID := models.ID(jwt.UserIDFromContext(ctx))
 
 	var res *NetworkOperationResponse
+	var affected []models.ID
 	err := db.Tx(func(tx *gorm.DB) error {
-		var network models.Network
-		err := models.NewNetworkQuerySet(tx).IDEq(networkID).One(&network)
+		var err error
+		affected, err = models.NetworkMemberIDs(tx, networkID)
 		if err != nil {
 			return err
 		}
-		if network.CreatedByID != userID {
-			return errcode.ErrIllegalOperation
-		}
 
 		err = models.NewNetworkUserQuerySet(tx).NetworkIDEq(networkID).Delete()
 		if err != nil {
@@ -302,6 +552,11 @@ func (s *server) DeleteNetwork(ctx context.Context, r *http.Request) (*NetworkOp
 			return err
 		}
 
+		err = models.NewDeviceApprovalQuerySet(tx).NetworkIDEq(networkID).Delete()
+		if err != nil {
+			return err
+		}
+
 		err = models.NewNetworkQuerySet(tx).
 			IDEq(networkID).
 			Delete()
@@ -314,7 +569,12 @@ func (s *server) DeleteNetwork(ctx context.Context, r *http.Request) (*NetworkOp
 		}
 		return nil
 	})
-	return res, err
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
+
+	return res, nil
 }
 
 type (
@@ -342,18 +602,22 @@ func (s *server) ChangeNetworkMemberRole(ctx context.Context, r *http.Request, r
 		return nil, errcode.ErrIllegalRequest
 	}
 
-	// Only owner can change the member permission
+	// Only owner can change the member permission, which is checked by the
+	// authorize plugin.
 	var res *ChangeMemberPermissionResponse
 	err := db.Tx(func(tx *gorm.DB) error {
-		err := s.networkUserOperationCheck(ctx, networkID, models.RoleTypeOwner)
-		if err != nil {
-			return err
-		}
-
 		var role = models.RoleTypeMember
 		if req.Role == "admin" {
 			role = models.RoleTypeAdmin
 		}
+		var member models.NetworkUser
+		err := models.NewNetworkUserQuerySet(tx).
+			NetworkIDEq(networkID).
+			UserIDEq(userID).
+			One(&member)
+		if err != nil {
+			return err
+		}
 		err = models.NewNetworkUserQuerySet(tx).
 			NetworkIDEq(networkID).
 			UserIDEq(userID).
@@ -364,6 +628,17 @@ func (s *server) ChangeNetworkMemberRole(ctx context.Context, r *http.Request, r
 			return err
 		}
 
+		log := &models.AuditLog{
+			Action:     models.AuditActionChangeMemberRole,
+			TargetType: auditTargetMember,
+			TargetID:   userID,
+			NetworkID:  networkID,
+		}
+		err = audit(ctx, tx, log, auditState{"role": member.Role}, auditState{"role": role})
+		if err != nil {
+			return err
+		}
+
 		res = &ChangeMemberPermissionResponse{
 			Role: role,
 		}
@@ -395,14 +670,11 @@ func (s *server) DeleteNetworkUser(ctx context.Context, r *http.Request) (*Delet
 	}
 
 	var res *DeleteNetworkUserResponse
+	var affected []models.ID
 	err := db.Tx(func(tx *gorm.DB) error {
 		userIDFromJwt := models.ID(jwt.UserIDFromContext(ctx))
-		var role string
-		if err := tx.Raw(`select role from network_users where network_id = ? and user_id = ?`, networkID, userID).Scan(&role).Error; err != nil {
-			return err
-		}
-		requestUserRole := models.RoleType(role) // Request user role
-		if userID == userIDFromJwt {             //delete self
+		requestUserRole := grantFromContext(ctx).networkRole // Request user role
+		if userID == userIDFromJwt {                         //delete self
 			if requestUserRole == models.RoleTypeOwner {
 				return errors.New("owner can not delete self from the network")
 			}
@@ -410,6 +682,7 @@ func (s *server) DeleteNetworkUser(ctx context.Context, r *http.Request) (*Delet
 			if requestUserRole == models.RoleTypeMember {
 				return errors.New("member can only delete self")
 			}
+			var role string
 			if err := tx.Raw(`select role from network_users where network_id = ? and user_id = ?`, networkID, userID).Scan(&role).Error; err != nil {
 				return err
 			}
@@ -422,7 +695,20 @@ func (s *server) DeleteNetworkUser(ctx context.Context, r *http.Request) (*Delet
 			}
 		}
 
+		var member models.NetworkUser
 		err := models.NewNetworkUserQuerySet(tx).
+			NetworkIDEq(networkID).
+			UserIDEq(userID).
+			One(&member)
+		if err != nil {
+			return err
+		}
+		// The removed member is affected as well.
+		affected, err = models.NetworkMemberIDs(tx, networkID)
+		if err != nil {
+			return err
+		}
+		err = models.NewNetworkUserQuerySet(tx).
 			NetworkIDEq(networkID).
 			UserIDEq(userID).
 			Delete()
@@ -430,14 +716,28 @@ func (s *server) DeleteNetworkUser(ctx context.Context, r *http.Request) (*Delet
 			return err
 		}
 
+		log := &models.AuditLog{
+			Action:     models.AuditActionDeleteMember,
+			TargetType: auditTargetMember,
+			TargetID:   userID,
+			NetworkID:  networkID,
+		}
+		if err := audit(ctx, tx, log, auditState{"role": member.Role}, nil); err != nil {
+			return err
+		}
+
 		res = &DeleteNetworkUserResponse{
 			UserID: userID,
 		}
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
 
-	return res, err
+	return res, nil
 }
 
 type (
@@ -466,22 +766,14 @@ func (s *server) InviteMember(ctx context.Context, r *http.Request, req *InviteM
 	}
 
 	userID := models.ID(jwt.UserIDFromContext(ctx))
+	role := grantFromContext(ctx).networkRole // invite user
 
 	var res *InviteMemberResponse
 	err := db.Tx(func(tx *gorm.DB) error {
-		var invitationNetworkUser models.NetworkUser //invite user
-		err := models.NewNetworkUserQuerySet(tx).
-			PreloadNetwork().
-			NetworkIDEq(networkID).
-			UserIDEq(userID).
-			One(&invitationNetworkUser)
-		if err != nil {
-			return err
-		}
-		if invitationNetworkUser.Role == models.RoleTypeMember {
+		if role == models.RoleTypeMember {
 			return errors.New("network member can not invite user")
 		}
-		if req.Role == models.RoleTypeAdmin && invitationNetworkUser.Role != models.RoleTypeOwner {
+		if req.Role == models.RoleTypeAdmin && role != models.RoleTypeOwner {
 			return errors.New("only network owner can invite admin")
 		}
 
@@ -493,7 +785,7 @@ func (s *server) InviteMember(ctx context.Context, r *http.Request, req *InviteM
 		// Check duplication invitation
 		updateInvitation := false
 		var unProcessInvitation models.Invitation
-		err = models.NewInvitationQuerySet(tx).
+		err := models.NewInvitationQuerySet(tx).
 			NetworkIDEq(networkID).
 			UserIDEq(user.ID).
 			One(&unProcessInvitation)
@@ -0,0 +1,545 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pairmesh/pairmesh/errcode"
+	"github.com/pairmesh/pairmesh/pkg/jwt"
+	"github.com/pairmesh/pairmesh/portal/db"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type (
+	// OrganizationRequest is the request to create or update an organization
+	OrganizationRequest struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	// OrganizationItem is the organization information item struct
+	OrganizationItem struct {
+		OrganizationID models.ID                   `json:"organization_id"`
+		Name           string                      `json:"name"`
+		Description    string                      `json:"description"`
+		CreatedAt      int64                       `json:"created_at"`
+		Role           models.OrganizationRoleType `json:"role"`
+	}
+
+	// OrganizationResponse is the response of the organization operations
+	OrganizationResponse struct {
+		Organization OrganizationItem `json:"organization"`
+	}
+
+	// OrganizationListResponse is the response of the organizations of the user
+	OrganizationListResponse struct {
+		Organizations []OrganizationItem `json:"organizations"`
+	}
+)
+
+// OrganizationList returns the organizations which the user belongs to
+func (s *server) OrganizationList(ctx context.Context) (*OrganizationListResponse, error) {
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+
+	res := &OrganizationListResponse{Organizations: []OrganizationItem{}}
+	err := db.Tx(func(tx *gorm.DB) error {
+		var orgUsers []models.OrganizationUser
+		err := models.NewOrganizationUserQuerySet(tx).PreloadOrganization().UserIDEq(userID).All(&orgUsers)
+		if err != nil {
+			return err
+		}
+		for _, u := range orgUsers {
+			res.Organizations = append(res.Organizations, OrganizationItem{
+				OrganizationID: u.OrganizationID,
+				Name:           u.Organization.Name,
+				Description:    u.Organization.Description,
+				CreatedAt:      u.Organization.CreatedAt.UnixNano() / 1e6,
+				Role:           u.Role,
+			})
+		}
+		return nil
+	})
+	return res, err
+}
+
+// CreateOrganization creates an organization, and the creator is the admin of
+// the organization.
+func (s *server) CreateOrganization(ctx context.Context, req *OrganizationRequest) (*OrganizationResponse, error) {
+	if req.Name == "" {
+		return nil, errcode.ErrIllegalRequest
+	}
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+
+	var res *OrganizationResponse
+	err := db.Tx(func(tx *gorm.DB) error {
+		org := &models.Organization{
+			CreatedByID: userID,
+			Name:        req.Name,
+			Description: req.Description,
+		}
+		if err := tx.Create(org).Error; err != nil {
+			return err
+		}
+
+		orgUser := &models.OrganizationUser{
+			OrganizationID: org.ID,
+			UserID:         userID,
+			Role:           models.OrganizationRoleAdmin,
+		}
+		if err := tx.Create(orgUser).Error; err != nil {
+			return err
+		}
+
+		res = &OrganizationResponse{
+			Organization: OrganizationItem{
+				OrganizationID: org.ID,
+				Name:           org.Name,
+				Description:    org.Description,
+				CreatedAt:      org.CreatedAt.UnixNano() / 1e6,
+				Role:           models.OrganizationRoleAdmin,
+			},
+		}
+		return nil
+	})
+	return res, err
+}
+
+// UpdateOrganization updates the organization
+func (s *server) UpdateOrganization(ctx context.Context, r *http.Request, req *OrganizationRequest) (*OrganizationResponse, error) {
+	vars := Vars(mux.Vars(r))
+	organizationID := vars.ModelID("organization_id")
+	if organizationID == 0 || req.Name == "" {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	var res *OrganizationResponse
+	err := db.Tx(func(tx *gorm.DB) error {
+		var org models.Organization
+		if err := models.NewOrganizationQuerySet(tx).IDEq(organizationID).One(&org); err != nil {
+			return err
+		}
+		err := models.NewOrganizationQuerySet(tx).
+			IDEq(organizationID).
+			GetUpdater().
+			SetName(req.Name).
+			SetDescription(req.Description).
+			Update()
+		if err != nil {
+			return err
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionUpdateOrganization,
+			TargetType:     auditTargetOrganization,
+			TargetID:       organizationID,
+			OrganizationID: organizationID,
+		}
+		before := auditState{"name": org.Name, "description": org.Description}
+		after := auditState{"name": req.Name, "description": req.Description}
+		if err := audit(ctx, tx, log, before, after); err != nil {
+			return err
+		}
+
+		res = &OrganizationResponse{
+			Organization: OrganizationItem{
+				OrganizationID: organizationID,
+				Name:           req.Name,
+				Description:    req.Description,
+				Role:           grantFromContext(ctx).organizationRole,
+			},
+		}
+		return nil
+	})
+	return res, err
+}
+
+type (
+	// OrganizationMemberItem is the member information item of an organization
+	OrganizationMemberItem struct {
+		UserID   models.ID                   `json:"user_id"`
+		Name     string                      `json:"name"`
+		Email    string                      `json:"email"`
+		JoinTime int64                       `json:"join_time"`
+		Role     models.OrganizationRoleType `json:"role"`
+	}
+
+	// OrganizationMemberResponse is the response of the organization members
+	OrganizationMemberResponse struct {
+		Members []OrganizationMemberItem `json:"members"`
+	}
+
+	// OrganizationMemberRequest is the request to add a member to the organization
+	// or change the role of a member. The email is only used to add a member.
+	OrganizationMemberRequest struct {
+		Email string                      `json:"email"`
+		Role  models.OrganizationRoleType `json:"role"`
+	}
+
+	// OrganizationMemberOperationResponse is the response to the member operations
+	OrganizationMemberOperationResponse struct {
+		UserID models.ID                   `json:"user_id"`
+		Role   models.OrganizationRoleType `json:"role"`
+	}
+)
+
+// OrganizationMembers returns the members of the organization
+func (s *server) OrganizationMembers(r *http.Request) (*OrganizationMemberResponse, error) {
+	vars := Vars(mux.Vars(r))
+	organizationID := vars.ModelID("organization_id")
+	if organizationID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	res := &OrganizationMemberResponse{Members: []OrganizationMemberItem{}}
+	err := db.Tx(func(tx *gorm.DB) error {
+		var orgUsers []models.OrganizationUser
+		err := models.NewOrganizationUserQuerySet(tx).PreloadUser().OrganizationIDEq(organizationID).All(&orgUsers)
+		if err != nil {
+			return err
+		}
+		for _, u := range orgUsers {
+			res.Members = append(res.Members, OrganizationMemberItem{
+				UserID:   u.UserID,
+				Name:     u.User.Name,
+				Email:    u.User.Email,
+				JoinTime: u.CreatedAt.Unix(),
+				Role:     u.Role,
+			})
+		}
+		return nil
+	})
+	return res, err
+}
+
+// AddOrganizationMember adds the user identified by the email to the organization
+func (s *server) AddOrganizationMember(ctx context.Context, r *http.Request, req *OrganizationMemberRequest) (*OrganizationMemberOperationResponse, error) {
+	vars := Vars(mux.Vars(r))
+	organizationID := vars.ModelID("organization_id")
+	if organizationID == 0 || req.Email == "" || !req.Role.Valid() {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	var res *OrganizationMemberOperationResponse
+	err := db.Tx(func(tx *gorm.DB) error {
+		var user models.User
+		if err := models.NewUserQuerySet(tx).EmailEq(req.Email).One(&user); err != nil {
+			return errors.Errorf("cannot find user %s", req.Email)
+		}
+
+		role, err := models.OrganizationRole(tx, organizationID, user.ID)
+		if err != nil {
+			return err
+		}
+		if role != "" {
+			return errors.Errorf("user %s is already a member of the organization", req.Email)
+		}
+
+		orgUser := &models.OrganizationUser{
+			OrganizationID: organizationID,
+			UserID:         user.ID,
+			Role:           req.Role,
+		}
+		if err := tx.Create(orgUser).Error; err != nil {
+			return err
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionAddOrganizationMember,
+			TargetType:     auditTargetOrganizationMember,
+			TargetID:       user.ID,
+			OrganizationID: organizationID,
+		}
+		if err := audit(ctx, tx, log, nil, auditState{"role": req.Role}); err != nil {
+			return err
+		}
+
+		res = &OrganizationMemberOperationResponse{UserID: user.ID, Role: req.Role}
+		return nil
+	})
+	return res, err
+}
+
+// checkOrganizationAdminRemains checks whether the organization still has an
+// admin after the user is removed or demoted.
+func checkOrganizationAdminRemains(tx *gorm.DB, organizationID, userID models.ID) error {
+	count, err := models.NewOrganizationUserQuerySet(tx).
+		OrganizationIDEq(organizationID).
+		UserIDNe(userID).
+		RoleEq(models.OrganizationRoleAdmin).
+		Count()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.New("the organization must have at least one admin")
+	}
+	return nil
+}
+
+// ChangeOrganizationMemberRole changes the role of the member of the organization
+func (s *server) ChangeOrganizationMemberRole(ctx context.Context, r *http.Request, req *OrganizationMemberRequest) (*OrganizationMemberOperationResponse, error) {
+	vars := Vars(mux.Vars(r))
+	organizationID := vars.ModelID("organization_id")
+	userID := vars.ModelID("user_id")
+	if organizationID == 0 || userID == 0 || !req.Role.Valid() {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	err := db.Tx(func(tx *gorm.DB) error {
+		role, err := models.OrganizationRole(tx, organizationID, userID)
+		if err != nil {
+			return err
+		}
+		if role == "" {
+			return errcode.ErrNotFound
+		}
+		if req.Role != models.OrganizationRoleAdmin {
+			if err := checkOrganizationAdminRemains(tx, organizationID, userID); err != nil {
+				return err
+			}
+		}
+		err = models.NewOrganizationUserQuerySet(tx).
+			OrganizationIDEq(organizationID).
+			UserIDEq(userID).
+			GetUpdater().
+			SetRole(req.Role).
+			Update()
+		if err != nil {
+			return err
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionChangeOrganizationMemberRole,
+			TargetType:     auditTargetOrganizationMember,
+			TargetID:       userID,
+			OrganizationID: organizationID,
+		}
+		return audit(ctx, tx, log, auditState{"role": role}, auditState{"role": req.Role})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &OrganizationMemberOperationResponse{UserID: userID, Role: req.Role}, nil
+}
+
+// DeleteOrganizationMember removes the member from the organization
+func (s *server) DeleteOrganizationMember(ctx context.Context, r *http.Request) (*OrganizationMemberOperationResponse, error) {
+	vars := Vars(mux.Vars(r))
+	organizationID := vars.ModelID("organization_id")
+	userID := vars.ModelID("user_id")
+	if organizationID == 0 || userID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	err := db.Tx(func(tx *gorm.DB) error {
+		role, err := models.OrganizationRole(tx, organizationID, userID)
+		if err != nil {
+			return err
+		}
+		if role == "" {
+			return errcode.ErrNotFound
+		}
+		if err := checkOrganizationAdminRemains(tx, organizationID, userID); err != nil {
+			return err
+		}
+		err = models.NewOrganizationUserQuerySet(tx).
+			OrganizationIDEq(organizationID).
+			UserIDEq(userID).
+			Delete()
+		if err != nil {
+			return err
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionDeleteOrganizationMember,
+			TargetType:     auditTargetOrganizationMember,
+			TargetID:       userID,
+			OrganizationID: organizationID,
+		}
+		return audit(ctx, tx, log, auditState{"role": role}, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &OrganizationMemberOperationResponse{UserID: userID}, nil
+}
+
+// OrganizationNetworks returns the networks owned by the organization
+func (s *server) OrganizationNetworks(ctx context.Context, r *http.Request) (*NetworkInfoResponse, error) {
+	vars := Vars(mux.Vars(r))
+	organizationID := vars.ModelID("organization_id")
+	if organizationID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+	role := organizationNetworkRole[grantFromContext(ctx).organizationRole]
+
+	res := &NetworkInfoResponse{Networks: []NetworkItem{}}
+	err := db.Tx(func(tx *gorm.DB) error {
+		var networks []models.Network
+		if err := models.NewNetworkQuerySet(tx).OrganizationIDEq(organizationID).All(&networks); err != nil {
+			return err
+		}
+		for _, network := range networks {
+			uc, dc, err := models.NetworkStats(tx, network.ID)
+			if err != nil {
+				return err
+			}
+			res.Networks = append(res.Networks, NetworkItem{
+				NetworkID:       network.ID,
+				Name:            network.Name,
+				Description:     network.Description,
+				Broadcast:       network.Broadcast,
+				RequireApproval: network.RequireApproval,
+				CreatedAt:       network.CreatedAt.UnixNano() / 1e6,
+				MemberCount:     uc,
+				DeviceCount:     dc,
+				Role:            role,
+
+				OrganizationID: organizationID,
+			})
+		}
+		return nil
+	})
+	return res, err
+}
+
+// OrganizationDevices returns the devices registered to the organization
+func (s *server) OrganizationDevices(r *http.Request) (*DeviceListResponse, error) {
+	vars := Vars(mux.Vars(r))
+	organizationID := vars.ModelID("organization_id")
+	if organizationID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	res := &DeviceListResponse{}
+	err := db.Tx(func(tx *gorm.DB) error {
+		var devices []models.Device
+		err := models.NewDeviceQuerySet(tx).OrganizationIDEq(organizationID).OrderDescByCreatedAt().All(&devices)
+		if err != nil {
+			return err
+		}
+		for i := range devices {
+			res.Devices = append(res.Devices, deviceListItem(&devices[i]))
+		}
+		return nil
+	})
+	return res, err
+}
+
+// OrganizationKeys returns the auth keys of the organization
+func (s *server) OrganizationKeys(r *http.Request) (*KeyListResponse, error) {
+	vars := Vars(mux.Vars(r))
+	organizationID := vars.ModelID("organization_id")
+	if organizationID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	res := &KeyListResponse{}
+	err := db.Tx(func(tx *gorm.DB) error {
+		var keys []models.AuthKey
+		err := models.NewAuthKeyQuerySet(tx).OrganizationIDEq(organizationID).OrderDescByCreatedAt().All(&keys)
+		if err != nil {
+			return err
+		}
+		for i := range keys {
+			res.Keys = append(res.Keys, keyListItem(&keys[i]))
+		}
+		return nil
+	})
+	return res, err
+}
+
+type (
+	// OrganizationRelayRequest is the request to assign relay servers to the
+	// organization, and the empty list allows all relay servers.
+	OrganizationRelayRequest struct {
+		RelayServerIDs []models.ID `json:"relay_server_ids"`
+	}
+
+	// OrganizationRelayResponse is the response of the relay servers assigned
+	// to the organization.
+	OrganizationRelayResponse struct {
+		RelayServerIDs []models.ID `json:"relay_server_ids"`
+	}
+)
+
+// OrganizationRelays returns the relay servers assigned to the organization
+func (s *server) OrganizationRelays(r *http.Request) (*OrganizationRelayResponse, error) {
+	vars := Vars(mux.Vars(r))
+	organizationID := vars.ModelID("organization_id")
+	if organizationID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+
+	res := &OrganizationRelayResponse{}
+	err := db.Tx(func(tx *gorm.DB) error {
+		var err error
+		res.RelayServerIDs, err = models.OrganizationRelayServerIDs(tx, organizationID)
+		return err
+	})
+	return res, err
+}
+
+// AssignOrganizationRelays replaces the relay servers assigned to the organization.
+// The devices of the organization switch the primary relay server on the next
+// latency report if it's no longer assigned.
+func (s *server) AssignOrganizationRelays(ctx context.Context, r *http.Request, req *OrganizationRelayRequest) (*OrganizationRelayResponse, error) {
+	vars := Vars(mux.Vars(r))
+	organizationID := vars.ModelID("organization_id")
+	if organizationID == 0 {
+		return nil, errcode.ErrIllegalRequest
+	}
+	for _, id := range req.RelayServerIDs {
+		if _, found := s.relayServers.byID.Load(id); !found {
+			return nil, errors.Errorf("relay server %d not found", id)
+		}
+	}
+
+	err := db.Tx(func(tx *gorm.DB) error {
+		before, err := models.OrganizationRelayServerIDs(tx, organizationID)
+		if err != nil {
+			return err
+		}
+		if err := models.NewOrganizationRelayQuerySet(tx).OrganizationIDEq(organizationID).Delete(); err != nil {
+			return err
+		}
+		for _, id := range req.RelayServerIDs {
+			relay := &models.OrganizationRelay{OrganizationID: organizationID, RelayServerID: id}
+			if err := tx.Create(relay).Error; err != nil {
+				return err
+			}
+		}
+
+		log := &models.AuditLog{
+			Action:         models.AuditActionAssignOrganizationRelays,
+			TargetType:     auditTargetOrganization,
+			TargetID:       organizationID,
+			OrganizationID: organizationID,
+		}
+		return audit(ctx, tx, log,
+			auditState{"relay_server_ids": before},
+			auditState{"relay_server_ids": append([]models.ID{}, req.RelayServerIDs...)})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &OrganizationRelayResponse{RelayServerIDs: req.RelayServerIDs}, nil
+}
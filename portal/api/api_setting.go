@@ -47,9 +47,11 @@ func (s *server) UserProfileSetting(ctx context.Context, req *UserProfileSetting
 
 	// TODO: validate
 
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+
 	var res *UserProfileSettingResponse
+	var affected []models.ID
 	err := db.Tx(func(tx *gorm.DB) error {
-		userID := models.ID(jwt.UserIDFromContext(ctx))
 		var user models.User
 		err := models.NewUserQuerySet(tx).IDEq(userID).One(&user)
 		if err != nil {
@@ -75,9 +77,18 @@ func (s *server) UserProfileSetting(ctx context.Context, req *UserProfileSetting
 			res.Email = req.Email
 			updater.SetEmail(req.Email)
 		}
+		if err := updater.Update(); err != nil {
+			return err
+		}
 
-		return updater.Update()
+		// The peers are named after the users.
+		affected, err = models.PeerUserIDs(tx, userID)
+		return err
 	})
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
 
-	return res, err
+	return res, nil
 }
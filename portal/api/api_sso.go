@@ -20,12 +20,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/pairmesh/pairmesh/pkg/jwt"
 	"github.com/pairmesh/pairmesh/portal/db"
 	"github.com/pairmesh/pairmesh/portal/db/models"
 	"github.com/pairmesh/pairmesh/portal/sso"
+	"github.com/pairmesh/pairmesh/portal/sso/oidc"
+
+	"github.com/gorilla/mux"
 	"github.com/pingcap/fn"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -34,14 +36,18 @@ import (
 type ssoServer struct {
 	client   *http.Client
 	redirect string
+	// graphNotifier notifies the peers of the ephemeral devices removed after
+	// logged out.
+	graphNotifier *graphNotifier
 }
 
 var errUnknownSSOProvider = errors.New("unknown sso provider")
 
-func newSSOServer(redirect string) *ssoServer {
+func newSSOServer(redirect string, notifier *graphNotifier) *ssoServer {
 	srv := ssoServer{
-		redirect: redirect,
-		client:   &http.Client{},
+		redirect:      redirect,
+		client:        &http.Client{},
+		graphNotifier: notifier,
 	}
 	return &srv
 }
@@ -76,6 +82,27 @@ func (s *ssoServer) SSOMethods(form *fn.Form) ([]*SSOMethod, error) {
 	return res, nil
 }
 
+// AuthCodeRedirect redirects to the consent page of the provider picked by the
+// user, and the state of the login is created by the provider here.
+func (s *ssoServer) AuthCodeRedirect(w http.ResponseWriter, r *http.Request) {
+	provider := sso.WithName(sso.GitHub)
+	if name, found := mux.Vars(r)["name"]; found {
+		provider = sso.WithOIDCName(name)
+	}
+	if provider == nil {
+		http.Error(w, errUnknownSSOProvider.Error(), http.StatusNotFound)
+		return
+	}
+
+	// The link is empty if the provider is unavailable.
+	link := provider.AuthCodeURL(s.redirect, r.URL.Query().Get("client"))
+	if link == "" {
+		http.Error(w, "sso provider is unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	http.Redirect(w, r, link, http.StatusFound)
+}
+
 // UserInfo is the struct of a user's info
 type UserInfo struct {
 	ID     uint64 `json:"id"`
@@ -115,6 +142,42 @@ func (s *ssoServer) GithubAuthCallback(form *fn.Form) (*CallbackResponse, error)
 		return nil, err
 	}
 
+	return s.login(user, sso.GitHub, client)
+}
+
+// OIDCAuthCallback is the callback function to handle OpenID Connect authentication
+func (s *ssoServer) OIDCAuthCallback(r *http.Request, form *fn.Form) (*CallbackResponse, error) {
+	code := form.Get("code")
+	if code == "" {
+		return nil, fmt.Errorf("illegal parameter, no code")
+	}
+
+	name := mux.Vars(r)["name"]
+	provider := sso.WithOIDCName(name)
+	if provider == nil {
+		return nil, fmt.Errorf("illegal parameter: unrecognized provider")
+	}
+
+	client, err := oidc.ConsumeState(name, form.Get("state"))
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := provider.AccessToken(code)
+	if err != nil {
+		return nil, err
+	}
+
+	user, _, err := provider.UserInfo(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.login(user, sso.OIDC, client)
+}
+
+// login creates the access token of the user logged in with the vendor
+func (s *ssoServer) login(user *models.User, vendor sso.Vendor, client string) (*CallbackResponse, error) {
 	// Desktop use client information to pass the machine info
 	type nodeInfo struct {
 		Port    int    `json:"port"`
@@ -133,7 +196,7 @@ func (s *ssoServer) GithubAuthCallback(form *fn.Form) (*CallbackResponse, error)
 	}
 
 	userID := uint64(user.ID)
-	ts, err := jwt.Shared().CreateToken(userID, info.Machine, uint8(sso.GitHub), 0, false)
+	ts, err := jwt.Shared().CreateToken(userID, info.Machine, uint8(vendor), 0, false)
 	if err != nil {
 		return nil, err
 	}
@@ -176,26 +239,33 @@ func (s *ssoServer) Logout(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	now := time.Now()
+	// The device registered with an ephemeral key is removed after logged out.
+	var affected []models.ID
 	err = db.Tx(func(tx *gorm.DB) error {
-		var authKey models.AuthKey
-		if err = models.NewAuthKeyQuerySet(tx).IDEq(models.ID(metadata.AuthKeyID)).One(&authKey); err != nil {
-			return fmt.Errorf("get auth key's by id is failed: %w", err)
+		var devices []models.Device
+		err := models.NewDeviceQuerySet(tx).
+			UserIDEq(models.ID(metadata.UserID)).
+			MachineIDEq(metadata.MachineID).
+			EphemeralEq(true).
+			All(&devices)
+		if err != nil {
+			return fmt.Errorf("get ephemeral devices is failed: %w", err)
 		}
-
-		// not a ephemeral key
-		if authKey.Type != models.KeyTypeEphemeral {
+		if len(devices) == 0 {
 			return nil
 		}
-
-		updater := models.NewAuthKeyQuerySet(tx).IDEq(models.ID(metadata.AuthKeyID)).GetUpdater()
-		if err = updater.SetDeletedAt(&now).Update(); err != nil {
-			return fmt.Errorf("delete key is failed: %w", err)
+		for _, d := range devices {
+			if err := models.DeleteDevice(tx, d.ID); err != nil {
+				return fmt.Errorf("delete ephemeral device is failed: %w", err)
+			}
 		}
-		return nil
+		affected, err = models.PeerUserIDs(tx, models.ID(metadata.UserID))
+		return err
 	})
 	if err != nil {
 		zap.L().Error("", zap.Error(err))
+		return
 	}
+	s.graphNotifier.notify(affected...)
 
 }
@@ -0,0 +1,341 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pairmesh/pairmesh/errcode"
+	"github.com/pairmesh/pairmesh/pkg/jwt"
+	"github.com/pairmesh/pairmesh/portal/db"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+
+	"github.com/gorilla/mux"
+	"github.com/pingcap/fn"
+	"gorm.io/gorm"
+)
+
+type (
+	// permission is the access level required by an HTTP API, and the resource
+	// is identified by the route variables of the request.
+	permission int
+
+	// grant is the access granted to the current user for the requested
+	// resource, which is stored in the context of the request.
+	grant struct {
+		networkRole      models.RoleType
+		organizationRole models.OrganizationRoleType
+	}
+
+	grantKey struct{}
+)
+
+const (
+	// permUser allows all authenticated users, and the handlers only operate
+	// the resources owned by the current user.
+	permUser permission = iota
+	// permUserRead allows reading the resources of the user identified by
+	// `user_id`, which requires sharing a network with the user or auditing an
+	// organization which the user belongs to.
+	permUserRead
+	// permNetworkRead, permNetworkAdmin and permNetworkOwner require the member,
+	// admin and owner role of the network identified by `network_id`.
+	permNetworkRead
+	permNetworkAdmin
+	permNetworkOwner
+	// permDeviceRead and permDeviceAdmin allow reading and managing the subnet
+	// routes of the device identified by `device_id`, and permDeviceOwner
+	// requires owning the device or administering its organization, which
+	// allows revoking, restoring and deleting the device.
+	permDeviceRead
+	permDeviceAdmin
+	permDeviceOwner
+	// permKeyOwner requires owning the auth key identified by `key_id` or
+	// administering its organization.
+	permKeyOwner
+	// permOrganizationRead, permOrganizationAudit and permOrganizationAdmin
+	// require any role, a read-all role and the admin role of the organization
+	// identified by `organization_id`.
+	permOrganizationRead
+	permOrganizationAudit
+	permOrganizationAdmin
+)
+
+// networkRoleRank orders the network roles by privileges.
+var networkRoleRank = map[models.RoleType]int{
+	models.RoleTypeMember: 1,
+	models.RoleTypeAdmin:  2,
+	models.RoleTypeOwner:  3,
+}
+
+// organizationNetworkRole maps the organization roles to the roles of all
+// networks of the organization.
+var organizationNetworkRole = map[models.OrganizationRoleType]models.RoleType{
+	models.OrganizationRoleAdmin:        models.RoleTypeOwner,
+	models.OrganizationRoleNetworkAdmin: models.RoleTypeAdmin,
+	models.OrganizationRoleAuditor:      models.RoleTypeMember,
+}
+
+// hasOrganizationRole returns whether the role is one of the allowed roles.
+func hasOrganizationRole(role models.OrganizationRoleType, allowed ...models.OrganizationRoleType) bool {
+	for _, r := range allowed {
+		if role == r {
+			return true
+		}
+	}
+	return false
+}
+
+// checkOrganizationRole checks whether the user has any of the allowed roles in
+// the organization, which is used to check the organization in request bodies.
+func checkOrganizationRole(tx *gorm.DB, organizationID, userID models.ID, allowed ...models.OrganizationRoleType) error {
+	role, err := models.OrganizationRole(tx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+	if !hasOrganizationRole(role, allowed...) {
+		return errcode.ErrIllegalOperation
+	}
+	return nil
+}
+
+// grantFromContext returns the access granted by the authorize plugin.
+func grantFromContext(ctx context.Context) grant {
+	g, _ := ctx.Value(grantKey{}).(grant)
+	return g
+}
+
+// authorize returns the plugin which checks whether the current user has the
+// permission of the resource requested. All HTTP APIs authed by jwt or auth key
+// go through it.
+func (s *server) authorize(perm permission) fn.PluginFunc {
+	return func(ctx context.Context, r *http.Request) (context.Context, error) {
+		userID := models.ID(jwt.UserIDFromContext(ctx))
+		vars := Vars(mux.Vars(r))
+
+		var g grant
+		err := db.Tx(func(tx *gorm.DB) error {
+			var err error
+			g, err = checkPermission(tx, userID, perm, vars)
+			return err
+		})
+		if err != nil {
+			return ctx, err
+		}
+		return context.WithValue(ctx, grantKey{}, g), nil
+	}
+}
+
+// checkPermission checks the permission of the user to the resource identified
+// by the route variables.
+func checkPermission(tx *gorm.DB, userID models.ID, perm permission, vars Vars) (grant, error) {
+	switch perm {
+	case permUser:
+		return grant{}, nil
+
+	case permUserRead:
+		memberID := vars.ModelID("user_id")
+		if memberID == 0 {
+			return grant{}, errcode.ErrIllegalRequest
+		}
+		return grant{}, checkUserRead(tx, userID, memberID)
+
+	case permNetworkRead, permNetworkAdmin, permNetworkOwner:
+		networkID := vars.ModelID("network_id")
+		if networkID == 0 {
+			return grant{}, errcode.ErrIllegalRequest
+		}
+		role, orgRole, err := networkRole(tx, userID, networkID)
+		if err != nil {
+			return grant{}, err
+		}
+		required := map[permission]models.RoleType{
+			permNetworkRead:  models.RoleTypeMember,
+			permNetworkAdmin: models.RoleTypeAdmin,
+			permNetworkOwner: models.RoleTypeOwner,
+		}[perm]
+		if networkRoleRank[role] < networkRoleRank[required] {
+			return grant{}, errcode.ErrIllegalOperation
+		}
+		return grant{networkRole: role, organizationRole: orgRole}, nil
+
+	case permDeviceRead, permDeviceAdmin, permDeviceOwner:
+		deviceID := vars.ModelID("device_id")
+		if deviceID == 0 {
+			return grant{}, errcode.ErrIllegalRequest
+		}
+		return checkDevice(tx, userID, deviceID, perm)
+
+	case permKeyOwner:
+		keyID := vars.ModelID("key_id")
+		if keyID == 0 {
+			return grant{}, errcode.ErrIllegalRequest
+		}
+		var key models.AuthKey
+		if err := models.NewAuthKeyQuerySet(tx).IDEq(keyID).One(&key); err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return grant{}, errcode.ErrNotFound
+			}
+			return grant{}, err
+		}
+		orgRole, err := models.OrganizationRole(tx, key.OrganizationID, userID)
+		if err != nil {
+			return grant{}, err
+		}
+		if key.UserID != userID && orgRole != models.OrganizationRoleAdmin {
+			return grant{}, errcode.ErrIllegalOperation
+		}
+		return grant{organizationRole: orgRole}, nil
+
+	case permOrganizationRead, permOrganizationAudit, permOrganizationAdmin:
+		organizationID := vars.ModelID("organization_id")
+		if organizationID == 0 {
+			return grant{}, errcode.ErrIllegalRequest
+		}
+		orgRole, err := models.OrganizationRole(tx, organizationID, userID)
+		if err != nil {
+			return grant{}, err
+		}
+		allowed := map[permission][]models.OrganizationRoleType{
+			permOrganizationRead: {
+				models.OrganizationRoleAdmin,
+				models.OrganizationRoleNetworkAdmin,
+				models.OrganizationRoleAuditor,
+				models.OrganizationRoleMember,
+			},
+			permOrganizationAudit: {
+				models.OrganizationRoleAdmin,
+				models.OrganizationRoleNetworkAdmin,
+				models.OrganizationRoleAuditor,
+			},
+			permOrganizationAdmin: {models.OrganizationRoleAdmin},
+		}[perm]
+		if !hasOrganizationRole(orgRole, allowed...) {
+			return grant{}, errcode.ErrIllegalOperation
+		}
+		return grant{organizationRole: orgRole}, nil
+	}
+	return grant{}, errcode.ErrIllegalOperation
+}
+
+// networkRole returns the effective role of the user in the network. The admin
+// of the organization which owns the network is treated as the owner, the
+// network admin of the organization is treated as the admin, and the auditor
+// of the organization can read the network as a member.
+func networkRole(tx *gorm.DB, userID, networkID models.ID) (models.RoleType, models.OrganizationRoleType, error) {
+	var network models.Network
+	if err := models.NewNetworkQuerySet(tx).IDEq(networkID).One(&network); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return "", "", errcode.ErrNotFound
+		}
+		return "", "", err
+	}
+
+	var role models.RoleType
+	var networkUser models.NetworkUser
+	err := models.NewNetworkUserQuerySet(tx).NetworkIDEq(networkID).UserIDEq(userID).One(&networkUser)
+	switch err {
+	case nil:
+		role = networkUser.Role
+	case gorm.ErrRecordNotFound:
+	default:
+		return "", "", err
+	}
+
+	orgRole, err := models.OrganizationRole(tx, network.OrganizationID, userID)
+	if err != nil {
+		return "", "", err
+	}
+	if r := organizationNetworkRole[orgRole]; networkRoleRank[r] > networkRoleRank[role] {
+		role = r
+	}
+	return role, orgRole, nil
+}
+
+// checkUserRead checks whether the user can read the resources of the member.
+func checkUserRead(tx *gorm.DB, userID, memberID models.ID) error {
+	if userID == memberID {
+		return nil
+	}
+	isPeer, err := models.IsNetworkPeerOf(tx, userID, memberID)
+	if err != nil || isPeer {
+		return err
+	}
+	isAuditor, err := models.IsOrganizationRoleOf(tx, userID, memberID,
+		models.OrganizationRoleAdmin, models.OrganizationRoleAuditor)
+	if err != nil {
+		return err
+	}
+	if !isAuditor {
+		return errcode.ErrIllegalOperation
+	}
+	return nil
+}
+
+// checkDevice checks the permission of the user to the device. Only the owner
+// and admin of the networks which the device owner belongs to can manage the
+// subnet routes of the device. The device owner can manage its own subnet routes
+// if no networks joined, because the subnet routes are only visible to itself.
+// The organization roles are applied to the devices of the organization.
+func checkDevice(tx *gorm.DB, userID, deviceID models.ID, perm permission) (grant, error) {
+	var device models.Device
+	if err := models.NewDeviceQuerySet(tx).IDEq(deviceID).One(&device); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return grant{}, errcode.ErrNotFound
+		}
+		return grant{}, err
+	}
+
+	orgRole, err := models.OrganizationRole(tx, device.OrganizationID, userID)
+	if err != nil {
+		return grant{}, err
+	}
+	g := grant{organizationRole: orgRole}
+
+	if perm == permDeviceOwner {
+		if device.UserID != userID && orgRole != models.OrganizationRoleAdmin {
+			return grant{}, errcode.ErrIllegalOperation
+		}
+		return g, nil
+	}
+
+	allowed := []models.OrganizationRoleType{models.OrganizationRoleAdmin, models.OrganizationRoleNetworkAdmin}
+	if perm == permDeviceRead {
+		allowed = append(allowed, models.OrganizationRoleAuditor)
+	}
+	if hasOrganizationRole(orgRole, allowed...) {
+		return g, nil
+	}
+
+	if device.UserID == userID {
+		count, err := models.NewNetworkUserQuerySet(tx).UserIDEq(userID).Count()
+		if err != nil {
+			return grant{}, err
+		}
+		if count == 0 {
+			return g, nil
+		}
+	}
+
+	isAdmin, err := models.IsNetworkAdminOf(tx, userID, device.UserID)
+	if err != nil {
+		return grant{}, err
+	}
+	if !isAdmin {
+		return grant{}, errcode.ErrIllegalOperation
+	}
+	return g, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pairmesh/pairmesh/errcode"
+	"github.com/pairmesh/pairmesh/portal/db/dbtest"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPermission(t *testing.T) {
+	a := assert.New(t)
+	tx := dbtest.SQLite(t)
+
+	var alice, bob, carol, dave, erin models.User
+	for _, u := range []*models.User{&alice, &bob, &carol, &dave, &erin} {
+		a.Nil(u.Create(tx))
+	}
+	org := &models.Organization{CreatedByID: alice.ID, Name: "acme"}
+	a.Nil(org.Create(tx))
+	a.Nil((&models.OrganizationUser{OrganizationID: org.ID, UserID: alice.ID, Role: models.OrganizationRoleAdmin}).Create(tx))
+	a.Nil((&models.OrganizationUser{OrganizationID: org.ID, UserID: bob.ID, Role: models.OrganizationRoleAuditor}).Create(tx))
+	a.Nil((&models.OrganizationUser{OrganizationID: org.ID, UserID: carol.ID, Role: models.OrganizationRoleMember}).Create(tx))
+
+	network := &models.Network{CreatedByID: carol.ID, OrganizationID: org.ID, Name: "office"}
+	a.Nil(network.Create(tx))
+	a.Nil((&models.NetworkUser{NetworkID: network.ID, UserID: carol.ID, Role: models.RoleTypeMember}).Create(tx))
+	a.Nil((&models.NetworkUser{NetworkID: network.ID, UserID: erin.ID, Role: models.RoleTypeAdmin}).Create(tx))
+	device := &models.Device{UserID: carol.ID, OrganizationID: org.ID, LastSeen: time.Now()}
+	a.Nil(device.Create(tx))
+
+	id := func(v models.ID) string { return strconv.FormatUint(uint64(v), 10) }
+	networkVars := Vars{"network_id": id(network.ID)}
+	deviceVars := Vars{"device_id": id(device.ID)}
+	orgVars := Vars{"organization_id": id(org.ID)}
+
+	cases := []struct {
+		user models.ID
+		perm permission
+		vars Vars
+		err  error
+	}{
+		// The organization roles are applied to the networks of the organization.
+		{alice.ID, permNetworkOwner, networkVars, nil},
+		{bob.ID, permNetworkRead, networkVars, nil},
+		{bob.ID, permNetworkAdmin, networkVars, errcode.ErrIllegalOperation},
+		{carol.ID, permNetworkRead, networkVars, nil},
+		{carol.ID, permNetworkAdmin, networkVars, errcode.ErrIllegalOperation},
+		{dave.ID, permNetworkRead, networkVars, errcode.ErrIllegalOperation},
+		{dave.ID, permNetworkRead, Vars{}, errcode.ErrIllegalRequest},
+
+		{alice.ID, permDeviceOwner, deviceVars, nil},
+		{bob.ID, permDeviceRead, deviceVars, nil},
+		{bob.ID, permDeviceAdmin, deviceVars, errcode.ErrIllegalOperation},
+		{dave.ID, permDeviceOwner, deviceVars, errcode.ErrIllegalOperation},
+		// The network admins manage the subnet routes, but only the owner and
+		// the organization admin revoke, restore and delete the device.
+		{carol.ID, permDeviceOwner, deviceVars, nil},
+		{erin.ID, permDeviceAdmin, deviceVars, nil},
+		{erin.ID, permDeviceOwner, deviceVars, errcode.ErrIllegalOperation},
+
+		{alice.ID, permOrganizationAdmin, orgVars, nil},
+		{bob.ID, permOrganizationAudit, orgVars, nil},
+		{bob.ID, permOrganizationAdmin, orgVars, errcode.ErrIllegalOperation},
+		{carol.ID, permOrganizationRead, orgVars, nil},
+		{carol.ID, permOrganizationAudit, orgVars, errcode.ErrIllegalOperation},
+		{dave.ID, permOrganizationRead, orgVars, errcode.ErrIllegalOperation},
+
+		{bob.ID, permUserRead, Vars{"user_id": id(carol.ID)}, nil},
+		{carol.ID, permUserRead, Vars{"user_id": id(alice.ID)}, errcode.ErrIllegalOperation},
+		{dave.ID, permUser, Vars{}, nil},
+	}
+	for i, c := range cases {
+		_, err := checkPermission(tx, c.user, c.perm, c.vars)
+		a.Equal(c.err, err, "case %d", i)
+	}
+
+	g, err := checkPermission(tx, alice.ID, permNetworkRead, networkVars)
+	a.Nil(err)
+	a.Equal(models.RoleTypeOwner, g.networkRole)
+	a.Equal(models.OrganizationRoleAdmin, g.organizationRole)
+}
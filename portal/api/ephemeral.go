@@ -0,0 +1,102 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/pairmesh/pairmesh/portal/db"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ephemeralCheckInterval is the interval to check the offline ephemeral devices.
+const ephemeralCheckInterval = time.Minute
+
+// removeEphemeralDevices removes the devices registered with the ephemeral keys
+// periodically if they have been offline for the ttl, which frees their
+// addresses and the device quotas of the owners.
+func (s *server) removeEphemeralDevices(ctx context.Context, ttl time.Duration) {
+	// The devices are assumed to be online within the AssumeOnlineDuration
+	// since the last seen time.
+	if ttl < models.AssumeOnlineDuration {
+		ttl = models.AssumeOnlineDuration
+	}
+
+	ticker := time.NewTicker(ephemeralCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.removeOfflineEphemeralDevices(time.Now().Add(-ttl)); err != nil {
+				zap.L().Error("Remove offline ephemeral devices failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// removeOfflineEphemeralDevices removes the ephemeral devices which are last
+// seen before the deadline.
+func (s *server) removeOfflineEphemeralDevices(deadline time.Time) error {
+	var devices []models.Device
+	var affected []models.ID
+	err := db.Tx(func(tx *gorm.DB) error {
+		var err error
+		devices, err = deleteOfflineEphemeralDevices(tx, deadline)
+		if err != nil {
+			return err
+		}
+		var owners []models.ID
+		for _, d := range devices {
+			owners = append(owners, d.UserID)
+		}
+		affected, err = models.PeerUserIDs(tx, owners...)
+		return err
+	})
+	if err != nil || len(devices) == 0 {
+		return err
+	}
+
+	for _, d := range devices {
+		s.relayCandidates.Delete(d.ID)
+		zap.L().Info("Remove offline ephemeral device", zap.Any("device_id", d.ID), zap.String("address", d.Address), zap.Time("last_seen", d.LastSeen))
+	}
+	s.graphNotifier.notify(affected...)
+	return nil
+}
+
+// deleteOfflineEphemeralDevices deletes the ephemeral devices which are last
+// seen before the deadline, and returns the deleted devices.
+func deleteOfflineEphemeralDevices(tx *gorm.DB, deadline time.Time) ([]models.Device, error) {
+	var devices []models.Device
+	err := models.NewDeviceQuerySet(tx).
+		EphemeralEq(true).
+		LastSeenLt(deadline).
+		All(&devices)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range devices {
+		if err := models.DeleteDevice(tx, d.ID); err != nil {
+			return nil, err
+		}
+	}
+	return devices, nil
+}
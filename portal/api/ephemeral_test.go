@@ -0,0 +1,60 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pairmesh/pairmesh/portal/db/dbtest"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteOfflineEphemeralDevices(t *testing.T) {
+	a := assert.New(t)
+	tx := dbtest.SQLite(t)
+
+	var user models.User
+	a.Nil(user.Create(tx))
+
+	now := time.Now()
+	devices := []*models.Device{
+		{UserID: user.ID, Name: "runner-offline", Address: "10.0.0.2", LastSeen: now.Add(-time.Hour), Ephemeral: true},
+		{UserID: user.ID, Name: "runner-online", Address: "10.0.0.3", LastSeen: now, Ephemeral: true},
+		{UserID: user.ID, Name: "laptop", Address: "10.0.0.4", LastSeen: now.Add(-time.Hour)},
+	}
+	for _, d := range devices {
+		a.Nil(d.Create(tx))
+	}
+	a.Nil(models.SyncDeviceRoutes(tx, devices[0].ID, []string{"192.168.1.0/24"}))
+
+	deleted, err := deleteOfflineEphemeralDevices(tx, now.Add(-30*time.Minute))
+	a.Nil(err)
+	a.Len(deleted, 1)
+	a.Equal(devices[0].ID, deleted[0].ID)
+
+	// The address and the device quota are freed.
+	count, err := models.NewDeviceQuerySet(tx).UserIDEq(user.ID).Count()
+	a.Nil(err)
+	a.Equal(int64(2), count)
+	count, err = models.NewDeviceQuerySet(tx).AddressEq("10.0.0.2").Count()
+	a.Nil(err)
+	a.Zero(count)
+	count, err = models.NewDeviceRouteQuerySet(tx).DeviceIDEq(devices[0].ID).Count()
+	a.Nil(err)
+	a.Zero(count)
+}
@@ -0,0 +1,165 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pairmesh/pairmesh/pkg/fsutil"
+	"github.com/pairmesh/pairmesh/portal/config"
+	"github.com/pairmesh/pairmesh/security"
+	"go.uber.org/zap"
+)
+
+// defaultPrivateKeyPath is the path of the private key which is generated
+// automatically if the private key path is not specified.
+const defaultPrivateKeyPath = "pairportal.ed25519.pem"
+
+// loadKeyRing loads the active private key and the retiring keys to sign and
+// verify the credentials. To rotate the key, move the path of the active key to
+// the retiring keys and specify a new private key. The retiring key can be
+// removed after the credential lease since all credentials have been renewed.
+func loadKeyRing(cfg *config.Config) (*security.KeyRing, error) {
+	if cfg.PrivateKey == "" {
+		path := defaultPrivateKeyPath
+		if fsutil.IsExists(path) {
+			zap.L().Info("Private key path is not specified, but found in default path", zap.String("path", path))
+		} else {
+			zap.L().Info("Private key path is not specified, generated automatically", zap.String("path", path))
+			if err := generatePrivateKey(path); err != nil {
+				return nil, fmt.Errorf("generate private key is failed: %w", err)
+			}
+		}
+		cfg.PrivateKey = path
+	}
+
+	active, err := readPrivateKey(cfg.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("read private key is failed: %w", err)
+	}
+
+	var retiring []ed25519.PublicKey
+	for _, path := range cfg.RetiringKeys {
+		key, err := readPublicKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("read retiring key is failed: %w", err)
+		}
+		retiring = append(retiring, key)
+	}
+
+	ring := security.NewKeyRing(active, retiring...)
+	zap.L().Info("Load the credential signing keys successfully",
+		zap.Stringer("active", ring.Active().ID),
+		zap.Int("retiring", len(retiring)))
+
+	return ring, nil
+}
+
+// errRSAKey is returned for the RSA keys of the previous releases, which signed
+// the credentials without a key ID and cannot be used in the key ring.
+func errRSAKey(path string) error {
+	return fmt.Errorf("%s is an RSA key which is no longer supported, regenerate an Ed25519 signing key "+
+		"(e.g. `openssl genpkey -algorithm ed25519`) or leave the private key unspecified to generate one automatically", path)
+}
+
+// generatePrivateKey generates an Ed25519 private key and saves it in the PKCS
+// #8 PEM format.
+func generatePrivateKey(path string) error {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	data, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: data}), 0600)
+}
+
+func readPrivateKey(path string) (ed25519.PrivateKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		return nil, errRSAKey(path)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch priv := key.(type) {
+	case ed25519.PrivateKey:
+		return priv, nil
+	case *rsa.PrivateKey:
+		return nil, errRSAKey(path)
+	default:
+		return nil, fmt.Errorf("%s is not an Ed25519 private key", path)
+	}
+}
+
+// readPublicKey reads the public key from the file of either the private key or
+// the public key.
+func readPublicKey(path string) (ed25519.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY", "RSA PUBLIC KEY":
+		return nil, errRSAKey(path)
+	case "PRIVATE KEY":
+		priv, err := readPrivateKey(path)
+		if err != nil {
+			return nil, err
+		}
+		return priv.Public().(ed25519.PublicKey), nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch pub := key.(type) {
+	case ed25519.PublicKey:
+		return pub, nil
+	case *rsa.PublicKey:
+		return nil, errRSAKey(path)
+	default:
+		return nil, fmt.Errorf("%s is not an Ed25519 public key", path)
+	}
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		// The previous releases saved the RSA key in the raw PKCS #1 DER format.
+		if _, err := x509.ParsePKCS1PrivateKey(data); err == nil {
+			return nil, errRSAKey(path)
+		}
+		return nil, fmt.Errorf("%s is not a PEM file", path)
+	}
+	return block, nil
+}
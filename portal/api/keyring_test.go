@@ -0,0 +1,71 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadRSAKey(t *testing.T) {
+	a := assert.New(t)
+
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	a.Nil(err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(priv)
+	a.Nil(err)
+	pkix, err := x509.MarshalPKIXPublicKey(priv.Public())
+	a.Nil(err)
+
+	dir := t.TempDir()
+	write := func(name string, data []byte) string {
+		path := filepath.Join(dir, name)
+		a.Nil(ioutil.WriteFile(path, data, 0600))
+		return path
+	}
+	pkcs1 := x509.MarshalPKCS1PrivateKey(priv)
+	der := write("der", pkcs1)
+	pkcs1PEM := write("pkcs1", pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: pkcs1}))
+	pkcs8PEM := write("pkcs8", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}))
+	pkixPEM := write("pkix", pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix}))
+
+	for _, path := range []string{der, pkcs1PEM, pkcs8PEM} {
+		_, err := readPrivateKey(path)
+		a.NotNil(err, path)
+		a.True(strings.Contains(err.Error(), "regenerate an Ed25519 signing key"), err.Error())
+	}
+	for _, path := range []string{der, pkcs1PEM, pkcs8PEM, pkixPEM} {
+		_, err := readPublicKey(path)
+		a.NotNil(err, path)
+		a.True(strings.Contains(err.Error(), "regenerate an Ed25519 signing key"), err.Error())
+	}
+
+	// The Ed25519 key is still accepted.
+	path := filepath.Join(dir, "ed25519")
+	a.Nil(generatePrivateKey(path))
+	key, err := readPrivateKey(path)
+	a.Nil(err)
+	pub, err := readPublicKey(path)
+	a.Nil(err)
+	a.Equal(key.Public(), pub)
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"time"
+
+	"github.com/pairmesh/pairmesh/portal/db/models"
+	"github.com/pairmesh/pairmesh/protocol"
+)
+
+// peerGraphCacheTTL is the maximum duration to cache the peer graph of a user.
+// The cached peer graphs are invalidated by the changes notified, and the TTL
+// bounds the staleness of the time-based fields, e.g: the active status.
+const peerGraphCacheTTL = 30 * time.Second
+
+// cachedGraph is the peer graph computed for a user.
+type cachedGraph struct {
+	version  uint64
+	expireAt time.Time
+	graph    *protocol.PeerGraphResponse
+}
+
+// cachedPeerGraph returns the peer graph of the user with the unique hash, and
+// the peer graph is computed only if the cached one is outdated. The returned
+// peer graph is shared and must not be modified, except the top level fields.
+func (s *server) cachedPeerGraph(userID models.ID) (*protocol.PeerGraphResponse, error) {
+	return s.loadPeerGraph(userID, s.peerGraph)
+}
+
+// loadPeerGraph returns the cached peer graph of the user if it's not outdated
+// by the changes of the user, otherwise the peer graph is computed.
+func (s *server) loadPeerGraph(userID models.ID, compute func(models.ID) (*protocol.PeerGraphResponse, error)) (*protocol.PeerGraphResponse, error) {
+	// Retrieve the version before computing the peer graph, so the changes
+	// happened during computing invalidate the cached peer graph.
+	version := s.graphNotifier.currentVersion(userID)
+	if v, found := s.graphCache.Load(userID); found {
+		cached := v.(cachedGraph)
+		if cached.version == version && time.Now().Before(cached.expireAt) {
+			graph := *cached.graph
+			return &graph, nil
+		}
+		s.graphCache.Delete(userID)
+	}
+
+	graph, err := compute(userID)
+	if err != nil {
+		return nil, err
+	}
+	graph.UniqueHash = peerGraphHash(graph)
+	s.graphCache.Store(userID, cachedGraph{
+		version:  version,
+		expireAt: time.Now().Add(peerGraphCacheTTL),
+		graph:    graph,
+	})
+
+	res := *graph
+	return &res, nil
+}
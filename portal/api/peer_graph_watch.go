@@ -0,0 +1,287 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pairmesh/pairmesh/pkg/jwt"
+	"github.com/pairmesh/pairmesh/portal/db"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+	"github.com/pairmesh/pairmesh/protocol"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// peerGraphWatchTimeout is the maximum duration of a long polling request to
+// watch the peer graph, which must be shorter than the timeout of nodes.
+const peerGraphWatchTimeout = 50 * time.Second
+
+// graphSnapshotTTL is the duration to keep the peer graph pushed to a device
+// after its last watch request, and the device receives the complete peer
+// graph if it watches again after the snapshot is evicted.
+const graphSnapshotTTL = 3 * peerGraphWatchTimeout
+
+type (
+	// graphNotifier notifies the watchers of the peer graphs once there is any
+	// change which may affect the peer graphs of the users. The watchers compare
+	// the latest peer graph with the known one, so a false notification is
+	// harmless.
+	graphNotifier struct {
+		mu sync.Mutex
+		// models.ID (user) -> the channel closed on the next change
+		chs map[models.ID]chan struct{}
+		// seq is increased on every notification, and the versions record
+		// the sequence of the latest notification of every user, which are
+		// used to invalidate the cached peer graphs of the users. The users
+		// absent from the versions are at the version of the latest
+		// notification of all users.
+		seq      uint64
+		all      uint64
+		versions map[models.ID]uint64
+	}
+
+	// graphSnapshot is the latest peer graph pushed to a device, which is used
+	// as the base of the next delta.
+	graphSnapshot struct {
+		hash     string
+		graph    *protocol.PeerGraphResponse
+		accessAt time.Time
+	}
+)
+
+func newGraphNotifier() *graphNotifier {
+	return &graphNotifier{
+		chs:      map[models.ID]chan struct{}{},
+		versions: map[models.ID]uint64{},
+	}
+}
+
+// changed returns a channel which is closed on the next change of the peer
+// graph of the user.
+func (n *graphNotifier) changed(userID models.ID) <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	ch, found := n.chs[userID]
+	if !found {
+		ch = make(chan struct{})
+		n.chs[userID] = ch
+	}
+	return ch
+}
+
+// currentVersion returns the version of the latest notification of the user.
+func (n *graphNotifier) currentVersion(userID models.ID) uint64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if v, found := n.versions[userID]; found {
+		return v
+	}
+	return n.all
+}
+
+// notify wakes up the watchers of the users.
+func (n *graphNotifier) notify(userIDs ...models.ID) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.seq++
+	for _, id := range userIDs {
+		if ch, found := n.chs[id]; found {
+			close(ch)
+			delete(n.chs, id)
+		}
+		n.versions[id] = n.seq
+	}
+}
+
+// notifyAll wakes up all watchers, which is used by the changes affecting the
+// peer graphs of all users, e.g: the relay servers.
+func (n *graphNotifier) notifyAll() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for _, ch := range n.chs {
+		close(ch)
+	}
+	n.chs = map[models.ID]chan struct{}{}
+	n.seq++
+	n.all = n.seq
+	n.versions = map[models.ID]uint64{}
+}
+
+// onMembershipsChanged notifies the watchers of the networks which the user
+// joins or leaves by the memberships granted by the identity providers.
+func (s *server) onMembershipsChanged(userID models.ID, networkIDs []models.ID) {
+	var affected []models.ID
+	err := db.Tx(func(tx *gorm.DB) error {
+		var err error
+		affected, err = models.NetworkMemberIDs(tx, networkIDs...)
+		return err
+	})
+	if err != nil {
+		zap.L().Error("Retrieve the members of networks failed", zap.Error(err))
+	}
+	// The user may have left the networks.
+	s.graphNotifier.notify(append(affected, userID)...)
+}
+
+// sweepGraphSnapshots evicts the snapshots of the devices which stop watching
+// the peer graph periodically until the context is done.
+func (s *server) sweepGraphSnapshots(ctx context.Context) {
+	ticker := time.NewTicker(graphSnapshotTTL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.evictGraphSnapshots(time.Now().Add(-graphSnapshotTTL))
+		}
+	}
+}
+
+// evictGraphSnapshots removes the snapshots of the devices which haven't
+// watched the peer graph since the deadline.
+func (s *server) evictGraphSnapshots(deadline time.Time) {
+	s.graphSnapshots.Range(func(key, value interface{}) bool {
+		if value.(graphSnapshot).accessAt.Before(deadline) {
+			s.graphSnapshots.Delete(key)
+		}
+		return true
+	})
+}
+
+// peerGraphHash returns the hash which identifies the content of the peer graph.
+func peerGraphHash(graph *protocol.PeerGraphResponse) string {
+	content := protocol.PeerGraphResponse{
+		RelayServers: graph.RelayServers,
+		Peers:        graph.Peers,
+		Networks:     graph.Networks,
+		ACL:          graph.ACL,
+	}
+	data, err := json.Marshal(content)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// peerGraphDelta returns the changes from the base peer graph to the latest one.
+func peerGraphDelta(base, latest *protocol.PeerGraphResponse) *protocol.PeerGraphDelta {
+	delta := &protocol.PeerGraphDelta{}
+
+	basePeers := make(map[protocol.PeerID]protocol.Peer, len(base.Peers))
+	for _, p := range base.Peers {
+		basePeers[p.ID] = p
+	}
+	for _, p := range latest.Peers {
+		prev, found := basePeers[p.ID]
+		switch {
+		case !found:
+			delta.Events = append(delta.Events, protocol.PeerEvent{Type: protocol.PeerEventAdd, Peer: p})
+		case !reflect.DeepEqual(prev, p):
+			delta.Events = append(delta.Events, protocol.PeerEvent{Type: protocol.PeerEventUpdate, Peer: p})
+		}
+		delete(basePeers, p.ID)
+	}
+	for _, p := range base.Peers {
+		if _, found := basePeers[p.ID]; found {
+			delta.Events = append(delta.Events, protocol.PeerEvent{Type: protocol.PeerEventRemove, Peer: p})
+		}
+	}
+
+	if !reflect.DeepEqual(base.RelayServers, latest.RelayServers) ||
+		!reflect.DeepEqual(base.Networks, latest.Networks) ||
+		!reflect.DeepEqual(base.ACL, latest.ACL) {
+		delta.TopologyChanged = true
+		delta.RelayServers = latest.RelayServers
+		delta.Networks = latest.Networks
+		delta.ACL = latest.ACL
+	}
+	return delta
+}
+
+// WatchPeerGraph waits until the peer graph of the device differs from the one
+// identified by the hash, and responds the changes. The complete peer graph is
+// responded if the known peer graph of the device is absent.
+func (s *server) WatchPeerGraph(ctx context.Context, r *http.Request) (*protocol.PeerGraphDelta, error) {
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+	key := fmt.Sprintf("%d/%s", userID, jwt.MachineIDFromContext(ctx))
+	hash := r.URL.Query().Get("hash")
+	deviceID, err := requestDeviceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := time.NewTimer(peerGraphWatchTimeout)
+	defer timeout.Stop()
+
+	for {
+		// Retrieve the notification channel before the peer graph to avoid
+		// missing the changes happened during building the peer graph.
+		// The version of the cached peer graph is retrieved afterwards, so a
+		// cached peer graph is never older than the channel.
+		changed := s.graphNotifier.changed(userID)
+
+		graph, err := s.cachedPeerGraph(userID)
+		if err != nil {
+			return nil, err
+		}
+		graph = devicePeerGraph(graph, userID, deviceID)
+		latest := graph.UniqueHash
+
+		v, found := s.graphSnapshots.Load(key)
+		if latest != hash {
+			var delta *protocol.PeerGraphDelta
+			if found && hash != "" && v.(graphSnapshot).hash == hash {
+				delta = peerGraphDelta(v.(graphSnapshot).graph, graph)
+			} else {
+				delta = &protocol.PeerGraphDelta{Full: graph}
+			}
+			delta.BaseHash = hash
+			delta.UniqueHash = latest
+			s.graphSnapshots.Store(key, graphSnapshot{hash: latest, graph: graph, accessAt: time.Now()})
+			return delta, nil
+		}
+		// The snapshot is refreshed to keep it from being evicted while the
+		// device is watching.
+		s.graphSnapshots.Store(key, graphSnapshot{hash: latest, graph: graph, accessAt: time.Now()})
+
+		select {
+		case <-changed:
+		case <-timeout.C:
+			return &protocol.PeerGraphDelta{BaseHash: hash, UniqueHash: hash}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pairmesh/pairmesh/portal/db/models"
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerGraphDelta(t *testing.T) {
+	a := assert.New(t)
+
+	base := &protocol.PeerGraphResponse{
+		RelayServers: []protocol.RelayServer{{ID: 1}},
+		Peers: []protocol.Peer{
+			{ID: 1, Name: "a"},
+			{ID: 2, Name: "b"},
+			{ID: 3, Name: "c"},
+		},
+		Networks: []protocol.Network{{ID: 1, Peers: []protocol.PeerID{1, 2, 3}}},
+	}
+	latest := &protocol.PeerGraphResponse{
+		RelayServers: base.RelayServers,
+		Peers: []protocol.Peer{
+			{ID: 1, Name: "a"},
+			{ID: 2, Name: "b2"},
+			{ID: 4, Name: "d"},
+		},
+		Networks: base.Networks,
+	}
+
+	a.Equal(peerGraphHash(base), peerGraphHash(&protocol.PeerGraphResponse{
+		UniqueHash:   "ignored",
+		RelayServers: base.RelayServers,
+		Peers:        base.Peers,
+		Networks:     base.Networks,
+	}))
+	a.NotEqual(peerGraphHash(base), peerGraphHash(latest))
+
+	delta := peerGraphDelta(base, latest)
+	a.False(delta.TopologyChanged)
+	a.Equal([]protocol.PeerEvent{
+		{Type: protocol.PeerEventUpdate, Peer: protocol.Peer{ID: 2, Name: "b2"}},
+		{Type: protocol.PeerEventAdd, Peer: protocol.Peer{ID: 4, Name: "d"}},
+		{Type: protocol.PeerEventRemove, Peer: protocol.Peer{ID: 3, Name: "c"}},
+	}, delta.Events)
+
+	latest.Networks = []protocol.Network{{ID: 1, Peers: []protocol.PeerID{1, 2, 4}}}
+	delta = peerGraphDelta(base, latest)
+	a.True(delta.TopologyChanged)
+	a.Equal(latest.Networks, delta.Networks)
+}
+
+func TestCachedPeerGraph(t *testing.T) {
+	a := assert.New(t)
+
+	s := &server{graphNotifier: newGraphNotifier()}
+	computed := 0
+	compute := func(userID models.ID) (*protocol.PeerGraphResponse, error) {
+		computed++
+		return &protocol.PeerGraphResponse{Peers: []protocol.Peer{{ID: protocol.PeerID(userID)}}}, nil
+	}
+
+	graph, err := s.loadPeerGraph(1, compute)
+	a.Nil(err)
+	a.Equal(1, computed)
+	a.NotEmpty(graph.UniqueHash)
+
+	// The top level fields can be modified without affecting the cache.
+	graph.NotModified = true
+	cached, err := s.loadPeerGraph(1, compute)
+	a.Nil(err)
+	a.Equal(1, computed)
+	a.False(cached.NotModified)
+	a.Equal(graph.UniqueHash, cached.UniqueHash)
+
+	// The changes of other users don't invalidate the cached peer graph.
+	_, err = s.loadPeerGraph(2, compute)
+	a.Nil(err)
+	a.Equal(2, computed)
+	s.graphNotifier.notify(2)
+	_, err = s.loadPeerGraph(1, compute)
+	a.Nil(err)
+	a.Equal(2, computed)
+
+	// The cached peer graph is invalidated by the changes of the user.
+	s.graphNotifier.notify(1)
+	_, err = s.loadPeerGraph(1, compute)
+	a.Nil(err)
+	a.Equal(3, computed)
+	_, err = s.loadPeerGraph(1, compute)
+	a.Nil(err)
+	a.Equal(3, computed)
+
+	// The changes of all users invalidate all cached peer graphs.
+	s.graphNotifier.notifyAll()
+	_, err = s.loadPeerGraph(1, compute)
+	a.Nil(err)
+	_, err = s.loadPeerGraph(2, compute)
+	a.Nil(err)
+	a.Equal(5, computed)
+
+	// The expired peer graph is computed again.
+	v, _ := s.graphCache.Load(models.ID(1))
+	expired := v.(cachedGraph)
+	expired.expireAt = time.Now()
+	s.graphCache.Store(models.ID(1), expired)
+	_, err = s.loadPeerGraph(1, compute)
+	a.Nil(err)
+	a.Equal(6, computed)
+}
+
+func TestGraphNotifier(t *testing.T) {
+	a := assert.New(t)
+
+	n := newGraphNotifier()
+	alice, bob := n.changed(1), n.changed(2)
+
+	// Only the watchers of the affected users are woken up.
+	n.notify(1)
+	a.True(isClosed(alice))
+	a.False(isClosed(bob))
+	a.False(isClosed(n.changed(1)))
+
+	n.notifyAll()
+	a.True(isClosed(bob))
+}
+
+func TestEvictGraphSnapshots(t *testing.T) {
+	a := assert.New(t)
+
+	s := &server{}
+	now := time.Now()
+	s.graphSnapshots.Store("1/watching", graphSnapshot{hash: "a", accessAt: now})
+	s.graphSnapshots.Store("1/gone", graphSnapshot{hash: "b", accessAt: now.Add(-2 * graphSnapshotTTL)})
+
+	s.evictGraphSnapshots(now.Add(-graphSnapshotTTL))
+	_, found := s.graphSnapshots.Load("1/watching")
+	a.True(found)
+	_, found = s.graphSnapshots.Load("1/gone")
+	a.False(found)
+}
+
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
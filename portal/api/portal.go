@@ -15,32 +15,30 @@ package api
 
 import (
 	"context"
-	"crypto/rand"
-	"crypto/rsa"
-	"crypto/x509"
 	"fmt"
-	"io/ioutil"
 	"net"
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pairmesh/pairmesh/internal/ledis"
 
 	// grouped for init
-	"github.com/pairmesh/pairmesh/pkg/fsutil"
 	"github.com/pairmesh/pairmesh/pkg/jwt"
 	"github.com/pairmesh/pairmesh/portal/config"
 	"github.com/pairmesh/pairmesh/portal/db"
+	"github.com/pairmesh/pairmesh/portal/release"
 	"github.com/pairmesh/pairmesh/portal/sso"
 
-	// Need this anonymous import because we relay on the github.init() func to register sso provider.
+	// Need these anonymous imports because we relay on the init() func to register sso providers.
 	_ "github.com/pairmesh/pairmesh/portal/sso/github"
+	_ "github.com/pairmesh/pairmesh/portal/sso/oidc"
 
 	"go.uber.org/zap"
 )
 
-func serveHTTP(cfg *config.Config) (*http.Server, error) {
+func serveHTTP(ctx context.Context, cfg *config.Config) (*http.Server, error) {
 	setupMiddleware()
 
 	// Preflight the database
@@ -49,8 +47,8 @@ func serveHTTP(cfg *config.Config) (*http.Server, error) {
 		return nil, fmt.Errorf("initialize sso is failed: %w", err)
 	}
 
-	if err = db.Initialize(cfg.MySQL); err != nil {
-		return nil, fmt.Errorf("initialize mysql is failed: %w", err)
+	if err = db.Initialize(cfg.DatabaseConfig()); err != nil {
+		return nil, fmt.Errorf("initialize database is failed: %w", err)
 	}
 
 	err = ledis.Initialize(cfg.DataDir)
@@ -69,52 +67,35 @@ func serveHTTP(cfg *config.Config) (*http.Server, error) {
 		return nil, fmt.Errorf("initialize jwt is failed: %w", err)
 	}
 
-	// Load the private key
-	var key *rsa.PrivateKey
-	if cfg.PrivateKey == "" {
-		path := "pairportal.private.pem"
-		if fsutil.IsExists(path) {
-			zap.L().Info("Private privateKey path is not specified, but found in default path", zap.String("path", path))
-			data, err := ioutil.ReadFile(path)
-			if err != nil {
-				return nil, fmt.Errorf("read private key is failed: %w", err)
-			}
-			key, err = x509.ParsePKCS1PrivateKey(data)
-			if err != nil {
-				return nil, fmt.Errorf("parse private key is failed: %w", err)
-			}
-
-		} else {
-			zap.L().Info("Private privateKey path is not specified, generated automatically", zap.String("path", path))
-			priv, err := rsa.GenerateKey(rand.Reader, 512)
-			if err != nil {
-				return nil, fmt.Errorf("generate private key is failed: %w", err)
-			}
-			data := x509.MarshalPKCS1PrivateKey(priv)
-			err = ioutil.WriteFile(path, data, 0600)
-			if err != nil {
-				return nil, fmt.Errorf("save private key is failed: %w", err)
-			}
-			key = priv
-		}
-		cfg.PrivateKey = path
+	// Load the keys to sign the credentials
+	keyRing, err := loadKeyRing(cfg)
+	if err != nil {
+		return nil, err
 	}
 
 	// Trim sso redirect so that tailing "/" will be removed
 	redirect := strings.TrimRight(cfg.SSO.Redirect, "/")
 
 	var (
-		server    = newServer(cfg.Relay.AuthKey, key)
-		ssoServer = newSSOServer(redirect)
+		server    = newServer(cfg.Relay.AuthKey, keyRing, release.NewStore(cfg.ReleaseManifest))
+		ssoServer = newSSOServer(redirect, server.graphNotifier)
 
 		mux     = route(server, ssoServer)
 		address = fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
 	)
 
+	// The memberships granted by the identity providers change the peer graphs.
+	sso.ObserveMemberships(server.onMembershipsChanged)
+
 	if err := server.preload(); err != nil {
 		return nil, fmt.Errorf("preload data is failed: %w", err)
 	}
 
+	// Remove the ephemeral devices which have been offline for the TTL.
+	go server.removeEphemeralDevices(ctx, time.Duration(cfg.EphemeralDeviceTTL)*time.Second)
+	// Evict the peer graph snapshots of the devices which stop watching.
+	go server.sweepGraphSnapshots(ctx)
+
 	srv := &http.Server{
 		Handler: mux,
 	}
@@ -147,7 +128,7 @@ func serveHTTP(cfg *config.Config) (*http.Server, error) {
 func Serve(ctx context.Context, wg *sync.WaitGroup, cfg *config.Config) {
 	defer wg.Done()
 
-	srv, err := serveHTTP(cfg)
+	srv, err := serveHTTP(ctx, cfg)
 	if err != nil {
 		zap.L().Error("Serve http is failed", zap.Error(err))
 		return
@@ -0,0 +1,246 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/pairmesh/pairmesh/errcode"
+	"github.com/pairmesh/pairmesh/pkg/jwt"
+	"github.com/pairmesh/pairmesh/portal/db"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+	"github.com/pairmesh/pairmesh/protocol"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// relaySwitchMinGain and relaySwitchRatio are the hysteresis to switch the
+	// primary relay server of a node: the round-trip time of the candidate must
+	// be lower than the current one by at least the gain and the ratio.
+	relaySwitchMinGain = 20 * time.Millisecond
+	relaySwitchRatio   = 0.7
+
+	// relaySwitchRounds is the number of the consecutive latency reports which
+	// prefer the same candidate before switching to it.
+	relaySwitchRounds = 2
+)
+
+// relayCandidate records the relay server which is preferred by the latest
+// latency reports of a device.
+type relayCandidate struct {
+	serverID models.ID
+	rounds   int
+}
+
+// relayServerInfo converts the relay server model to the protocol structure.
+func relayServerInfo(r *models.RelayServer) protocol.RelayServer {
+	return protocol.RelayServer{
+		ID:        protocol.ServerID(r.ID),
+		Name:      r.Name,
+		Region:    r.Region,
+		Host:      r.Host,
+		Port:      r.Port,
+		STUNPort:  r.STUNPort,
+		PublicKey: r.PublicKey,
+	}
+}
+
+// isActiveRelayServer returns whether the relay server keeps alive recently.
+func (s *server) isActiveRelayServer(id models.ID) bool {
+	v, found := s.relayServers.byID.Load(id)
+	if !found {
+		return false
+	}
+	return time.Since(v.(*models.RelayServer).KeepaliveAt) <= relayServerExpiration
+}
+
+// relayAssigned returns whether the relay server is assigned to the organization
+// of the device, and all relay servers are available if none is assigned.
+func relayAssigned(assigned []models.ID, id models.ID) bool {
+	if len(assigned) == 0 {
+		return true
+	}
+	for _, a := range assigned {
+		if a == id {
+			return true
+		}
+	}
+	return false
+}
+
+// fastestRelayServer returns the active relay server with the lowest latency.
+func (s *server) fastestRelayServer(assigned []models.ID, latencies []protocol.RelayLatency) (protocol.RelayLatency, bool) {
+	var (
+		fastest protocol.RelayLatency
+		found   bool
+	)
+	for _, l := range latencies {
+		if l.RTT <= 0 || !relayAssigned(assigned, models.ID(l.ServerID)) || !s.isActiveRelayServer(models.ID(l.ServerID)) {
+			continue
+		}
+		if !found || l.RTT < fastest.RTT {
+			fastest, found = l, true
+		}
+	}
+	return fastest, found
+}
+
+// selectRelayServerID selects the primary relay server for a node from the
+// assigned relay servers. The active relay server with the lowest latency is
+// preferred, and a random active relay server is selected if no latency is
+// reported.
+func (s *server) selectRelayServerID(assigned []models.ID, latencies []protocol.RelayLatency) models.ID {
+	if fastest, found := s.fastestRelayServer(assigned, latencies); found {
+		return models.ID(fastest.ServerID)
+	}
+
+	var active, all []models.ID
+	s.relayServers.byID.Range(func(key, value interface{}) bool {
+		id := value.(*models.RelayServer).ID
+		if !relayAssigned(assigned, id) {
+			return true
+		}
+		all = append(all, id)
+		if s.isActiveRelayServer(id) {
+			active = append(active, id)
+		}
+		return true
+	})
+	if len(active) > 0 {
+		return active[rand.Intn(len(active))]
+	}
+	if len(all) > 0 {
+		return all[rand.Intn(len(all))]
+	}
+	if len(assigned) > 0 {
+		// None of the assigned relay servers exists.
+		return s.selectRelayServerID(nil, latencies)
+	}
+	return 0
+}
+
+// preferRelayServerID returns the primary relay server of the device according
+// to the reported latencies. The primary relay server is only switched if it
+// is offline, unreachable by the device, no longer assigned, or a clearly better
+// relay server is preferred by several consecutive reports.
+func (s *server) preferRelayServerID(deviceID, current models.ID, assigned []models.ID, latencies []protocol.RelayLatency) models.ID {
+	if !relayAssigned(assigned, current) {
+		s.relayCandidates.Delete(deviceID)
+		return s.selectRelayServerID(assigned, latencies)
+	}
+
+	fastest, found := s.fastestRelayServer(assigned, latencies)
+	if !found || models.ID(fastest.ServerID) == current {
+		s.relayCandidates.Delete(deviceID)
+		return current
+	}
+
+	var currentRTT time.Duration
+	for _, l := range latencies {
+		if models.ID(l.ServerID) == current {
+			currentRTT = l.RTT
+		}
+	}
+	if currentRTT <= 0 || !s.isActiveRelayServer(current) {
+		s.relayCandidates.Delete(deviceID)
+		return models.ID(fastest.ServerID)
+	}
+
+	if !clearlyBetter(fastest.RTT, currentRTT) {
+		s.relayCandidates.Delete(deviceID)
+		return current
+	}
+
+	candidate := relayCandidate{serverID: models.ID(fastest.ServerID), rounds: 1}
+	if v, found := s.relayCandidates.Load(deviceID); found {
+		if prev := v.(relayCandidate); prev.serverID == candidate.serverID {
+			candidate.rounds = prev.rounds + 1
+		}
+	}
+	if candidate.rounds < relaySwitchRounds {
+		s.relayCandidates.Store(deviceID, candidate)
+		return current
+	}
+	s.relayCandidates.Delete(deviceID)
+	return candidate.serverID
+}
+
+// clearlyBetter returns whether the candidate round-trip time is better enough
+// than the current one to switch the primary relay server.
+func clearlyBetter(candidate, current time.Duration) bool {
+	return current-candidate >= relaySwitchMinGain && float64(candidate) <= float64(current)*relaySwitchRatio
+}
+
+// RelayLatency receives the latencies to the relay servers measured by the node
+// and returns the primary relay server selected for the node.
+func (s *server) RelayLatency(ctx context.Context, _ *http.Request, req *protocol.RelayLatencyRequest) (*protocol.RelayLatencyResponse, error) {
+	userID := models.ID(jwt.UserIDFromContext(ctx))
+	machineID := jwt.MachineIDFromContext(ctx)
+
+	device := &models.Device{}
+	var affected []models.ID
+	err := db.Tx(func(tx *gorm.DB) error {
+		err := models.NewDeviceQuerySet(tx).
+			UserIDEq(userID).
+			MachineIDEq(machineID).
+			One(device)
+		if err == gorm.ErrRecordNotFound {
+			return errcode.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		assigned, err := models.OrganizationRelayServerIDs(tx, device.OrganizationID)
+		if err != nil {
+			return err
+		}
+		serverID := s.preferRelayServerID(device.ID, device.RelayServerID, assigned, req.Latencies)
+		if serverID == device.RelayServerID {
+			return nil
+		}
+
+		zap.L().Info("Switch the primary relay server of device",
+			zap.Any("device_id", device.ID),
+			zap.Any("from", device.RelayServerID),
+			zap.Any("to", serverID))
+
+		device.RelayServerID = serverID
+		err = models.NewDeviceQuerySet(tx).
+			IDEq(device.ID).
+			GetUpdater().
+			SetRelayServerID(serverID).
+			Update()
+		if err != nil {
+			return err
+		}
+		affected, err = models.PeerUserIDs(tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.graphNotifier.notify(affected...)
+
+	v, found := s.relayServers.byID.Load(device.RelayServerID)
+	if !found {
+		return nil, errcode.ErrNotFound
+	}
+	return &protocol.RelayLatencyResponse{PrimaryServer: relayServerInfo(v.(*models.RelayServer))}, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pairmesh/pairmesh/portal/db/models"
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreferRelayServerID(t *testing.T) {
+	s := &server{}
+	for _, id := range []models.ID{1, 2, 3} {
+		r := &models.RelayServer{KeepaliveAt: time.Now()}
+		r.ID = id
+		s.relayServers.byID.Store(id, r)
+	}
+	// The relay server 3 is offline.
+	v, _ := s.relayServers.byID.Load(models.ID(3))
+	v.(*models.RelayServer).KeepaliveAt = time.Now().Add(-time.Hour)
+
+	latencies := func(rtts ...time.Duration) []protocol.RelayLatency {
+		var res []protocol.RelayLatency
+		for i, rtt := range rtts {
+			res = append(res, protocol.RelayLatency{ServerID: protocol.ServerID(i + 1), RTT: rtt})
+		}
+		return res
+	}
+	const deviceID = models.ID(100)
+
+	// New devices use the fastest active relay server.
+	assert.Equal(t, models.ID(2), s.selectRelayServerID(nil, latencies(80*time.Millisecond, 50*time.Millisecond, time.Millisecond)))
+
+	// Keep the current relay server if the candidate isn't clearly better.
+	assert.Equal(t, models.ID(1), s.preferRelayServerID(deviceID, 1, nil, latencies(60*time.Millisecond, 50*time.Millisecond)))
+	assert.Equal(t, models.ID(1), s.preferRelayServerID(deviceID, 1, nil, latencies(25*time.Millisecond, 10*time.Millisecond)))
+
+	// Switch only if the candidate is preferred by consecutive reports.
+	assert.Equal(t, models.ID(1), s.preferRelayServerID(deviceID, 1, nil, latencies(100*time.Millisecond, 20*time.Millisecond)))
+	assert.Equal(t, models.ID(1), s.preferRelayServerID(deviceID, 1, nil, latencies(100*time.Millisecond, 95*time.Millisecond)))
+	assert.Equal(t, models.ID(1), s.preferRelayServerID(deviceID, 1, nil, latencies(100*time.Millisecond, 20*time.Millisecond)))
+	assert.Equal(t, models.ID(2), s.preferRelayServerID(deviceID, 1, nil, latencies(100*time.Millisecond, 20*time.Millisecond)))
+
+	// Switch immediately if the current relay server is unreachable or offline.
+	assert.Equal(t, models.ID(1), s.preferRelayServerID(deviceID, 2, nil, latencies(100*time.Millisecond)))
+	assert.Equal(t, models.ID(2), s.preferRelayServerID(deviceID, 3, nil, latencies(30*time.Millisecond, 20*time.Millisecond, time.Millisecond)))
+
+	// Only the relay servers assigned to the organization are selected.
+	assigned := []models.ID{1}
+	assert.Equal(t, models.ID(1), s.selectRelayServerID(assigned, latencies(80*time.Millisecond, 50*time.Millisecond)))
+	assert.Equal(t, models.ID(1), s.preferRelayServerID(deviceID, 2, assigned, latencies(80*time.Millisecond, 50*time.Millisecond)))
+	assert.Equal(t, models.ID(1), s.selectRelayServerID([]models.ID{4}, latencies(50*time.Millisecond, 80*time.Millisecond)))
+}
@@ -15,8 +15,6 @@
 package api
 
 import (
-	"crypto/rsa"
-	"crypto/x509"
 	"encoding/base64"
 	"fmt"
 	"net/http"
@@ -28,6 +26,10 @@ import (
 	"github.com/pairmesh/pairmesh/constant"
 	"github.com/pairmesh/pairmesh/portal/db"
 	"github.com/pairmesh/pairmesh/portal/db/models"
+	"github.com/pairmesh/pairmesh/portal/release"
+	"github.com/pairmesh/pairmesh/portal/sso"
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/pairmesh/pairmesh/security"
 	"github.com/pingcap/fn"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -35,15 +37,12 @@ import (
 
 const credentialLease = time.Hour
 
-type (
-	publicKey struct {
-		// BASE64 representation of the public key, which is used to cache the
-		// base64 encoded raw data to avoid encoding every relayServer keepalive
-		// request.
-		base64 string
-		raw    *rsa.PublicKey
-	}
+// relayServerExpiration is the duration after the last keepalive that a relay
+// server is assumed to be offline, which is longer than the default keepalive
+// interval of relay servers.
+const relayServerExpiration = 15 * time.Minute
 
+type (
 	relayServers struct {
 		byAddr sync.Map
 		byID   sync.Map
@@ -54,27 +53,45 @@ type (
 		// relayAuthKey is used to authenticate the relayServer servers requests.
 		relayAuthKey string
 
-		// privateKey is used to sign the credential which is used to identify
+		// keyRing is used to sign the credential which is used to identify
 		// the node hold the IP address of specified network id.
-		// TODO: private key rotate
-		privateKey *rsa.PrivateKey
-		publicKey  publicKey
+		keyRing *security.KeyRing
+		// publicKeys caches the base64 encoded public keys of the key ring to
+		// avoid encoding every relayServer keepalive request.
+		publicKeys []protocol.VerifyingKey
 
 		// ServerID -> models.RelayServer
 		relayServers relayServers
+		// models.ID (device) -> relayCandidate
+		relayCandidates sync.Map
+
+		// graphNotifier notifies the watchers of the peer graph, and the
+		// graphSnapshots are the latest peer graphs pushed to the devices.
+		graphNotifier *graphNotifier
+		// UserID/MachineID -> graphSnapshot
+		graphSnapshots sync.Map
+		// models.ID (user) -> cachedGraph
+		graphCache sync.Map
+
+		// releases serves the release manifest to the nodes for version check.
+		releases *release.Store
 	}
 )
 
 // newServer returns a new gateway server instance and the gateway server is
 // used to handle the HTTP requests/UDP packets and store the peer information.
-func newServer(relayAuthKey string, privateKey *rsa.PrivateKey) *server {
+func newServer(relayAuthKey string, keyRing *security.KeyRing, releases *release.Store) *server {
 	srv := &server{
-		relayAuthKey: relayAuthKey,
-		privateKey:   privateKey,
-		publicKey: publicKey{
-			base64: base64.RawStdEncoding.EncodeToString(x509.MarshalPKCS1PublicKey(&privateKey.PublicKey)),
-			raw:    &privateKey.PublicKey,
-		},
+		relayAuthKey:  relayAuthKey,
+		keyRing:       keyRing,
+		graphNotifier: newGraphNotifier(),
+		releases:      releases,
+	}
+	for id, key := range keyRing.VerifyingKeys() {
+		srv.publicKeys = append(srv.publicKeys, protocol.VerifyingKey{
+			ID:        id.String(),
+			PublicKey: base64.RawStdEncoding.EncodeToString(key),
+		})
 	}
 	return srv
 
@@ -95,6 +112,7 @@ func (s *server) preload() error {
 	}
 
 	for _, r := range relayServers {
+		r := r
 		s.relayServers.byID.Store(r.ID, &r)
 		s.relayServers.byAddr.Store(fmt.Sprintf("%s:%d", r.Host, r.Port), &r)
 	}
@@ -108,9 +126,12 @@ func route(server *server, ssoSrv *ssoServer) http.Handler {
 	router := mux.NewRouter()
 
 	// All APIs for SSO Login/out: `/api/v1/login`
-	router.Handle("/api/v1/version/check", fn.Wrap(server.VersionCheck)).Methods(http.MethodGet)
+	router.Handle(constant.URIVersionCheck, fn.Wrap(server.VersionCheck)).Methods(http.MethodGet)
 	router.Handle("/api/v1/login/sso-methods", fn.Wrap(ssoSrv.SSOMethods)).Methods(http.MethodGet)
+	router.Handle(sso.URIAuthCodeLogin+"/github", http.HandlerFunc(ssoSrv.AuthCodeRedirect)).Methods(http.MethodGet)
+	router.Handle(sso.URIAuthCodeLogin+"/oidc/{name}", http.HandlerFunc(ssoSrv.AuthCodeRedirect)).Methods(http.MethodGet)
 	router.Handle("/api/v1/login/auth/callback/github", fn.Wrap(ssoSrv.GithubAuthCallback)).Methods(http.MethodPost)
+	router.Handle("/api/v1/login/auth/callback/oidc/{name}", fn.Wrap(ssoSrv.OIDCAuthCallback)).Methods(http.MethodPost)
 	router.Handle(constant.URILogout, http.HandlerFunc(ssoSrv.Logout)).Methods(http.MethodGet)
 
 	// All HTTP APIs requested by the relayServer servers
@@ -120,31 +141,64 @@ func route(server *server, ssoSrv *ssoServer) http.Handler {
 	// All HTTP APIs requested by the PairMesh peers
 	peerAPI := fn.NewGroup().Plugin(peerTokenValidator)
 	router.Handle(constant.URIDevicePeerGraph, peerAPI.Wrap(server.PeerGraph)).Methods(http.MethodGet)
+	router.Handle(constant.URIDevicePeerWatch, peerAPI.Wrap(server.WatchPeerGraph)).Methods(http.MethodGet)
 	router.Handle(constant.URIDevicePreflight, peerAPI.Wrap(server.Preflight)).Methods(http.MethodPost)
+	router.Handle(constant.URIDeviceLatency, peerAPI.Wrap(server.RelayLatency)).Methods(http.MethodPost)
 	router.Handle(constant.URIRenewCredential, peerAPI.Wrap(server.RenewCredential)).Methods(http.MethodPost)
 	router.Handle(constant.URLKeyExchange, peerAPI.Wrap(server.ExchangeKey)).Methods(http.MethodPost)
 
-	// All HTTP APIs authed by jwt or auth key
-	httpAPI := fn.NewGroup().Plugin(tokenValidator)
-	router.Handle("/api/v1/settings/user/profile", httpAPI.Wrap(server.UserProfileSetting)).Methods(http.MethodPut)
-	router.Handle("/api/v1/keys", httpAPI.Wrap(server.KeyList)).Methods(http.MethodGet)
-	router.Handle("/api/v1/key", httpAPI.Wrap(server.CreateKey)).Methods(http.MethodPost)
-	router.Handle("/api/v1/key/{key_id}", httpAPI.Wrap(server.ChangeKey)).Methods(http.MethodPut)
-	router.Handle("/api/v1/key/{key_id}", httpAPI.Wrap(server.DeleteKey)).Methods(http.MethodDelete)
-	router.Handle("/api/v1/user/profile", httpAPI.Wrap(server.UserProfile)).Methods(http.MethodGet)
-	router.Handle("/api/v1/user/{user_id}/devices", httpAPI.Wrap(server.UserDeviceList)).Methods(http.MethodGet)
-	router.Handle("/api/v1/devices", httpAPI.Wrap(server.DeviceList)).Methods(http.MethodGet)
-	router.Handle("/api/v1/device/{device_id}", httpAPI.Wrap(server.DeviceUpdate)).Methods(http.MethodPut)
-	router.Handle("/api/v1/networks", httpAPI.Wrap(server.NetworkList)).Methods(http.MethodGet)
-	router.Handle("/api/v1/network", httpAPI.Wrap(server.CreateNetwork)).Methods(http.MethodPost)
-	router.Handle("/api/v1/network/{network_id}", httpAPI.Wrap(server.UpdateNetwork)).Methods(http.MethodPut)
-	router.Handle("/api/v1/network/{network_id}", httpAPI.Wrap(server.DeleteNetwork)).Methods(http.MethodDelete)
-	router.Handle("/api/v1/network/{network_id}/members", httpAPI.Wrap(server.NetworkMembers)).Methods(http.MethodGet)
-	router.Handle("/api/v1/network/{network_id}/member/invite", httpAPI.Wrap(server.InviteMember)).Methods(http.MethodPost)
-	router.Handle("/api/v1/network/{network_id}/member/{user_id}", httpAPI.Wrap(server.DeleteNetworkUser)).Methods(http.MethodDelete)
-	router.Handle("/api/v1/network/{network_id}/member/{user_id}/role", httpAPI.Wrap(server.ChangeNetworkMemberRole)).Methods(http.MethodPut)
-	router.Handle("/api/v1/invitations", httpAPI.Wrap(server.Invitations)).Methods(http.MethodGet)
-	router.Handle("/api/v1/invitation/{invitation_id}", httpAPI.Wrap(server.HandleInvitation)).Methods(http.MethodPut)
+	// All HTTP APIs authed by jwt or auth key. Every API declares the permission
+	// which is checked by the authorize plugin, and the APIs modifying the peer
+	// graphs notify the watchers of the affected users.
+	httpAPI := func(perm permission) *fn.Group {
+		return fn.NewGroup().Plugin(tokenValidator, server.authorize(perm))
+	}
+	router.Handle("/api/v1/settings/user/profile", httpAPI(permUser).Wrap(server.UserProfileSetting)).Methods(http.MethodPut)
+	router.Handle("/api/v1/keys", httpAPI(permUser).Wrap(server.KeyList)).Methods(http.MethodGet)
+	router.Handle("/api/v1/key", httpAPI(permUser).Wrap(server.CreateKey)).Methods(http.MethodPost)
+	router.Handle("/api/v1/key/{key_id}", httpAPI(permKeyOwner).Wrap(server.ChangeKey)).Methods(http.MethodPut)
+	router.Handle("/api/v1/key/{key_id}", httpAPI(permKeyOwner).Wrap(server.DeleteKey)).Methods(http.MethodDelete)
+	router.Handle("/api/v1/user/profile", httpAPI(permUser).Wrap(server.UserProfile)).Methods(http.MethodGet)
+	router.Handle("/api/v1/user/{user_id}/devices", httpAPI(permUserRead).Wrap(server.UserDeviceList)).Methods(http.MethodGet)
+	router.Handle("/api/v1/devices", httpAPI(permUser).Wrap(server.DeviceList)).Methods(http.MethodGet)
+	router.Handle("/api/v1/device/{device_id}", httpAPI(permDeviceOwner).Wrap(server.DeviceUpdate)).Methods(http.MethodPut)
+	router.Handle("/api/v1/device/{device_id}", httpAPI(permDeviceOwner).Wrap(server.DeviceDelete)).Methods(http.MethodDelete)
+	router.Handle("/api/v1/device/{device_id}/routes", httpAPI(permDeviceRead).Wrap(server.DeviceRouteList)).Methods(http.MethodGet)
+	router.Handle("/api/v1/device/{device_id}/route/{route_id}", httpAPI(permDeviceAdmin).Wrap(server.DeviceRouteApprove)).Methods(http.MethodPut)
+	router.Handle("/api/v1/device/{device_id}/revoke", httpAPI(permDeviceOwner).Wrap(server.DeviceRevoke)).Methods(http.MethodPut)
+	router.Handle("/api/v1/device/{device_id}/restore", httpAPI(permDeviceOwner).Wrap(server.DeviceRestore)).Methods(http.MethodPut)
+	router.Handle("/api/v1/networks", httpAPI(permUser).Wrap(server.NetworkList)).Methods(http.MethodGet)
+	router.Handle("/api/v1/network", httpAPI(permUser).Wrap(server.CreateNetwork)).Methods(http.MethodPost)
+	router.Handle("/api/v1/network/{network_id}", httpAPI(permNetworkAdmin).Wrap(server.UpdateNetwork)).Methods(http.MethodPut)
+	router.Handle("/api/v1/network/{network_id}", httpAPI(permNetworkOwner).Wrap(server.DeleteNetwork)).Methods(http.MethodDelete)
+	router.Handle("/api/v1/network/{network_id}/members", httpAPI(permNetworkRead).Wrap(server.NetworkMembers)).Methods(http.MethodGet)
+	router.Handle("/api/v1/network/{network_id}/exit-nodes", httpAPI(permNetworkRead).Wrap(server.NetworkExitNodes)).Methods(http.MethodGet)
+	router.Handle("/api/v1/network/{network_id}/devices/pending", httpAPI(permNetworkAdmin).Wrap(server.NetworkPendingDevices)).Methods(http.MethodGet)
+	router.Handle("/api/v1/network/{network_id}/device/{device_id}", httpAPI(permNetworkAdmin).Wrap(server.NetworkDeviceApprove)).Methods(http.MethodPut)
+	router.Handle("/api/v1/network/{network_id}/acl", httpAPI(permNetworkRead).Wrap(server.NetworkACL)).Methods(http.MethodGet)
+	router.Handle("/api/v1/network/{network_id}/acl", httpAPI(permNetworkAdmin).Wrap(server.CreateACLRule)).Methods(http.MethodPost)
+	router.Handle("/api/v1/network/{network_id}/acl/{rule_id}", httpAPI(permNetworkAdmin).Wrap(server.UpdateACLRule)).Methods(http.MethodPut)
+	router.Handle("/api/v1/network/{network_id}/acl/{rule_id}", httpAPI(permNetworkAdmin).Wrap(server.DeleteACLRule)).Methods(http.MethodDelete)
+	router.Handle("/api/v1/network/{network_id}/member/invite", httpAPI(permNetworkAdmin).Wrap(server.InviteMember)).Methods(http.MethodPost)
+	router.Handle("/api/v1/network/{network_id}/member/{user_id}", httpAPI(permNetworkRead).Wrap(server.DeleteNetworkUser)).Methods(http.MethodDelete)
+	router.Handle("/api/v1/network/{network_id}/member/{user_id}/role", httpAPI(permNetworkOwner).Wrap(server.ChangeNetworkMemberRole)).Methods(http.MethodPut)
+	router.Handle("/api/v1/invitations", httpAPI(permUser).Wrap(server.Invitations)).Methods(http.MethodGet)
+	router.Handle("/api/v1/invitation/{invitation_id}", httpAPI(permUser).Wrap(server.HandleInvitation)).Methods(http.MethodPut)
+	router.Handle("/api/v1/audit", httpAPI(permUser).Wrap(server.AuditLogs)).Methods(http.MethodGet)
+	// The export streams JSON lines which can't be encoded by fn, so it validates the token itself.
+	router.Handle("/api/v1/audit/export", http.HandlerFunc(server.ExportAuditLogs)).Methods(http.MethodGet)
+	router.Handle("/api/v1/organizations", httpAPI(permUser).Wrap(server.OrganizationList)).Methods(http.MethodGet)
+	router.Handle("/api/v1/organization", httpAPI(permUser).Wrap(server.CreateOrganization)).Methods(http.MethodPost)
+	router.Handle("/api/v1/organization/{organization_id}", httpAPI(permOrganizationAdmin).Wrap(server.UpdateOrganization)).Methods(http.MethodPut)
+	router.Handle("/api/v1/organization/{organization_id}/members", httpAPI(permOrganizationRead).Wrap(server.OrganizationMembers)).Methods(http.MethodGet)
+	router.Handle("/api/v1/organization/{organization_id}/member", httpAPI(permOrganizationAdmin).Wrap(server.AddOrganizationMember)).Methods(http.MethodPost)
+	router.Handle("/api/v1/organization/{organization_id}/member/{user_id}", httpAPI(permOrganizationAdmin).Wrap(server.DeleteOrganizationMember)).Methods(http.MethodDelete)
+	router.Handle("/api/v1/organization/{organization_id}/member/{user_id}/role", httpAPI(permOrganizationAdmin).Wrap(server.ChangeOrganizationMemberRole)).Methods(http.MethodPut)
+	router.Handle("/api/v1/organization/{organization_id}/networks", httpAPI(permOrganizationAudit).Wrap(server.OrganizationNetworks)).Methods(http.MethodGet)
+	router.Handle("/api/v1/organization/{organization_id}/devices", httpAPI(permOrganizationAudit).Wrap(server.OrganizationDevices)).Methods(http.MethodGet)
+	router.Handle("/api/v1/organization/{organization_id}/keys", httpAPI(permOrganizationAudit).Wrap(server.OrganizationKeys)).Methods(http.MethodGet)
+	router.Handle("/api/v1/organization/{organization_id}/relays", httpAPI(permOrganizationRead).Wrap(server.OrganizationRelays)).Methods(http.MethodGet)
+	router.Handle("/api/v1/organization/{organization_id}/relays", httpAPI(permOrganizationAdmin).Wrap(server.AssignOrganizationRelays)).Methods(http.MethodPut)
 
 	return gziphandler.GzipHandler(router)
 }
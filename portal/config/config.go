@@ -32,10 +32,26 @@ type Config struct {
 	PrivateKey string `yaml:"privateKey"`
 	DataDir    string `yaml:"dataDir"`
 
-	Relay *Relay `yaml:"relay"`
+	// RetiringKeys are the paths of the keys which have been rotated, and the
+	// credentials signed by them are still accepted until expired.
+	RetiringKeys []string `yaml:"retiringKeys"`
+
+	// ReleaseManifest is the path of the release manifest, which is reloaded
+	// after modified. The version check is disabled if not specified.
+	ReleaseManifest string `yaml:"releaseManifest"`
+
+	// EphemeralDeviceTTL is the seconds after which the offline devices
+	// registered with ephemeral keys are removed.
+	EphemeralDeviceTTL uint32 `yaml:"ephemeralDeviceTtl"`
+
+	Relay    *Relay    `yaml:"relay"`
+	Database *Database `yaml:"database"`
+	JWT      *JWT      `yaml:"jwt"`
+	SSO      *SSO      `yaml:"sso"`
+
+	// MySQL is the legacy database configuration, which is used if the
+	// database section is absent.
 	MySQL *MySQL `yaml:"mysql"`
-	JWT   *JWT   `yaml:"jwt"`
-	SSO   *SSO   `yaml:"sso"`
 }
 
 // Relay represents a relay instance with its auth key
@@ -49,10 +65,65 @@ type GitHub struct {
 	ClientSecret string `yaml:"clientSecret"`
 }
 
+// OIDC represents a generic OpenID Connect provider's configuration
+type OIDC struct {
+	// Name identifies the provider, which is shown in the login methods and
+	// used in the callback URI.
+	Name string `yaml:"name"`
+	// Issuer is the issuer URL of the provider, and the endpoints are
+	// discovered from `{issuer}/.well-known/openid-configuration`.
+	Issuer       string     `yaml:"issuer"`
+	ClientID     string     `yaml:"clientID"`
+	ClientSecret string     `yaml:"clientSecret"`
+	Scopes       []string   `yaml:"scopes"`
+	Claims       OIDCClaims `yaml:"claims"`
+	// Groups maps the groups of the users to network memberships.
+	Groups []OIDCGroup `yaml:"groups"`
+}
+
+// OIDCClaims represents the claim names of the user information, and the
+// standard claims are used if absent.
+type OIDCClaims struct {
+	Email  string `yaml:"email"`
+	Name   string `yaml:"name"`
+	Groups string `yaml:"groups"`
+}
+
+// OIDCGroup maps a group of the OpenID Connect provider to a network membership
+type OIDCGroup struct {
+	Group     string `yaml:"group"`
+	NetworkID uint64 `yaml:"networkID"`
+	// Role is one of admin and member, and the default role is member.
+	Role string `yaml:"role"`
+}
+
 // SSO represents the sso provider(s) configuration
 type SSO struct {
 	Redirect string `yaml:"redirect"`
 	GitHub   GitHub `yaml:"github"`
+	OIDC     []OIDC `yaml:"oidc"`
+}
+
+// Database drivers supported by the portal service.
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// Database represents the database connection configuration
+type Database struct {
+	// Driver is one of mysql, postgres and sqlite.
+	Driver   string `yaml:"driver"`
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	DB       string `yaml:"db"`
+	// SSLMode is the sslmode parameter of the postgres connection.
+	SSLMode string `yaml:"sslMode"`
+	// Path is the database file path of the sqlite driver.
+	Path string `yaml:"path"`
 }
 
 // MySQL represents the mysql connection configuration
@@ -80,11 +151,12 @@ type Data struct {
 // New returns a config instance with default value
 func New() *Config {
 	return &Config{
-		Host:    "0.0.0.0",
-		Port:    2823,
-		TLSKey:  "",
-		TLSCert: "",
-		DataDir: "./cache/",
+		Host:               "0.0.0.0",
+		Port:               2823,
+		TLSKey:             "",
+		TLSCert:            "",
+		DataDir:            "./cache/",
+		EphemeralDeviceTTL: 1800,
 		SSO: &SSO{
 			Redirect: "http://127.0.0.1:2823",
 		},
@@ -104,6 +176,25 @@ func New() *Config {
 	}
 }
 
+// DatabaseConfig returns the database configuration, and the legacy mysql
+// configuration is converted if the database section is absent.
+func (c *Config) DatabaseConfig() *Database {
+	if c.Database != nil {
+		return c.Database
+	}
+	if c.MySQL == nil {
+		return nil
+	}
+	return &Database{
+		Driver:   DriverMySQL,
+		Host:     c.MySQL.Host,
+		Port:     c.MySQL.Port,
+		User:     c.MySQL.User,
+		Password: c.MySQL.Password,
+		DB:       c.MySQL.DB,
+	}
+}
+
 // FromReader returns the configuration instance from reader
 func FromReader(reader io.Reader) (*Config, error) {
 	config := New()
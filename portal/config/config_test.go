@@ -93,3 +93,53 @@ mysql:
 	a.Equal(cfg.MySQL.Password, "123456")
 	a.Equal(cfg.MySQL.DB, "pairportal")
 }
+
+func TestOIDCConfig(t *testing.T) {
+	cfg, err := config.FromBytes([]byte(`
+sso:
+  redirect: 'https://mesh.example.com'
+  oidc:
+    - name: Keycloak
+      issuer: https://sso.example.com/realms/acme
+      clientID: pairmesh
+      clientSecret: secret
+      scopes: [openid, email, profile]
+      claims:
+        groups: roles
+      groups:
+        - group: engineering
+          networkID: 1
+          role: admin
+`))
+
+	a := assert.New(t)
+	a.Nil(err)
+	a.Len(cfg.SSO.OIDC, 1)
+	p := cfg.SSO.OIDC[0]
+	a.Equal("Keycloak", p.Name)
+	a.Equal("https://sso.example.com/realms/acme", p.Issuer)
+	a.Equal([]string{"openid", "email", "profile"}, p.Scopes)
+	a.Equal("roles", p.Claims.Groups)
+	a.Equal(config.OIDCGroup{Group: "engineering", NetworkID: 1, Role: "admin"}, p.Groups[0])
+}
+
+func TestDatabaseConfig(t *testing.T) {
+	a := assert.New(t)
+
+	// The legacy mysql section is used if the database section is absent.
+	cfg := config.New()
+	db := cfg.DatabaseConfig()
+	a.Equal(config.DriverMySQL, db.Driver)
+	a.Equal(3306, db.Port)
+	a.Equal("pairportal", db.DB)
+
+	cfg, err := config.FromBytes([]byte(`
+database:
+  driver: sqlite
+  path: /var/lib/pairportal/pairportal.db
+`))
+	a.Nil(err)
+	db = cfg.DatabaseConfig()
+	a.Equal(config.DriverSQLite, db.Driver)
+	a.Equal("/var/lib/pairportal/pairportal.db", db.Path)
+}
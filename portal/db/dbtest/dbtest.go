@@ -0,0 +1,41 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dbtest provides the database fixtures of tests.
+package dbtest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pairmesh/pairmesh/portal/db/models"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// SQLite returns a sqlite database in the temporary directory of the test with
+// all tables migrated.
+func SQLite(t testing.TB) *gorm.DB {
+	t.Helper()
+	tx, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "pairportal.db")), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.AutoMigrate(models.Tables()...); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pairmesh/pairmesh/portal/config"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestQuerySetDialects(t *testing.T) {
+	a := assert.New(t)
+
+	postgres, err := dialector(&config.Database{Driver: config.DriverPostgres, Host: "127.0.0.1", Port: 5432, DB: "pairportal"})
+	a.Nil(err)
+	sqlite, err := dialector(&config.Database{Driver: config.DriverSQLite, Path: filepath.Join(t.TempDir(), "pairportal.db")})
+	a.Nil(err)
+
+	cases := []struct {
+		dialector gorm.Dialector
+		expected  string
+	}{
+		{
+			// The version query needs a server, which the dry run doesn't have.
+			dialector: mysql.New(mysql.Config{DSN: "pairportal@tcp(127.0.0.1:3306)/pairportal", SkipInitializeWithVersion: true}),
+			expected:  "SELECT * FROM `devices` WHERE `name` = ? AND `id` IN (?,?) AND `deleted_at` IS NULL AND `name` NOT LIKE ? ORDER BY `last_seen` DESC",
+		},
+		{
+			dialector: postgres,
+			expected:  `SELECT * FROM "devices" WHERE "name" = $1 AND "id" IN ($2,$3) AND "deleted_at" IS NULL AND "name" NOT LIKE $4 ORDER BY "last_seen" DESC`,
+		},
+		{
+			dialector: sqlite,
+			expected:  "SELECT * FROM `devices` WHERE `name` = ? AND `id` IN (?,?) AND `deleted_at` IS NULL AND `name` NOT LIKE ? ORDER BY `last_seen` DESC",
+		},
+	}
+
+	for _, c := range cases {
+		tx, err := gorm.Open(c.dialector, &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+		a.Nil(err, c.dialector.Name())
+
+		var devices []models.Device
+		stmt := models.NewDeviceQuerySet(tx).
+			NameEq("laptop").
+			IDIn(1, 2).
+			DeletedAtIsNull().
+			NameNotlike("phone%").
+			OrderDescByLastSeen().
+			GetDB().Find(&devices).Statement
+		a.Equal(c.expected, stmt.SQL.String(), c.dialector.Name())
+	}
+}
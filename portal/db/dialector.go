@@ -0,0 +1,91 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/pairmesh/pairmesh/portal/config"
+	"go.uber.org/zap"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// defaultSQLitePath is the database file path of the sqlite driver if it isn't
+// specified in the configuration.
+const defaultSQLitePath = "pairportal.db"
+
+// dialector returns the gorm dialector of the configured database driver.
+func dialector(cfg *config.Database) (gorm.Dialector, error) {
+	switch cfg.Driver {
+	case config.DriverMySQL, "":
+		dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8&parseTime=true&loc=Local", cfg.User, cfg.Password, cfg.Host, cfg.Port, cfg.DB)
+		return mysql.New(mysql.Config{DSN: dsn}), nil
+
+	case config.DriverPostgres:
+		sslMode := cfg.SSLMode
+		if sslMode == "" {
+			sslMode = "disable"
+		}
+		dsn := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(cfg.User, cfg.Password),
+			Host:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+			Path:     cfg.DB,
+			RawQuery: url.Values{"sslmode": []string{sslMode}}.Encode(),
+		}
+		return postgres.Open(dsn.String()), nil
+
+	case config.DriverSQLite:
+		path := sqlitePath(cfg)
+		if dir := filepath.Dir(path); dir != "." {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return nil, err
+			}
+		}
+		// The busy timeout and immediate transactions make the concurrent
+		// writers wait for the lock instead of failing immediately.
+		dsn := fmt.Sprintf("file:%s?_busy_timeout=5000&_journal_mode=WAL&_txlock=immediate&_loc=auto", path)
+		return sqlite.Open(dsn), nil
+	}
+
+	return nil, fmt.Errorf("unsupported database driver: %s", cfg.Driver)
+}
+
+func sqlitePath(cfg *config.Database) string {
+	if cfg.Path == "" {
+		return defaultSQLitePath
+	}
+	return cfg.Path
+}
+
+// logFields returns the fields to log the database configuration.
+func logFields(cfg *config.Database) []zap.Field {
+	if cfg.Driver == config.DriverSQLite {
+		return []zap.Field{zap.String("driver", cfg.Driver), zap.String("path", sqlitePath(cfg))}
+	}
+	return []zap.Field{
+		zap.String("driver", cfg.Driver),
+		zap.String("host", cfg.Host),
+		zap.Int("port", cfg.Port),
+		zap.String("user", cfg.User),
+		zap.String("db", cfg.DB),
+	}
+}
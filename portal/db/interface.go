@@ -17,13 +17,11 @@ package db
 import (
 	"database/sql"
 	"errors"
-	"fmt"
 
 	"github.com/pairmesh/pairmesh/portal/config"
 	"github.com/pairmesh/pairmesh/portal/db/models"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -32,31 +30,25 @@ var initialized = atomic.Bool{}
 var globalDB *gorm.DB
 
 // Initialize initialize the database
-func Initialize(cfg *config.MySQL) error {
+func Initialize(cfg *config.Database) error {
+	if cfg == nil {
+		return errors.New("database configuration is missing")
+	}
 	if initialized.Swap(true) {
 		return errors.New("initialize twice")
 	}
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8&parseTime=true&loc=Local", cfg.User, cfg.Password, cfg.Host, cfg.Port, cfg.DB)
-	db, err := gorm.Open(mysql.New(mysql.Config{DSN: dsn}), &gorm.Config{Logger: &Logger{}})
+	dial, err := dialector(cfg)
 	if err != nil {
 		return err
 	}
-
-	allTables := []interface{}{
-		&models.User{},
-		&models.AuthKey{},
-		&models.NetworkUser{},
-		&models.Invitation{},
-		&models.Network{},
-		&models.Device{},
-		&models.RelayServer{},
-		&models.GithubUser{},
-		&models.WechatUser{},
+	db, err := gorm.Open(dial, &gorm.Config{Logger: &Logger{}})
+	if err != nil {
+		return err
 	}
 
 	// Create table if not exists
-	err = db.AutoMigrate(allTables...)
+	err = db.AutoMigrate(models.Tables()...)
 	if err != nil {
 		return err
 	}
@@ -66,11 +58,7 @@ func Initialize(cfg *config.MySQL) error {
 	// The global database instance.
 	globalDB = db
 
-	zap.L().Info("Preflight the database successfully",
-		zap.String("host", cfg.Host),
-		zap.Int("port", cfg.Port),
-		zap.String("user", cfg.User),
-		zap.String("db", cfg.DB))
+	zap.L().Info("Preflight the database successfully", logFields(cfg)...)
 
 	return nil
 }
@@ -0,0 +1,68 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pairmesh/pairmesh/portal/config"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestInitializeSQLite(t *testing.T) {
+	a := assert.New(t)
+	cfg := &config.Database{
+		Driver: config.DriverSQLite,
+		Path:   filepath.Join(t.TempDir(), "data", "pairportal.db"),
+	}
+	a.Nil(Initialize(cfg))
+	a.NotNil(Initialize(cfg))
+
+	err := Tx(func(tx *gorm.DB) error {
+		owner := &models.User{Name: "alice", Email: "alice@example.com"}
+		a.Nil(owner.Create(tx))
+		member := &models.User{Name: "bob", Email: "bob@example.com"}
+		a.Nil(member.Create(tx))
+
+		network := &models.Network{CreatedByID: owner.ID, Name: "home"}
+		a.Nil(network.Create(tx))
+		a.Nil((&models.NetworkUser{UserID: owner.ID, NetworkID: network.ID, Role: models.RoleTypeOwner}).Create(tx))
+		a.Nil((&models.NetworkUser{UserID: member.ID, NetworkID: network.ID}).Create(tx))
+		a.Nil((&models.Device{UserID: member.ID, Name: "laptop", OS: "linux", LastSeen: time.Now()}).Create(tx))
+
+		// The column defaults are applied on all drivers.
+		var nu models.NetworkUser
+		a.Nil(models.NewNetworkUserQuerySet(tx).UserIDEq(member.ID).One(&nu))
+		a.Equal(models.RoleTypeMember, nu.Role)
+
+		err := models.NewNetworkUserQuerySet(tx).IDEq(nu.ID).GetUpdater().SetRole(models.RoleTypeAdmin).Update()
+		a.Nil(err)
+		isAdmin, err := models.IsNetworkAdminOf(tx, member.ID, owner.ID)
+		a.Nil(err)
+		a.True(isAdmin)
+
+		devices, err := models.PeerDevices(tx, owner.ID)
+		a.Nil(err)
+		a.Len(devices, 1)
+		a.Equal("laptop", devices[0].Name)
+		return nil
+	})
+	a.Nil(err)
+}
@@ -0,0 +1,48 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+var errAuditLogAppendOnly = errors.New("audit log is append-only")
+
+// BeforeUpdate rejects the modifications of audit logs
+func (AuditLog) BeforeUpdate(*gorm.DB) error {
+	return errAuditLogAppendOnly
+}
+
+// BeforeDelete rejects the deletions of audit logs
+func (AuditLog) BeforeDelete(*gorm.DB) error {
+	return errAuditLogAppendOnly
+}
+
+// CreateAuditLog appends the audit log, and the organization of the log is
+// inherited from the network if not specified, which makes the actions in the
+// networks of an organization visible to the organization auditors.
+func CreateAuditLog(tx *gorm.DB, log *AuditLog) error {
+	if log.OrganizationID == 0 && log.NetworkID != 0 {
+		var network Network
+		err := NewNetworkQuerySet(tx).IDEq(log.NetworkID).One(&network)
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return err
+		}
+		log.OrganizationID = network.OrganizationID
+	}
+	return tx.Create(log).Error
+}
@@ -8,35 +8,36 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ===== BEGIN of all query sets
 
-// ===== BEGIN of query set AuthKeyQuerySet
+// ===== BEGIN of query set ACLRuleQuerySet
 
-// AuthKeyQuerySet is an queryset type for AuthKey
-type AuthKeyQuerySet struct {
+// ACLRuleQuerySet is an queryset type for ACLRule
+type ACLRuleQuerySet struct {
 	db *gorm.DB
 }
 
-// NewAuthKeyQuerySet constructs new AuthKeyQuerySet
-func NewAuthKeyQuerySet(db *gorm.DB) AuthKeyQuerySet {
-	return AuthKeyQuerySet{
-		db: db.Model(&AuthKey{}),
+// NewACLRuleQuerySet constructs new ACLRuleQuerySet
+func NewACLRuleQuerySet(db *gorm.DB) ACLRuleQuerySet {
+	return ACLRuleQuerySet{
+		db: db.Model(&ACLRule{}),
 	}
 }
 
-func (qs AuthKeyQuerySet) w(db *gorm.DB) AuthKeyQuerySet {
-	return NewAuthKeyQuerySet(db)
+func (qs ACLRuleQuerySet) w(db *gorm.DB) ACLRuleQuerySet {
+	return NewACLRuleQuerySet(db)
 }
 
-func (qs AuthKeyQuerySet) Preload(query string, args ...interface{}) AuthKeyQuerySet {
-	return NewAuthKeyQuerySet(qs.db.Preload(query, args...))
+func (qs ACLRuleQuerySet) Preload(query string, args ...interface{}) ACLRuleQuerySet {
+	return NewACLRuleQuerySet(qs.db.Preload(query, args...))
 }
 
 // Preload preload associations with given conditions
 //    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
-func (qs AuthKeyQuerySet) Select(fields ...AuthKeyDBSchemaField) AuthKeyQuerySet {
+func (qs ACLRuleQuerySet) Select(fields ...ACLRuleDBSchemaField) ACLRuleQuerySet {
 	names := []string{}
 	for _, f := range fields {
 		names = append(names, f.String())
@@ -47,25 +48,93 @@ func (qs AuthKeyQuerySet) Select(fields ...AuthKeyDBSchemaField) AuthKeyQuerySet
 
 // Create is an autogenerated method
 // nolint: dupl
-func (o *AuthKey) Create(db *gorm.DB) error {
+func (o *ACLRule) Create(db *gorm.DB) error {
 	return db.Create(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (o *AuthKey) Delete(db *gorm.DB) error {
+func (o *ACLRule) Delete(db *gorm.DB) error {
 	return db.Delete(o).Error
 }
 
+// ActionEq is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ActionEq(action ACLAction) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "action", Value: action}))
+}
+
+// ActionGt is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ActionGt(action ACLAction) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "action", Value: action}))
+}
+
+// ActionGte is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ActionGte(action ACLAction) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "action", Value: action}))
+}
+
+// ActionIn is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ActionIn(action ...ACLAction) ACLRuleQuerySet {
+	if len(action) == 0 {
+		qs.db.AddError(errors.New("must at least pass one action in ActionIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "action"}, action))
+}
+
+// ActionLike is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ActionLike(action ACLAction) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "action", Value: action}))
+}
+
+// ActionLt is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ActionLt(action ACLAction) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "action", Value: action}))
+}
+
+// ActionLte is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ActionLte(action ACLAction) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "action", Value: action}))
+}
+
+// ActionNe is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ActionNe(action ACLAction) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "action", Value: action}))
+}
+
+// ActionNotIn is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ActionNotIn(action ...ACLAction) ACLRuleQuerySet {
+	if len(action) == 0 {
+		qs.db.AddError(errors.New("must at least pass one action in ActionNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "action"}, action))
+}
+
+// ActionNotlike is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ActionNotlike(action ACLAction) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "action", Value: action})))
+}
+
 // All is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) All(ret *[]AuthKey) error {
+func (qs ACLRuleQuerySet) All(ret *[]ACLRule) error {
 	return qs.db.Find(ret).Error
 }
 
 // Count is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) Count() (int64, error) {
+func (qs ACLRuleQuerySet) Count() (int64, error) {
 	var count int64
 	err := qs.db.Count(&count).Error
 	return count, err
@@ -73,937 +142,1057 @@ func (qs AuthKeyQuerySet) Count() (int64, error) {
 
 // CreatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) CreatedAtEq(createdAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`created_at` = ?", createdAt))
+func (qs ACLRuleQuerySet) CreatedAtEq(createdAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) CreatedAtGt(createdAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`created_at` > ?", createdAt))
+func (qs ACLRuleQuerySet) CreatedAtGt(createdAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) CreatedAtGte(createdAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`created_at` >= ?", createdAt))
+func (qs ACLRuleQuerySet) CreatedAtGte(createdAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) CreatedAtLt(createdAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`created_at` < ?", createdAt))
+func (qs ACLRuleQuerySet) CreatedAtLt(createdAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) CreatedAtLte(createdAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`created_at` <= ?", createdAt))
+func (qs ACLRuleQuerySet) CreatedAtLte(createdAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtNe is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) CreatedAtNe(createdAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`created_at` != ?", createdAt))
+func (qs ACLRuleQuerySet) CreatedAtNe(createdAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedByIDEq is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) CreatedByIDEq(createdByID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDGt is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) CreatedByIDGt(createdByID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDGte is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) CreatedByIDGte(createdByID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDIn is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) CreatedByIDIn(createdByID ...ID) ACLRuleQuerySet {
+	if len(createdByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one createdByID in CreatedByIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "created_by_id"}, createdByID))
+}
+
+// CreatedByIDLt is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) CreatedByIDLt(createdByID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDLte is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) CreatedByIDLte(createdByID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDNe is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) CreatedByIDNe(createdByID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) CreatedByIDNotIn(createdByID ...ID) ACLRuleQuerySet {
+	if len(createdByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one createdByID in CreatedByIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "created_by_id"}, createdByID))
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) Delete() error {
-	return qs.db.Delete(AuthKey{}).Error
+func (qs ACLRuleQuerySet) Delete() error {
+	return qs.db.Delete(ACLRule{}).Error
 }
 
 // DeleteNum is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(AuthKey{})
+func (qs ACLRuleQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(ACLRule{})
 	return db.RowsAffected, db.Error
 }
 
 // DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(AuthKey{})
+func (qs ACLRuleQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(ACLRule{})
 	return db.RowsAffected, db.Error
 }
 
 // DeletedAtEq is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) DeletedAtEq(deletedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` = ?", deletedAt))
+func (qs ACLRuleQuerySet) DeletedAtEq(deletedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) DeletedAtGt(deletedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` > ?", deletedAt))
+func (qs ACLRuleQuerySet) DeletedAtGt(deletedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) DeletedAtGte(deletedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` >= ?", deletedAt))
+func (qs ACLRuleQuerySet) DeletedAtGte(deletedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) DeletedAtIsNotNull() AuthKeyQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+func (qs ACLRuleQuerySet) DeletedAtIsNotNull() ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtIsNull is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) DeletedAtIsNull() AuthKeyQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NULL"))
+func (qs ACLRuleQuerySet) DeletedAtIsNull() ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtLt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) DeletedAtLt(deletedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` < ?", deletedAt))
+func (qs ACLRuleQuerySet) DeletedAtLt(deletedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtLte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) DeletedAtLte(deletedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` <= ?", deletedAt))
+func (qs ACLRuleQuerySet) DeletedAtLte(deletedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtNe is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) DeletedAtNe(deletedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` != ?", deletedAt))
+func (qs ACLRuleQuerySet) DeletedAtNe(deletedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
 }
 
-// EnabledEq is an autogenerated method
+// DstDeviceIDEq is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) EnabledEq(enabled bool) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`enabled` = ?", enabled))
+func (qs ACLRuleQuerySet) DstDeviceIDEq(dstDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "dst_device_id", Value: dstDeviceID}))
 }
 
-// EnabledIn is an autogenerated method
+// DstDeviceIDGt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) EnabledIn(enabled ...bool) AuthKeyQuerySet {
-	if len(enabled) == 0 {
-		qs.db.AddError(errors.New("must at least pass one enabled in EnabledIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("enabled IN (?)", enabled))
+func (qs ACLRuleQuerySet) DstDeviceIDGt(dstDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "dst_device_id", Value: dstDeviceID}))
 }
 
-// EnabledNe is an autogenerated method
+// DstDeviceIDGte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) EnabledNe(enabled bool) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`enabled` != ?", enabled))
+func (qs ACLRuleQuerySet) DstDeviceIDGte(dstDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "dst_device_id", Value: dstDeviceID}))
 }
 
-// EnabledNotIn is an autogenerated method
+// DstDeviceIDIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) EnabledNotIn(enabled ...bool) AuthKeyQuerySet {
-	if len(enabled) == 0 {
-		qs.db.AddError(errors.New("must at least pass one enabled in EnabledNotIn"))
+func (qs ACLRuleQuerySet) DstDeviceIDIn(dstDeviceID ...ID) ACLRuleQuerySet {
+	if len(dstDeviceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one dstDeviceID in DstDeviceIDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("enabled NOT IN (?)", enabled))
-}
-
-// ExpiredAtEq is an autogenerated method
-// nolint: dupl
-func (qs AuthKeyQuerySet) ExpiredAtEq(expiredAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`expired_at` = ?", expiredAt))
-}
-
-// ExpiredAtGt is an autogenerated method
-// nolint: dupl
-func (qs AuthKeyQuerySet) ExpiredAtGt(expiredAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`expired_at` > ?", expiredAt))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "dst_device_id"}, dstDeviceID))
 }
 
-// ExpiredAtGte is an autogenerated method
+// DstDeviceIDLt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) ExpiredAtGte(expiredAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`expired_at` >= ?", expiredAt))
+func (qs ACLRuleQuerySet) DstDeviceIDLt(dstDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "dst_device_id", Value: dstDeviceID}))
 }
 
-// ExpiredAtLt is an autogenerated method
+// DstDeviceIDLte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) ExpiredAtLt(expiredAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`expired_at` < ?", expiredAt))
+func (qs ACLRuleQuerySet) DstDeviceIDLte(dstDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "dst_device_id", Value: dstDeviceID}))
 }
 
-// ExpiredAtLte is an autogenerated method
+// DstDeviceIDNe is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) ExpiredAtLte(expiredAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`expired_at` <= ?", expiredAt))
+func (qs ACLRuleQuerySet) DstDeviceIDNe(dstDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "dst_device_id", Value: dstDeviceID}))
 }
 
-// ExpiredAtNe is an autogenerated method
+// DstDeviceIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) ExpiredAtNe(expiredAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`expired_at` != ?", expiredAt))
+func (qs ACLRuleQuerySet) DstDeviceIDNotIn(dstDeviceID ...ID) ACLRuleQuerySet {
+	if len(dstDeviceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one dstDeviceID in DstDeviceIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "dst_device_id"}, dstDeviceID))
 }
 
 // GetDB is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) GetDB() *gorm.DB {
+func (qs ACLRuleQuerySet) GetDB() *gorm.DB {
 	return qs.db
 }
 
 // GetUpdater is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) GetUpdater() AuthKeyUpdater {
-	return NewAuthKeyUpdater(qs.db)
+func (qs ACLRuleQuerySet) GetUpdater() ACLRuleUpdater {
+	return NewACLRuleUpdater(qs.db)
 }
 
 // IDEq is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) IDEq(ID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`id` = ?", ID))
+func (qs ACLRuleQuerySet) IDEq(ID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
 }
 
 // IDGt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) IDGt(ID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`id` > ?", ID))
+func (qs ACLRuleQuerySet) IDGt(ID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
 }
 
 // IDGte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) IDGte(ID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`id` >= ?", ID))
+func (qs ACLRuleQuerySet) IDGte(ID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
 }
 
 // IDIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) IDIn(ID ...ID) AuthKeyQuerySet {
+func (qs ACLRuleQuerySet) IDIn(ID ...ID) ACLRuleQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // IDLt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) IDLt(ID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`id` < ?", ID))
+func (qs ACLRuleQuerySet) IDLt(ID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
 }
 
 // IDLte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) IDLte(ID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`id` <= ?", ID))
+func (qs ACLRuleQuerySet) IDLte(ID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
 }
 
 // IDNe is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) IDNe(ID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`id` != ?", ID))
+func (qs ACLRuleQuerySet) IDNe(ID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
 }
 
 // IDNotIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) IDNotIn(ID ...ID) AuthKeyQuerySet {
+func (qs ACLRuleQuerySet) IDNotIn(ID ...ID) ACLRuleQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
 }
 
-// KeyEq is an autogenerated method
+// Limit is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) KeyEq(key string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`key` = ?", key))
+func (qs ACLRuleQuerySet) Limit(limit int) ACLRuleQuerySet {
+	return qs.w(qs.db.Limit(limit))
 }
 
-// KeyGt is an autogenerated method
+// NetworkIDEq is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) KeyGt(key string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`key` > ?", key))
+func (qs ACLRuleQuerySet) NetworkIDEq(networkID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "network_id", Value: networkID}))
 }
 
-// KeyGte is an autogenerated method
+// NetworkIDGt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) KeyGte(key string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`key` >= ?", key))
+func (qs ACLRuleQuerySet) NetworkIDGt(networkID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "network_id", Value: networkID}))
 }
 
-// KeyIn is an autogenerated method
+// NetworkIDGte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) KeyIn(key ...string) AuthKeyQuerySet {
-	if len(key) == 0 {
-		qs.db.AddError(errors.New("must at least pass one key in KeyIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("key IN (?)", key))
+func (qs ACLRuleQuerySet) NetworkIDGte(networkID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "network_id", Value: networkID}))
 }
 
-// KeyLike is an autogenerated method
+// NetworkIDIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) KeyLike(key string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`key` LIKE ?", key))
+func (qs ACLRuleQuerySet) NetworkIDIn(networkID ...ID) ACLRuleQuerySet {
+	if len(networkID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "network_id"}, networkID))
 }
 
-// KeyLt is an autogenerated method
+// NetworkIDLt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) KeyLt(key string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`key` < ?", key))
+func (qs ACLRuleQuerySet) NetworkIDLt(networkID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "network_id", Value: networkID}))
 }
 
-// KeyLte is an autogenerated method
+// NetworkIDLte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) KeyLte(key string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`key` <= ?", key))
+func (qs ACLRuleQuerySet) NetworkIDLte(networkID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "network_id", Value: networkID}))
 }
 
-// KeyNe is an autogenerated method
+// NetworkIDNe is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) KeyNe(key string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`key` != ?", key))
+func (qs ACLRuleQuerySet) NetworkIDNe(networkID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "network_id", Value: networkID}))
 }
 
-// KeyNotIn is an autogenerated method
+// NetworkIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) KeyNotIn(key ...string) AuthKeyQuerySet {
-	if len(key) == 0 {
-		qs.db.AddError(errors.New("must at least pass one key in KeyNotIn"))
+func (qs ACLRuleQuerySet) NetworkIDNotIn(networkID ...ID) ACLRuleQuerySet {
+	if len(networkID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("key NOT IN (?)", key))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "network_id"}, networkID))
 }
 
-// KeyNotlike is an autogenerated method
+// NetworkIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) KeyNotlike(key string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`key` NOT LIKE ?", key))
+func (qs ACLRuleQuerySet) NetworkIsNotNull() ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "network", Value: nil}))
 }
 
-// Limit is an autogenerated method
+// NetworkIsNull is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) Limit(limit int) AuthKeyQuerySet {
-	return qs.w(qs.db.Limit(limit))
+func (qs ACLRuleQuerySet) NetworkIsNull() ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "network", Value: nil}))
 }
 
-// MachineIDEq is an autogenerated method
+// Offset is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) MachineIDEq(machineID string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`machine_id` = ?", machineID))
+func (qs ACLRuleQuerySet) Offset(offset int) ACLRuleQuerySet {
+	return qs.w(qs.db.Offset(offset))
 }
 
-// MachineIDGt is an autogenerated method
-// nolint: dupl
-func (qs AuthKeyQuerySet) MachineIDGt(machineID string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`machine_id` > ?", machineID))
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs ACLRuleQuerySet) One(ret *ACLRule) error {
+	return qs.db.First(ret).Error
 }
 
-// MachineIDGte is an autogenerated method
+// OrderAscByAction is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) MachineIDGte(machineID string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`machine_id` >= ?", machineID))
+func (qs ACLRuleQuerySet) OrderAscByAction() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "action"}}))
 }
 
-// MachineIDIn is an autogenerated method
+// OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) MachineIDIn(machineID ...string) AuthKeyQuerySet {
-	if len(machineID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one machineID in MachineIDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("machine_id IN (?)", machineID))
+func (qs ACLRuleQuerySet) OrderAscByCreatedAt() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
 }
 
-// MachineIDLike is an autogenerated method
+// OrderAscByCreatedByID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) MachineIDLike(machineID string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`machine_id` LIKE ?", machineID))
+func (qs ACLRuleQuerySet) OrderAscByCreatedByID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_by_id"}}))
 }
 
-// MachineIDLt is an autogenerated method
+// OrderAscByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) MachineIDLt(machineID string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`machine_id` < ?", machineID))
+func (qs ACLRuleQuerySet) OrderAscByDeletedAt() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
 }
 
-// MachineIDLte is an autogenerated method
+// OrderAscByDstDeviceID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) MachineIDLte(machineID string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`machine_id` <= ?", machineID))
+func (qs ACLRuleQuerySet) OrderAscByDstDeviceID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "dst_device_id"}}))
 }
 
-// MachineIDNe is an autogenerated method
+// OrderAscByID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) MachineIDNe(machineID string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`machine_id` != ?", machineID))
+func (qs ACLRuleQuerySet) OrderAscByID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
 }
 
-// MachineIDNotIn is an autogenerated method
+// OrderAscByNetworkID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) MachineIDNotIn(machineID ...string) AuthKeyQuerySet {
-	if len(machineID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one machineID in MachineIDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("machine_id NOT IN (?)", machineID))
+func (qs ACLRuleQuerySet) OrderAscByNetworkID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "network_id"}}))
 }
 
-// MachineIDNotlike is an autogenerated method
+// OrderAscByPorts is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) MachineIDNotlike(machineID string) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`machine_id` NOT LIKE ?", machineID))
+func (qs ACLRuleQuerySet) OrderAscByPorts() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "ports"}}))
 }
 
-// Offset is an autogenerated method
+// OrderAscByPriority is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) Offset(offset int) AuthKeyQuerySet {
-	return qs.w(qs.db.Offset(offset))
+func (qs ACLRuleQuerySet) OrderAscByPriority() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "priority"}}))
 }
 
-// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
-// if nothing was fetched
-func (qs AuthKeyQuerySet) One(ret *AuthKey) error {
-	return qs.db.First(ret).Error
+// OrderAscByProtocol is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) OrderAscByProtocol() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "protocol"}}))
 }
 
-// OrderAscByCreatedAt is an autogenerated method
+// OrderAscBySrcDeviceID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderAscByCreatedAt() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+func (qs ACLRuleQuerySet) OrderAscBySrcDeviceID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "src_device_id"}}))
 }
 
-// OrderAscByDeletedAt is an autogenerated method
+// OrderAscBySrcUserID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderAscByDeletedAt() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+func (qs ACLRuleQuerySet) OrderAscBySrcUserID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "src_user_id"}}))
 }
 
-// OrderAscByEnabled is an autogenerated method
+// OrderAscByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderAscByEnabled() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("enabled ASC"))
+func (qs ACLRuleQuerySet) OrderAscByUpdatedAt() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
 }
 
-// OrderAscByExpiredAt is an autogenerated method
+// OrderDescByAction is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderAscByExpiredAt() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("expired_at ASC"))
+func (qs ACLRuleQuerySet) OrderDescByAction() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "action"}, Desc: true}))
 }
 
-// OrderAscByID is an autogenerated method
+// OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderAscByID() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+func (qs ACLRuleQuerySet) OrderDescByCreatedAt() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
 }
 
-// OrderAscByKey is an autogenerated method
+// OrderDescByCreatedByID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderAscByKey() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("key ASC"))
+func (qs ACLRuleQuerySet) OrderDescByCreatedByID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_by_id"}, Desc: true}))
 }
 
-// OrderAscByMachineID is an autogenerated method
+// OrderDescByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderAscByMachineID() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("machine_id ASC"))
+func (qs ACLRuleQuerySet) OrderDescByDeletedAt() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
 }
 
-// OrderAscByRole is an autogenerated method
+// OrderDescByDstDeviceID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderAscByRole() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("role ASC"))
+func (qs ACLRuleQuerySet) OrderDescByDstDeviceID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "dst_device_id"}, Desc: true}))
 }
 
-// OrderAscByType is an autogenerated method
+// OrderDescByID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderAscByType() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("type ASC"))
+func (qs ACLRuleQuerySet) OrderDescByID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
 }
 
-// OrderAscByUpdatedAt is an autogenerated method
+// OrderDescByNetworkID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderAscByUpdatedAt() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+func (qs ACLRuleQuerySet) OrderDescByNetworkID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "network_id"}, Desc: true}))
 }
 
-// OrderAscByUserID is an autogenerated method
+// OrderDescByPorts is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderAscByUserID() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("user_id ASC"))
+func (qs ACLRuleQuerySet) OrderDescByPorts() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "ports"}, Desc: true}))
 }
 
-// OrderDescByCreatedAt is an autogenerated method
+// OrderDescByPriority is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderDescByCreatedAt() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+func (qs ACLRuleQuerySet) OrderDescByPriority() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "priority"}, Desc: true}))
 }
 
-// OrderDescByDeletedAt is an autogenerated method
+// OrderDescByProtocol is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderDescByDeletedAt() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+func (qs ACLRuleQuerySet) OrderDescByProtocol() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "protocol"}, Desc: true}))
 }
 
-// OrderDescByEnabled is an autogenerated method
+// OrderDescBySrcDeviceID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderDescByEnabled() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("enabled DESC"))
+func (qs ACLRuleQuerySet) OrderDescBySrcDeviceID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "src_device_id"}, Desc: true}))
 }
 
-// OrderDescByExpiredAt is an autogenerated method
+// OrderDescBySrcUserID is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderDescByExpiredAt() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("expired_at DESC"))
+func (qs ACLRuleQuerySet) OrderDescBySrcUserID() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "src_user_id"}, Desc: true}))
 }
 
-// OrderDescByID is an autogenerated method
+// OrderDescByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderDescByID() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (qs ACLRuleQuerySet) OrderDescByUpdatedAt() ACLRuleQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
 }
 
-// OrderDescByKey is an autogenerated method
+// PortsEq is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderDescByKey() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("key DESC"))
+func (qs ACLRuleQuerySet) PortsEq(ports string) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "ports", Value: ports}))
 }
 
-// OrderDescByMachineID is an autogenerated method
+// PortsGt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderDescByMachineID() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("machine_id DESC"))
+func (qs ACLRuleQuerySet) PortsGt(ports string) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "ports", Value: ports}))
 }
 
-// OrderDescByRole is an autogenerated method
+// PortsGte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderDescByRole() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("role DESC"))
+func (qs ACLRuleQuerySet) PortsGte(ports string) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "ports", Value: ports}))
 }
 
-// OrderDescByType is an autogenerated method
+// PortsIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderDescByType() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("type DESC"))
+func (qs ACLRuleQuerySet) PortsIn(ports ...string) ACLRuleQuerySet {
+	if len(ports) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ports in PortsIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "ports"}, ports))
 }
 
-// OrderDescByUpdatedAt is an autogenerated method
+// PortsLike is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderDescByUpdatedAt() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+func (qs ACLRuleQuerySet) PortsLike(ports string) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "ports", Value: ports}))
 }
 
-// OrderDescByUserID is an autogenerated method
+// PortsLt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) OrderDescByUserID() AuthKeyQuerySet {
-	return qs.w(qs.db.Order("user_id DESC"))
+func (qs ACLRuleQuerySet) PortsLt(ports string) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "ports", Value: ports}))
 }
 
-// PreloadUser is an autogenerated method
+// PortsLte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) PreloadUser() AuthKeyQuerySet {
-	return qs.w(qs.db.Preload("User"))
+func (qs ACLRuleQuerySet) PortsLte(ports string) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "ports", Value: ports}))
 }
 
-// RoleEq is an autogenerated method
+// PortsNe is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) RoleEq(role KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`role` = ?", role))
+func (qs ACLRuleQuerySet) PortsNe(ports string) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "ports", Value: ports}))
 }
 
-// RoleGt is an autogenerated method
+// PortsNotIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) RoleGt(role KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`role` > ?", role))
+func (qs ACLRuleQuerySet) PortsNotIn(ports ...string) ACLRuleQuerySet {
+	if len(ports) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ports in PortsNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "ports"}, ports))
 }
 
-// RoleGte is an autogenerated method
+// PortsNotlike is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) RoleGte(role KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`role` >= ?", role))
+func (qs ACLRuleQuerySet) PortsNotlike(ports string) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "ports", Value: ports})))
 }
 
-// RoleIn is an autogenerated method
+// PreloadNetwork is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) RoleIn(role ...KeyType) AuthKeyQuerySet {
-	if len(role) == 0 {
-		qs.db.AddError(errors.New("must at least pass one role in RoleIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("role IN (?)", role))
+func (qs ACLRuleQuerySet) PreloadNetwork() ACLRuleQuerySet {
+	return qs.w(qs.db.Preload("Network"))
 }
 
-// RoleLike is an autogenerated method
+// PriorityEq is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) RoleLike(role KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`role` LIKE ?", role))
+func (qs ACLRuleQuerySet) PriorityEq(priority int) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "priority", Value: priority}))
 }
 
-// RoleLt is an autogenerated method
+// PriorityGt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) RoleLt(role KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`role` < ?", role))
+func (qs ACLRuleQuerySet) PriorityGt(priority int) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "priority", Value: priority}))
 }
 
-// RoleLte is an autogenerated method
+// PriorityGte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) RoleLte(role KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`role` <= ?", role))
+func (qs ACLRuleQuerySet) PriorityGte(priority int) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "priority", Value: priority}))
 }
 
-// RoleNe is an autogenerated method
+// PriorityIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) RoleNe(role KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`role` != ?", role))
+func (qs ACLRuleQuerySet) PriorityIn(priority ...int) ACLRuleQuerySet {
+	if len(priority) == 0 {
+		qs.db.AddError(errors.New("must at least pass one priority in PriorityIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "priority"}, priority))
 }
 
-// RoleNotIn is an autogenerated method
+// PriorityLt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) RoleNotIn(role ...KeyType) AuthKeyQuerySet {
-	if len(role) == 0 {
-		qs.db.AddError(errors.New("must at least pass one role in RoleNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("role NOT IN (?)", role))
+func (qs ACLRuleQuerySet) PriorityLt(priority int) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "priority", Value: priority}))
 }
 
-// RoleNotlike is an autogenerated method
+// PriorityLte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) RoleNotlike(role KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`role` NOT LIKE ?", role))
+func (qs ACLRuleQuerySet) PriorityLte(priority int) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "priority", Value: priority}))
 }
 
-// TypeEq is an autogenerated method
+// PriorityNe is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) TypeEq(typeValue KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`type` = ?", typeValue))
+func (qs ACLRuleQuerySet) PriorityNe(priority int) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "priority", Value: priority}))
 }
 
-// TypeGt is an autogenerated method
+// PriorityNotIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) TypeGt(typeValue KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`type` > ?", typeValue))
+func (qs ACLRuleQuerySet) PriorityNotIn(priority ...int) ACLRuleQuerySet {
+	if len(priority) == 0 {
+		qs.db.AddError(errors.New("must at least pass one priority in PriorityNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "priority"}, priority))
 }
 
-// TypeGte is an autogenerated method
+// ProtocolEq is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) TypeGte(typeValue KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`type` >= ?", typeValue))
+func (qs ACLRuleQuerySet) ProtocolEq(protocol ACLProtocol) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "protocol", Value: protocol}))
 }
 
-// TypeIn is an autogenerated method
+// ProtocolGt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) TypeIn(typeValue ...KeyType) AuthKeyQuerySet {
-	if len(typeValue) == 0 {
-		qs.db.AddError(errors.New("must at least pass one typeValue in TypeIn"))
+func (qs ACLRuleQuerySet) ProtocolGt(protocol ACLProtocol) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "protocol", Value: protocol}))
+}
+
+// ProtocolGte is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ProtocolGte(protocol ACLProtocol) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "protocol", Value: protocol}))
+}
+
+// ProtocolIn is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) ProtocolIn(protocol ...ACLProtocol) ACLRuleQuerySet {
+	if len(protocol) == 0 {
+		qs.db.AddError(errors.New("must at least pass one protocol in ProtocolIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("type IN (?)", typeValue))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "protocol"}, protocol))
 }
 
-// TypeLike is an autogenerated method
+// ProtocolLike is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) TypeLike(typeValue KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`type` LIKE ?", typeValue))
+func (qs ACLRuleQuerySet) ProtocolLike(protocol ACLProtocol) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "protocol", Value: protocol}))
 }
 
-// TypeLt is an autogenerated method
+// ProtocolLt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) TypeLt(typeValue KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`type` < ?", typeValue))
+func (qs ACLRuleQuerySet) ProtocolLt(protocol ACLProtocol) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "protocol", Value: protocol}))
 }
 
-// TypeLte is an autogenerated method
+// ProtocolLte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) TypeLte(typeValue KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`type` <= ?", typeValue))
+func (qs ACLRuleQuerySet) ProtocolLte(protocol ACLProtocol) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "protocol", Value: protocol}))
 }
 
-// TypeNe is an autogenerated method
+// ProtocolNe is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) TypeNe(typeValue KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`type` != ?", typeValue))
+func (qs ACLRuleQuerySet) ProtocolNe(protocol ACLProtocol) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "protocol", Value: protocol}))
 }
 
-// TypeNotIn is an autogenerated method
+// ProtocolNotIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) TypeNotIn(typeValue ...KeyType) AuthKeyQuerySet {
-	if len(typeValue) == 0 {
-		qs.db.AddError(errors.New("must at least pass one typeValue in TypeNotIn"))
+func (qs ACLRuleQuerySet) ProtocolNotIn(protocol ...ACLProtocol) ACLRuleQuerySet {
+	if len(protocol) == 0 {
+		qs.db.AddError(errors.New("must at least pass one protocol in ProtocolNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("type NOT IN (?)", typeValue))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "protocol"}, protocol))
 }
 
-// TypeNotlike is an autogenerated method
+// ProtocolNotlike is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) TypeNotlike(typeValue KeyType) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`type` NOT LIKE ?", typeValue))
+func (qs ACLRuleQuerySet) ProtocolNotlike(protocol ACLProtocol) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "protocol", Value: protocol})))
 }
 
-// UpdatedAtEq is an autogenerated method
+// SrcDeviceIDEq is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UpdatedAtEq(updatedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`updated_at` = ?", updatedAt))
+func (qs ACLRuleQuerySet) SrcDeviceIDEq(srcDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "src_device_id", Value: srcDeviceID}))
 }
 
-// UpdatedAtGt is an autogenerated method
+// SrcDeviceIDGt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UpdatedAtGt(updatedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`updated_at` > ?", updatedAt))
+func (qs ACLRuleQuerySet) SrcDeviceIDGt(srcDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "src_device_id", Value: srcDeviceID}))
 }
 
-// UpdatedAtGte is an autogenerated method
+// SrcDeviceIDGte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UpdatedAtGte(updatedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`updated_at` >= ?", updatedAt))
+func (qs ACLRuleQuerySet) SrcDeviceIDGte(srcDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "src_device_id", Value: srcDeviceID}))
 }
 
-// UpdatedAtIsNotNull is an autogenerated method
+// SrcDeviceIDIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UpdatedAtIsNotNull() AuthKeyQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NOT NULL"))
+func (qs ACLRuleQuerySet) SrcDeviceIDIn(srcDeviceID ...ID) ACLRuleQuerySet {
+	if len(srcDeviceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one srcDeviceID in SrcDeviceIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "src_device_id"}, srcDeviceID))
 }
 
-// UpdatedAtIsNull is an autogenerated method
+// SrcDeviceIDLt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UpdatedAtIsNull() AuthKeyQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NULL"))
+func (qs ACLRuleQuerySet) SrcDeviceIDLt(srcDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "src_device_id", Value: srcDeviceID}))
 }
 
-// UpdatedAtLt is an autogenerated method
+// SrcDeviceIDLte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UpdatedAtLt(updatedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`updated_at` < ?", updatedAt))
+func (qs ACLRuleQuerySet) SrcDeviceIDLte(srcDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "src_device_id", Value: srcDeviceID}))
 }
 
-// UpdatedAtLte is an autogenerated method
+// SrcDeviceIDNe is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UpdatedAtLte(updatedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`updated_at` <= ?", updatedAt))
+func (qs ACLRuleQuerySet) SrcDeviceIDNe(srcDeviceID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "src_device_id", Value: srcDeviceID}))
 }
 
-// UpdatedAtNe is an autogenerated method
+// SrcDeviceIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UpdatedAtNe(updatedAt time.Time) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`updated_at` != ?", updatedAt))
+func (qs ACLRuleQuerySet) SrcDeviceIDNotIn(srcDeviceID ...ID) ACLRuleQuerySet {
+	if len(srcDeviceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one srcDeviceID in SrcDeviceIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "src_device_id"}, srcDeviceID))
 }
 
-// UserIDEq is an autogenerated method
+// SrcUserIDEq is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UserIDEq(userID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`user_id` = ?", userID))
+func (qs ACLRuleQuerySet) SrcUserIDEq(srcUserID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "src_user_id", Value: srcUserID}))
 }
 
-// UserIDGt is an autogenerated method
+// SrcUserIDGt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UserIDGt(userID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`user_id` > ?", userID))
+func (qs ACLRuleQuerySet) SrcUserIDGt(srcUserID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "src_user_id", Value: srcUserID}))
 }
 
-// UserIDGte is an autogenerated method
+// SrcUserIDGte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UserIDGte(userID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`user_id` >= ?", userID))
+func (qs ACLRuleQuerySet) SrcUserIDGte(srcUserID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "src_user_id", Value: srcUserID}))
 }
 
-// UserIDIn is an autogenerated method
+// SrcUserIDIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UserIDIn(userID ...ID) AuthKeyQuerySet {
-	if len(userID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+func (qs ACLRuleQuerySet) SrcUserIDIn(srcUserID ...ID) ACLRuleQuerySet {
+	if len(srcUserID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one srcUserID in SrcUserIDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("user_id IN (?)", userID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "src_user_id"}, srcUserID))
 }
 
-// UserIDLt is an autogenerated method
+// SrcUserIDLt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UserIDLt(userID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`user_id` < ?", userID))
+func (qs ACLRuleQuerySet) SrcUserIDLt(srcUserID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "src_user_id", Value: srcUserID}))
 }
 
-// UserIDLte is an autogenerated method
+// SrcUserIDLte is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UserIDLte(userID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`user_id` <= ?", userID))
+func (qs ACLRuleQuerySet) SrcUserIDLte(srcUserID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "src_user_id", Value: srcUserID}))
 }
 
-// UserIDNe is an autogenerated method
+// SrcUserIDNe is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UserIDNe(userID ID) AuthKeyQuerySet {
-	return qs.w(qs.db.Where("`user_id` != ?", userID))
+func (qs ACLRuleQuerySet) SrcUserIDNe(srcUserID ID) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "src_user_id", Value: srcUserID}))
 }
 
-// UserIDNotIn is an autogenerated method
+// SrcUserIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UserIDNotIn(userID ...ID) AuthKeyQuerySet {
-	if len(userID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+func (qs ACLRuleQuerySet) SrcUserIDNotIn(srcUserID ...ID) ACLRuleQuerySet {
+	if len(srcUserID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one srcUserID in SrcUserIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "src_user_id"}, srcUserID))
 }
 
-// UserIsNotNull is an autogenerated method
+// UpdatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UserIsNotNull() AuthKeyQuerySet {
-	return qs.w(qs.db.Where("user IS NOT NULL"))
+func (qs ACLRuleQuerySet) UpdatedAtEq(updatedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
 }
 
-// UserIsNull is an autogenerated method
+// UpdatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs AuthKeyQuerySet) UserIsNull() AuthKeyQuerySet {
-	return qs.w(qs.db.Where("user IS NULL"))
+func (qs ACLRuleQuerySet) UpdatedAtGt(updatedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) UpdatedAtGte(updatedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) UpdatedAtIsNotNull() ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) UpdatedAtIsNull() ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) UpdatedAtLt(updatedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) UpdatedAtLte(updatedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs ACLRuleQuerySet) UpdatedAtNe(updatedAt time.Time) ACLRuleQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
+}
+
+// SetAction is an autogenerated method
+// nolint: dupl
+func (u ACLRuleUpdater) SetAction(action ACLAction) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.Action)] = action
+	return u
 }
 
 // SetCreatedAt is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) SetCreatedAt(createdAt time.Time) AuthKeyUpdater {
-	u.fields[string(AuthKeyDBSchema.CreatedAt)] = createdAt
+func (u ACLRuleUpdater) SetCreatedAt(createdAt time.Time) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.CreatedAt)] = createdAt
 	return u
 }
 
-// SetDeletedAt is an autogenerated method
+// SetCreatedByID is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) SetDeletedAt(deletedAt *time.Time) AuthKeyUpdater {
-	u.fields[string(AuthKeyDBSchema.DeletedAt)] = deletedAt
+func (u ACLRuleUpdater) SetCreatedByID(createdByID ID) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.CreatedByID)] = createdByID
 	return u
 }
 
-// SetEnabled is an autogenerated method
+// SetDeletedAt is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) SetEnabled(enabled bool) AuthKeyUpdater {
-	u.fields[string(AuthKeyDBSchema.Enabled)] = enabled
+func (u ACLRuleUpdater) SetDeletedAt(deletedAt *time.Time) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.DeletedAt)] = deletedAt
 	return u
 }
 
-// SetExpiredAt is an autogenerated method
+// SetDstDeviceID is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) SetExpiredAt(expiredAt time.Time) AuthKeyUpdater {
-	u.fields[string(AuthKeyDBSchema.ExpiredAt)] = expiredAt
+func (u ACLRuleUpdater) SetDstDeviceID(dstDeviceID ID) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.DstDeviceID)] = dstDeviceID
 	return u
 }
 
 // SetID is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) SetID(ID ID) AuthKeyUpdater {
-	u.fields[string(AuthKeyDBSchema.ID)] = ID
+func (u ACLRuleUpdater) SetID(ID ID) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.ID)] = ID
 	return u
 }
 
-// SetKey is an autogenerated method
+// SetNetworkID is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) SetKey(key string) AuthKeyUpdater {
-	u.fields[string(AuthKeyDBSchema.Key)] = key
+func (u ACLRuleUpdater) SetNetworkID(networkID ID) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.NetworkID)] = networkID
 	return u
 }
 
-// SetMachineID is an autogenerated method
+// SetPorts is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) SetMachineID(machineID string) AuthKeyUpdater {
-	u.fields[string(AuthKeyDBSchema.MachineID)] = machineID
+func (u ACLRuleUpdater) SetPorts(ports string) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.Ports)] = ports
 	return u
 }
 
-// SetRole is an autogenerated method
+// SetPriority is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) SetRole(role KeyType) AuthKeyUpdater {
-	u.fields[string(AuthKeyDBSchema.Role)] = role
+func (u ACLRuleUpdater) SetPriority(priority int) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.Priority)] = priority
 	return u
 }
 
-// SetType is an autogenerated method
+// SetProtocol is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) SetType(typeValue KeyType) AuthKeyUpdater {
-	u.fields[string(AuthKeyDBSchema.Type)] = typeValue
+func (u ACLRuleUpdater) SetProtocol(protocol ACLProtocol) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.Protocol)] = protocol
 	return u
 }
 
-// SetUpdatedAt is an autogenerated method
+// SetSrcDeviceID is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) SetUpdatedAt(updatedAt *time.Time) AuthKeyUpdater {
-	u.fields[string(AuthKeyDBSchema.UpdatedAt)] = updatedAt
+func (u ACLRuleUpdater) SetSrcDeviceID(srcDeviceID ID) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.SrcDeviceID)] = srcDeviceID
 	return u
 }
 
-// SetUserID is an autogenerated method
+// SetSrcUserID is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) SetUserID(userID ID) AuthKeyUpdater {
-	u.fields[string(AuthKeyDBSchema.UserID)] = userID
+func (u ACLRuleUpdater) SetSrcUserID(srcUserID ID) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.SrcUserID)] = srcUserID
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u ACLRuleUpdater) SetUpdatedAt(updatedAt *time.Time) ACLRuleUpdater {
+	u.fields[string(ACLRuleDBSchema.UpdatedAt)] = updatedAt
 	return u
 }
 
 // Update is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) Update() error {
+func (u ACLRuleUpdater) Update() error {
 	return u.db.Updates(u.fields).Error
 }
 
 // UpdateNum is an autogenerated method
 // nolint: dupl
-func (u AuthKeyUpdater) UpdateNum() (int64, error) {
+func (u ACLRuleUpdater) UpdateNum() (int64, error) {
 	db := u.db.Updates(u.fields)
 	return db.RowsAffected, db.Error
 }
 
-// ===== END of query set AuthKeyQuerySet
+// ===== END of query set ACLRuleQuerySet
 
-// ===== BEGIN of AuthKey modifiers
+// ===== BEGIN of ACLRule modifiers
 
-// AuthKeyDBSchemaField describes database schema field. It requires for method 'Update'
-type AuthKeyDBSchemaField string
+// ACLRuleDBSchemaField describes database schema field. It requires for method 'Update'
+type ACLRuleDBSchemaField string
 
 // String method returns string representation of field.
 // nolint: dupl
-func (f AuthKeyDBSchemaField) String() string {
+func (f ACLRuleDBSchemaField) String() string {
 	return string(f)
 }
 
-// AuthKeyDBSchema stores db field names of AuthKey
-var AuthKeyDBSchema = struct {
-	ID        AuthKeyDBSchemaField
-	CreatedAt AuthKeyDBSchemaField
-	UpdatedAt AuthKeyDBSchemaField
-	DeletedAt AuthKeyDBSchemaField
-	UserID    AuthKeyDBSchemaField
-	User      AuthKeyDBSchemaField
-	Type      AuthKeyDBSchemaField
-	Role      AuthKeyDBSchemaField
-	Key       AuthKeyDBSchemaField
-	MachineID AuthKeyDBSchemaField
-	ExpiredAt AuthKeyDBSchemaField
-	Enabled   AuthKeyDBSchemaField
+// ACLRuleDBSchema stores db field names of ACLRule
+var ACLRuleDBSchema = struct {
+	ID          ACLRuleDBSchemaField
+	CreatedAt   ACLRuleDBSchemaField
+	UpdatedAt   ACLRuleDBSchemaField
+	DeletedAt   ACLRuleDBSchemaField
+	NetworkID   ACLRuleDBSchemaField
+	Network     ACLRuleDBSchemaField
+	Priority    ACLRuleDBSchemaField
+	Action      ACLRuleDBSchemaField
+	SrcUserID   ACLRuleDBSchemaField
+	SrcDeviceID ACLRuleDBSchemaField
+	DstDeviceID ACLRuleDBSchemaField
+	Protocol    ACLRuleDBSchemaField
+	Ports       ACLRuleDBSchemaField
+	CreatedByID ACLRuleDBSchemaField
 }{
 
-	ID:        AuthKeyDBSchemaField("id"),
-	CreatedAt: AuthKeyDBSchemaField("created_at"),
-	UpdatedAt: AuthKeyDBSchemaField("updated_at"),
-	DeletedAt: AuthKeyDBSchemaField("deleted_at"),
-	UserID:    AuthKeyDBSchemaField("user_id"),
-	User:      AuthKeyDBSchemaField("user"),
-	Type:      AuthKeyDBSchemaField("type"),
-	Role:      AuthKeyDBSchemaField("role"),
-	Key:       AuthKeyDBSchemaField("key"),
-	MachineID: AuthKeyDBSchemaField("machine_id"),
-	ExpiredAt: AuthKeyDBSchemaField("expired_at"),
-	Enabled:   AuthKeyDBSchemaField("enabled"),
-}
-
-// Update updates AuthKey fields by primary key
-// nolint: dupl
-func (o *AuthKey) Update(db *gorm.DB, fields ...AuthKeyDBSchemaField) error {
+	ID:          ACLRuleDBSchemaField("id"),
+	CreatedAt:   ACLRuleDBSchemaField("created_at"),
+	UpdatedAt:   ACLRuleDBSchemaField("updated_at"),
+	DeletedAt:   ACLRuleDBSchemaField("deleted_at"),
+	NetworkID:   ACLRuleDBSchemaField("network_id"),
+	Network:     ACLRuleDBSchemaField("network"),
+	Priority:    ACLRuleDBSchemaField("priority"),
+	Action:      ACLRuleDBSchemaField("action"),
+	SrcUserID:   ACLRuleDBSchemaField("src_user_id"),
+	SrcDeviceID: ACLRuleDBSchemaField("src_device_id"),
+	DstDeviceID: ACLRuleDBSchemaField("dst_device_id"),
+	Protocol:    ACLRuleDBSchemaField("protocol"),
+	Ports:       ACLRuleDBSchemaField("ports"),
+	CreatedByID: ACLRuleDBSchemaField("created_by_id"),
+}
+
+// Update updates ACLRule fields by primary key
+// nolint: dupl
+func (o *ACLRule) Update(db *gorm.DB, fields ...ACLRuleDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
-		"id":         o.ID,
-		"created_at": o.CreatedAt,
-		"updated_at": o.UpdatedAt,
-		"deleted_at": o.DeletedAt,
-		"user_id":    o.UserID,
-		"user":       o.User,
-		"type":       o.Type,
-		"role":       o.Role,
-		"key":        o.Key,
-		"machine_id": o.MachineID,
-		"expired_at": o.ExpiredAt,
-		"enabled":    o.Enabled,
+		"id":            o.ID,
+		"created_at":    o.CreatedAt,
+		"updated_at":    o.UpdatedAt,
+		"deleted_at":    o.DeletedAt,
+		"network_id":    o.NetworkID,
+		"network":       o.Network,
+		"priority":      o.Priority,
+		"action":        o.Action,
+		"src_user_id":   o.SrcUserID,
+		"src_device_id": o.SrcDeviceID,
+		"dst_device_id": o.DstDeviceID,
+		"protocol":      o.Protocol,
+		"ports":         o.Ports,
+		"created_by_id": o.CreatedByID,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -1015,55 +1204,55 @@ func (o *AuthKey) Update(db *gorm.DB, fields ...AuthKeyDBSchemaField) error {
 			return err
 		}
 
-		return fmt.Errorf("can't update AuthKey %v fields %v: %s",
+		return fmt.Errorf("can't update ACLRule %v fields %v: %s",
 			o, fields, err)
 	}
 
 	return nil
 }
 
-// AuthKeyUpdater is an AuthKey updates manager
-type AuthKeyUpdater struct {
+// ACLRuleUpdater is an ACLRule updates manager
+type ACLRuleUpdater struct {
 	fields map[string]interface{}
 	db     *gorm.DB
 }
 
-// NewAuthKeyUpdater creates new AuthKey updater
+// NewACLRuleUpdater creates new ACLRule updater
 // nolint: dupl
-func NewAuthKeyUpdater(db *gorm.DB) AuthKeyUpdater {
-	return AuthKeyUpdater{
+func NewACLRuleUpdater(db *gorm.DB) ACLRuleUpdater {
+	return ACLRuleUpdater{
 		fields: map[string]interface{}{},
-		db:     db.Model(&AuthKey{}),
+		db:     db.Model(&ACLRule{}),
 	}
 }
 
-// ===== END of AuthKey modifiers
+// ===== END of ACLRule modifiers
 
-// ===== BEGIN of query set DeviceQuerySet
+// ===== BEGIN of query set AuditLogQuerySet
 
-// DeviceQuerySet is an queryset type for Device
-type DeviceQuerySet struct {
+// AuditLogQuerySet is an queryset type for AuditLog
+type AuditLogQuerySet struct {
 	db *gorm.DB
 }
 
-// NewDeviceQuerySet constructs new DeviceQuerySet
-func NewDeviceQuerySet(db *gorm.DB) DeviceQuerySet {
-	return DeviceQuerySet{
-		db: db.Model(&Device{}),
+// NewAuditLogQuerySet constructs new AuditLogQuerySet
+func NewAuditLogQuerySet(db *gorm.DB) AuditLogQuerySet {
+	return AuditLogQuerySet{
+		db: db.Model(&AuditLog{}),
 	}
 }
 
-func (qs DeviceQuerySet) w(db *gorm.DB) DeviceQuerySet {
-	return NewDeviceQuerySet(db)
+func (qs AuditLogQuerySet) w(db *gorm.DB) AuditLogQuerySet {
+	return NewAuditLogQuerySet(db)
 }
 
-func (qs DeviceQuerySet) Preload(query string, args ...interface{}) DeviceQuerySet {
-	return NewDeviceQuerySet(qs.db.Preload(query, args...))
+func (qs AuditLogQuerySet) Preload(query string, args ...interface{}) AuditLogQuerySet {
+	return NewAuditLogQuerySet(qs.db.Preload(query, args...))
 }
 
 // Preload preload associations with given conditions
 //    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
-func (qs DeviceQuerySet) Select(fields ...DeviceDBSchemaField) DeviceQuerySet {
+func (qs AuditLogQuerySet) Select(fields ...AuditLogDBSchemaField) AuditLogQuerySet {
 	names := []string{}
 	for _, f := range fields {
 		names = append(names, f.String())
@@ -1074,1077 +1263,1104 @@ func (qs DeviceQuerySet) Select(fields ...DeviceDBSchemaField) DeviceQuerySet {
 
 // Create is an autogenerated method
 // nolint: dupl
-func (o *Device) Create(db *gorm.DB) error {
+func (o *AuditLog) Create(db *gorm.DB) error {
 	return db.Create(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (o *Device) Delete(db *gorm.DB) error {
+func (o *AuditLog) Delete(db *gorm.DB) error {
 	return db.Delete(o).Error
 }
 
-// AddressEq is an autogenerated method
+// ActionEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) AddressEq(address string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`address` = ?", address))
+func (qs AuditLogQuerySet) ActionEq(action AuditAction) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "action", Value: action}))
 }
 
-// AddressGt is an autogenerated method
+// ActionGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) AddressGt(address string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`address` > ?", address))
+func (qs AuditLogQuerySet) ActionGt(action AuditAction) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "action", Value: action}))
 }
 
-// AddressGte is an autogenerated method
+// ActionGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) AddressGte(address string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`address` >= ?", address))
+func (qs AuditLogQuerySet) ActionGte(action AuditAction) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "action", Value: action}))
 }
 
-// AddressIn is an autogenerated method
+// ActionIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) AddressIn(address ...string) DeviceQuerySet {
-	if len(address) == 0 {
-		qs.db.AddError(errors.New("must at least pass one address in AddressIn"))
+func (qs AuditLogQuerySet) ActionIn(action ...AuditAction) AuditLogQuerySet {
+	if len(action) == 0 {
+		qs.db.AddError(errors.New("must at least pass one action in ActionIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("address IN (?)", address))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "action"}, action))
 }
 
-// AddressLike is an autogenerated method
+// ActionLike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) AddressLike(address string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`address` LIKE ?", address))
+func (qs AuditLogQuerySet) ActionLike(action AuditAction) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "action", Value: action}))
 }
 
-// AddressLt is an autogenerated method
+// ActionLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) AddressLt(address string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`address` < ?", address))
+func (qs AuditLogQuerySet) ActionLt(action AuditAction) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "action", Value: action}))
 }
 
-// AddressLte is an autogenerated method
+// ActionLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) AddressLte(address string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`address` <= ?", address))
+func (qs AuditLogQuerySet) ActionLte(action AuditAction) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "action", Value: action}))
 }
 
-// AddressNe is an autogenerated method
+// ActionNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) AddressNe(address string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`address` != ?", address))
+func (qs AuditLogQuerySet) ActionNe(action AuditAction) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "action", Value: action}))
 }
 
-// AddressNotIn is an autogenerated method
+// ActionNotIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) AddressNotIn(address ...string) DeviceQuerySet {
-	if len(address) == 0 {
-		qs.db.AddError(errors.New("must at least pass one address in AddressNotIn"))
+func (qs AuditLogQuerySet) ActionNotIn(action ...AuditAction) AuditLogQuerySet {
+	if len(action) == 0 {
+		qs.db.AddError(errors.New("must at least pass one action in ActionNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("address NOT IN (?)", address))
-}
-
-// AddressNotlike is an autogenerated method
-// nolint: dupl
-func (qs DeviceQuerySet) AddressNotlike(address string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`address` NOT LIKE ?", address))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "action"}, action))
 }
 
-// All is an autogenerated method
+// ActionNotlike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) All(ret *[]Device) error {
-	return qs.db.Find(ret).Error
+func (qs AuditLogQuerySet) ActionNotlike(action AuditAction) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "action", Value: action})))
 }
 
-// Count is an autogenerated method
+// ActorIDEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) Count() (int64, error) {
-	var count int64
-	err := qs.db.Count(&count).Error
-	return count, err
+func (qs AuditLogQuerySet) ActorIDEq(actorID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "actor_id", Value: actorID}))
 }
 
-// CreatedAtEq is an autogenerated method
+// ActorIDGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) CreatedAtEq(createdAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`created_at` = ?", createdAt))
+func (qs AuditLogQuerySet) ActorIDGt(actorID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "actor_id", Value: actorID}))
 }
 
-// CreatedAtGt is an autogenerated method
+// ActorIDGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) CreatedAtGt(createdAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`created_at` > ?", createdAt))
+func (qs AuditLogQuerySet) ActorIDGte(actorID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "actor_id", Value: actorID}))
 }
 
-// CreatedAtGte is an autogenerated method
+// ActorIDIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) CreatedAtGte(createdAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`created_at` >= ?", createdAt))
+func (qs AuditLogQuerySet) ActorIDIn(actorID ...ID) AuditLogQuerySet {
+	if len(actorID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one actorID in ActorIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "actor_id"}, actorID))
 }
 
-// CreatedAtLt is an autogenerated method
+// ActorIDLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) CreatedAtLt(createdAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`created_at` < ?", createdAt))
+func (qs AuditLogQuerySet) ActorIDLt(actorID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "actor_id", Value: actorID}))
 }
 
-// CreatedAtLte is an autogenerated method
+// ActorIDLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) CreatedAtLte(createdAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`created_at` <= ?", createdAt))
+func (qs AuditLogQuerySet) ActorIDLte(actorID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "actor_id", Value: actorID}))
 }
 
-// CreatedAtNe is an autogenerated method
+// ActorIDNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) CreatedAtNe(createdAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`created_at` != ?", createdAt))
+func (qs AuditLogQuerySet) ActorIDNe(actorID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "actor_id", Value: actorID}))
 }
 
-// Delete is an autogenerated method
+// ActorIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) Delete() error {
-	return qs.db.Delete(Device{}).Error
+func (qs AuditLogQuerySet) ActorIDNotIn(actorID ...ID) AuditLogQuerySet {
+	if len(actorID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one actorID in ActorIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "actor_id"}, actorID))
 }
 
-// DeleteNum is an autogenerated method
+// AfterEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(Device{})
-	return db.RowsAffected, db.Error
+func (qs AuditLogQuerySet) AfterEq(after string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "after", Value: after}))
 }
 
-// DeleteNumUnscoped is an autogenerated method
+// AfterGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(Device{})
-	return db.RowsAffected, db.Error
+func (qs AuditLogQuerySet) AfterGt(after string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "after", Value: after}))
 }
 
-// DeletedAtEq is an autogenerated method
+// AfterGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) DeletedAtEq(deletedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` = ?", deletedAt))
+func (qs AuditLogQuerySet) AfterGte(after string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "after", Value: after}))
 }
 
-// DeletedAtGt is an autogenerated method
+// AfterIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) DeletedAtGt(deletedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` > ?", deletedAt))
+func (qs AuditLogQuerySet) AfterIn(after ...string) AuditLogQuerySet {
+	if len(after) == 0 {
+		qs.db.AddError(errors.New("must at least pass one after in AfterIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "after"}, after))
 }
 
-// DeletedAtGte is an autogenerated method
+// AfterLike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) DeletedAtGte(deletedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` >= ?", deletedAt))
+func (qs AuditLogQuerySet) AfterLike(after string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "after", Value: after}))
 }
 
-// DeletedAtIsNotNull is an autogenerated method
+// AfterLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) DeletedAtIsNotNull() DeviceQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+func (qs AuditLogQuerySet) AfterLt(after string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "after", Value: after}))
 }
 
-// DeletedAtIsNull is an autogenerated method
+// AfterLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) DeletedAtIsNull() DeviceQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NULL"))
+func (qs AuditLogQuerySet) AfterLte(after string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "after", Value: after}))
 }
 
-// DeletedAtLt is an autogenerated method
+// AfterNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) DeletedAtLt(deletedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` < ?", deletedAt))
+func (qs AuditLogQuerySet) AfterNe(after string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "after", Value: after}))
 }
 
-// DeletedAtLte is an autogenerated method
+// AfterNotIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) DeletedAtLte(deletedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` <= ?", deletedAt))
+func (qs AuditLogQuerySet) AfterNotIn(after ...string) AuditLogQuerySet {
+	if len(after) == 0 {
+		qs.db.AddError(errors.New("must at least pass one after in AfterNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "after"}, after))
 }
 
-// DeletedAtNe is an autogenerated method
+// AfterNotlike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) DeletedAtNe(deletedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` != ?", deletedAt))
+func (qs AuditLogQuerySet) AfterNotlike(after string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "after", Value: after})))
 }
 
-// GetDB is an autogenerated method
+// All is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) GetDB() *gorm.DB {
-	return qs.db
+func (qs AuditLogQuerySet) All(ret *[]AuditLog) error {
+	return qs.db.Find(ret).Error
 }
 
-// GetUpdater is an autogenerated method
+// BeforeEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) GetUpdater() DeviceUpdater {
-	return NewDeviceUpdater(qs.db)
+func (qs AuditLogQuerySet) BeforeEq(before string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "before", Value: before}))
 }
 
-// IDEq is an autogenerated method
+// BeforeGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) IDEq(ID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`id` = ?", ID))
+func (qs AuditLogQuerySet) BeforeGt(before string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "before", Value: before}))
 }
 
-// IDGt is an autogenerated method
+// BeforeGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) IDGt(ID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`id` > ?", ID))
+func (qs AuditLogQuerySet) BeforeGte(before string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "before", Value: before}))
 }
 
-// IDGte is an autogenerated method
+// BeforeIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) IDGte(ID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`id` >= ?", ID))
+func (qs AuditLogQuerySet) BeforeIn(before ...string) AuditLogQuerySet {
+	if len(before) == 0 {
+		qs.db.AddError(errors.New("must at least pass one before in BeforeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "before"}, before))
 }
 
-// IDIn is an autogenerated method
+// BeforeLike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) IDIn(ID ...ID) DeviceQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+func (qs AuditLogQuerySet) BeforeLike(before string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "before", Value: before}))
 }
 
-// IDLt is an autogenerated method
+// BeforeLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) IDLt(ID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`id` < ?", ID))
+func (qs AuditLogQuerySet) BeforeLt(before string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "before", Value: before}))
 }
 
-// IDLte is an autogenerated method
+// BeforeLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) IDLte(ID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`id` <= ?", ID))
+func (qs AuditLogQuerySet) BeforeLte(before string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "before", Value: before}))
 }
 
-// IDNe is an autogenerated method
+// BeforeNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) IDNe(ID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`id` != ?", ID))
+func (qs AuditLogQuerySet) BeforeNe(before string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "before", Value: before}))
 }
 
-// IDNotIn is an autogenerated method
+// BeforeNotIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) IDNotIn(ID ...ID) DeviceQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+func (qs AuditLogQuerySet) BeforeNotIn(before ...string) AuditLogQuerySet {
+	if len(before) == 0 {
+		qs.db.AddError(errors.New("must at least pass one before in BeforeNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "before"}, before))
 }
 
-// LastSeenEq is an autogenerated method
+// BeforeNotlike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) LastSeenEq(lastSeen time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`last_seen` = ?", lastSeen))
+func (qs AuditLogQuerySet) BeforeNotlike(before string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "before", Value: before})))
 }
 
-// LastSeenGt is an autogenerated method
+// Count is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) LastSeenGt(lastSeen time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`last_seen` > ?", lastSeen))
+func (qs AuditLogQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
 }
 
-// LastSeenGte is an autogenerated method
+// CreatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) LastSeenGte(lastSeen time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`last_seen` >= ?", lastSeen))
+func (qs AuditLogQuerySet) CreatedAtEq(createdAt time.Time) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
 }
 
-// LastSeenLt is an autogenerated method
+// CreatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) LastSeenLt(lastSeen time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`last_seen` < ?", lastSeen))
+func (qs AuditLogQuerySet) CreatedAtGt(createdAt time.Time) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
 }
 
-// LastSeenLte is an autogenerated method
+// CreatedAtGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) LastSeenLte(lastSeen time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`last_seen` <= ?", lastSeen))
+func (qs AuditLogQuerySet) CreatedAtGte(createdAt time.Time) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
 }
 
-// LastSeenNe is an autogenerated method
+// CreatedAtLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) LastSeenNe(lastSeen time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`last_seen` != ?", lastSeen))
+func (qs AuditLogQuerySet) CreatedAtLt(createdAt time.Time) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
 }
 
-// Limit is an autogenerated method
+// CreatedAtLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) Limit(limit int) DeviceQuerySet {
-	return qs.w(qs.db.Limit(limit))
+func (qs AuditLogQuerySet) CreatedAtLte(createdAt time.Time) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
 }
 
-// MachineIDEq is an autogenerated method
+// CreatedAtNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) MachineIDEq(machineID string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`machine_id` = ?", machineID))
+func (qs AuditLogQuerySet) CreatedAtNe(createdAt time.Time) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
 }
 
-// MachineIDGt is an autogenerated method
+// Delete is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) MachineIDGt(machineID string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`machine_id` > ?", machineID))
+func (qs AuditLogQuerySet) Delete() error {
+	return qs.db.Delete(AuditLog{}).Error
 }
 
-// MachineIDGte is an autogenerated method
+// DeleteNum is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) MachineIDGte(machineID string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`machine_id` >= ?", machineID))
+func (qs AuditLogQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(AuditLog{})
+	return db.RowsAffected, db.Error
 }
 
-// MachineIDIn is an autogenerated method
+// DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) MachineIDIn(machineID ...string) DeviceQuerySet {
-	if len(machineID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one machineID in MachineIDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("machine_id IN (?)", machineID))
+func (qs AuditLogQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(AuditLog{})
+	return db.RowsAffected, db.Error
 }
 
-// MachineIDLike is an autogenerated method
+// GetDB is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) MachineIDLike(machineID string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`machine_id` LIKE ?", machineID))
+func (qs AuditLogQuerySet) GetDB() *gorm.DB {
+	return qs.db
 }
 
-// MachineIDLt is an autogenerated method
+// GetUpdater is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) MachineIDLt(machineID string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`machine_id` < ?", machineID))
+func (qs AuditLogQuerySet) GetUpdater() AuditLogUpdater {
+	return NewAuditLogUpdater(qs.db)
 }
 
-// MachineIDLte is an autogenerated method
+// IDEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) MachineIDLte(machineID string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`machine_id` <= ?", machineID))
+func (qs AuditLogQuerySet) IDEq(ID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
 }
 
-// MachineIDNe is an autogenerated method
+// IDGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) MachineIDNe(machineID string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`machine_id` != ?", machineID))
+func (qs AuditLogQuerySet) IDGt(ID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
 }
 
-// MachineIDNotIn is an autogenerated method
+// IDGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) MachineIDNotIn(machineID ...string) DeviceQuerySet {
-	if len(machineID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one machineID in MachineIDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("machine_id NOT IN (?)", machineID))
+func (qs AuditLogQuerySet) IDGte(ID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
 }
 
-// MachineIDNotlike is an autogenerated method
+// IDIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) MachineIDNotlike(machineID string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`machine_id` NOT LIKE ?", machineID))
+func (qs AuditLogQuerySet) IDIn(ID ...ID) AuditLogQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
 }
 
-// NameEq is an autogenerated method
+// IDLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) NameEq(name string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`name` = ?", name))
+func (qs AuditLogQuerySet) IDLt(ID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
 }
 
-// NameGt is an autogenerated method
+// IDLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) NameGt(name string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`name` > ?", name))
+func (qs AuditLogQuerySet) IDLte(ID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
 }
 
-// NameGte is an autogenerated method
+// IDNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) NameGte(name string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`name` >= ?", name))
+func (qs AuditLogQuerySet) IDNe(ID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
 }
 
-// NameIn is an autogenerated method
+// IDNotIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) NameIn(name ...string) DeviceQuerySet {
-	if len(name) == 0 {
-		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
+func (qs AuditLogQuerySet) IDNotIn(ID ...ID) AuditLogQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("name IN (?)", name))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
 }
 
-// NameLike is an autogenerated method
+// Limit is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) NameLike(name string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`name` LIKE ?", name))
+func (qs AuditLogQuerySet) Limit(limit int) AuditLogQuerySet {
+	return qs.w(qs.db.Limit(limit))
 }
 
-// NameLt is an autogenerated method
+// NetworkIDEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) NameLt(name string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`name` < ?", name))
+func (qs AuditLogQuerySet) NetworkIDEq(networkID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "network_id", Value: networkID}))
 }
 
-// NameLte is an autogenerated method
+// NetworkIDGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) NameLte(name string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`name` <= ?", name))
+func (qs AuditLogQuerySet) NetworkIDGt(networkID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "network_id", Value: networkID}))
 }
 
-// NameNe is an autogenerated method
+// NetworkIDGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) NameNe(name string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`name` != ?", name))
+func (qs AuditLogQuerySet) NetworkIDGte(networkID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "network_id", Value: networkID}))
 }
 
-// NameNotIn is an autogenerated method
+// NetworkIDIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) NameNotIn(name ...string) DeviceQuerySet {
-	if len(name) == 0 {
-		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
+func (qs AuditLogQuerySet) NetworkIDIn(networkID ...ID) AuditLogQuerySet {
+	if len(networkID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("name NOT IN (?)", name))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "network_id"}, networkID))
 }
 
-// NameNotlike is an autogenerated method
+// NetworkIDLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) NameNotlike(name string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`name` NOT LIKE ?", name))
+func (qs AuditLogQuerySet) NetworkIDLt(networkID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "network_id", Value: networkID}))
 }
 
-// OSEq is an autogenerated method
+// NetworkIDLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OSEq(oS string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`os` = ?", oS))
+func (qs AuditLogQuerySet) NetworkIDLte(networkID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "network_id", Value: networkID}))
 }
 
-// OSGt is an autogenerated method
+// NetworkIDNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OSGt(oS string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`os` > ?", oS))
+func (qs AuditLogQuerySet) NetworkIDNe(networkID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "network_id", Value: networkID}))
 }
 
-// OSGte is an autogenerated method
+// NetworkIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OSGte(oS string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`os` >= ?", oS))
-}
-
-// OSIn is an autogenerated method
-// nolint: dupl
-func (qs DeviceQuerySet) OSIn(oS ...string) DeviceQuerySet {
-	if len(oS) == 0 {
-		qs.db.AddError(errors.New("must at least pass one oS in OSIn"))
+func (qs AuditLogQuerySet) NetworkIDNotIn(networkID ...ID) AuditLogQuerySet {
+	if len(networkID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("os IN (?)", oS))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "network_id"}, networkID))
 }
 
-// OSLike is an autogenerated method
+// Offset is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OSLike(oS string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`os` LIKE ?", oS))
+func (qs AuditLogQuerySet) Offset(offset int) AuditLogQuerySet {
+	return qs.w(qs.db.Offset(offset))
 }
 
-// OSLt is an autogenerated method
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs AuditLogQuerySet) One(ret *AuditLog) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByAction is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OSLt(oS string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`os` < ?", oS))
+func (qs AuditLogQuerySet) OrderAscByAction() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "action"}}))
 }
 
-// OSLte is an autogenerated method
+// OrderAscByActorID is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OSLte(oS string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`os` <= ?", oS))
+func (qs AuditLogQuerySet) OrderAscByActorID() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "actor_id"}}))
 }
 
-// OSNe is an autogenerated method
+// OrderAscByAfter is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OSNe(oS string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`os` != ?", oS))
+func (qs AuditLogQuerySet) OrderAscByAfter() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "after"}}))
 }
 
-// OSNotIn is an autogenerated method
+// OrderAscByBefore is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OSNotIn(oS ...string) DeviceQuerySet {
-	if len(oS) == 0 {
-		qs.db.AddError(errors.New("must at least pass one oS in OSNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("os NOT IN (?)", oS))
+func (qs AuditLogQuerySet) OrderAscByBefore() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "before"}}))
 }
 
-// OSNotlike is an autogenerated method
+// OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OSNotlike(oS string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`os` NOT LIKE ?", oS))
+func (qs AuditLogQuerySet) OrderAscByCreatedAt() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
 }
 
-// Offset is an autogenerated method
+// OrderAscByID is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) Offset(offset int) DeviceQuerySet {
-	return qs.w(qs.db.Offset(offset))
+func (qs AuditLogQuerySet) OrderAscByID() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
 }
 
-// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
-// if nothing was fetched
-func (qs DeviceQuerySet) One(ret *Device) error {
-	return qs.db.First(ret).Error
+// OrderAscByNetworkID is an autogenerated method
+// nolint: dupl
+func (qs AuditLogQuerySet) OrderAscByNetworkID() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "network_id"}}))
 }
 
-// OrderAscByAddress is an autogenerated method
+// OrderAscByOrganizationID is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByAddress() DeviceQuerySet {
-	return qs.w(qs.db.Order("address ASC"))
+func (qs AuditLogQuerySet) OrderAscByOrganizationID() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}}))
 }
 
-// OrderAscByCreatedAt is an autogenerated method
+// OrderAscByRemoteAddr is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByCreatedAt() DeviceQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+func (qs AuditLogQuerySet) OrderAscByRemoteAddr() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "remote_addr"}}))
 }
 
-// OrderAscByDeletedAt is an autogenerated method
+// OrderAscByTargetID is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByDeletedAt() DeviceQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+func (qs AuditLogQuerySet) OrderAscByTargetID() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "target_id"}}))
 }
 
-// OrderAscByID is an autogenerated method
+// OrderAscByTargetType is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByID() DeviceQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+func (qs AuditLogQuerySet) OrderAscByTargetType() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "target_type"}}))
 }
 
-// OrderAscByLastSeen is an autogenerated method
+// OrderAscByUserAgent is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByLastSeen() DeviceQuerySet {
-	return qs.w(qs.db.Order("last_seen ASC"))
+func (qs AuditLogQuerySet) OrderAscByUserAgent() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_agent"}}))
 }
 
-// OrderAscByMachineID is an autogenerated method
+// OrderDescByAction is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByMachineID() DeviceQuerySet {
-	return qs.w(qs.db.Order("machine_id ASC"))
+func (qs AuditLogQuerySet) OrderDescByAction() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "action"}, Desc: true}))
 }
 
-// OrderAscByName is an autogenerated method
+// OrderDescByActorID is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByName() DeviceQuerySet {
-	return qs.w(qs.db.Order("name ASC"))
+func (qs AuditLogQuerySet) OrderDescByActorID() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "actor_id"}, Desc: true}))
 }
 
-// OrderAscByOS is an autogenerated method
+// OrderDescByAfter is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByOS() DeviceQuerySet {
-	return qs.w(qs.db.Order("os ASC"))
+func (qs AuditLogQuerySet) OrderDescByAfter() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "after"}, Desc: true}))
 }
 
-// OrderAscByRelayServerID is an autogenerated method
+// OrderDescByBefore is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByRelayServerID() DeviceQuerySet {
-	return qs.w(qs.db.Order("relay_server_id ASC"))
+func (qs AuditLogQuerySet) OrderDescByBefore() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "before"}, Desc: true}))
 }
 
-// OrderAscByUpdatedAt is an autogenerated method
+// OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByUpdatedAt() DeviceQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+func (qs AuditLogQuerySet) OrderDescByCreatedAt() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
 }
 
-// OrderAscByUserID is an autogenerated method
+// OrderDescByID is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByUserID() DeviceQuerySet {
-	return qs.w(qs.db.Order("user_id ASC"))
+func (qs AuditLogQuerySet) OrderDescByID() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
 }
 
-// OrderAscByVersion is an autogenerated method
+// OrderDescByNetworkID is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderAscByVersion() DeviceQuerySet {
-	return qs.w(qs.db.Order("version ASC"))
+func (qs AuditLogQuerySet) OrderDescByNetworkID() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "network_id"}, Desc: true}))
 }
 
-// OrderDescByAddress is an autogenerated method
+// OrderDescByOrganizationID is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByAddress() DeviceQuerySet {
-	return qs.w(qs.db.Order("address DESC"))
+func (qs AuditLogQuerySet) OrderDescByOrganizationID() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}, Desc: true}))
 }
 
-// OrderDescByCreatedAt is an autogenerated method
+// OrderDescByRemoteAddr is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByCreatedAt() DeviceQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+func (qs AuditLogQuerySet) OrderDescByRemoteAddr() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "remote_addr"}, Desc: true}))
 }
 
-// OrderDescByDeletedAt is an autogenerated method
+// OrderDescByTargetID is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByDeletedAt() DeviceQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+func (qs AuditLogQuerySet) OrderDescByTargetID() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "target_id"}, Desc: true}))
 }
 
-// OrderDescByID is an autogenerated method
+// OrderDescByTargetType is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByID() DeviceQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (qs AuditLogQuerySet) OrderDescByTargetType() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "target_type"}, Desc: true}))
 }
 
-// OrderDescByLastSeen is an autogenerated method
+// OrderDescByUserAgent is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByLastSeen() DeviceQuerySet {
-	return qs.w(qs.db.Order("last_seen DESC"))
+func (qs AuditLogQuerySet) OrderDescByUserAgent() AuditLogQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_agent"}, Desc: true}))
 }
 
-// OrderDescByMachineID is an autogenerated method
+// OrganizationIDEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByMachineID() DeviceQuerySet {
-	return qs.w(qs.db.Order("machine_id DESC"))
+func (qs AuditLogQuerySet) OrganizationIDEq(organizationID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderDescByName is an autogenerated method
+// OrganizationIDGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByName() DeviceQuerySet {
-	return qs.w(qs.db.Order("name DESC"))
+func (qs AuditLogQuerySet) OrganizationIDGt(organizationID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderDescByOS is an autogenerated method
+// OrganizationIDGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByOS() DeviceQuerySet {
-	return qs.w(qs.db.Order("os DESC"))
+func (qs AuditLogQuerySet) OrganizationIDGte(organizationID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderDescByRelayServerID is an autogenerated method
+// OrganizationIDIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByRelayServerID() DeviceQuerySet {
-	return qs.w(qs.db.Order("relay_server_id DESC"))
+func (qs AuditLogQuerySet) OrganizationIDIn(organizationID ...ID) AuditLogQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "organization_id"}, organizationID))
 }
 
-// OrderDescByUpdatedAt is an autogenerated method
+// OrganizationIDLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByUpdatedAt() DeviceQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+func (qs AuditLogQuerySet) OrganizationIDLt(organizationID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderDescByUserID is an autogenerated method
+// OrganizationIDLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByUserID() DeviceQuerySet {
-	return qs.w(qs.db.Order("user_id DESC"))
+func (qs AuditLogQuerySet) OrganizationIDLte(organizationID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderDescByVersion is an autogenerated method
+// OrganizationIDNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) OrderDescByVersion() DeviceQuerySet {
-	return qs.w(qs.db.Order("version DESC"))
+func (qs AuditLogQuerySet) OrganizationIDNe(organizationID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "organization_id", Value: organizationID}))
 }
 
-// PreloadUser is an autogenerated method
+// OrganizationIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) PreloadUser() DeviceQuerySet {
-	return qs.w(qs.db.Preload("User"))
+func (qs AuditLogQuerySet) OrganizationIDNotIn(organizationID ...ID) AuditLogQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "organization_id"}, organizationID))
 }
 
-// RelayServerIDEq is an autogenerated method
+// RemoteAddrEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) RelayServerIDEq(relayServerID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`relay_server_id` = ?", relayServerID))
+func (qs AuditLogQuerySet) RemoteAddrEq(remoteAddr string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "remote_addr", Value: remoteAddr}))
 }
 
-// RelayServerIDGt is an autogenerated method
+// RemoteAddrGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) RelayServerIDGt(relayServerID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`relay_server_id` > ?", relayServerID))
+func (qs AuditLogQuerySet) RemoteAddrGt(remoteAddr string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "remote_addr", Value: remoteAddr}))
 }
 
-// RelayServerIDGte is an autogenerated method
+// RemoteAddrGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) RelayServerIDGte(relayServerID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`relay_server_id` >= ?", relayServerID))
+func (qs AuditLogQuerySet) RemoteAddrGte(remoteAddr string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "remote_addr", Value: remoteAddr}))
 }
 
-// RelayServerIDIn is an autogenerated method
+// RemoteAddrIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) RelayServerIDIn(relayServerID ...ID) DeviceQuerySet {
-	if len(relayServerID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one relayServerID in RelayServerIDIn"))
+func (qs AuditLogQuerySet) RemoteAddrIn(remoteAddr ...string) AuditLogQuerySet {
+	if len(remoteAddr) == 0 {
+		qs.db.AddError(errors.New("must at least pass one remoteAddr in RemoteAddrIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("relay_server_id IN (?)", relayServerID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "remote_addr"}, remoteAddr))
 }
 
-// RelayServerIDLt is an autogenerated method
+// RemoteAddrLike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) RelayServerIDLt(relayServerID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`relay_server_id` < ?", relayServerID))
+func (qs AuditLogQuerySet) RemoteAddrLike(remoteAddr string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "remote_addr", Value: remoteAddr}))
 }
 
-// RelayServerIDLte is an autogenerated method
+// RemoteAddrLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) RelayServerIDLte(relayServerID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`relay_server_id` <= ?", relayServerID))
+func (qs AuditLogQuerySet) RemoteAddrLt(remoteAddr string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "remote_addr", Value: remoteAddr}))
 }
 
-// RelayServerIDNe is an autogenerated method
+// RemoteAddrLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) RelayServerIDNe(relayServerID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`relay_server_id` != ?", relayServerID))
+func (qs AuditLogQuerySet) RemoteAddrLte(remoteAddr string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "remote_addr", Value: remoteAddr}))
 }
 
-// RelayServerIDNotIn is an autogenerated method
+// RemoteAddrNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) RelayServerIDNotIn(relayServerID ...ID) DeviceQuerySet {
-	if len(relayServerID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one relayServerID in RelayServerIDNotIn"))
+func (qs AuditLogQuerySet) RemoteAddrNe(remoteAddr string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "remote_addr", Value: remoteAddr}))
+}
+
+// RemoteAddrNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogQuerySet) RemoteAddrNotIn(remoteAddr ...string) AuditLogQuerySet {
+	if len(remoteAddr) == 0 {
+		qs.db.AddError(errors.New("must at least pass one remoteAddr in RemoteAddrNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("relay_server_id NOT IN (?)", relayServerID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "remote_addr"}, remoteAddr))
 }
 
-// UpdatedAtEq is an autogenerated method
+// RemoteAddrNotlike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UpdatedAtEq(updatedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`updated_at` = ?", updatedAt))
+func (qs AuditLogQuerySet) RemoteAddrNotlike(remoteAddr string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "remote_addr", Value: remoteAddr})))
 }
 
-// UpdatedAtGt is an autogenerated method
+// TargetIDEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UpdatedAtGt(updatedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`updated_at` > ?", updatedAt))
+func (qs AuditLogQuerySet) TargetIDEq(targetID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "target_id", Value: targetID}))
 }
 
-// UpdatedAtGte is an autogenerated method
+// TargetIDGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UpdatedAtGte(updatedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`updated_at` >= ?", updatedAt))
+func (qs AuditLogQuerySet) TargetIDGt(targetID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "target_id", Value: targetID}))
 }
 
-// UpdatedAtIsNotNull is an autogenerated method
+// TargetIDGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UpdatedAtIsNotNull() DeviceQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NOT NULL"))
+func (qs AuditLogQuerySet) TargetIDGte(targetID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "target_id", Value: targetID}))
 }
 
-// UpdatedAtIsNull is an autogenerated method
+// TargetIDIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UpdatedAtIsNull() DeviceQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NULL"))
+func (qs AuditLogQuerySet) TargetIDIn(targetID ...ID) AuditLogQuerySet {
+	if len(targetID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one targetID in TargetIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "target_id"}, targetID))
 }
 
-// UpdatedAtLt is an autogenerated method
+// TargetIDLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UpdatedAtLt(updatedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`updated_at` < ?", updatedAt))
+func (qs AuditLogQuerySet) TargetIDLt(targetID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "target_id", Value: targetID}))
 }
 
-// UpdatedAtLte is an autogenerated method
+// TargetIDLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UpdatedAtLte(updatedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`updated_at` <= ?", updatedAt))
+func (qs AuditLogQuerySet) TargetIDLte(targetID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "target_id", Value: targetID}))
 }
 
-// UpdatedAtNe is an autogenerated method
+// TargetIDNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UpdatedAtNe(updatedAt time.Time) DeviceQuerySet {
-	return qs.w(qs.db.Where("`updated_at` != ?", updatedAt))
+func (qs AuditLogQuerySet) TargetIDNe(targetID ID) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "target_id", Value: targetID}))
 }
 
-// UserIDEq is an autogenerated method
+// TargetIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UserIDEq(userID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`user_id` = ?", userID))
+func (qs AuditLogQuerySet) TargetIDNotIn(targetID ...ID) AuditLogQuerySet {
+	if len(targetID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one targetID in TargetIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "target_id"}, targetID))
 }
 
-// UserIDGt is an autogenerated method
+// TargetTypeEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UserIDGt(userID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`user_id` > ?", userID))
+func (qs AuditLogQuerySet) TargetTypeEq(targetType string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "target_type", Value: targetType}))
 }
 
-// UserIDGte is an autogenerated method
+// TargetTypeGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UserIDGte(userID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`user_id` >= ?", userID))
+func (qs AuditLogQuerySet) TargetTypeGt(targetType string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "target_type", Value: targetType}))
 }
 
-// UserIDIn is an autogenerated method
+// TargetTypeGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UserIDIn(userID ...ID) DeviceQuerySet {
-	if len(userID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+func (qs AuditLogQuerySet) TargetTypeGte(targetType string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "target_type", Value: targetType}))
+}
+
+// TargetTypeIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogQuerySet) TargetTypeIn(targetType ...string) AuditLogQuerySet {
+	if len(targetType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one targetType in TargetTypeIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("user_id IN (?)", userID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "target_type"}, targetType))
 }
 
-// UserIDLt is an autogenerated method
+// TargetTypeLike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UserIDLt(userID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`user_id` < ?", userID))
+func (qs AuditLogQuerySet) TargetTypeLike(targetType string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "target_type", Value: targetType}))
 }
 
-// UserIDLte is an autogenerated method
+// TargetTypeLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UserIDLte(userID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`user_id` <= ?", userID))
+func (qs AuditLogQuerySet) TargetTypeLt(targetType string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "target_type", Value: targetType}))
 }
 
-// UserIDNe is an autogenerated method
+// TargetTypeLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UserIDNe(userID ID) DeviceQuerySet {
-	return qs.w(qs.db.Where("`user_id` != ?", userID))
+func (qs AuditLogQuerySet) TargetTypeLte(targetType string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "target_type", Value: targetType}))
 }
 
-// UserIDNotIn is an autogenerated method
+// TargetTypeNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UserIDNotIn(userID ...ID) DeviceQuerySet {
-	if len(userID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+func (qs AuditLogQuerySet) TargetTypeNe(targetType string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "target_type", Value: targetType}))
 }
 
-// UserIsNotNull is an autogenerated method
+// TargetTypeNotIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UserIsNotNull() DeviceQuerySet {
-	return qs.w(qs.db.Where("user IS NOT NULL"))
+func (qs AuditLogQuerySet) TargetTypeNotIn(targetType ...string) AuditLogQuerySet {
+	if len(targetType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one targetType in TargetTypeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "target_type"}, targetType))
 }
 
-// UserIsNull is an autogenerated method
+// TargetTypeNotlike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) UserIsNull() DeviceQuerySet {
-	return qs.w(qs.db.Where("user IS NULL"))
+func (qs AuditLogQuerySet) TargetTypeNotlike(targetType string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "target_type", Value: targetType})))
 }
 
-// VersionEq is an autogenerated method
+// UserAgentEq is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) VersionEq(version string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`version` = ?", version))
+func (qs AuditLogQuerySet) UserAgentEq(userAgent string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user_agent", Value: userAgent}))
 }
 
-// VersionGt is an autogenerated method
+// UserAgentGt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) VersionGt(version string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`version` > ?", version))
+func (qs AuditLogQuerySet) UserAgentGt(userAgent string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "user_agent", Value: userAgent}))
 }
 
-// VersionGte is an autogenerated method
+// UserAgentGte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) VersionGte(version string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`version` >= ?", version))
+func (qs AuditLogQuerySet) UserAgentGte(userAgent string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "user_agent", Value: userAgent}))
 }
 
-// VersionIn is an autogenerated method
+// UserAgentIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) VersionIn(version ...string) DeviceQuerySet {
-	if len(version) == 0 {
-		qs.db.AddError(errors.New("must at least pass one version in VersionIn"))
+func (qs AuditLogQuerySet) UserAgentIn(userAgent ...string) AuditLogQuerySet {
+	if len(userAgent) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userAgent in UserAgentIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("version IN (?)", version))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "user_agent"}, userAgent))
 }
 
-// VersionLike is an autogenerated method
+// UserAgentLike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) VersionLike(version string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`version` LIKE ?", version))
+func (qs AuditLogQuerySet) UserAgentLike(userAgent string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "user_agent", Value: userAgent}))
 }
 
-// VersionLt is an autogenerated method
+// UserAgentLt is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) VersionLt(version string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`version` < ?", version))
+func (qs AuditLogQuerySet) UserAgentLt(userAgent string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "user_agent", Value: userAgent}))
 }
 
-// VersionLte is an autogenerated method
+// UserAgentLte is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) VersionLte(version string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`version` <= ?", version))
+func (qs AuditLogQuerySet) UserAgentLte(userAgent string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "user_agent", Value: userAgent}))
 }
 
-// VersionNe is an autogenerated method
+// UserAgentNe is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) VersionNe(version string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`version` != ?", version))
+func (qs AuditLogQuerySet) UserAgentNe(userAgent string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user_agent", Value: userAgent}))
 }
 
-// VersionNotIn is an autogenerated method
+// UserAgentNotIn is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) VersionNotIn(version ...string) DeviceQuerySet {
-	if len(version) == 0 {
-		qs.db.AddError(errors.New("must at least pass one version in VersionNotIn"))
+func (qs AuditLogQuerySet) UserAgentNotIn(userAgent ...string) AuditLogQuerySet {
+	if len(userAgent) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userAgent in UserAgentNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("version NOT IN (?)", version))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "user_agent"}, userAgent))
 }
 
-// VersionNotlike is an autogenerated method
+// UserAgentNotlike is an autogenerated method
 // nolint: dupl
-func (qs DeviceQuerySet) VersionNotlike(version string) DeviceQuerySet {
-	return qs.w(qs.db.Where("`version` NOT LIKE ?", version))
+func (qs AuditLogQuerySet) UserAgentNotlike(userAgent string) AuditLogQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "user_agent", Value: userAgent})))
 }
 
-// SetAddress is an autogenerated method
+// SetAction is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetAddress(address string) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.Address)] = address
+func (u AuditLogUpdater) SetAction(action AuditAction) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.Action)] = action
 	return u
 }
 
-// SetCreatedAt is an autogenerated method
+// SetActorID is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetCreatedAt(createdAt time.Time) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.CreatedAt)] = createdAt
+func (u AuditLogUpdater) SetActorID(actorID ID) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.ActorID)] = actorID
 	return u
 }
 
-// SetDeletedAt is an autogenerated method
+// SetAfter is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetDeletedAt(deletedAt *time.Time) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.DeletedAt)] = deletedAt
+func (u AuditLogUpdater) SetAfter(after string) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.After)] = after
 	return u
 }
 
-// SetID is an autogenerated method
+// SetBefore is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetID(ID ID) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.ID)] = ID
+func (u AuditLogUpdater) SetBefore(before string) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.Before)] = before
 	return u
 }
 
-// SetLastSeen is an autogenerated method
+// SetCreatedAt is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetLastSeen(lastSeen time.Time) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.LastSeen)] = lastSeen
+func (u AuditLogUpdater) SetCreatedAt(createdAt time.Time) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.CreatedAt)] = createdAt
 	return u
 }
 
-// SetMachineID is an autogenerated method
+// SetID is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetMachineID(machineID string) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.MachineID)] = machineID
+func (u AuditLogUpdater) SetID(ID ID) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.ID)] = ID
 	return u
 }
 
-// SetName is an autogenerated method
+// SetNetworkID is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetName(name string) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.Name)] = name
+func (u AuditLogUpdater) SetNetworkID(networkID ID) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.NetworkID)] = networkID
 	return u
 }
 
-// SetOS is an autogenerated method
+// SetOrganizationID is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetOS(oS string) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.OS)] = oS
+func (u AuditLogUpdater) SetOrganizationID(organizationID ID) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.OrganizationID)] = organizationID
 	return u
 }
 
-// SetRelayServerID is an autogenerated method
+// SetRemoteAddr is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetRelayServerID(relayServerID ID) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.RelayServerID)] = relayServerID
+func (u AuditLogUpdater) SetRemoteAddr(remoteAddr string) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.RemoteAddr)] = remoteAddr
 	return u
 }
 
-// SetUpdatedAt is an autogenerated method
+// SetTargetID is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetUpdatedAt(updatedAt *time.Time) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.UpdatedAt)] = updatedAt
+func (u AuditLogUpdater) SetTargetID(targetID ID) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.TargetID)] = targetID
 	return u
 }
 
-// SetUserID is an autogenerated method
+// SetTargetType is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetUserID(userID ID) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.UserID)] = userID
+func (u AuditLogUpdater) SetTargetType(targetType string) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.TargetType)] = targetType
 	return u
 }
 
-// SetVersion is an autogenerated method
+// SetUserAgent is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) SetVersion(version string) DeviceUpdater {
-	u.fields[string(DeviceDBSchema.Version)] = version
+func (u AuditLogUpdater) SetUserAgent(userAgent string) AuditLogUpdater {
+	u.fields[string(AuditLogDBSchema.UserAgent)] = userAgent
 	return u
 }
 
 // Update is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) Update() error {
+func (u AuditLogUpdater) Update() error {
 	return u.db.Updates(u.fields).Error
 }
 
 // UpdateNum is an autogenerated method
 // nolint: dupl
-func (u DeviceUpdater) UpdateNum() (int64, error) {
+func (u AuditLogUpdater) UpdateNum() (int64, error) {
 	db := u.db.Updates(u.fields)
 	return db.RowsAffected, db.Error
 }
 
-// ===== END of query set DeviceQuerySet
+// ===== END of query set AuditLogQuerySet
 
-// ===== BEGIN of Device modifiers
+// ===== BEGIN of AuditLog modifiers
 
-// DeviceDBSchemaField describes database schema field. It requires for method 'Update'
-type DeviceDBSchemaField string
+// AuditLogDBSchemaField describes database schema field. It requires for method 'Update'
+type AuditLogDBSchemaField string
 
 // String method returns string representation of field.
 // nolint: dupl
-func (f DeviceDBSchemaField) String() string {
+func (f AuditLogDBSchemaField) String() string {
 	return string(f)
 }
 
-// DeviceDBSchema stores db field names of Device
-var DeviceDBSchema = struct {
-	ID            DeviceDBSchemaField
-	CreatedAt     DeviceDBSchemaField
-	UpdatedAt     DeviceDBSchemaField
-	DeletedAt     DeviceDBSchemaField
-	UserID        DeviceDBSchemaField
-	User          DeviceDBSchemaField
-	RelayServerID DeviceDBSchemaField
-	Name          DeviceDBSchemaField
-	OS            DeviceDBSchemaField
-	Version       DeviceDBSchemaField
-	MachineID     DeviceDBSchemaField
-	LastSeen      DeviceDBSchemaField
-	Address       DeviceDBSchemaField
+// AuditLogDBSchema stores db field names of AuditLog
+var AuditLogDBSchema = struct {
+	ID             AuditLogDBSchemaField
+	CreatedAt      AuditLogDBSchemaField
+	ActorID        AuditLogDBSchemaField
+	Action         AuditLogDBSchemaField
+	TargetType     AuditLogDBSchemaField
+	TargetID       AuditLogDBSchemaField
+	OrganizationID AuditLogDBSchemaField
+	NetworkID      AuditLogDBSchemaField
+	Before         AuditLogDBSchemaField
+	After          AuditLogDBSchemaField
+	RemoteAddr     AuditLogDBSchemaField
+	UserAgent      AuditLogDBSchemaField
 }{
 
-	ID:            DeviceDBSchemaField("id"),
-	CreatedAt:     DeviceDBSchemaField("created_at"),
-	UpdatedAt:     DeviceDBSchemaField("updated_at"),
-	DeletedAt:     DeviceDBSchemaField("deleted_at"),
-	UserID:        DeviceDBSchemaField("user_id"),
-	User:          DeviceDBSchemaField("user"),
-	RelayServerID: DeviceDBSchemaField("relay_server_id"),
-	Name:          DeviceDBSchemaField("name"),
-	OS:            DeviceDBSchemaField("os"),
-	Version:       DeviceDBSchemaField("version"),
-	MachineID:     DeviceDBSchemaField("machine_id"),
-	LastSeen:      DeviceDBSchemaField("last_seen"),
-	Address:       DeviceDBSchemaField("address"),
+	ID:             AuditLogDBSchemaField("id"),
+	CreatedAt:      AuditLogDBSchemaField("created_at"),
+	ActorID:        AuditLogDBSchemaField("actor_id"),
+	Action:         AuditLogDBSchemaField("action"),
+	TargetType:     AuditLogDBSchemaField("target_type"),
+	TargetID:       AuditLogDBSchemaField("target_id"),
+	OrganizationID: AuditLogDBSchemaField("organization_id"),
+	NetworkID:      AuditLogDBSchemaField("network_id"),
+	Before:         AuditLogDBSchemaField("before"),
+	After:          AuditLogDBSchemaField("after"),
+	RemoteAddr:     AuditLogDBSchemaField("remote_addr"),
+	UserAgent:      AuditLogDBSchemaField("user_agent"),
 }
 
-// Update updates Device fields by primary key
+// Update updates AuditLog fields by primary key
 // nolint: dupl
-func (o *Device) Update(db *gorm.DB, fields ...DeviceDBSchemaField) error {
+func (o *AuditLog) Update(db *gorm.DB, fields ...AuditLogDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
 		"id":              o.ID,
 		"created_at":      o.CreatedAt,
-		"updated_at":      o.UpdatedAt,
-		"deleted_at":      o.DeletedAt,
-		"user_id":         o.UserID,
-		"user":            o.User,
-		"relay_server_id": o.RelayServerID,
-		"name":            o.Name,
-		"os":              o.OS,
-		"version":         o.Version,
-		"machine_id":      o.MachineID,
-		"last_seen":       o.LastSeen,
-		"address":         o.Address,
+		"actor_id":        o.ActorID,
+		"action":          o.Action,
+		"target_type":     o.TargetType,
+		"target_id":       o.TargetID,
+		"organization_id": o.OrganizationID,
+		"network_id":      o.NetworkID,
+		"before":          o.Before,
+		"after":           o.After,
+		"remote_addr":     o.RemoteAddr,
+		"user_agent":      o.UserAgent,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -2156,55 +2372,55 @@ func (o *Device) Update(db *gorm.DB, fields ...DeviceDBSchemaField) error {
 			return err
 		}
 
-		return fmt.Errorf("can't update Device %v fields %v: %s",
+		return fmt.Errorf("can't update AuditLog %v fields %v: %s",
 			o, fields, err)
 	}
 
 	return nil
 }
 
-// DeviceUpdater is an Device updates manager
-type DeviceUpdater struct {
+// AuditLogUpdater is an AuditLog updates manager
+type AuditLogUpdater struct {
 	fields map[string]interface{}
 	db     *gorm.DB
 }
 
-// NewDeviceUpdater creates new Device updater
+// NewAuditLogUpdater creates new AuditLog updater
 // nolint: dupl
-func NewDeviceUpdater(db *gorm.DB) DeviceUpdater {
-	return DeviceUpdater{
+func NewAuditLogUpdater(db *gorm.DB) AuditLogUpdater {
+	return AuditLogUpdater{
 		fields: map[string]interface{}{},
-		db:     db.Model(&Device{}),
+		db:     db.Model(&AuditLog{}),
 	}
 }
 
-// ===== END of Device modifiers
+// ===== END of AuditLog modifiers
 
-// ===== BEGIN of query set GithubUserQuerySet
+// ===== BEGIN of query set AuthKeyQuerySet
 
-// GithubUserQuerySet is an queryset type for GithubUser
-type GithubUserQuerySet struct {
+// AuthKeyQuerySet is an queryset type for AuthKey
+type AuthKeyQuerySet struct {
 	db *gorm.DB
 }
 
-// NewGithubUserQuerySet constructs new GithubUserQuerySet
-func NewGithubUserQuerySet(db *gorm.DB) GithubUserQuerySet {
-	return GithubUserQuerySet{
-		db: db.Model(&GithubUser{}),
+// NewAuthKeyQuerySet constructs new AuthKeyQuerySet
+func NewAuthKeyQuerySet(db *gorm.DB) AuthKeyQuerySet {
+	return AuthKeyQuerySet{
+		db: db.Model(&AuthKey{}),
 	}
 }
 
-func (qs GithubUserQuerySet) w(db *gorm.DB) GithubUserQuerySet {
-	return NewGithubUserQuerySet(db)
+func (qs AuthKeyQuerySet) w(db *gorm.DB) AuthKeyQuerySet {
+	return NewAuthKeyQuerySet(db)
 }
 
-func (qs GithubUserQuerySet) Preload(query string, args ...interface{}) GithubUserQuerySet {
-	return NewGithubUserQuerySet(qs.db.Preload(query, args...))
+func (qs AuthKeyQuerySet) Preload(query string, args ...interface{}) AuthKeyQuerySet {
+	return NewAuthKeyQuerySet(qs.db.Preload(query, args...))
 }
 
 // Preload preload associations with given conditions
 //    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
-func (qs GithubUserQuerySet) Select(fields ...GithubUserDBSchemaField) GithubUserQuerySet {
+func (qs AuthKeyQuerySet) Select(fields ...AuthKeyDBSchemaField) AuthKeyQuerySet {
 	names := []string{}
 	for _, f := range fields {
 		names = append(names, f.String())
@@ -2215,93 +2431,25 @@ func (qs GithubUserQuerySet) Select(fields ...GithubUserDBSchemaField) GithubUse
 
 // Create is an autogenerated method
 // nolint: dupl
-func (o *GithubUser) Create(db *gorm.DB) error {
+func (o *AuthKey) Create(db *gorm.DB) error {
 	return db.Create(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (o *GithubUser) Delete(db *gorm.DB) error {
+func (o *AuthKey) Delete(db *gorm.DB) error {
 	return db.Delete(o).Error
 }
 
 // All is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) All(ret *[]GithubUser) error {
+func (qs AuthKeyQuerySet) All(ret *[]AuthKey) error {
 	return qs.db.Find(ret).Error
 }
 
-// AvatarURLEq is an autogenerated method
-// nolint: dupl
-func (qs GithubUserQuerySet) AvatarURLEq(avatarURL string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`avatar_url` = ?", avatarURL))
-}
-
-// AvatarURLGt is an autogenerated method
-// nolint: dupl
-func (qs GithubUserQuerySet) AvatarURLGt(avatarURL string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`avatar_url` > ?", avatarURL))
-}
-
-// AvatarURLGte is an autogenerated method
-// nolint: dupl
-func (qs GithubUserQuerySet) AvatarURLGte(avatarURL string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`avatar_url` >= ?", avatarURL))
-}
-
-// AvatarURLIn is an autogenerated method
-// nolint: dupl
-func (qs GithubUserQuerySet) AvatarURLIn(avatarURL ...string) GithubUserQuerySet {
-	if len(avatarURL) == 0 {
-		qs.db.AddError(errors.New("must at least pass one avatarURL in AvatarURLIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("avatar_url IN (?)", avatarURL))
-}
-
-// AvatarURLLike is an autogenerated method
-// nolint: dupl
-func (qs GithubUserQuerySet) AvatarURLLike(avatarURL string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`avatar_url` LIKE ?", avatarURL))
-}
-
-// AvatarURLLt is an autogenerated method
-// nolint: dupl
-func (qs GithubUserQuerySet) AvatarURLLt(avatarURL string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`avatar_url` < ?", avatarURL))
-}
-
-// AvatarURLLte is an autogenerated method
-// nolint: dupl
-func (qs GithubUserQuerySet) AvatarURLLte(avatarURL string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`avatar_url` <= ?", avatarURL))
-}
-
-// AvatarURLNe is an autogenerated method
-// nolint: dupl
-func (qs GithubUserQuerySet) AvatarURLNe(avatarURL string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`avatar_url` != ?", avatarURL))
-}
-
-// AvatarURLNotIn is an autogenerated method
-// nolint: dupl
-func (qs GithubUserQuerySet) AvatarURLNotIn(avatarURL ...string) GithubUserQuerySet {
-	if len(avatarURL) == 0 {
-		qs.db.AddError(errors.New("must at least pass one avatarURL in AvatarURLNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("avatar_url NOT IN (?)", avatarURL))
-}
-
-// AvatarURLNotlike is an autogenerated method
-// nolint: dupl
-func (qs GithubUserQuerySet) AvatarURLNotlike(avatarURL string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`avatar_url` NOT LIKE ?", avatarURL))
-}
-
 // Count is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) Count() (int64, error) {
+func (qs AuthKeyQuerySet) Count() (int64, error) {
 	var count int64
 	err := qs.db.Count(&count).Error
 	return count, err
@@ -2309,734 +2457,8270 @@ func (qs GithubUserQuerySet) Count() (int64, error) {
 
 // CreatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) CreatedAtEq(createdAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` = ?", createdAt))
+func (qs AuthKeyQuerySet) CreatedAtEq(createdAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) CreatedAtGt(createdAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` > ?", createdAt))
+func (qs AuthKeyQuerySet) CreatedAtGt(createdAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) CreatedAtGte(createdAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` >= ?", createdAt))
+func (qs AuthKeyQuerySet) CreatedAtGte(createdAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) CreatedAtLt(createdAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` < ?", createdAt))
+func (qs AuthKeyQuerySet) CreatedAtLt(createdAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) CreatedAtLte(createdAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` <= ?", createdAt))
+func (qs AuthKeyQuerySet) CreatedAtLte(createdAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtNe is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) CreatedAtNe(createdAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` != ?", createdAt))
+func (qs AuthKeyQuerySet) CreatedAtNe(createdAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) Delete() error {
-	return qs.db.Delete(GithubUser{}).Error
+func (qs AuthKeyQuerySet) Delete() error {
+	return qs.db.Delete(AuthKey{}).Error
 }
 
 // DeleteNum is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(GithubUser{})
+func (qs AuthKeyQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(AuthKey{})
 	return db.RowsAffected, db.Error
 }
 
 // DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(GithubUser{})
+func (qs AuthKeyQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(AuthKey{})
 	return db.RowsAffected, db.Error
 }
 
 // DeletedAtEq is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) DeletedAtEq(deletedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` = ?", deletedAt))
+func (qs AuthKeyQuerySet) DeletedAtEq(deletedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) DeletedAtGt(deletedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` > ?", deletedAt))
+func (qs AuthKeyQuerySet) DeletedAtGt(deletedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) DeletedAtGte(deletedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` >= ?", deletedAt))
+func (qs AuthKeyQuerySet) DeletedAtGte(deletedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) DeletedAtIsNotNull() GithubUserQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+func (qs AuthKeyQuerySet) DeletedAtIsNotNull() AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtIsNull is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) DeletedAtIsNull() GithubUserQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NULL"))
+func (qs AuthKeyQuerySet) DeletedAtIsNull() AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtLt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) DeletedAtLt(deletedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` < ?", deletedAt))
+func (qs AuthKeyQuerySet) DeletedAtLt(deletedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtLte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) DeletedAtLte(deletedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` <= ?", deletedAt))
+func (qs AuthKeyQuerySet) DeletedAtLte(deletedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtNe is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) DeletedAtNe(deletedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` != ?", deletedAt))
+func (qs AuthKeyQuerySet) DeletedAtNe(deletedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
 }
 
-// GetDB is an autogenerated method
+// EnabledEq is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) GetDB() *gorm.DB {
-	return qs.db
+func (qs AuthKeyQuerySet) EnabledEq(enabled bool) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "enabled", Value: enabled}))
 }
 
-// GetUpdater is an autogenerated method
+// EnabledIn is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) GetUpdater() GithubUserUpdater {
-	return NewGithubUserUpdater(qs.db)
+func (qs AuthKeyQuerySet) EnabledIn(enabled ...bool) AuthKeyQuerySet {
+	if len(enabled) == 0 {
+		qs.db.AddError(errors.New("must at least pass one enabled in EnabledIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "enabled"}, enabled))
 }
 
-// GithubIDEq is an autogenerated method
+// EnabledNe is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) GithubIDEq(githubID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`github_id` = ?", githubID))
+func (qs AuthKeyQuerySet) EnabledNe(enabled bool) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "enabled", Value: enabled}))
 }
 
-// GithubIDGt is an autogenerated method
+// EnabledNotIn is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) GithubIDGt(githubID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`github_id` > ?", githubID))
+func (qs AuthKeyQuerySet) EnabledNotIn(enabled ...bool) AuthKeyQuerySet {
+	if len(enabled) == 0 {
+		qs.db.AddError(errors.New("must at least pass one enabled in EnabledNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "enabled"}, enabled))
 }
 
-// GithubIDGte is an autogenerated method
+// ExpiredAtEq is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) GithubIDGte(githubID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`github_id` >= ?", githubID))
+func (qs AuthKeyQuerySet) ExpiredAtEq(expiredAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "expired_at", Value: expiredAt}))
 }
 
-// GithubIDIn is an autogenerated method
+// ExpiredAtGt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) GithubIDIn(githubID ...ID) GithubUserQuerySet {
-	if len(githubID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one githubID in GithubIDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("github_id IN (?)", githubID))
+func (qs AuthKeyQuerySet) ExpiredAtGt(expiredAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "expired_at", Value: expiredAt}))
 }
 
-// GithubIDLt is an autogenerated method
+// ExpiredAtGte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) GithubIDLt(githubID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`github_id` < ?", githubID))
+func (qs AuthKeyQuerySet) ExpiredAtGte(expiredAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "expired_at", Value: expiredAt}))
 }
 
-// GithubIDLte is an autogenerated method
+// ExpiredAtLt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) GithubIDLte(githubID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`github_id` <= ?", githubID))
+func (qs AuthKeyQuerySet) ExpiredAtLt(expiredAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "expired_at", Value: expiredAt}))
 }
 
-// GithubIDNe is an autogenerated method
+// ExpiredAtLte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) GithubIDNe(githubID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`github_id` != ?", githubID))
+func (qs AuthKeyQuerySet) ExpiredAtLte(expiredAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "expired_at", Value: expiredAt}))
 }
 
-// GithubIDNotIn is an autogenerated method
+// ExpiredAtNe is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) GithubIDNotIn(githubID ...ID) GithubUserQuerySet {
-	if len(githubID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one githubID in GithubIDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("github_id NOT IN (?)", githubID))
+func (qs AuthKeyQuerySet) ExpiredAtNe(expiredAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "expired_at", Value: expiredAt}))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) GetUpdater() AuthKeyUpdater {
+	return NewAuthKeyUpdater(qs.db)
 }
 
 // IDEq is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) IDEq(ID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`id` = ?", ID))
+func (qs AuthKeyQuerySet) IDEq(ID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
 }
 
 // IDGt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) IDGt(ID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`id` > ?", ID))
+func (qs AuthKeyQuerySet) IDGt(ID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
 }
 
 // IDGte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) IDGte(ID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`id` >= ?", ID))
+func (qs AuthKeyQuerySet) IDGte(ID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
 }
 
 // IDIn is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) IDIn(ID ...ID) GithubUserQuerySet {
+func (qs AuthKeyQuerySet) IDIn(ID ...ID) AuthKeyQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // IDLt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) IDLt(ID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`id` < ?", ID))
+func (qs AuthKeyQuerySet) IDLt(ID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
 }
 
 // IDLte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) IDLte(ID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`id` <= ?", ID))
+func (qs AuthKeyQuerySet) IDLte(ID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
 }
 
 // IDNe is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) IDNe(ID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`id` != ?", ID))
+func (qs AuthKeyQuerySet) IDNe(ID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
 }
 
 // IDNotIn is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) IDNotIn(ID ...ID) GithubUserQuerySet {
+func (qs AuthKeyQuerySet) IDNotIn(ID ...ID) AuthKeyQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
-}
-
-// Limit is an autogenerated method
-// nolint: dupl
-func (qs GithubUserQuerySet) Limit(limit int) GithubUserQuerySet {
-	return qs.w(qs.db.Limit(limit))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
 }
 
-// LocationEq is an autogenerated method
+// KeyEq is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LocationEq(location string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`location` = ?", location))
+func (qs AuthKeyQuerySet) KeyEq(key string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "key", Value: key}))
 }
 
-// LocationGt is an autogenerated method
+// KeyGt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LocationGt(location string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`location` > ?", location))
+func (qs AuthKeyQuerySet) KeyGt(key string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "key", Value: key}))
 }
 
-// LocationGte is an autogenerated method
+// KeyGte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LocationGte(location string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`location` >= ?", location))
+func (qs AuthKeyQuerySet) KeyGte(key string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "key", Value: key}))
 }
 
-// LocationIn is an autogenerated method
+// KeyIn is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LocationIn(location ...string) GithubUserQuerySet {
-	if len(location) == 0 {
-		qs.db.AddError(errors.New("must at least pass one location in LocationIn"))
+func (qs AuthKeyQuerySet) KeyIn(key ...string) AuthKeyQuerySet {
+	if len(key) == 0 {
+		qs.db.AddError(errors.New("must at least pass one key in KeyIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("location IN (?)", location))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "key"}, key))
 }
 
-// LocationLike is an autogenerated method
+// KeyLike is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LocationLike(location string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`location` LIKE ?", location))
+func (qs AuthKeyQuerySet) KeyLike(key string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "key", Value: key}))
 }
 
-// LocationLt is an autogenerated method
+// KeyLt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LocationLt(location string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`location` < ?", location))
+func (qs AuthKeyQuerySet) KeyLt(key string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "key", Value: key}))
 }
 
-// LocationLte is an autogenerated method
+// KeyLte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LocationLte(location string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`location` <= ?", location))
+func (qs AuthKeyQuerySet) KeyLte(key string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "key", Value: key}))
 }
 
-// LocationNe is an autogenerated method
+// KeyNe is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LocationNe(location string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`location` != ?", location))
+func (qs AuthKeyQuerySet) KeyNe(key string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "key", Value: key}))
 }
 
-// LocationNotIn is an autogenerated method
+// KeyNotIn is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LocationNotIn(location ...string) GithubUserQuerySet {
-	if len(location) == 0 {
-		qs.db.AddError(errors.New("must at least pass one location in LocationNotIn"))
+func (qs AuthKeyQuerySet) KeyNotIn(key ...string) AuthKeyQuerySet {
+	if len(key) == 0 {
+		qs.db.AddError(errors.New("must at least pass one key in KeyNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("location NOT IN (?)", location))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "key"}, key))
 }
 
-// LocationNotlike is an autogenerated method
+// KeyNotlike is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LocationNotlike(location string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`location` NOT LIKE ?", location))
+func (qs AuthKeyQuerySet) KeyNotlike(key string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "key", Value: key})))
 }
 
-// LoginEq is an autogenerated method
+// Limit is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LoginEq(login string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`login` = ?", login))
+func (qs AuthKeyQuerySet) Limit(limit int) AuthKeyQuerySet {
+	return qs.w(qs.db.Limit(limit))
 }
 
-// LoginGt is an autogenerated method
+// MachineIDEq is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LoginGt(login string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`login` > ?", login))
+func (qs AuthKeyQuerySet) MachineIDEq(machineID string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "machine_id", Value: machineID}))
 }
 
-// LoginGte is an autogenerated method
+// MachineIDGt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LoginGte(login string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`login` >= ?", login))
+func (qs AuthKeyQuerySet) MachineIDGt(machineID string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "machine_id", Value: machineID}))
 }
 
-// LoginIn is an autogenerated method
+// MachineIDGte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LoginIn(login ...string) GithubUserQuerySet {
-	if len(login) == 0 {
-		qs.db.AddError(errors.New("must at least pass one login in LoginIn"))
+func (qs AuthKeyQuerySet) MachineIDGte(machineID string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "machine_id", Value: machineID}))
+}
+
+// MachineIDIn is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) MachineIDIn(machineID ...string) AuthKeyQuerySet {
+	if len(machineID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one machineID in MachineIDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("login IN (?)", login))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "machine_id"}, machineID))
 }
 
-// LoginLike is an autogenerated method
+// MachineIDLike is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LoginLike(login string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`login` LIKE ?", login))
+func (qs AuthKeyQuerySet) MachineIDLike(machineID string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "machine_id", Value: machineID}))
 }
 
-// LoginLt is an autogenerated method
+// MachineIDLt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LoginLt(login string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`login` < ?", login))
+func (qs AuthKeyQuerySet) MachineIDLt(machineID string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "machine_id", Value: machineID}))
 }
 
-// LoginLte is an autogenerated method
+// MachineIDLte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LoginLte(login string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`login` <= ?", login))
+func (qs AuthKeyQuerySet) MachineIDLte(machineID string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "machine_id", Value: machineID}))
 }
 
-// LoginNe is an autogenerated method
+// MachineIDNe is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LoginNe(login string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`login` != ?", login))
+func (qs AuthKeyQuerySet) MachineIDNe(machineID string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "machine_id", Value: machineID}))
 }
 
-// LoginNotIn is an autogenerated method
+// MachineIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LoginNotIn(login ...string) GithubUserQuerySet {
-	if len(login) == 0 {
-		qs.db.AddError(errors.New("must at least pass one login in LoginNotIn"))
+func (qs AuthKeyQuerySet) MachineIDNotIn(machineID ...string) AuthKeyQuerySet {
+	if len(machineID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one machineID in MachineIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("login NOT IN (?)", login))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "machine_id"}, machineID))
 }
 
-// LoginNotlike is an autogenerated method
+// MachineIDNotlike is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) LoginNotlike(login string) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`login` NOT LIKE ?", login))
+func (qs AuthKeyQuerySet) MachineIDNotlike(machineID string) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "machine_id", Value: machineID})))
 }
 
 // Offset is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) Offset(offset int) GithubUserQuerySet {
+func (qs AuthKeyQuerySet) Offset(offset int) AuthKeyQuerySet {
 	return qs.w(qs.db.Offset(offset))
 }
 
 // One is used to retrieve one result. It returns gorm.ErrRecordNotFound
 // if nothing was fetched
-func (qs GithubUserQuerySet) One(ret *GithubUser) error {
+func (qs AuthKeyQuerySet) One(ret *AuthKey) error {
 	return qs.db.First(ret).Error
 }
 
-// OrderAscByAvatarURL is an autogenerated method
+// OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderAscByAvatarURL() GithubUserQuerySet {
-	return qs.w(qs.db.Order("avatar_url ASC"))
+func (qs AuthKeyQuerySet) OrderAscByCreatedAt() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
 }
 
-// OrderAscByCreatedAt is an autogenerated method
+// OrderAscByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderAscByCreatedAt() GithubUserQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+func (qs AuthKeyQuerySet) OrderAscByDeletedAt() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
 }
 
-// OrderAscByDeletedAt is an autogenerated method
+// OrderAscByEnabled is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderAscByDeletedAt() GithubUserQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+func (qs AuthKeyQuerySet) OrderAscByEnabled() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "enabled"}}))
 }
 
-// OrderAscByGithubID is an autogenerated method
+// OrderAscByExpiredAt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderAscByGithubID() GithubUserQuerySet {
-	return qs.w(qs.db.Order("github_id ASC"))
+func (qs AuthKeyQuerySet) OrderAscByExpiredAt() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "expired_at"}}))
 }
 
 // OrderAscByID is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderAscByID() GithubUserQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+func (qs AuthKeyQuerySet) OrderAscByID() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
 }
 
-// OrderAscByLocation is an autogenerated method
+// OrderAscByKey is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderAscByLocation() GithubUserQuerySet {
-	return qs.w(qs.db.Order("location ASC"))
+func (qs AuthKeyQuerySet) OrderAscByKey() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "key"}}))
 }
 
-// OrderAscByLogin is an autogenerated method
+// OrderAscByMachineID is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderAscByLogin() GithubUserQuerySet {
-	return qs.w(qs.db.Order("login ASC"))
+func (qs AuthKeyQuerySet) OrderAscByMachineID() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "machine_id"}}))
 }
 
-// OrderAscByUpdatedAt is an autogenerated method
+// OrderAscByOrganizationID is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderAscByUpdatedAt() GithubUserQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+func (qs AuthKeyQuerySet) OrderAscByOrganizationID() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}}))
 }
 
-// OrderAscByUserID is an autogenerated method
+// OrderAscByRole is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderAscByUserID() GithubUserQuerySet {
-	return qs.w(qs.db.Order("user_id ASC"))
+func (qs AuthKeyQuerySet) OrderAscByRole() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "role"}}))
 }
 
-// OrderDescByAvatarURL is an autogenerated method
+// OrderAscByType is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderDescByAvatarURL() GithubUserQuerySet {
-	return qs.w(qs.db.Order("avatar_url DESC"))
+func (qs AuthKeyQuerySet) OrderAscByType() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "type"}}))
 }
 
-// OrderDescByCreatedAt is an autogenerated method
+// OrderAscByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderDescByCreatedAt() GithubUserQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+func (qs AuthKeyQuerySet) OrderAscByUpdatedAt() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
 }
 
-// OrderDescByDeletedAt is an autogenerated method
+// OrderAscByUserID is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderDescByDeletedAt() GithubUserQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+func (qs AuthKeyQuerySet) OrderAscByUserID() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}}))
 }
 
-// OrderDescByGithubID is an autogenerated method
+// OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderDescByGithubID() GithubUserQuerySet {
-	return qs.w(qs.db.Order("github_id DESC"))
+func (qs AuthKeyQuerySet) OrderDescByCreatedAt() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
 }
 
-// OrderDescByID is an autogenerated method
+// OrderDescByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderDescByID() GithubUserQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (qs AuthKeyQuerySet) OrderDescByDeletedAt() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
 }
 
-// OrderDescByLocation is an autogenerated method
+// OrderDescByEnabled is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderDescByLocation() GithubUserQuerySet {
-	return qs.w(qs.db.Order("location DESC"))
+func (qs AuthKeyQuerySet) OrderDescByEnabled() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "enabled"}, Desc: true}))
 }
 
-// OrderDescByLogin is an autogenerated method
+// OrderDescByExpiredAt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderDescByLogin() GithubUserQuerySet {
-	return qs.w(qs.db.Order("login DESC"))
+func (qs AuthKeyQuerySet) OrderDescByExpiredAt() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "expired_at"}, Desc: true}))
 }
 
-// OrderDescByUpdatedAt is an autogenerated method
+// OrderDescByID is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderDescByUpdatedAt() GithubUserQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+func (qs AuthKeyQuerySet) OrderDescByID() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
 }
 
-// OrderDescByUserID is an autogenerated method
+// OrderDescByKey is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) OrderDescByUserID() GithubUserQuerySet {
-	return qs.w(qs.db.Order("user_id DESC"))
+func (qs AuthKeyQuerySet) OrderDescByKey() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "key"}, Desc: true}))
 }
 
-// PreloadUser is an autogenerated method
+// OrderDescByMachineID is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) PreloadUser() GithubUserQuerySet {
-	return qs.w(qs.db.Preload("User"))
+func (qs AuthKeyQuerySet) OrderDescByMachineID() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "machine_id"}, Desc: true}))
 }
 
-// UpdatedAtEq is an autogenerated method
+// OrderDescByOrganizationID is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UpdatedAtEq(updatedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` = ?", updatedAt))
+func (qs AuthKeyQuerySet) OrderDescByOrganizationID() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}, Desc: true}))
 }
 
-// UpdatedAtGt is an autogenerated method
+// OrderDescByRole is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UpdatedAtGt(updatedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` > ?", updatedAt))
+func (qs AuthKeyQuerySet) OrderDescByRole() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "role"}, Desc: true}))
 }
 
-// UpdatedAtGte is an autogenerated method
+// OrderDescByType is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UpdatedAtGte(updatedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` >= ?", updatedAt))
+func (qs AuthKeyQuerySet) OrderDescByType() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "type"}, Desc: true}))
 }
 
-// UpdatedAtIsNotNull is an autogenerated method
+// OrderDescByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UpdatedAtIsNotNull() GithubUserQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NOT NULL"))
+func (qs AuthKeyQuerySet) OrderDescByUpdatedAt() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
 }
 
-// UpdatedAtIsNull is an autogenerated method
+// OrderDescByUserID is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UpdatedAtIsNull() GithubUserQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NULL"))
+func (qs AuthKeyQuerySet) OrderDescByUserID() AuthKeyQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}, Desc: true}))
 }
 
-// UpdatedAtLt is an autogenerated method
+// OrganizationIDEq is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UpdatedAtLt(updatedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` < ?", updatedAt))
+func (qs AuthKeyQuerySet) OrganizationIDEq(organizationID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "organization_id", Value: organizationID}))
 }
 
-// UpdatedAtLte is an autogenerated method
+// OrganizationIDGt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UpdatedAtLte(updatedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` <= ?", updatedAt))
+func (qs AuthKeyQuerySet) OrganizationIDGt(organizationID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "organization_id", Value: organizationID}))
 }
 
-// UpdatedAtNe is an autogenerated method
+// OrganizationIDGte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UpdatedAtNe(updatedAt time.Time) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` != ?", updatedAt))
+func (qs AuthKeyQuerySet) OrganizationIDGte(organizationID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "organization_id", Value: organizationID}))
 }
 
-// UserIDEq is an autogenerated method
+// OrganizationIDIn is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UserIDEq(userID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` = ?", userID))
+func (qs AuthKeyQuerySet) OrganizationIDIn(organizationID ...ID) AuthKeyQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "organization_id"}, organizationID))
 }
 
-// UserIDGt is an autogenerated method
+// OrganizationIDLt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UserIDGt(userID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` > ?", userID))
+func (qs AuthKeyQuerySet) OrganizationIDLt(organizationID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "organization_id", Value: organizationID}))
 }
 
-// UserIDGte is an autogenerated method
+// OrganizationIDLte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UserIDGte(userID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` >= ?", userID))
+func (qs AuthKeyQuerySet) OrganizationIDLte(organizationID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "organization_id", Value: organizationID}))
 }
 
-// UserIDIn is an autogenerated method
+// OrganizationIDNe is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UserIDIn(userID ...ID) GithubUserQuerySet {
-	if len(userID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+func (qs AuthKeyQuerySet) OrganizationIDNe(organizationID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) OrganizationIDNotIn(organizationID ...ID) AuthKeyQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("user_id IN (?)", userID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "organization_id"}, organizationID))
 }
 
-// UserIDLt is an autogenerated method
+// PreloadUser is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UserIDLt(userID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` < ?", userID))
+func (qs AuthKeyQuerySet) PreloadUser() AuthKeyQuerySet {
+	return qs.w(qs.db.Preload("User"))
 }
 
-// UserIDLte is an autogenerated method
+// RoleEq is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UserIDLte(userID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` <= ?", userID))
+func (qs AuthKeyQuerySet) RoleEq(role KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "role", Value: role}))
 }
 
-// UserIDNe is an autogenerated method
+// RoleGt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UserIDNe(userID ID) GithubUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` != ?", userID))
+func (qs AuthKeyQuerySet) RoleGt(role KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "role", Value: role}))
 }
 
-// UserIDNotIn is an autogenerated method
+// RoleGte is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UserIDNotIn(userID ...ID) GithubUserQuerySet {
-	if len(userID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+func (qs AuthKeyQuerySet) RoleGte(role KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "role", Value: role}))
+}
+
+// RoleIn is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) RoleIn(role ...KeyType) AuthKeyQuerySet {
+	if len(role) == 0 {
+		qs.db.AddError(errors.New("must at least pass one role in RoleIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "role"}, role))
 }
 
-// UserIsNotNull is an autogenerated method
+// RoleLike is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UserIsNotNull() GithubUserQuerySet {
-	return qs.w(qs.db.Where("user IS NOT NULL"))
+func (qs AuthKeyQuerySet) RoleLike(role KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "role", Value: role}))
 }
 
-// UserIsNull is an autogenerated method
+// RoleLt is an autogenerated method
 // nolint: dupl
-func (qs GithubUserQuerySet) UserIsNull() GithubUserQuerySet {
-	return qs.w(qs.db.Where("user IS NULL"))
+func (qs AuthKeyQuerySet) RoleLt(role KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "role", Value: role}))
 }
 
-// SetAvatarURL is an autogenerated method
+// RoleLte is an autogenerated method
 // nolint: dupl
-func (u GithubUserUpdater) SetAvatarURL(avatarURL string) GithubUserUpdater {
-	u.fields[string(GithubUserDBSchema.AvatarURL)] = avatarURL
-	return u
+func (qs AuthKeyQuerySet) RoleLte(role KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "role", Value: role}))
 }
 
-// SetCreatedAt is an autogenerated method
+// RoleNe is an autogenerated method
 // nolint: dupl
-func (u GithubUserUpdater) SetCreatedAt(createdAt time.Time) GithubUserUpdater {
-	u.fields[string(GithubUserDBSchema.CreatedAt)] = createdAt
-	return u
+func (qs AuthKeyQuerySet) RoleNe(role KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "role", Value: role}))
 }
 
-// SetDeletedAt is an autogenerated method
+// RoleNotIn is an autogenerated method
 // nolint: dupl
-func (u GithubUserUpdater) SetDeletedAt(deletedAt *time.Time) GithubUserUpdater {
-	u.fields[string(GithubUserDBSchema.DeletedAt)] = deletedAt
-	return u
+func (qs AuthKeyQuerySet) RoleNotIn(role ...KeyType) AuthKeyQuerySet {
+	if len(role) == 0 {
+		qs.db.AddError(errors.New("must at least pass one role in RoleNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "role"}, role))
 }
 
-// SetGithubID is an autogenerated method
+// RoleNotlike is an autogenerated method
 // nolint: dupl
-func (u GithubUserUpdater) SetGithubID(githubID ID) GithubUserUpdater {
-	u.fields[string(GithubUserDBSchema.GithubID)] = githubID
-	return u
+func (qs AuthKeyQuerySet) RoleNotlike(role KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "role", Value: role})))
 }
 
-// SetID is an autogenerated method
+// TypeEq is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) TypeEq(typeValue KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "type", Value: typeValue}))
+}
+
+// TypeGt is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) TypeGt(typeValue KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "type", Value: typeValue}))
+}
+
+// TypeGte is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) TypeGte(typeValue KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "type", Value: typeValue}))
+}
+
+// TypeIn is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) TypeIn(typeValue ...KeyType) AuthKeyQuerySet {
+	if len(typeValue) == 0 {
+		qs.db.AddError(errors.New("must at least pass one typeValue in TypeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "type"}, typeValue))
+}
+
+// TypeLike is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) TypeLike(typeValue KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "type", Value: typeValue}))
+}
+
+// TypeLt is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) TypeLt(typeValue KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "type", Value: typeValue}))
+}
+
+// TypeLte is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) TypeLte(typeValue KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "type", Value: typeValue}))
+}
+
+// TypeNe is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) TypeNe(typeValue KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "type", Value: typeValue}))
+}
+
+// TypeNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) TypeNotIn(typeValue ...KeyType) AuthKeyQuerySet {
+	if len(typeValue) == 0 {
+		qs.db.AddError(errors.New("must at least pass one typeValue in TypeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "type"}, typeValue))
+}
+
+// TypeNotlike is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) TypeNotlike(typeValue KeyType) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "type", Value: typeValue})))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UpdatedAtEq(updatedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UpdatedAtGt(updatedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UpdatedAtGte(updatedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UpdatedAtIsNotNull() AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UpdatedAtIsNull() AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UpdatedAtLt(updatedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UpdatedAtLte(updatedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UpdatedAtNe(updatedAt time.Time) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UserIDEq(userID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user_id", Value: userID}))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UserIDGt(userID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "user_id", Value: userID}))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UserIDGte(userID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "user_id", Value: userID}))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UserIDIn(userID ...ID) AuthKeyQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UserIDLt(userID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "user_id", Value: userID}))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UserIDLte(userID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "user_id", Value: userID}))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UserIDNe(userID ID) AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user_id", Value: userID}))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UserIDNotIn(userID ...ID) AuthKeyQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UserIsNotNull() AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user", Value: nil}))
+}
+
+// UserIsNull is an autogenerated method
+// nolint: dupl
+func (qs AuthKeyQuerySet) UserIsNull() AuthKeyQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user", Value: nil}))
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetCreatedAt(createdAt time.Time) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetDeletedAt(deletedAt *time.Time) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetEnabled is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetEnabled(enabled bool) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.Enabled)] = enabled
+	return u
+}
+
+// SetExpiredAt is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetExpiredAt(expiredAt time.Time) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.ExpiredAt)] = expiredAt
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetID(ID ID) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.ID)] = ID
+	return u
+}
+
+// SetKey is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetKey(key string) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.Key)] = key
+	return u
+}
+
+// SetMachineID is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetMachineID(machineID string) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.MachineID)] = machineID
+	return u
+}
+
+// SetOrganizationID is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetOrganizationID(organizationID ID) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.OrganizationID)] = organizationID
+	return u
+}
+
+// SetRole is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetRole(role KeyType) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.Role)] = role
+	return u
+}
+
+// SetType is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetType(typeValue KeyType) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.Type)] = typeValue
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetUpdatedAt(updatedAt *time.Time) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) SetUserID(userID ID) AuthKeyUpdater {
+	u.fields[string(AuthKeyDBSchema.UserID)] = userID
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u AuthKeyUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set AuthKeyQuerySet
+
+// ===== BEGIN of AuthKey modifiers
+
+// AuthKeyDBSchemaField describes database schema field. It requires for method 'Update'
+type AuthKeyDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f AuthKeyDBSchemaField) String() string {
+	return string(f)
+}
+
+// AuthKeyDBSchema stores db field names of AuthKey
+var AuthKeyDBSchema = struct {
+	ID             AuthKeyDBSchemaField
+	CreatedAt      AuthKeyDBSchemaField
+	UpdatedAt      AuthKeyDBSchemaField
+	DeletedAt      AuthKeyDBSchemaField
+	UserID         AuthKeyDBSchemaField
+	User           AuthKeyDBSchemaField
+	Type           AuthKeyDBSchemaField
+	Role           AuthKeyDBSchemaField
+	Key            AuthKeyDBSchemaField
+	MachineID      AuthKeyDBSchemaField
+	ExpiredAt      AuthKeyDBSchemaField
+	Enabled        AuthKeyDBSchemaField
+	OrganizationID AuthKeyDBSchemaField
+}{
+
+	ID:             AuthKeyDBSchemaField("id"),
+	CreatedAt:      AuthKeyDBSchemaField("created_at"),
+	UpdatedAt:      AuthKeyDBSchemaField("updated_at"),
+	DeletedAt:      AuthKeyDBSchemaField("deleted_at"),
+	UserID:         AuthKeyDBSchemaField("user_id"),
+	User:           AuthKeyDBSchemaField("user"),
+	Type:           AuthKeyDBSchemaField("type"),
+	Role:           AuthKeyDBSchemaField("role"),
+	Key:            AuthKeyDBSchemaField("key"),
+	MachineID:      AuthKeyDBSchemaField("machine_id"),
+	ExpiredAt:      AuthKeyDBSchemaField("expired_at"),
+	Enabled:        AuthKeyDBSchemaField("enabled"),
+	OrganizationID: AuthKeyDBSchemaField("organization_id"),
+}
+
+// Update updates AuthKey fields by primary key
+// nolint: dupl
+func (o *AuthKey) Update(db *gorm.DB, fields ...AuthKeyDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":              o.ID,
+		"created_at":      o.CreatedAt,
+		"updated_at":      o.UpdatedAt,
+		"deleted_at":      o.DeletedAt,
+		"user_id":         o.UserID,
+		"user":            o.User,
+		"type":            o.Type,
+		"role":            o.Role,
+		"key":             o.Key,
+		"machine_id":      o.MachineID,
+		"expired_at":      o.ExpiredAt,
+		"enabled":         o.Enabled,
+		"organization_id": o.OrganizationID,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update AuthKey %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// AuthKeyUpdater is an AuthKey updates manager
+type AuthKeyUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewAuthKeyUpdater creates new AuthKey updater
+// nolint: dupl
+func NewAuthKeyUpdater(db *gorm.DB) AuthKeyUpdater {
+	return AuthKeyUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&AuthKey{}),
+	}
+}
+
+// ===== END of AuthKey modifiers
+
+// ===== BEGIN of query set DeviceQuerySet
+
+// DeviceQuerySet is an queryset type for Device
+type DeviceQuerySet struct {
+	db *gorm.DB
+}
+
+// NewDeviceQuerySet constructs new DeviceQuerySet
+func NewDeviceQuerySet(db *gorm.DB) DeviceQuerySet {
+	return DeviceQuerySet{
+		db: db.Model(&Device{}),
+	}
+}
+
+func (qs DeviceQuerySet) w(db *gorm.DB) DeviceQuerySet {
+	return NewDeviceQuerySet(db)
+}
+
+func (qs DeviceQuerySet) Preload(query string, args ...interface{}) DeviceQuerySet {
+	return NewDeviceQuerySet(qs.db.Preload(query, args...))
+}
+
+// Preload preload associations with given conditions
+//    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
+func (qs DeviceQuerySet) Select(fields ...DeviceDBSchemaField) DeviceQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Device) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Device) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// AddressEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) AddressEq(address string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "address", Value: address}))
+}
+
+// AddressGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) AddressGt(address string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "address", Value: address}))
+}
+
+// AddressGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) AddressGte(address string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "address", Value: address}))
+}
+
+// AddressIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) AddressIn(address ...string) DeviceQuerySet {
+	if len(address) == 0 {
+		qs.db.AddError(errors.New("must at least pass one address in AddressIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "address"}, address))
+}
+
+// AddressLike is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) AddressLike(address string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "address", Value: address}))
+}
+
+// AddressLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) AddressLt(address string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "address", Value: address}))
+}
+
+// AddressLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) AddressLte(address string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "address", Value: address}))
+}
+
+// AddressNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) AddressNe(address string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "address", Value: address}))
+}
+
+// AddressNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) AddressNotIn(address ...string) DeviceQuerySet {
+	if len(address) == 0 {
+		qs.db.AddError(errors.New("must at least pass one address in AddressNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "address"}, address))
+}
+
+// AddressNotlike is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) AddressNotlike(address string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "address", Value: address})))
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) All(ret *[]Device) error {
+	return qs.db.Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) CreatedAtEq(createdAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) CreatedAtGt(createdAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) CreatedAtGte(createdAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) CreatedAtLt(createdAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) CreatedAtLte(createdAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) CreatedAtNe(createdAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) Delete() error {
+	return qs.db.Delete(Device{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(Device{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(Device{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) DeletedAtEq(deletedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) DeletedAtGt(deletedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) DeletedAtGte(deletedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) DeletedAtIsNotNull() DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) DeletedAtIsNull() DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) DeletedAtLt(deletedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) DeletedAtLte(deletedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) DeletedAtNe(deletedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// EphemeralEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) EphemeralEq(ephemeral bool) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "ephemeral", Value: ephemeral}))
+}
+
+// EphemeralIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) EphemeralIn(ephemeral ...bool) DeviceQuerySet {
+	if len(ephemeral) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ephemeral in EphemeralIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "ephemeral"}, ephemeral))
+}
+
+// EphemeralNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) EphemeralNe(ephemeral bool) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "ephemeral", Value: ephemeral}))
+}
+
+// EphemeralNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) EphemeralNotIn(ephemeral ...bool) DeviceQuerySet {
+	if len(ephemeral) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ephemeral in EphemeralNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "ephemeral"}, ephemeral))
+}
+
+// ExitNodeEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) ExitNodeEq(exitNode bool) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "exit_node", Value: exitNode}))
+}
+
+// ExitNodeIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) ExitNodeIn(exitNode ...bool) DeviceQuerySet {
+	if len(exitNode) == 0 {
+		qs.db.AddError(errors.New("must at least pass one exitNode in ExitNodeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "exit_node"}, exitNode))
+}
+
+// ExitNodeNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) ExitNodeNe(exitNode bool) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "exit_node", Value: exitNode}))
+}
+
+// ExitNodeNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) ExitNodeNotIn(exitNode ...bool) DeviceQuerySet {
+	if len(exitNode) == 0 {
+		qs.db.AddError(errors.New("must at least pass one exitNode in ExitNodeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "exit_node"}, exitNode))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) GetUpdater() DeviceUpdater {
+	return NewDeviceUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) IDEq(ID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) IDGt(ID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) IDGte(ID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) IDIn(ID ...ID) DeviceQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) IDLt(ID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) IDLte(ID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) IDNe(ID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) IDNotIn(ID ...ID) DeviceQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// LastSeenEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) LastSeenEq(lastSeen time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "last_seen", Value: lastSeen}))
+}
+
+// LastSeenGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) LastSeenGt(lastSeen time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "last_seen", Value: lastSeen}))
+}
+
+// LastSeenGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) LastSeenGte(lastSeen time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "last_seen", Value: lastSeen}))
+}
+
+// LastSeenLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) LastSeenLt(lastSeen time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "last_seen", Value: lastSeen}))
+}
+
+// LastSeenLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) LastSeenLte(lastSeen time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "last_seen", Value: lastSeen}))
+}
+
+// LastSeenNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) LastSeenNe(lastSeen time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "last_seen", Value: lastSeen}))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) Limit(limit int) DeviceQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MachineIDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) MachineIDEq(machineID string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "machine_id", Value: machineID}))
+}
+
+// MachineIDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) MachineIDGt(machineID string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "machine_id", Value: machineID}))
+}
+
+// MachineIDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) MachineIDGte(machineID string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "machine_id", Value: machineID}))
+}
+
+// MachineIDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) MachineIDIn(machineID ...string) DeviceQuerySet {
+	if len(machineID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one machineID in MachineIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "machine_id"}, machineID))
+}
+
+// MachineIDLike is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) MachineIDLike(machineID string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "machine_id", Value: machineID}))
+}
+
+// MachineIDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) MachineIDLt(machineID string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "machine_id", Value: machineID}))
+}
+
+// MachineIDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) MachineIDLte(machineID string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "machine_id", Value: machineID}))
+}
+
+// MachineIDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) MachineIDNe(machineID string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "machine_id", Value: machineID}))
+}
+
+// MachineIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) MachineIDNotIn(machineID ...string) DeviceQuerySet {
+	if len(machineID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one machineID in MachineIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "machine_id"}, machineID))
+}
+
+// MachineIDNotlike is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) MachineIDNotlike(machineID string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "machine_id", Value: machineID})))
+}
+
+// NameEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) NameEq(name string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "name", Value: name}))
+}
+
+// NameGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) NameGt(name string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "name", Value: name}))
+}
+
+// NameGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) NameGte(name string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "name", Value: name}))
+}
+
+// NameIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) NameIn(name ...string) DeviceQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "name"}, name))
+}
+
+// NameLike is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) NameLike(name string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "name", Value: name}))
+}
+
+// NameLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) NameLt(name string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "name", Value: name}))
+}
+
+// NameLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) NameLte(name string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "name", Value: name}))
+}
+
+// NameNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) NameNe(name string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "name", Value: name}))
+}
+
+// NameNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) NameNotIn(name ...string) DeviceQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "name"}, name))
+}
+
+// NameNotlike is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) NameNotlike(name string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "name", Value: name})))
+}
+
+// OSEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OSEq(oS string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "os", Value: oS}))
+}
+
+// OSGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OSGt(oS string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "os", Value: oS}))
+}
+
+// OSGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OSGte(oS string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "os", Value: oS}))
+}
+
+// OSIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OSIn(oS ...string) DeviceQuerySet {
+	if len(oS) == 0 {
+		qs.db.AddError(errors.New("must at least pass one oS in OSIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "os"}, oS))
+}
+
+// OSLike is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OSLike(oS string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "os", Value: oS}))
+}
+
+// OSLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OSLt(oS string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "os", Value: oS}))
+}
+
+// OSLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OSLte(oS string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "os", Value: oS}))
+}
+
+// OSNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OSNe(oS string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "os", Value: oS}))
+}
+
+// OSNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OSNotIn(oS ...string) DeviceQuerySet {
+	if len(oS) == 0 {
+		qs.db.AddError(errors.New("must at least pass one oS in OSNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "os"}, oS))
+}
+
+// OSNotlike is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OSNotlike(oS string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "os", Value: oS})))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) Offset(offset int) DeviceQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs DeviceQuerySet) One(ret *Device) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByAddress is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByAddress() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "address"}}))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByCreatedAt() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByDeletedAt() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
+}
+
+// OrderAscByEphemeral is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByEphemeral() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "ephemeral"}}))
+}
+
+// OrderAscByExitNode is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByExitNode() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "exit_node"}}))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByID() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
+}
+
+// OrderAscByLastSeen is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByLastSeen() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "last_seen"}}))
+}
+
+// OrderAscByMachineID is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByMachineID() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "machine_id"}}))
+}
+
+// OrderAscByName is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByName() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "name"}}))
+}
+
+// OrderAscByOS is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByOS() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "os"}}))
+}
+
+// OrderAscByOrganizationID is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByOrganizationID() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}}))
+}
+
+// OrderAscByRelayServerID is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByRelayServerID() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "relay_server_id"}}))
+}
+
+// OrderAscByRevokedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByRevokedAt() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "revoked_at"}}))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByUpdatedAt() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByUserID() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}}))
+}
+
+// OrderAscByVersion is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderAscByVersion() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "version"}}))
+}
+
+// OrderDescByAddress is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByAddress() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "address"}, Desc: true}))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByCreatedAt() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByDeletedAt() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
+}
+
+// OrderDescByEphemeral is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByEphemeral() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "ephemeral"}, Desc: true}))
+}
+
+// OrderDescByExitNode is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByExitNode() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "exit_node"}, Desc: true}))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByID() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
+}
+
+// OrderDescByLastSeen is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByLastSeen() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "last_seen"}, Desc: true}))
+}
+
+// OrderDescByMachineID is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByMachineID() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "machine_id"}, Desc: true}))
+}
+
+// OrderDescByName is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByName() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "name"}, Desc: true}))
+}
+
+// OrderDescByOS is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByOS() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "os"}, Desc: true}))
+}
+
+// OrderDescByOrganizationID is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByOrganizationID() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}, Desc: true}))
+}
+
+// OrderDescByRelayServerID is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByRelayServerID() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "relay_server_id"}, Desc: true}))
+}
+
+// OrderDescByRevokedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByRevokedAt() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "revoked_at"}, Desc: true}))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByUpdatedAt() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByUserID() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}, Desc: true}))
+}
+
+// OrderDescByVersion is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrderDescByVersion() DeviceQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "version"}, Desc: true}))
+}
+
+// OrganizationIDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrganizationIDEq(organizationID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrganizationIDGt(organizationID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrganizationIDGte(organizationID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrganizationIDIn(organizationID ...ID) DeviceQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "organization_id"}, organizationID))
+}
+
+// OrganizationIDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrganizationIDLt(organizationID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrganizationIDLte(organizationID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrganizationIDNe(organizationID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) OrganizationIDNotIn(organizationID ...ID) DeviceQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "organization_id"}, organizationID))
+}
+
+// PreloadUser is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) PreloadUser() DeviceQuerySet {
+	return qs.w(qs.db.Preload("User"))
+}
+
+// RelayServerIDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RelayServerIDEq(relayServerID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "relay_server_id", Value: relayServerID}))
+}
+
+// RelayServerIDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RelayServerIDGt(relayServerID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "relay_server_id", Value: relayServerID}))
+}
+
+// RelayServerIDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RelayServerIDGte(relayServerID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "relay_server_id", Value: relayServerID}))
+}
+
+// RelayServerIDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RelayServerIDIn(relayServerID ...ID) DeviceQuerySet {
+	if len(relayServerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one relayServerID in RelayServerIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "relay_server_id"}, relayServerID))
+}
+
+// RelayServerIDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RelayServerIDLt(relayServerID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "relay_server_id", Value: relayServerID}))
+}
+
+// RelayServerIDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RelayServerIDLte(relayServerID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "relay_server_id", Value: relayServerID}))
+}
+
+// RelayServerIDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RelayServerIDNe(relayServerID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "relay_server_id", Value: relayServerID}))
+}
+
+// RelayServerIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RelayServerIDNotIn(relayServerID ...ID) DeviceQuerySet {
+	if len(relayServerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one relayServerID in RelayServerIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "relay_server_id"}, relayServerID))
+}
+
+// RevokedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RevokedAtEq(revokedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "revoked_at", Value: revokedAt}))
+}
+
+// RevokedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RevokedAtGt(revokedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "revoked_at", Value: revokedAt}))
+}
+
+// RevokedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RevokedAtGte(revokedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "revoked_at", Value: revokedAt}))
+}
+
+// RevokedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RevokedAtIsNotNull() DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "revoked_at", Value: nil}))
+}
+
+// RevokedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RevokedAtIsNull() DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "revoked_at", Value: nil}))
+}
+
+// RevokedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RevokedAtLt(revokedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "revoked_at", Value: revokedAt}))
+}
+
+// RevokedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RevokedAtLte(revokedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "revoked_at", Value: revokedAt}))
+}
+
+// RevokedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) RevokedAtNe(revokedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "revoked_at", Value: revokedAt}))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UpdatedAtEq(updatedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UpdatedAtGt(updatedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UpdatedAtGte(updatedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UpdatedAtIsNotNull() DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UpdatedAtIsNull() DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UpdatedAtLt(updatedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UpdatedAtLte(updatedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UpdatedAtNe(updatedAt time.Time) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UserIDEq(userID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user_id", Value: userID}))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UserIDGt(userID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "user_id", Value: userID}))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UserIDGte(userID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "user_id", Value: userID}))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UserIDIn(userID ...ID) DeviceQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UserIDLt(userID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "user_id", Value: userID}))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UserIDLte(userID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "user_id", Value: userID}))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UserIDNe(userID ID) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user_id", Value: userID}))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UserIDNotIn(userID ...ID) DeviceQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UserIsNotNull() DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user", Value: nil}))
+}
+
+// UserIsNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) UserIsNull() DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user", Value: nil}))
+}
+
+// VersionEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) VersionEq(version string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "version", Value: version}))
+}
+
+// VersionGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) VersionGt(version string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "version", Value: version}))
+}
+
+// VersionGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) VersionGte(version string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "version", Value: version}))
+}
+
+// VersionIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) VersionIn(version ...string) DeviceQuerySet {
+	if len(version) == 0 {
+		qs.db.AddError(errors.New("must at least pass one version in VersionIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "version"}, version))
+}
+
+// VersionLike is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) VersionLike(version string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "version", Value: version}))
+}
+
+// VersionLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) VersionLt(version string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "version", Value: version}))
+}
+
+// VersionLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) VersionLte(version string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "version", Value: version}))
+}
+
+// VersionNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) VersionNe(version string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "version", Value: version}))
+}
+
+// VersionNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) VersionNotIn(version ...string) DeviceQuerySet {
+	if len(version) == 0 {
+		qs.db.AddError(errors.New("must at least pass one version in VersionNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "version"}, version))
+}
+
+// VersionNotlike is an autogenerated method
+// nolint: dupl
+func (qs DeviceQuerySet) VersionNotlike(version string) DeviceQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "version", Value: version})))
+}
+
+// SetAddress is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetAddress(address string) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.Address)] = address
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetCreatedAt(createdAt time.Time) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetDeletedAt(deletedAt *time.Time) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetEphemeral is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetEphemeral(ephemeral bool) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.Ephemeral)] = ephemeral
+	return u
+}
+
+// SetExitNode is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetExitNode(exitNode bool) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.ExitNode)] = exitNode
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetID(ID ID) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.ID)] = ID
+	return u
+}
+
+// SetLastSeen is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetLastSeen(lastSeen time.Time) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.LastSeen)] = lastSeen
+	return u
+}
+
+// SetMachineID is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetMachineID(machineID string) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.MachineID)] = machineID
+	return u
+}
+
+// SetName is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetName(name string) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.Name)] = name
+	return u
+}
+
+// SetOS is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetOS(oS string) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.OS)] = oS
+	return u
+}
+
+// SetOrganizationID is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetOrganizationID(organizationID ID) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.OrganizationID)] = organizationID
+	return u
+}
+
+// SetRelayServerID is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetRelayServerID(relayServerID ID) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.RelayServerID)] = relayServerID
+	return u
+}
+
+// SetRevokedAt is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetRevokedAt(revokedAt *time.Time) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.RevokedAt)] = revokedAt
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetUpdatedAt(updatedAt *time.Time) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetUserID(userID ID) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.UserID)] = userID
+	return u
+}
+
+// SetVersion is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) SetVersion(version string) DeviceUpdater {
+	u.fields[string(DeviceDBSchema.Version)] = version
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u DeviceUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set DeviceQuerySet
+
+// ===== BEGIN of Device modifiers
+
+// DeviceDBSchemaField describes database schema field. It requires for method 'Update'
+type DeviceDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f DeviceDBSchemaField) String() string {
+	return string(f)
+}
+
+// DeviceDBSchema stores db field names of Device
+var DeviceDBSchema = struct {
+	ID             DeviceDBSchemaField
+	CreatedAt      DeviceDBSchemaField
+	UpdatedAt      DeviceDBSchemaField
+	DeletedAt      DeviceDBSchemaField
+	UserID         DeviceDBSchemaField
+	User           DeviceDBSchemaField
+	RelayServerID  DeviceDBSchemaField
+	Name           DeviceDBSchemaField
+	OS             DeviceDBSchemaField
+	Version        DeviceDBSchemaField
+	MachineID      DeviceDBSchemaField
+	LastSeen       DeviceDBSchemaField
+	Address        DeviceDBSchemaField
+	ExitNode       DeviceDBSchemaField
+	OrganizationID DeviceDBSchemaField
+	Ephemeral      DeviceDBSchemaField
+	RevokedAt      DeviceDBSchemaField
+}{
+
+	ID:             DeviceDBSchemaField("id"),
+	CreatedAt:      DeviceDBSchemaField("created_at"),
+	UpdatedAt:      DeviceDBSchemaField("updated_at"),
+	DeletedAt:      DeviceDBSchemaField("deleted_at"),
+	UserID:         DeviceDBSchemaField("user_id"),
+	User:           DeviceDBSchemaField("user"),
+	RelayServerID:  DeviceDBSchemaField("relay_server_id"),
+	Name:           DeviceDBSchemaField("name"),
+	OS:             DeviceDBSchemaField("os"),
+	Version:        DeviceDBSchemaField("version"),
+	MachineID:      DeviceDBSchemaField("machine_id"),
+	LastSeen:       DeviceDBSchemaField("last_seen"),
+	Address:        DeviceDBSchemaField("address"),
+	ExitNode:       DeviceDBSchemaField("exit_node"),
+	OrganizationID: DeviceDBSchemaField("organization_id"),
+	Ephemeral:      DeviceDBSchemaField("ephemeral"),
+	RevokedAt:      DeviceDBSchemaField("revoked_at"),
+}
+
+// Update updates Device fields by primary key
+// nolint: dupl
+func (o *Device) Update(db *gorm.DB, fields ...DeviceDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":              o.ID,
+		"created_at":      o.CreatedAt,
+		"updated_at":      o.UpdatedAt,
+		"deleted_at":      o.DeletedAt,
+		"user_id":         o.UserID,
+		"user":            o.User,
+		"relay_server_id": o.RelayServerID,
+		"name":            o.Name,
+		"os":              o.OS,
+		"version":         o.Version,
+		"machine_id":      o.MachineID,
+		"last_seen":       o.LastSeen,
+		"address":         o.Address,
+		"exit_node":       o.ExitNode,
+		"organization_id": o.OrganizationID,
+		"ephemeral":       o.Ephemeral,
+		"revoked_at":      o.RevokedAt,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Device %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// DeviceUpdater is an Device updates manager
+type DeviceUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewDeviceUpdater creates new Device updater
+// nolint: dupl
+func NewDeviceUpdater(db *gorm.DB) DeviceUpdater {
+	return DeviceUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Device{}),
+	}
+}
+
+// ===== END of Device modifiers
+
+// ===== BEGIN of query set DeviceApprovalQuerySet
+
+// DeviceApprovalQuerySet is an queryset type for DeviceApproval
+type DeviceApprovalQuerySet struct {
+	db *gorm.DB
+}
+
+// NewDeviceApprovalQuerySet constructs new DeviceApprovalQuerySet
+func NewDeviceApprovalQuerySet(db *gorm.DB) DeviceApprovalQuerySet {
+	return DeviceApprovalQuerySet{
+		db: db.Model(&DeviceApproval{}),
+	}
+}
+
+func (qs DeviceApprovalQuerySet) w(db *gorm.DB) DeviceApprovalQuerySet {
+	return NewDeviceApprovalQuerySet(db)
+}
+
+func (qs DeviceApprovalQuerySet) Preload(query string, args ...interface{}) DeviceApprovalQuerySet {
+	return NewDeviceApprovalQuerySet(qs.db.Preload(query, args...))
+}
+
+// Preload preload associations with given conditions
+//    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
+func (qs DeviceApprovalQuerySet) Select(fields ...DeviceApprovalDBSchemaField) DeviceApprovalQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *DeviceApproval) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *DeviceApproval) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) All(ret *[]DeviceApproval) error {
+	return qs.db.Find(ret).Error
+}
+
+// ApprovedByIDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedByIDEq(approvedByID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedByIDGt(approvedByID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedByIDGte(approvedByID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedByIDIn(approvedByID ...ID) DeviceApprovalQuerySet {
+	if len(approvedByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one approvedByID in ApprovedByIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "approved_by_id"}, approvedByID))
+}
+
+// ApprovedByIDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedByIDLt(approvedByID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedByIDLte(approvedByID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedByIDNe(approvedByID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedByIDNotIn(approvedByID ...ID) DeviceApprovalQuerySet {
+	if len(approvedByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one approvedByID in ApprovedByIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "approved_by_id"}, approvedByID))
+}
+
+// ApprovedEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedEq(approved bool) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "approved", Value: approved}))
+}
+
+// ApprovedIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedIn(approved ...bool) DeviceApprovalQuerySet {
+	if len(approved) == 0 {
+		qs.db.AddError(errors.New("must at least pass one approved in ApprovedIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "approved"}, approved))
+}
+
+// ApprovedNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedNe(approved bool) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "approved", Value: approved}))
+}
+
+// ApprovedNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) ApprovedNotIn(approved ...bool) DeviceApprovalQuerySet {
+	if len(approved) == 0 {
+		qs.db.AddError(errors.New("must at least pass one approved in ApprovedNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "approved"}, approved))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) CreatedAtEq(createdAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) CreatedAtGt(createdAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) CreatedAtGte(createdAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) CreatedAtLt(createdAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) CreatedAtLte(createdAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) CreatedAtNe(createdAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) Delete() error {
+	return qs.db.Delete(DeviceApproval{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(DeviceApproval{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(DeviceApproval{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeletedAtEq(deletedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeletedAtGt(deletedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeletedAtGte(deletedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeletedAtIsNotNull() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeletedAtIsNull() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeletedAtLt(deletedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeletedAtLte(deletedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeletedAtNe(deletedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeviceIDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeviceIDEq(deviceID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeviceIDGt(deviceID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeviceIDGte(deviceID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeviceIDIn(deviceID ...ID) DeviceApprovalQuerySet {
+	if len(deviceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one deviceID in DeviceIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "device_id"}, deviceID))
+}
+
+// DeviceIDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeviceIDLt(deviceID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeviceIDLte(deviceID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeviceIDNe(deviceID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeviceIDNotIn(deviceID ...ID) DeviceApprovalQuerySet {
+	if len(deviceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one deviceID in DeviceIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "device_id"}, deviceID))
+}
+
+// DeviceIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeviceIsNotNull() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "device", Value: nil}))
+}
+
+// DeviceIsNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) DeviceIsNull() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "device", Value: nil}))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) GetUpdater() DeviceApprovalUpdater {
+	return NewDeviceApprovalUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) IDEq(ID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) IDGt(ID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) IDGte(ID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) IDIn(ID ...ID) DeviceApprovalQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) IDLt(ID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) IDLte(ID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) IDNe(ID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) IDNotIn(ID ...ID) DeviceApprovalQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) Limit(limit int) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// NetworkIDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) NetworkIDEq(networkID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) NetworkIDGt(networkID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) NetworkIDGte(networkID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) NetworkIDIn(networkID ...ID) DeviceApprovalQuerySet {
+	if len(networkID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "network_id"}, networkID))
+}
+
+// NetworkIDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) NetworkIDLt(networkID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) NetworkIDLte(networkID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) NetworkIDNe(networkID ID) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) NetworkIDNotIn(networkID ...ID) DeviceApprovalQuerySet {
+	if len(networkID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "network_id"}, networkID))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) Offset(offset int) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs DeviceApprovalQuerySet) One(ret *DeviceApproval) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByApproved is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderAscByApproved() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "approved"}}))
+}
+
+// OrderAscByApprovedByID is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderAscByApprovedByID() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "approved_by_id"}}))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderAscByCreatedAt() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderAscByDeletedAt() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
+}
+
+// OrderAscByDeviceID is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderAscByDeviceID() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "device_id"}}))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderAscByID() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
+}
+
+// OrderAscByNetworkID is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderAscByNetworkID() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "network_id"}}))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderAscByUpdatedAt() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
+}
+
+// OrderDescByApproved is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderDescByApproved() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "approved"}, Desc: true}))
+}
+
+// OrderDescByApprovedByID is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderDescByApprovedByID() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "approved_by_id"}, Desc: true}))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderDescByCreatedAt() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderDescByDeletedAt() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
+}
+
+// OrderDescByDeviceID is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderDescByDeviceID() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "device_id"}, Desc: true}))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderDescByID() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
+}
+
+// OrderDescByNetworkID is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderDescByNetworkID() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "network_id"}, Desc: true}))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) OrderDescByUpdatedAt() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
+}
+
+// PreloadDevice is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) PreloadDevice() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Preload("Device"))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) UpdatedAtEq(updatedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) UpdatedAtGt(updatedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) UpdatedAtGte(updatedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) UpdatedAtIsNotNull() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) UpdatedAtIsNull() DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) UpdatedAtLt(updatedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) UpdatedAtLte(updatedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceApprovalQuerySet) UpdatedAtNe(updatedAt time.Time) DeviceApprovalQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
+}
+
+// SetApproved is an autogenerated method
+// nolint: dupl
+func (u DeviceApprovalUpdater) SetApproved(approved bool) DeviceApprovalUpdater {
+	u.fields[string(DeviceApprovalDBSchema.Approved)] = approved
+	return u
+}
+
+// SetApprovedByID is an autogenerated method
+// nolint: dupl
+func (u DeviceApprovalUpdater) SetApprovedByID(approvedByID ID) DeviceApprovalUpdater {
+	u.fields[string(DeviceApprovalDBSchema.ApprovedByID)] = approvedByID
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u DeviceApprovalUpdater) SetCreatedAt(createdAt time.Time) DeviceApprovalUpdater {
+	u.fields[string(DeviceApprovalDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u DeviceApprovalUpdater) SetDeletedAt(deletedAt *time.Time) DeviceApprovalUpdater {
+	u.fields[string(DeviceApprovalDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetDeviceID is an autogenerated method
+// nolint: dupl
+func (u DeviceApprovalUpdater) SetDeviceID(deviceID ID) DeviceApprovalUpdater {
+	u.fields[string(DeviceApprovalDBSchema.DeviceID)] = deviceID
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u DeviceApprovalUpdater) SetID(ID ID) DeviceApprovalUpdater {
+	u.fields[string(DeviceApprovalDBSchema.ID)] = ID
+	return u
+}
+
+// SetNetworkID is an autogenerated method
+// nolint: dupl
+func (u DeviceApprovalUpdater) SetNetworkID(networkID ID) DeviceApprovalUpdater {
+	u.fields[string(DeviceApprovalDBSchema.NetworkID)] = networkID
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u DeviceApprovalUpdater) SetUpdatedAt(updatedAt *time.Time) DeviceApprovalUpdater {
+	u.fields[string(DeviceApprovalDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u DeviceApprovalUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u DeviceApprovalUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set DeviceApprovalQuerySet
+
+// ===== BEGIN of DeviceApproval modifiers
+
+// DeviceApprovalDBSchemaField describes database schema field. It requires for method 'Update'
+type DeviceApprovalDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f DeviceApprovalDBSchemaField) String() string {
+	return string(f)
+}
+
+// DeviceApprovalDBSchema stores db field names of DeviceApproval
+var DeviceApprovalDBSchema = struct {
+	ID           DeviceApprovalDBSchemaField
+	CreatedAt    DeviceApprovalDBSchemaField
+	UpdatedAt    DeviceApprovalDBSchemaField
+	DeletedAt    DeviceApprovalDBSchemaField
+	DeviceID     DeviceApprovalDBSchemaField
+	Device       DeviceApprovalDBSchemaField
+	NetworkID    DeviceApprovalDBSchemaField
+	Approved     DeviceApprovalDBSchemaField
+	ApprovedByID DeviceApprovalDBSchemaField
+}{
+
+	ID:           DeviceApprovalDBSchemaField("id"),
+	CreatedAt:    DeviceApprovalDBSchemaField("created_at"),
+	UpdatedAt:    DeviceApprovalDBSchemaField("updated_at"),
+	DeletedAt:    DeviceApprovalDBSchemaField("deleted_at"),
+	DeviceID:     DeviceApprovalDBSchemaField("device_id"),
+	Device:       DeviceApprovalDBSchemaField("device"),
+	NetworkID:    DeviceApprovalDBSchemaField("network_id"),
+	Approved:     DeviceApprovalDBSchemaField("approved"),
+	ApprovedByID: DeviceApprovalDBSchemaField("approved_by_id"),
+}
+
+// Update updates DeviceApproval fields by primary key
+// nolint: dupl
+func (o *DeviceApproval) Update(db *gorm.DB, fields ...DeviceApprovalDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":             o.ID,
+		"created_at":     o.CreatedAt,
+		"updated_at":     o.UpdatedAt,
+		"deleted_at":     o.DeletedAt,
+		"device_id":      o.DeviceID,
+		"device":         o.Device,
+		"network_id":     o.NetworkID,
+		"approved":       o.Approved,
+		"approved_by_id": o.ApprovedByID,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update DeviceApproval %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// DeviceApprovalUpdater is an DeviceApproval updates manager
+type DeviceApprovalUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewDeviceApprovalUpdater creates new DeviceApproval updater
+// nolint: dupl
+func NewDeviceApprovalUpdater(db *gorm.DB) DeviceApprovalUpdater {
+	return DeviceApprovalUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&DeviceApproval{}),
+	}
+}
+
+// ===== END of DeviceApproval modifiers
+
+// ===== BEGIN of query set DeviceRouteQuerySet
+
+// DeviceRouteQuerySet is an queryset type for DeviceRoute
+type DeviceRouteQuerySet struct {
+	db *gorm.DB
+}
+
+// NewDeviceRouteQuerySet constructs new DeviceRouteQuerySet
+func NewDeviceRouteQuerySet(db *gorm.DB) DeviceRouteQuerySet {
+	return DeviceRouteQuerySet{
+		db: db.Model(&DeviceRoute{}),
+	}
+}
+
+func (qs DeviceRouteQuerySet) w(db *gorm.DB) DeviceRouteQuerySet {
+	return NewDeviceRouteQuerySet(db)
+}
+
+func (qs DeviceRouteQuerySet) Preload(query string, args ...interface{}) DeviceRouteQuerySet {
+	return NewDeviceRouteQuerySet(qs.db.Preload(query, args...))
+}
+
+// Preload preload associations with given conditions
+//    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
+func (qs DeviceRouteQuerySet) Select(fields ...DeviceRouteDBSchemaField) DeviceRouteQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *DeviceRoute) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *DeviceRoute) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) All(ret *[]DeviceRoute) error {
+	return qs.db.Find(ret).Error
+}
+
+// ApprovedByIDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedByIDEq(approvedByID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedByIDGt(approvedByID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedByIDGte(approvedByID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedByIDIn(approvedByID ...ID) DeviceRouteQuerySet {
+	if len(approvedByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one approvedByID in ApprovedByIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "approved_by_id"}, approvedByID))
+}
+
+// ApprovedByIDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedByIDLt(approvedByID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedByIDLte(approvedByID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedByIDNe(approvedByID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "approved_by_id", Value: approvedByID}))
+}
+
+// ApprovedByIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedByIDNotIn(approvedByID ...ID) DeviceRouteQuerySet {
+	if len(approvedByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one approvedByID in ApprovedByIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "approved_by_id"}, approvedByID))
+}
+
+// ApprovedEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedEq(approved bool) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "approved", Value: approved}))
+}
+
+// ApprovedIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedIn(approved ...bool) DeviceRouteQuerySet {
+	if len(approved) == 0 {
+		qs.db.AddError(errors.New("must at least pass one approved in ApprovedIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "approved"}, approved))
+}
+
+// ApprovedNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedNe(approved bool) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "approved", Value: approved}))
+}
+
+// ApprovedNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) ApprovedNotIn(approved ...bool) DeviceRouteQuerySet {
+	if len(approved) == 0 {
+		qs.db.AddError(errors.New("must at least pass one approved in ApprovedNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "approved"}, approved))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) CreatedAtEq(createdAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) CreatedAtGt(createdAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) CreatedAtGte(createdAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) CreatedAtLt(createdAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) CreatedAtLte(createdAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) CreatedAtNe(createdAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) Delete() error {
+	return qs.db.Delete(DeviceRoute{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(DeviceRoute{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(DeviceRoute{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeletedAtEq(deletedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeletedAtGt(deletedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeletedAtGte(deletedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeletedAtIsNotNull() DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeletedAtIsNull() DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeletedAtLt(deletedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeletedAtLte(deletedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeletedAtNe(deletedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeviceIDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeviceIDEq(deviceID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeviceIDGt(deviceID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeviceIDGte(deviceID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeviceIDIn(deviceID ...ID) DeviceRouteQuerySet {
+	if len(deviceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one deviceID in DeviceIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "device_id"}, deviceID))
+}
+
+// DeviceIDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeviceIDLt(deviceID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeviceIDLte(deviceID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeviceIDNe(deviceID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "device_id", Value: deviceID}))
+}
+
+// DeviceIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeviceIDNotIn(deviceID ...ID) DeviceRouteQuerySet {
+	if len(deviceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one deviceID in DeviceIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "device_id"}, deviceID))
+}
+
+// DeviceIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeviceIsNotNull() DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "device", Value: nil}))
+}
+
+// DeviceIsNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) DeviceIsNull() DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "device", Value: nil}))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) GetUpdater() DeviceRouteUpdater {
+	return NewDeviceRouteUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) IDEq(ID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) IDGt(ID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) IDGte(ID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) IDIn(ID ...ID) DeviceRouteQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) IDLt(ID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) IDLte(ID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) IDNe(ID ID) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) IDNotIn(ID ...ID) DeviceRouteQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) Limit(limit int) DeviceRouteQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) Offset(offset int) DeviceRouteQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs DeviceRouteQuerySet) One(ret *DeviceRoute) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByApproved is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderAscByApproved() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "approved"}}))
+}
+
+// OrderAscByApprovedByID is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderAscByApprovedByID() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "approved_by_id"}}))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderAscByCreatedAt() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderAscByDeletedAt() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
+}
+
+// OrderAscByDeviceID is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderAscByDeviceID() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "device_id"}}))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderAscByID() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
+}
+
+// OrderAscByPrefix is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderAscByPrefix() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "prefix"}}))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderAscByUpdatedAt() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
+}
+
+// OrderDescByApproved is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderDescByApproved() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "approved"}, Desc: true}))
+}
+
+// OrderDescByApprovedByID is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderDescByApprovedByID() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "approved_by_id"}, Desc: true}))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderDescByCreatedAt() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderDescByDeletedAt() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
+}
+
+// OrderDescByDeviceID is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderDescByDeviceID() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "device_id"}, Desc: true}))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderDescByID() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
+}
+
+// OrderDescByPrefix is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderDescByPrefix() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "prefix"}, Desc: true}))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) OrderDescByUpdatedAt() DeviceRouteQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
+}
+
+// PrefixEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) PrefixEq(prefix string) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "prefix", Value: prefix}))
+}
+
+// PrefixGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) PrefixGt(prefix string) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "prefix", Value: prefix}))
+}
+
+// PrefixGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) PrefixGte(prefix string) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "prefix", Value: prefix}))
+}
+
+// PrefixIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) PrefixIn(prefix ...string) DeviceRouteQuerySet {
+	if len(prefix) == 0 {
+		qs.db.AddError(errors.New("must at least pass one prefix in PrefixIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "prefix"}, prefix))
+}
+
+// PrefixLike is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) PrefixLike(prefix string) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "prefix", Value: prefix}))
+}
+
+// PrefixLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) PrefixLt(prefix string) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "prefix", Value: prefix}))
+}
+
+// PrefixLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) PrefixLte(prefix string) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "prefix", Value: prefix}))
+}
+
+// PrefixNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) PrefixNe(prefix string) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "prefix", Value: prefix}))
+}
+
+// PrefixNotIn is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) PrefixNotIn(prefix ...string) DeviceRouteQuerySet {
+	if len(prefix) == 0 {
+		qs.db.AddError(errors.New("must at least pass one prefix in PrefixNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "prefix"}, prefix))
+}
+
+// PrefixNotlike is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) PrefixNotlike(prefix string) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "prefix", Value: prefix})))
+}
+
+// PreloadDevice is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) PreloadDevice() DeviceRouteQuerySet {
+	return qs.w(qs.db.Preload("Device"))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) UpdatedAtEq(updatedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) UpdatedAtGt(updatedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) UpdatedAtGte(updatedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) UpdatedAtIsNotNull() DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) UpdatedAtIsNull() DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) UpdatedAtLt(updatedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) UpdatedAtLte(updatedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DeviceRouteQuerySet) UpdatedAtNe(updatedAt time.Time) DeviceRouteQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
+}
+
+// SetApproved is an autogenerated method
+// nolint: dupl
+func (u DeviceRouteUpdater) SetApproved(approved bool) DeviceRouteUpdater {
+	u.fields[string(DeviceRouteDBSchema.Approved)] = approved
+	return u
+}
+
+// SetApprovedByID is an autogenerated method
+// nolint: dupl
+func (u DeviceRouteUpdater) SetApprovedByID(approvedByID ID) DeviceRouteUpdater {
+	u.fields[string(DeviceRouteDBSchema.ApprovedByID)] = approvedByID
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u DeviceRouteUpdater) SetCreatedAt(createdAt time.Time) DeviceRouteUpdater {
+	u.fields[string(DeviceRouteDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u DeviceRouteUpdater) SetDeletedAt(deletedAt *time.Time) DeviceRouteUpdater {
+	u.fields[string(DeviceRouteDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetDeviceID is an autogenerated method
+// nolint: dupl
+func (u DeviceRouteUpdater) SetDeviceID(deviceID ID) DeviceRouteUpdater {
+	u.fields[string(DeviceRouteDBSchema.DeviceID)] = deviceID
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u DeviceRouteUpdater) SetID(ID ID) DeviceRouteUpdater {
+	u.fields[string(DeviceRouteDBSchema.ID)] = ID
+	return u
+}
+
+// SetPrefix is an autogenerated method
+// nolint: dupl
+func (u DeviceRouteUpdater) SetPrefix(prefix string) DeviceRouteUpdater {
+	u.fields[string(DeviceRouteDBSchema.Prefix)] = prefix
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u DeviceRouteUpdater) SetUpdatedAt(updatedAt *time.Time) DeviceRouteUpdater {
+	u.fields[string(DeviceRouteDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u DeviceRouteUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u DeviceRouteUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set DeviceRouteQuerySet
+
+// ===== BEGIN of DeviceRoute modifiers
+
+// DeviceRouteDBSchemaField describes database schema field. It requires for method 'Update'
+type DeviceRouteDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f DeviceRouteDBSchemaField) String() string {
+	return string(f)
+}
+
+// DeviceRouteDBSchema stores db field names of DeviceRoute
+var DeviceRouteDBSchema = struct {
+	ID           DeviceRouteDBSchemaField
+	CreatedAt    DeviceRouteDBSchemaField
+	UpdatedAt    DeviceRouteDBSchemaField
+	DeletedAt    DeviceRouteDBSchemaField
+	DeviceID     DeviceRouteDBSchemaField
+	Device       DeviceRouteDBSchemaField
+	Prefix       DeviceRouteDBSchemaField
+	Approved     DeviceRouteDBSchemaField
+	ApprovedByID DeviceRouteDBSchemaField
+}{
+
+	ID:           DeviceRouteDBSchemaField("id"),
+	CreatedAt:    DeviceRouteDBSchemaField("created_at"),
+	UpdatedAt:    DeviceRouteDBSchemaField("updated_at"),
+	DeletedAt:    DeviceRouteDBSchemaField("deleted_at"),
+	DeviceID:     DeviceRouteDBSchemaField("device_id"),
+	Device:       DeviceRouteDBSchemaField("device"),
+	Prefix:       DeviceRouteDBSchemaField("prefix"),
+	Approved:     DeviceRouteDBSchemaField("approved"),
+	ApprovedByID: DeviceRouteDBSchemaField("approved_by_id"),
+}
+
+// Update updates DeviceRoute fields by primary key
+// nolint: dupl
+func (o *DeviceRoute) Update(db *gorm.DB, fields ...DeviceRouteDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":             o.ID,
+		"created_at":     o.CreatedAt,
+		"updated_at":     o.UpdatedAt,
+		"deleted_at":     o.DeletedAt,
+		"device_id":      o.DeviceID,
+		"device":         o.Device,
+		"prefix":         o.Prefix,
+		"approved":       o.Approved,
+		"approved_by_id": o.ApprovedByID,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update DeviceRoute %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// DeviceRouteUpdater is an DeviceRoute updates manager
+type DeviceRouteUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewDeviceRouteUpdater creates new DeviceRoute updater
+// nolint: dupl
+func NewDeviceRouteUpdater(db *gorm.DB) DeviceRouteUpdater {
+	return DeviceRouteUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&DeviceRoute{}),
+	}
+}
+
+// ===== END of DeviceRoute modifiers
+
+// ===== BEGIN of query set GithubUserQuerySet
+
+// GithubUserQuerySet is an queryset type for GithubUser
+type GithubUserQuerySet struct {
+	db *gorm.DB
+}
+
+// NewGithubUserQuerySet constructs new GithubUserQuerySet
+func NewGithubUserQuerySet(db *gorm.DB) GithubUserQuerySet {
+	return GithubUserQuerySet{
+		db: db.Model(&GithubUser{}),
+	}
+}
+
+func (qs GithubUserQuerySet) w(db *gorm.DB) GithubUserQuerySet {
+	return NewGithubUserQuerySet(db)
+}
+
+func (qs GithubUserQuerySet) Preload(query string, args ...interface{}) GithubUserQuerySet {
+	return NewGithubUserQuerySet(qs.db.Preload(query, args...))
+}
+
+// Preload preload associations with given conditions
+//    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
+func (qs GithubUserQuerySet) Select(fields ...GithubUserDBSchemaField) GithubUserQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *GithubUser) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *GithubUser) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) All(ret *[]GithubUser) error {
+	return qs.db.Find(ret).Error
+}
+
+// AvatarURLEq is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) AvatarURLEq(avatarURL string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "avatar_url", Value: avatarURL}))
+}
+
+// AvatarURLGt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) AvatarURLGt(avatarURL string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "avatar_url", Value: avatarURL}))
+}
+
+// AvatarURLGte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) AvatarURLGte(avatarURL string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "avatar_url", Value: avatarURL}))
+}
+
+// AvatarURLIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) AvatarURLIn(avatarURL ...string) GithubUserQuerySet {
+	if len(avatarURL) == 0 {
+		qs.db.AddError(errors.New("must at least pass one avatarURL in AvatarURLIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "avatar_url"}, avatarURL))
+}
+
+// AvatarURLLike is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) AvatarURLLike(avatarURL string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "avatar_url", Value: avatarURL}))
+}
+
+// AvatarURLLt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) AvatarURLLt(avatarURL string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "avatar_url", Value: avatarURL}))
+}
+
+// AvatarURLLte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) AvatarURLLte(avatarURL string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "avatar_url", Value: avatarURL}))
+}
+
+// AvatarURLNe is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) AvatarURLNe(avatarURL string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "avatar_url", Value: avatarURL}))
+}
+
+// AvatarURLNotIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) AvatarURLNotIn(avatarURL ...string) GithubUserQuerySet {
+	if len(avatarURL) == 0 {
+		qs.db.AddError(errors.New("must at least pass one avatarURL in AvatarURLNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "avatar_url"}, avatarURL))
+}
+
+// AvatarURLNotlike is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) AvatarURLNotlike(avatarURL string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "avatar_url", Value: avatarURL})))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) CreatedAtEq(createdAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) CreatedAtGt(createdAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) CreatedAtGte(createdAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) CreatedAtLt(createdAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) CreatedAtLte(createdAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) CreatedAtNe(createdAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) Delete() error {
+	return qs.db.Delete(GithubUser{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(GithubUser{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(GithubUser{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) DeletedAtEq(deletedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) DeletedAtGt(deletedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) DeletedAtGte(deletedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) DeletedAtIsNotNull() GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) DeletedAtIsNull() GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) DeletedAtLt(deletedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) DeletedAtLte(deletedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) DeletedAtNe(deletedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) GetUpdater() GithubUserUpdater {
+	return NewGithubUserUpdater(qs.db)
+}
+
+// GithubIDEq is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) GithubIDEq(githubID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "github_id", Value: githubID}))
+}
+
+// GithubIDGt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) GithubIDGt(githubID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "github_id", Value: githubID}))
+}
+
+// GithubIDGte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) GithubIDGte(githubID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "github_id", Value: githubID}))
+}
+
+// GithubIDIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) GithubIDIn(githubID ...ID) GithubUserQuerySet {
+	if len(githubID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one githubID in GithubIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "github_id"}, githubID))
+}
+
+// GithubIDLt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) GithubIDLt(githubID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "github_id", Value: githubID}))
+}
+
+// GithubIDLte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) GithubIDLte(githubID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "github_id", Value: githubID}))
+}
+
+// GithubIDNe is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) GithubIDNe(githubID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "github_id", Value: githubID}))
+}
+
+// GithubIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) GithubIDNotIn(githubID ...ID) GithubUserQuerySet {
+	if len(githubID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one githubID in GithubIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "github_id"}, githubID))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) IDEq(ID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) IDGt(ID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) IDGte(ID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) IDIn(ID ...ID) GithubUserQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) IDLt(ID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) IDLte(ID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) IDNe(ID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) IDNotIn(ID ...ID) GithubUserQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) Limit(limit int) GithubUserQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// LocationEq is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LocationEq(location string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "location", Value: location}))
+}
+
+// LocationGt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LocationGt(location string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "location", Value: location}))
+}
+
+// LocationGte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LocationGte(location string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "location", Value: location}))
+}
+
+// LocationIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LocationIn(location ...string) GithubUserQuerySet {
+	if len(location) == 0 {
+		qs.db.AddError(errors.New("must at least pass one location in LocationIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "location"}, location))
+}
+
+// LocationLike is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LocationLike(location string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "location", Value: location}))
+}
+
+// LocationLt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LocationLt(location string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "location", Value: location}))
+}
+
+// LocationLte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LocationLte(location string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "location", Value: location}))
+}
+
+// LocationNe is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LocationNe(location string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "location", Value: location}))
+}
+
+// LocationNotIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LocationNotIn(location ...string) GithubUserQuerySet {
+	if len(location) == 0 {
+		qs.db.AddError(errors.New("must at least pass one location in LocationNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "location"}, location))
+}
+
+// LocationNotlike is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LocationNotlike(location string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "location", Value: location})))
+}
+
+// LoginEq is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LoginEq(login string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "login", Value: login}))
+}
+
+// LoginGt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LoginGt(login string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "login", Value: login}))
+}
+
+// LoginGte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LoginGte(login string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "login", Value: login}))
+}
+
+// LoginIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LoginIn(login ...string) GithubUserQuerySet {
+	if len(login) == 0 {
+		qs.db.AddError(errors.New("must at least pass one login in LoginIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "login"}, login))
+}
+
+// LoginLike is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LoginLike(login string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "login", Value: login}))
+}
+
+// LoginLt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LoginLt(login string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "login", Value: login}))
+}
+
+// LoginLte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LoginLte(login string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "login", Value: login}))
+}
+
+// LoginNe is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LoginNe(login string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "login", Value: login}))
+}
+
+// LoginNotIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LoginNotIn(login ...string) GithubUserQuerySet {
+	if len(login) == 0 {
+		qs.db.AddError(errors.New("must at least pass one login in LoginNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "login"}, login))
+}
+
+// LoginNotlike is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) LoginNotlike(login string) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "login", Value: login})))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) Offset(offset int) GithubUserQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs GithubUserQuerySet) One(ret *GithubUser) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByAvatarURL is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderAscByAvatarURL() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "avatar_url"}}))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderAscByCreatedAt() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderAscByDeletedAt() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
+}
+
+// OrderAscByGithubID is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderAscByGithubID() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "github_id"}}))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderAscByID() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
+}
+
+// OrderAscByLocation is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderAscByLocation() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "location"}}))
+}
+
+// OrderAscByLogin is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderAscByLogin() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "login"}}))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderAscByUpdatedAt() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderAscByUserID() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}}))
+}
+
+// OrderDescByAvatarURL is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderDescByAvatarURL() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "avatar_url"}, Desc: true}))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderDescByCreatedAt() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderDescByDeletedAt() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
+}
+
+// OrderDescByGithubID is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderDescByGithubID() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "github_id"}, Desc: true}))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderDescByID() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
+}
+
+// OrderDescByLocation is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderDescByLocation() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "location"}, Desc: true}))
+}
+
+// OrderDescByLogin is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderDescByLogin() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "login"}, Desc: true}))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderDescByUpdatedAt() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) OrderDescByUserID() GithubUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}, Desc: true}))
+}
+
+// PreloadUser is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) PreloadUser() GithubUserQuerySet {
+	return qs.w(qs.db.Preload("User"))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UpdatedAtEq(updatedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UpdatedAtGt(updatedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UpdatedAtGte(updatedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UpdatedAtIsNotNull() GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UpdatedAtIsNull() GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UpdatedAtLt(updatedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UpdatedAtLte(updatedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UpdatedAtNe(updatedAt time.Time) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UserIDEq(userID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user_id", Value: userID}))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UserIDGt(userID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "user_id", Value: userID}))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UserIDGte(userID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "user_id", Value: userID}))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UserIDIn(userID ...ID) GithubUserQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UserIDLt(userID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "user_id", Value: userID}))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UserIDLte(userID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "user_id", Value: userID}))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UserIDNe(userID ID) GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user_id", Value: userID}))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UserIDNotIn(userID ...ID) GithubUserQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UserIsNotNull() GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user", Value: nil}))
+}
+
+// UserIsNull is an autogenerated method
+// nolint: dupl
+func (qs GithubUserQuerySet) UserIsNull() GithubUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user", Value: nil}))
+}
+
+// SetAvatarURL is an autogenerated method
+// nolint: dupl
+func (u GithubUserUpdater) SetAvatarURL(avatarURL string) GithubUserUpdater {
+	u.fields[string(GithubUserDBSchema.AvatarURL)] = avatarURL
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u GithubUserUpdater) SetCreatedAt(createdAt time.Time) GithubUserUpdater {
+	u.fields[string(GithubUserDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u GithubUserUpdater) SetDeletedAt(deletedAt *time.Time) GithubUserUpdater {
+	u.fields[string(GithubUserDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetGithubID is an autogenerated method
+// nolint: dupl
+func (u GithubUserUpdater) SetGithubID(githubID ID) GithubUserUpdater {
+	u.fields[string(GithubUserDBSchema.GithubID)] = githubID
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u GithubUserUpdater) SetID(ID ID) GithubUserUpdater {
+	u.fields[string(GithubUserDBSchema.ID)] = ID
+	return u
+}
+
+// SetLocation is an autogenerated method
+// nolint: dupl
+func (u GithubUserUpdater) SetLocation(location string) GithubUserUpdater {
+	u.fields[string(GithubUserDBSchema.Location)] = location
+	return u
+}
+
+// SetLogin is an autogenerated method
+// nolint: dupl
+func (u GithubUserUpdater) SetLogin(login string) GithubUserUpdater {
+	u.fields[string(GithubUserDBSchema.Login)] = login
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u GithubUserUpdater) SetUpdatedAt(updatedAt *time.Time) GithubUserUpdater {
+	u.fields[string(GithubUserDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u GithubUserUpdater) SetUserID(userID ID) GithubUserUpdater {
+	u.fields[string(GithubUserDBSchema.UserID)] = userID
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u GithubUserUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u GithubUserUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set GithubUserQuerySet
+
+// ===== BEGIN of GithubUser modifiers
+
+// GithubUserDBSchemaField describes database schema field. It requires for method 'Update'
+type GithubUserDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f GithubUserDBSchemaField) String() string {
+	return string(f)
+}
+
+// GithubUserDBSchema stores db field names of GithubUser
+var GithubUserDBSchema = struct {
+	ID        GithubUserDBSchemaField
+	CreatedAt GithubUserDBSchemaField
+	UpdatedAt GithubUserDBSchemaField
+	DeletedAt GithubUserDBSchemaField
+	UserID    GithubUserDBSchemaField
+	User      GithubUserDBSchemaField
+	GithubID  GithubUserDBSchemaField
+	Login     GithubUserDBSchemaField
+	AvatarURL GithubUserDBSchemaField
+	Location  GithubUserDBSchemaField
+}{
+
+	ID:        GithubUserDBSchemaField("id"),
+	CreatedAt: GithubUserDBSchemaField("created_at"),
+	UpdatedAt: GithubUserDBSchemaField("updated_at"),
+	DeletedAt: GithubUserDBSchemaField("deleted_at"),
+	UserID:    GithubUserDBSchemaField("user_id"),
+	User:      GithubUserDBSchemaField("user"),
+	GithubID:  GithubUserDBSchemaField("github_id"),
+	Login:     GithubUserDBSchemaField("login"),
+	AvatarURL: GithubUserDBSchemaField("avatar_url"),
+	Location:  GithubUserDBSchemaField("location"),
+}
+
+// Update updates GithubUser fields by primary key
+// nolint: dupl
+func (o *GithubUser) Update(db *gorm.DB, fields ...GithubUserDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"user_id":    o.UserID,
+		"user":       o.User,
+		"github_id":  o.GithubID,
+		"login":      o.Login,
+		"avatar_url": o.AvatarURL,
+		"location":   o.Location,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update GithubUser %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// GithubUserUpdater is an GithubUser updates manager
+type GithubUserUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewGithubUserUpdater creates new GithubUser updater
+// nolint: dupl
+func NewGithubUserUpdater(db *gorm.DB) GithubUserUpdater {
+	return GithubUserUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&GithubUser{}),
+	}
+}
+
+// ===== END of GithubUser modifiers
+
+// ===== BEGIN of query set InvitationQuerySet
+
+// InvitationQuerySet is an queryset type for Invitation
+type InvitationQuerySet struct {
+	db *gorm.DB
+}
+
+// NewInvitationQuerySet constructs new InvitationQuerySet
+func NewInvitationQuerySet(db *gorm.DB) InvitationQuerySet {
+	return InvitationQuerySet{
+		db: db.Model(&Invitation{}),
+	}
+}
+
+func (qs InvitationQuerySet) w(db *gorm.DB) InvitationQuerySet {
+	return NewInvitationQuerySet(db)
+}
+
+func (qs InvitationQuerySet) Preload(query string, args ...interface{}) InvitationQuerySet {
+	return NewInvitationQuerySet(qs.db.Preload(query, args...))
+}
+
+// Preload preload associations with given conditions
+//    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
+func (qs InvitationQuerySet) Select(fields ...InvitationDBSchemaField) InvitationQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Invitation) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Invitation) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) All(ret *[]Invitation) error {
+	return qs.db.Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) CreatedAtEq(createdAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) CreatedAtGt(createdAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) CreatedAtGte(createdAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) CreatedAtLt(createdAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) CreatedAtLte(createdAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) CreatedAtNe(createdAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) Delete() error {
+	return qs.db.Delete(Invitation{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(Invitation{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(Invitation{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) DeletedAtEq(deletedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) DeletedAtGt(deletedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) DeletedAtGte(deletedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) DeletedAtIsNotNull() InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) DeletedAtIsNull() InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) DeletedAtLt(deletedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) DeletedAtLte(deletedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) DeletedAtNe(deletedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) GetUpdater() InvitationUpdater {
+	return NewInvitationUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) IDEq(ID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) IDGt(ID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) IDGte(ID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) IDIn(ID ...ID) InvitationQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) IDLt(ID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) IDLte(ID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) IDNe(ID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) IDNotIn(ID ...ID) InvitationQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// InvitedByIDEq is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) InvitedByIDEq(invitedByID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "invited_by_id", Value: invitedByID}))
+}
+
+// InvitedByIDGt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) InvitedByIDGt(invitedByID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "invited_by_id", Value: invitedByID}))
+}
+
+// InvitedByIDGte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) InvitedByIDGte(invitedByID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "invited_by_id", Value: invitedByID}))
+}
+
+// InvitedByIDIn is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) InvitedByIDIn(invitedByID ...ID) InvitationQuerySet {
+	if len(invitedByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one invitedByID in InvitedByIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "invited_by_id"}, invitedByID))
+}
+
+// InvitedByIDLt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) InvitedByIDLt(invitedByID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "invited_by_id", Value: invitedByID}))
+}
+
+// InvitedByIDLte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) InvitedByIDLte(invitedByID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "invited_by_id", Value: invitedByID}))
+}
+
+// InvitedByIDNe is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) InvitedByIDNe(invitedByID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "invited_by_id", Value: invitedByID}))
+}
+
+// InvitedByIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) InvitedByIDNotIn(invitedByID ...ID) InvitationQuerySet {
+	if len(invitedByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one invitedByID in InvitedByIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "invited_by_id"}, invitedByID))
+}
+
+// InvitedByIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) InvitedByIsNotNull() InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "invited_by", Value: nil}))
+}
+
+// InvitedByIsNull is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) InvitedByIsNull() InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "invited_by", Value: nil}))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) Limit(limit int) InvitationQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// NetworkIDEq is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) NetworkIDEq(networkID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDGt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) NetworkIDGt(networkID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDGte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) NetworkIDGte(networkID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDIn is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) NetworkIDIn(networkID ...ID) InvitationQuerySet {
+	if len(networkID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "network_id"}, networkID))
+}
+
+// NetworkIDLt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) NetworkIDLt(networkID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDLte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) NetworkIDLte(networkID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDNe is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) NetworkIDNe(networkID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) NetworkIDNotIn(networkID ...ID) InvitationQuerySet {
+	if len(networkID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "network_id"}, networkID))
+}
+
+// NetworkIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) NetworkIsNotNull() InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "network", Value: nil}))
+}
+
+// NetworkIsNull is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) NetworkIsNull() InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "network", Value: nil}))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) Offset(offset int) InvitationQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs InvitationQuerySet) One(ret *Invitation) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderAscByCreatedAt() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderAscByDeletedAt() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderAscByID() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
+}
+
+// OrderAscByInvitedByID is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderAscByInvitedByID() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "invited_by_id"}}))
+}
+
+// OrderAscByNetworkID is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderAscByNetworkID() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "network_id"}}))
+}
+
+// OrderAscByRole is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderAscByRole() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "role"}}))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderAscByUpdatedAt() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderAscByUserID() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}}))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderDescByCreatedAt() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderDescByDeletedAt() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderDescByID() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
+}
+
+// OrderDescByInvitedByID is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderDescByInvitedByID() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "invited_by_id"}, Desc: true}))
+}
+
+// OrderDescByNetworkID is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderDescByNetworkID() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "network_id"}, Desc: true}))
+}
+
+// OrderDescByRole is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderDescByRole() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "role"}, Desc: true}))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderDescByUpdatedAt() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) OrderDescByUserID() InvitationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}, Desc: true}))
+}
+
+// PreloadInvitedBy is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) PreloadInvitedBy() InvitationQuerySet {
+	return qs.w(qs.db.Preload("InvitedBy"))
+}
+
+// PreloadNetwork is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) PreloadNetwork() InvitationQuerySet {
+	return qs.w(qs.db.Preload("Network"))
+}
+
+// PreloadUser is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) PreloadUser() InvitationQuerySet {
+	return qs.w(qs.db.Preload("User"))
+}
+
+// RoleEq is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) RoleEq(role RoleType) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "role", Value: role}))
+}
+
+// RoleGt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) RoleGt(role RoleType) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "role", Value: role}))
+}
+
+// RoleGte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) RoleGte(role RoleType) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "role", Value: role}))
+}
+
+// RoleIn is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) RoleIn(role ...RoleType) InvitationQuerySet {
+	if len(role) == 0 {
+		qs.db.AddError(errors.New("must at least pass one role in RoleIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "role"}, role))
+}
+
+// RoleLike is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) RoleLike(role RoleType) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "role", Value: role}))
+}
+
+// RoleLt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) RoleLt(role RoleType) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "role", Value: role}))
+}
+
+// RoleLte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) RoleLte(role RoleType) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "role", Value: role}))
+}
+
+// RoleNe is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) RoleNe(role RoleType) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "role", Value: role}))
+}
+
+// RoleNotIn is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) RoleNotIn(role ...RoleType) InvitationQuerySet {
+	if len(role) == 0 {
+		qs.db.AddError(errors.New("must at least pass one role in RoleNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "role"}, role))
+}
+
+// RoleNotlike is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) RoleNotlike(role RoleType) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "role", Value: role})))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UpdatedAtEq(updatedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UpdatedAtGt(updatedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UpdatedAtGte(updatedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UpdatedAtIsNotNull() InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UpdatedAtIsNull() InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UpdatedAtLt(updatedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UpdatedAtLte(updatedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UpdatedAtNe(updatedAt time.Time) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UserIDEq(userID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user_id", Value: userID}))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UserIDGt(userID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "user_id", Value: userID}))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UserIDGte(userID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "user_id", Value: userID}))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UserIDIn(userID ...ID) InvitationQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UserIDLt(userID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "user_id", Value: userID}))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UserIDLte(userID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "user_id", Value: userID}))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UserIDNe(userID ID) InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user_id", Value: userID}))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UserIDNotIn(userID ...ID) InvitationQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UserIsNotNull() InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user", Value: nil}))
+}
+
+// UserIsNull is an autogenerated method
+// nolint: dupl
+func (qs InvitationQuerySet) UserIsNull() InvitationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user", Value: nil}))
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u InvitationUpdater) SetCreatedAt(createdAt time.Time) InvitationUpdater {
+	u.fields[string(InvitationDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u InvitationUpdater) SetDeletedAt(deletedAt *time.Time) InvitationUpdater {
+	u.fields[string(InvitationDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u InvitationUpdater) SetID(ID ID) InvitationUpdater {
+	u.fields[string(InvitationDBSchema.ID)] = ID
+	return u
+}
+
+// SetInvitedByID is an autogenerated method
+// nolint: dupl
+func (u InvitationUpdater) SetInvitedByID(invitedByID ID) InvitationUpdater {
+	u.fields[string(InvitationDBSchema.InvitedByID)] = invitedByID
+	return u
+}
+
+// SetNetworkID is an autogenerated method
+// nolint: dupl
+func (u InvitationUpdater) SetNetworkID(networkID ID) InvitationUpdater {
+	u.fields[string(InvitationDBSchema.NetworkID)] = networkID
+	return u
+}
+
+// SetRole is an autogenerated method
+// nolint: dupl
+func (u InvitationUpdater) SetRole(role RoleType) InvitationUpdater {
+	u.fields[string(InvitationDBSchema.Role)] = role
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u InvitationUpdater) SetUpdatedAt(updatedAt *time.Time) InvitationUpdater {
+	u.fields[string(InvitationDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u InvitationUpdater) SetUserID(userID ID) InvitationUpdater {
+	u.fields[string(InvitationDBSchema.UserID)] = userID
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u InvitationUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u InvitationUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set InvitationQuerySet
+
+// ===== BEGIN of Invitation modifiers
+
+// InvitationDBSchemaField describes database schema field. It requires for method 'Update'
+type InvitationDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f InvitationDBSchemaField) String() string {
+	return string(f)
+}
+
+// InvitationDBSchema stores db field names of Invitation
+var InvitationDBSchema = struct {
+	ID          InvitationDBSchemaField
+	CreatedAt   InvitationDBSchemaField
+	UpdatedAt   InvitationDBSchemaField
+	DeletedAt   InvitationDBSchemaField
+	NetworkID   InvitationDBSchemaField
+	Network     InvitationDBSchemaField
+	InvitedByID InvitationDBSchemaField
+	InvitedBy   InvitationDBSchemaField
+	UserID      InvitationDBSchemaField
+	User        InvitationDBSchemaField
+	Role        InvitationDBSchemaField
+}{
+
+	ID:          InvitationDBSchemaField("id"),
+	CreatedAt:   InvitationDBSchemaField("created_at"),
+	UpdatedAt:   InvitationDBSchemaField("updated_at"),
+	DeletedAt:   InvitationDBSchemaField("deleted_at"),
+	NetworkID:   InvitationDBSchemaField("network_id"),
+	Network:     InvitationDBSchemaField("network"),
+	InvitedByID: InvitationDBSchemaField("invited_by_id"),
+	InvitedBy:   InvitationDBSchemaField("invited_by"),
+	UserID:      InvitationDBSchemaField("user_id"),
+	User:        InvitationDBSchemaField("user"),
+	Role:        InvitationDBSchemaField("role"),
+}
+
+// Update updates Invitation fields by primary key
+// nolint: dupl
+func (o *Invitation) Update(db *gorm.DB, fields ...InvitationDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":            o.ID,
+		"created_at":    o.CreatedAt,
+		"updated_at":    o.UpdatedAt,
+		"deleted_at":    o.DeletedAt,
+		"network_id":    o.NetworkID,
+		"network":       o.Network,
+		"invited_by_id": o.InvitedByID,
+		"invited_by":    o.InvitedBy,
+		"user_id":       o.UserID,
+		"user":          o.User,
+		"role":          o.Role,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Invitation %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// InvitationUpdater is an Invitation updates manager
+type InvitationUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewInvitationUpdater creates new Invitation updater
+// nolint: dupl
+func NewInvitationUpdater(db *gorm.DB) InvitationUpdater {
+	return InvitationUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Invitation{}),
+	}
+}
+
+// ===== END of Invitation modifiers
+
+// ===== BEGIN of query set NetworkQuerySet
+
+// NetworkQuerySet is an queryset type for Network
+type NetworkQuerySet struct {
+	db *gorm.DB
+}
+
+// NewNetworkQuerySet constructs new NetworkQuerySet
+func NewNetworkQuerySet(db *gorm.DB) NetworkQuerySet {
+	return NetworkQuerySet{
+		db: db.Model(&Network{}),
+	}
+}
+
+func (qs NetworkQuerySet) w(db *gorm.DB) NetworkQuerySet {
+	return NewNetworkQuerySet(db)
+}
+
+func (qs NetworkQuerySet) Preload(query string, args ...interface{}) NetworkQuerySet {
+	return NewNetworkQuerySet(qs.db.Preload(query, args...))
+}
+
+// Preload preload associations with given conditions
+//    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
+func (qs NetworkQuerySet) Select(fields ...NetworkDBSchemaField) NetworkQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Network) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Network) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) All(ret *[]Network) error {
+	return qs.db.Find(ret).Error
+}
+
+// BroadcastEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) BroadcastEq(broadcast bool) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "broadcast", Value: broadcast}))
+}
+
+// BroadcastIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) BroadcastIn(broadcast ...bool) NetworkQuerySet {
+	if len(broadcast) == 0 {
+		qs.db.AddError(errors.New("must at least pass one broadcast in BroadcastIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "broadcast"}, broadcast))
+}
+
+// BroadcastNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) BroadcastNe(broadcast bool) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "broadcast", Value: broadcast}))
+}
+
+// BroadcastNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) BroadcastNotIn(broadcast ...bool) NetworkQuerySet {
+	if len(broadcast) == 0 {
+		qs.db.AddError(errors.New("must at least pass one broadcast in BroadcastNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "broadcast"}, broadcast))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedAtEq(createdAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedAtGt(createdAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedAtGte(createdAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedAtLt(createdAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedAtLte(createdAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedAtNe(createdAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedByIDEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedByIDEq(createdByID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedByIDGt(createdByID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedByIDGte(createdByID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedByIDIn(createdByID ...ID) NetworkQuerySet {
+	if len(createdByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one createdByID in CreatedByIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "created_by_id"}, createdByID))
+}
+
+// CreatedByIDLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedByIDLt(createdByID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedByIDLte(createdByID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedByIDNe(createdByID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_by_id", Value: createdByID}))
+}
+
+// CreatedByIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedByIDNotIn(createdByID ...ID) NetworkQuerySet {
+	if len(createdByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one createdByID in CreatedByIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "created_by_id"}, createdByID))
+}
+
+// CreatedByIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedByIsNotNull() NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_by", Value: nil}))
+}
+
+// CreatedByIsNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) CreatedByIsNull() NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_by", Value: nil}))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) Delete() error {
+	return qs.db.Delete(Network{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(Network{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(Network{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DeletedAtEq(deletedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DeletedAtGt(deletedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DeletedAtGte(deletedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DeletedAtIsNotNull() NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DeletedAtIsNull() NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DeletedAtLt(deletedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DeletedAtLte(deletedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DeletedAtNe(deletedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DescriptionEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DescriptionEq(description string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "description", Value: description}))
+}
+
+// DescriptionGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DescriptionGt(description string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "description", Value: description}))
+}
+
+// DescriptionGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DescriptionGte(description string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "description", Value: description}))
+}
+
+// DescriptionIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DescriptionIn(description ...string) NetworkQuerySet {
+	if len(description) == 0 {
+		qs.db.AddError(errors.New("must at least pass one description in DescriptionIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "description"}, description))
+}
+
+// DescriptionLike is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DescriptionLike(description string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "description", Value: description}))
+}
+
+// DescriptionLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DescriptionLt(description string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "description", Value: description}))
+}
+
+// DescriptionLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DescriptionLte(description string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "description", Value: description}))
+}
+
+// DescriptionNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DescriptionNe(description string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "description", Value: description}))
+}
+
+// DescriptionNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DescriptionNotIn(description ...string) NetworkQuerySet {
+	if len(description) == 0 {
+		qs.db.AddError(errors.New("must at least pass one description in DescriptionNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "description"}, description))
+}
+
+// DescriptionNotlike is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) DescriptionNotlike(description string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "description", Value: description})))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) GetUpdater() NetworkUpdater {
+	return NewNetworkUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) IDEq(ID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) IDGt(ID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) IDGte(ID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) IDIn(ID ...ID) NetworkQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) IDLt(ID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) IDLte(ID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) IDNe(ID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) IDNotIn(ID ...ID) NetworkQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) Limit(limit int) NetworkQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// NameEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) NameEq(name string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "name", Value: name}))
+}
+
+// NameGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) NameGt(name string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "name", Value: name}))
+}
+
+// NameGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) NameGte(name string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "name", Value: name}))
+}
+
+// NameIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) NameIn(name ...string) NetworkQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "name"}, name))
+}
+
+// NameLike is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) NameLike(name string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "name", Value: name}))
+}
+
+// NameLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) NameLt(name string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "name", Value: name}))
+}
+
+// NameLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) NameLte(name string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "name", Value: name}))
+}
+
+// NameNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) NameNe(name string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "name", Value: name}))
+}
+
+// NameNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) NameNotIn(name ...string) NetworkQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "name"}, name))
+}
+
+// NameNotlike is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) NameNotlike(name string) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "name", Value: name})))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) Offset(offset int) NetworkQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs NetworkQuerySet) One(ret *Network) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByBroadcast is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderAscByBroadcast() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "broadcast"}}))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderAscByCreatedAt() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
+}
+
+// OrderAscByCreatedByID is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderAscByCreatedByID() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_by_id"}}))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderAscByDeletedAt() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
+}
+
+// OrderAscByDescription is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderAscByDescription() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "description"}}))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderAscByID() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
+}
+
+// OrderAscByName is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderAscByName() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "name"}}))
+}
+
+// OrderAscByOrganizationID is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderAscByOrganizationID() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}}))
+}
+
+// OrderAscByRequireApproval is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderAscByRequireApproval() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "require_approval"}}))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderAscByUpdatedAt() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
+}
+
+// OrderDescByBroadcast is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderDescByBroadcast() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "broadcast"}, Desc: true}))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderDescByCreatedAt() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
+}
+
+// OrderDescByCreatedByID is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderDescByCreatedByID() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_by_id"}, Desc: true}))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderDescByDeletedAt() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
+}
+
+// OrderDescByDescription is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderDescByDescription() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "description"}, Desc: true}))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderDescByID() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
+}
+
+// OrderDescByName is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderDescByName() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "name"}, Desc: true}))
+}
+
+// OrderDescByOrganizationID is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderDescByOrganizationID() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}, Desc: true}))
+}
+
+// OrderDescByRequireApproval is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderDescByRequireApproval() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "require_approval"}, Desc: true}))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrderDescByUpdatedAt() NetworkQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
+}
+
+// OrganizationIDEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrganizationIDEq(organizationID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrganizationIDGt(organizationID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrganizationIDGte(organizationID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrganizationIDIn(organizationID ...ID) NetworkQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "organization_id"}, organizationID))
+}
+
+// OrganizationIDLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrganizationIDLt(organizationID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrganizationIDLte(organizationID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrganizationIDNe(organizationID ID) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "organization_id", Value: organizationID}))
+}
+
+// OrganizationIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) OrganizationIDNotIn(organizationID ...ID) NetworkQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "organization_id"}, organizationID))
+}
+
+// PreloadCreatedBy is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) PreloadCreatedBy() NetworkQuerySet {
+	return qs.w(qs.db.Preload("CreatedBy"))
+}
+
+// RequireApprovalEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) RequireApprovalEq(requireApproval bool) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "require_approval", Value: requireApproval}))
+}
+
+// RequireApprovalIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) RequireApprovalIn(requireApproval ...bool) NetworkQuerySet {
+	if len(requireApproval) == 0 {
+		qs.db.AddError(errors.New("must at least pass one requireApproval in RequireApprovalIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "require_approval"}, requireApproval))
+}
+
+// RequireApprovalNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) RequireApprovalNe(requireApproval bool) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "require_approval", Value: requireApproval}))
+}
+
+// RequireApprovalNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) RequireApprovalNotIn(requireApproval ...bool) NetworkQuerySet {
+	if len(requireApproval) == 0 {
+		qs.db.AddError(errors.New("must at least pass one requireApproval in RequireApprovalNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "require_approval"}, requireApproval))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) UpdatedAtEq(updatedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) UpdatedAtGt(updatedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) UpdatedAtGte(updatedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) UpdatedAtIsNotNull() NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) UpdatedAtIsNull() NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) UpdatedAtLt(updatedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) UpdatedAtLte(updatedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkQuerySet) UpdatedAtNe(updatedAt time.Time) NetworkQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
+}
+
+// SetBroadcast is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) SetBroadcast(broadcast bool) NetworkUpdater {
+	u.fields[string(NetworkDBSchema.Broadcast)] = broadcast
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) SetCreatedAt(createdAt time.Time) NetworkUpdater {
+	u.fields[string(NetworkDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetCreatedByID is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) SetCreatedByID(createdByID ID) NetworkUpdater {
+	u.fields[string(NetworkDBSchema.CreatedByID)] = createdByID
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) SetDeletedAt(deletedAt *time.Time) NetworkUpdater {
+	u.fields[string(NetworkDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetDescription is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) SetDescription(description string) NetworkUpdater {
+	u.fields[string(NetworkDBSchema.Description)] = description
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) SetID(ID ID) NetworkUpdater {
+	u.fields[string(NetworkDBSchema.ID)] = ID
+	return u
+}
+
+// SetName is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) SetName(name string) NetworkUpdater {
+	u.fields[string(NetworkDBSchema.Name)] = name
+	return u
+}
+
+// SetOrganizationID is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) SetOrganizationID(organizationID ID) NetworkUpdater {
+	u.fields[string(NetworkDBSchema.OrganizationID)] = organizationID
+	return u
+}
+
+// SetRequireApproval is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) SetRequireApproval(requireApproval bool) NetworkUpdater {
+	u.fields[string(NetworkDBSchema.RequireApproval)] = requireApproval
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) SetUpdatedAt(updatedAt *time.Time) NetworkUpdater {
+	u.fields[string(NetworkDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u NetworkUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set NetworkQuerySet
+
+// ===== BEGIN of Network modifiers
+
+// NetworkDBSchemaField describes database schema field. It requires for method 'Update'
+type NetworkDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f NetworkDBSchemaField) String() string {
+	return string(f)
+}
+
+// NetworkDBSchema stores db field names of Network
+var NetworkDBSchema = struct {
+	ID              NetworkDBSchemaField
+	CreatedAt       NetworkDBSchemaField
+	UpdatedAt       NetworkDBSchemaField
+	DeletedAt       NetworkDBSchemaField
+	CreatedByID     NetworkDBSchemaField
+	CreatedBy       NetworkDBSchemaField
+	Name            NetworkDBSchemaField
+	Description     NetworkDBSchemaField
+	Broadcast       NetworkDBSchemaField
+	OrganizationID  NetworkDBSchemaField
+	RequireApproval NetworkDBSchemaField
+}{
+
+	ID:              NetworkDBSchemaField("id"),
+	CreatedAt:       NetworkDBSchemaField("created_at"),
+	UpdatedAt:       NetworkDBSchemaField("updated_at"),
+	DeletedAt:       NetworkDBSchemaField("deleted_at"),
+	CreatedByID:     NetworkDBSchemaField("created_by_id"),
+	CreatedBy:       NetworkDBSchemaField("created_by"),
+	Name:            NetworkDBSchemaField("name"),
+	Description:     NetworkDBSchemaField("description"),
+	Broadcast:       NetworkDBSchemaField("broadcast"),
+	OrganizationID:  NetworkDBSchemaField("organization_id"),
+	RequireApproval: NetworkDBSchemaField("require_approval"),
+}
+
+// Update updates Network fields by primary key
+// nolint: dupl
+func (o *Network) Update(db *gorm.DB, fields ...NetworkDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":               o.ID,
+		"created_at":       o.CreatedAt,
+		"updated_at":       o.UpdatedAt,
+		"deleted_at":       o.DeletedAt,
+		"created_by_id":    o.CreatedByID,
+		"created_by":       o.CreatedBy,
+		"name":             o.Name,
+		"description":      o.Description,
+		"broadcast":        o.Broadcast,
+		"organization_id":  o.OrganizationID,
+		"require_approval": o.RequireApproval,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Network %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// NetworkUpdater is an Network updates manager
+type NetworkUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewNetworkUpdater creates new Network updater
+// nolint: dupl
+func NewNetworkUpdater(db *gorm.DB) NetworkUpdater {
+	return NetworkUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Network{}),
+	}
+}
+
+// ===== END of Network modifiers
+
+// ===== BEGIN of query set NetworkUserQuerySet
+
+// NetworkUserQuerySet is an queryset type for NetworkUser
+type NetworkUserQuerySet struct {
+	db *gorm.DB
+}
+
+// NewNetworkUserQuerySet constructs new NetworkUserQuerySet
+func NewNetworkUserQuerySet(db *gorm.DB) NetworkUserQuerySet {
+	return NetworkUserQuerySet{
+		db: db.Model(&NetworkUser{}),
+	}
+}
+
+func (qs NetworkUserQuerySet) w(db *gorm.DB) NetworkUserQuerySet {
+	return NewNetworkUserQuerySet(db)
+}
+
+func (qs NetworkUserQuerySet) Preload(query string, args ...interface{}) NetworkUserQuerySet {
+	return NewNetworkUserQuerySet(qs.db.Preload(query, args...))
+}
+
+// Preload preload associations with given conditions
+//    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
+func (qs NetworkUserQuerySet) Select(fields ...NetworkUserDBSchemaField) NetworkUserQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *NetworkUser) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *NetworkUser) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) All(ret *[]NetworkUser) error {
+	return qs.db.Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) CreatedAtEq(createdAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) CreatedAtGt(createdAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) CreatedAtGte(createdAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) CreatedAtLt(createdAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) CreatedAtLte(createdAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) CreatedAtNe(createdAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) Delete() error {
+	return qs.db.Delete(NetworkUser{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(NetworkUser{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(NetworkUser{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) DeletedAtEq(deletedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) DeletedAtGt(deletedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) DeletedAtGte(deletedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) DeletedAtIsNotNull() NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) DeletedAtIsNull() NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) DeletedAtLt(deletedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) DeletedAtLte(deletedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) DeletedAtNe(deletedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) GetUpdater() NetworkUserUpdater {
+	return NewNetworkUserUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) IDEq(ID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) IDGt(ID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) IDGte(ID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) IDIn(ID ...ID) NetworkUserQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) IDLt(ID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) IDLte(ID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) IDNe(ID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) IDNotIn(ID ...ID) NetworkUserQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) Limit(limit int) NetworkUserQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// NetworkIDEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) NetworkIDEq(networkID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) NetworkIDGt(networkID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) NetworkIDGte(networkID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) NetworkIDIn(networkID ...ID) NetworkUserQuerySet {
+	if len(networkID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "network_id"}, networkID))
+}
+
+// NetworkIDLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) NetworkIDLt(networkID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) NetworkIDLte(networkID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) NetworkIDNe(networkID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "network_id", Value: networkID}))
+}
+
+// NetworkIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) NetworkIDNotIn(networkID ...ID) NetworkUserQuerySet {
+	if len(networkID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "network_id"}, networkID))
+}
+
+// NetworkIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) NetworkIsNotNull() NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "network", Value: nil}))
+}
+
+// NetworkIsNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) NetworkIsNull() NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "network", Value: nil}))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) Offset(offset int) NetworkUserQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs NetworkUserQuerySet) One(ret *NetworkUser) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderAscByCreatedAt() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderAscByDeletedAt() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderAscByID() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
+}
+
+// OrderAscByNetworkID is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderAscByNetworkID() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "network_id"}}))
+}
+
+// OrderAscByOrigin is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderAscByOrigin() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "origin"}}))
+}
+
+// OrderAscByRole is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderAscByRole() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "role"}}))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderAscByUpdatedAt() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderAscByUserID() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}}))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderDescByCreatedAt() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderDescByDeletedAt() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderDescByID() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
+}
+
+// OrderDescByNetworkID is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderDescByNetworkID() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "network_id"}, Desc: true}))
+}
+
+// OrderDescByOrigin is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderDescByOrigin() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "origin"}, Desc: true}))
+}
+
+// OrderDescByRole is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderDescByRole() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "role"}, Desc: true}))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderDescByUpdatedAt() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OrderDescByUserID() NetworkUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}, Desc: true}))
+}
+
+// OriginEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OriginEq(origin string) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "origin", Value: origin}))
+}
+
+// OriginGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OriginGt(origin string) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "origin", Value: origin}))
+}
+
+// OriginGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OriginGte(origin string) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "origin", Value: origin}))
+}
+
+// OriginIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OriginIn(origin ...string) NetworkUserQuerySet {
+	if len(origin) == 0 {
+		qs.db.AddError(errors.New("must at least pass one origin in OriginIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "origin"}, origin))
+}
+
+// OriginLike is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OriginLike(origin string) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "origin", Value: origin}))
+}
+
+// OriginLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OriginLt(origin string) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "origin", Value: origin}))
+}
+
+// OriginLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OriginLte(origin string) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "origin", Value: origin}))
+}
+
+// OriginNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OriginNe(origin string) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "origin", Value: origin}))
+}
+
+// OriginNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OriginNotIn(origin ...string) NetworkUserQuerySet {
+	if len(origin) == 0 {
+		qs.db.AddError(errors.New("must at least pass one origin in OriginNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "origin"}, origin))
+}
+
+// OriginNotlike is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) OriginNotlike(origin string) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "origin", Value: origin})))
+}
+
+// PreloadNetwork is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) PreloadNetwork() NetworkUserQuerySet {
+	return qs.w(qs.db.Preload("Network"))
+}
+
+// PreloadUser is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) PreloadUser() NetworkUserQuerySet {
+	return qs.w(qs.db.Preload("User"))
+}
+
+// RoleEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) RoleEq(role RoleType) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "role", Value: role}))
+}
+
+// RoleGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) RoleGt(role RoleType) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "role", Value: role}))
+}
+
+// RoleGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) RoleGte(role RoleType) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "role", Value: role}))
+}
+
+// RoleIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) RoleIn(role ...RoleType) NetworkUserQuerySet {
+	if len(role) == 0 {
+		qs.db.AddError(errors.New("must at least pass one role in RoleIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "role"}, role))
+}
+
+// RoleLike is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) RoleLike(role RoleType) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "role", Value: role}))
+}
+
+// RoleLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) RoleLt(role RoleType) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "role", Value: role}))
+}
+
+// RoleLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) RoleLte(role RoleType) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "role", Value: role}))
+}
+
+// RoleNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) RoleNe(role RoleType) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "role", Value: role}))
+}
+
+// RoleNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) RoleNotIn(role ...RoleType) NetworkUserQuerySet {
+	if len(role) == 0 {
+		qs.db.AddError(errors.New("must at least pass one role in RoleNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "role"}, role))
+}
+
+// RoleNotlike is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) RoleNotlike(role RoleType) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "role", Value: role})))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UpdatedAtEq(updatedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UpdatedAtGt(updatedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UpdatedAtGte(updatedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UpdatedAtIsNotNull() NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UpdatedAtIsNull() NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UpdatedAtLt(updatedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UpdatedAtLte(updatedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UpdatedAtNe(updatedAt time.Time) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UserIDEq(userID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user_id", Value: userID}))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UserIDGt(userID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "user_id", Value: userID}))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UserIDGte(userID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "user_id", Value: userID}))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UserIDIn(userID ...ID) NetworkUserQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UserIDLt(userID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "user_id", Value: userID}))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UserIDLte(userID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "user_id", Value: userID}))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UserIDNe(userID ID) NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user_id", Value: userID}))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UserIDNotIn(userID ...ID) NetworkUserQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UserIsNotNull() NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user", Value: nil}))
+}
+
+// UserIsNull is an autogenerated method
+// nolint: dupl
+func (qs NetworkUserQuerySet) UserIsNull() NetworkUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user", Value: nil}))
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u NetworkUserUpdater) SetCreatedAt(createdAt time.Time) NetworkUserUpdater {
+	u.fields[string(NetworkUserDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u NetworkUserUpdater) SetDeletedAt(deletedAt *time.Time) NetworkUserUpdater {
+	u.fields[string(NetworkUserDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u NetworkUserUpdater) SetID(ID ID) NetworkUserUpdater {
+	u.fields[string(NetworkUserDBSchema.ID)] = ID
+	return u
+}
+
+// SetNetworkID is an autogenerated method
+// nolint: dupl
+func (u NetworkUserUpdater) SetNetworkID(networkID ID) NetworkUserUpdater {
+	u.fields[string(NetworkUserDBSchema.NetworkID)] = networkID
+	return u
+}
+
+// SetOrigin is an autogenerated method
+// nolint: dupl
+func (u NetworkUserUpdater) SetOrigin(origin string) NetworkUserUpdater {
+	u.fields[string(NetworkUserDBSchema.Origin)] = origin
+	return u
+}
+
+// SetRole is an autogenerated method
+// nolint: dupl
+func (u NetworkUserUpdater) SetRole(role RoleType) NetworkUserUpdater {
+	u.fields[string(NetworkUserDBSchema.Role)] = role
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u NetworkUserUpdater) SetUpdatedAt(updatedAt *time.Time) NetworkUserUpdater {
+	u.fields[string(NetworkUserDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u NetworkUserUpdater) SetUserID(userID ID) NetworkUserUpdater {
+	u.fields[string(NetworkUserDBSchema.UserID)] = userID
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u NetworkUserUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u NetworkUserUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set NetworkUserQuerySet
+
+// ===== BEGIN of NetworkUser modifiers
+
+// NetworkUserDBSchemaField describes database schema field. It requires for method 'Update'
+type NetworkUserDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f NetworkUserDBSchemaField) String() string {
+	return string(f)
+}
+
+// NetworkUserDBSchema stores db field names of NetworkUser
+var NetworkUserDBSchema = struct {
+	ID        NetworkUserDBSchemaField
+	CreatedAt NetworkUserDBSchemaField
+	UpdatedAt NetworkUserDBSchemaField
+	DeletedAt NetworkUserDBSchemaField
+	UserID    NetworkUserDBSchemaField
+	User      NetworkUserDBSchemaField
+	NetworkID NetworkUserDBSchemaField
+	Network   NetworkUserDBSchemaField
+	Role      NetworkUserDBSchemaField
+	Origin    NetworkUserDBSchemaField
+}{
+
+	ID:        NetworkUserDBSchemaField("id"),
+	CreatedAt: NetworkUserDBSchemaField("created_at"),
+	UpdatedAt: NetworkUserDBSchemaField("updated_at"),
+	DeletedAt: NetworkUserDBSchemaField("deleted_at"),
+	UserID:    NetworkUserDBSchemaField("user_id"),
+	User:      NetworkUserDBSchemaField("user"),
+	NetworkID: NetworkUserDBSchemaField("network_id"),
+	Network:   NetworkUserDBSchemaField("network"),
+	Role:      NetworkUserDBSchemaField("role"),
+	Origin:    NetworkUserDBSchemaField("origin"),
+}
+
+// Update updates NetworkUser fields by primary key
+// nolint: dupl
+func (o *NetworkUser) Update(db *gorm.DB, fields ...NetworkUserDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"user_id":    o.UserID,
+		"user":       o.User,
+		"network_id": o.NetworkID,
+		"network":    o.Network,
+		"role":       o.Role,
+		"origin":     o.Origin,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update NetworkUser %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// NetworkUserUpdater is an NetworkUser updates manager
+type NetworkUserUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewNetworkUserUpdater creates new NetworkUser updater
+// nolint: dupl
+func NewNetworkUserUpdater(db *gorm.DB) NetworkUserUpdater {
+	return NetworkUserUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&NetworkUser{}),
+	}
+}
+
+// ===== END of NetworkUser modifiers
+
+// ===== BEGIN of query set OIDCUserQuerySet
+
+// OIDCUserQuerySet is an queryset type for OIDCUser
+type OIDCUserQuerySet struct {
+	db *gorm.DB
+}
+
+// NewOIDCUserQuerySet constructs new OIDCUserQuerySet
+func NewOIDCUserQuerySet(db *gorm.DB) OIDCUserQuerySet {
+	return OIDCUserQuerySet{
+		db: db.Model(&OIDCUser{}),
+	}
+}
+
+func (qs OIDCUserQuerySet) w(db *gorm.DB) OIDCUserQuerySet {
+	return NewOIDCUserQuerySet(db)
+}
+
+func (qs OIDCUserQuerySet) Preload(query string, args ...interface{}) OIDCUserQuerySet {
+	return NewOIDCUserQuerySet(qs.db.Preload(query, args...))
+}
+
+// Preload preload associations with given conditions
+//    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
+func (qs OIDCUserQuerySet) Select(fields ...OIDCUserDBSchemaField) OIDCUserQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *OIDCUser) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *OIDCUser) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) All(ret *[]OIDCUser) error {
+	return qs.db.Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) CreatedAtEq(createdAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) CreatedAtGt(createdAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) CreatedAtGte(createdAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) CreatedAtLt(createdAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) CreatedAtLte(createdAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) CreatedAtNe(createdAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) Delete() error {
+	return qs.db.Delete(OIDCUser{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(OIDCUser{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(OIDCUser{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) DeletedAtEq(deletedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) DeletedAtGt(deletedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) DeletedAtGte(deletedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) DeletedAtIsNotNull() OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) DeletedAtIsNull() OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) DeletedAtLt(deletedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) DeletedAtLte(deletedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) DeletedAtNe(deletedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
+}
+
+// EmailEq is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) EmailEq(email string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "email", Value: email}))
+}
+
+// EmailGt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) EmailGt(email string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "email", Value: email}))
+}
+
+// EmailGte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) EmailGte(email string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "email", Value: email}))
+}
+
+// EmailIn is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) EmailIn(email ...string) OIDCUserQuerySet {
+	if len(email) == 0 {
+		qs.db.AddError(errors.New("must at least pass one email in EmailIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "email"}, email))
+}
+
+// EmailLike is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) EmailLike(email string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "email", Value: email}))
+}
+
+// EmailLt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) EmailLt(email string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "email", Value: email}))
+}
+
+// EmailLte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) EmailLte(email string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "email", Value: email}))
+}
+
+// EmailNe is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) EmailNe(email string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "email", Value: email}))
+}
+
+// EmailNotIn is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) EmailNotIn(email ...string) OIDCUserQuerySet {
+	if len(email) == 0 {
+		qs.db.AddError(errors.New("must at least pass one email in EmailNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "email"}, email))
+}
+
+// EmailNotlike is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) EmailNotlike(email string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "email", Value: email})))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) GetUpdater() OIDCUserUpdater {
+	return NewOIDCUserUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) IDEq(ID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) IDGt(ID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) IDGte(ID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) IDIn(ID ...ID) OIDCUserQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) IDLt(ID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) IDLte(ID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) IDNe(ID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) IDNotIn(ID ...ID) OIDCUserQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) Limit(limit int) OIDCUserQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) Offset(offset int) OIDCUserQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs OIDCUserQuerySet) One(ret *OIDCUser) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderAscByCreatedAt() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderAscByDeletedAt() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
+}
+
+// OrderAscByEmail is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderAscByEmail() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "email"}}))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderAscByID() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
+}
+
+// OrderAscByProvider is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderAscByProvider() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "provider"}}))
+}
+
+// OrderAscBySubject is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderAscBySubject() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "subject"}}))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderAscByUpdatedAt() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderAscByUserID() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}}))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderDescByCreatedAt() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderDescByDeletedAt() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
+}
+
+// OrderDescByEmail is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderDescByEmail() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "email"}, Desc: true}))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderDescByID() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
+}
+
+// OrderDescByProvider is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderDescByProvider() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "provider"}, Desc: true}))
+}
+
+// OrderDescBySubject is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderDescBySubject() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "subject"}, Desc: true}))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderDescByUpdatedAt() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) OrderDescByUserID() OIDCUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}, Desc: true}))
+}
+
+// PreloadUser is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) PreloadUser() OIDCUserQuerySet {
+	return qs.w(qs.db.Preload("User"))
+}
+
+// ProviderEq is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) ProviderEq(provider string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "provider", Value: provider}))
+}
+
+// ProviderGt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) ProviderGt(provider string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "provider", Value: provider}))
+}
+
+// ProviderGte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) ProviderGte(provider string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "provider", Value: provider}))
+}
+
+// ProviderIn is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) ProviderIn(provider ...string) OIDCUserQuerySet {
+	if len(provider) == 0 {
+		qs.db.AddError(errors.New("must at least pass one provider in ProviderIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "provider"}, provider))
+}
+
+// ProviderLike is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) ProviderLike(provider string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "provider", Value: provider}))
+}
+
+// ProviderLt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) ProviderLt(provider string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "provider", Value: provider}))
+}
+
+// ProviderLte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) ProviderLte(provider string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "provider", Value: provider}))
+}
+
+// ProviderNe is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) ProviderNe(provider string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "provider", Value: provider}))
+}
+
+// ProviderNotIn is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) ProviderNotIn(provider ...string) OIDCUserQuerySet {
+	if len(provider) == 0 {
+		qs.db.AddError(errors.New("must at least pass one provider in ProviderNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "provider"}, provider))
+}
+
+// ProviderNotlike is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) ProviderNotlike(provider string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "provider", Value: provider})))
+}
+
+// SubjectEq is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) SubjectEq(subject string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "subject", Value: subject}))
+}
+
+// SubjectGt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) SubjectGt(subject string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "subject", Value: subject}))
+}
+
+// SubjectGte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) SubjectGte(subject string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "subject", Value: subject}))
+}
+
+// SubjectIn is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) SubjectIn(subject ...string) OIDCUserQuerySet {
+	if len(subject) == 0 {
+		qs.db.AddError(errors.New("must at least pass one subject in SubjectIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "subject"}, subject))
+}
+
+// SubjectLike is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) SubjectLike(subject string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "subject", Value: subject}))
+}
+
+// SubjectLt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) SubjectLt(subject string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "subject", Value: subject}))
+}
+
+// SubjectLte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) SubjectLte(subject string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "subject", Value: subject}))
+}
+
+// SubjectNe is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) SubjectNe(subject string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "subject", Value: subject}))
+}
+
+// SubjectNotIn is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) SubjectNotIn(subject ...string) OIDCUserQuerySet {
+	if len(subject) == 0 {
+		qs.db.AddError(errors.New("must at least pass one subject in SubjectNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "subject"}, subject))
+}
+
+// SubjectNotlike is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) SubjectNotlike(subject string) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "subject", Value: subject})))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UpdatedAtEq(updatedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UpdatedAtGt(updatedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UpdatedAtGte(updatedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UpdatedAtIsNotNull() OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtIsNull is an autogenerated method
 // nolint: dupl
-func (u GithubUserUpdater) SetID(ID ID) GithubUserUpdater {
-	u.fields[string(GithubUserDBSchema.ID)] = ID
+func (qs OIDCUserQuerySet) UpdatedAtIsNull() OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UpdatedAtLt(updatedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UpdatedAtLte(updatedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UpdatedAtNe(updatedAt time.Time) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UserIDEq(userID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user_id", Value: userID}))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UserIDGt(userID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "user_id", Value: userID}))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UserIDGte(userID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "user_id", Value: userID}))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UserIDIn(userID ...ID) OIDCUserQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UserIDLt(userID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "user_id", Value: userID}))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UserIDLte(userID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "user_id", Value: userID}))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UserIDNe(userID ID) OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user_id", Value: userID}))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UserIDNotIn(userID ...ID) OIDCUserQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "user_id"}, userID))
+}
+
+// UserIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UserIsNotNull() OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user", Value: nil}))
+}
+
+// UserIsNull is an autogenerated method
+// nolint: dupl
+func (qs OIDCUserQuerySet) UserIsNull() OIDCUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user", Value: nil}))
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u OIDCUserUpdater) SetCreatedAt(createdAt time.Time) OIDCUserUpdater {
+	u.fields[string(OIDCUserDBSchema.CreatedAt)] = createdAt
 	return u
 }
 
-// SetLocation is an autogenerated method
+// SetDeletedAt is an autogenerated method
 // nolint: dupl
-func (u GithubUserUpdater) SetLocation(location string) GithubUserUpdater {
-	u.fields[string(GithubUserDBSchema.Location)] = location
+func (u OIDCUserUpdater) SetDeletedAt(deletedAt *time.Time) OIDCUserUpdater {
+	u.fields[string(OIDCUserDBSchema.DeletedAt)] = deletedAt
 	return u
 }
 
-// SetLogin is an autogenerated method
+// SetEmail is an autogenerated method
 // nolint: dupl
-func (u GithubUserUpdater) SetLogin(login string) GithubUserUpdater {
-	u.fields[string(GithubUserDBSchema.Login)] = login
+func (u OIDCUserUpdater) SetEmail(email string) OIDCUserUpdater {
+	u.fields[string(OIDCUserDBSchema.Email)] = email
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u OIDCUserUpdater) SetID(ID ID) OIDCUserUpdater {
+	u.fields[string(OIDCUserDBSchema.ID)] = ID
+	return u
+}
+
+// SetProvider is an autogenerated method
+// nolint: dupl
+func (u OIDCUserUpdater) SetProvider(provider string) OIDCUserUpdater {
+	u.fields[string(OIDCUserDBSchema.Provider)] = provider
+	return u
+}
+
+// SetSubject is an autogenerated method
+// nolint: dupl
+func (u OIDCUserUpdater) SetSubject(subject string) OIDCUserUpdater {
+	u.fields[string(OIDCUserDBSchema.Subject)] = subject
 	return u
 }
 
 // SetUpdatedAt is an autogenerated method
 // nolint: dupl
-func (u GithubUserUpdater) SetUpdatedAt(updatedAt *time.Time) GithubUserUpdater {
-	u.fields[string(GithubUserDBSchema.UpdatedAt)] = updatedAt
+func (u OIDCUserUpdater) SetUpdatedAt(updatedAt *time.Time) OIDCUserUpdater {
+	u.fields[string(OIDCUserDBSchema.UpdatedAt)] = updatedAt
 	return u
 }
 
 // SetUserID is an autogenerated method
 // nolint: dupl
-func (u GithubUserUpdater) SetUserID(userID ID) GithubUserUpdater {
-	u.fields[string(GithubUserDBSchema.UserID)] = userID
+func (u OIDCUserUpdater) SetUserID(userID ID) OIDCUserUpdater {
+	u.fields[string(OIDCUserDBSchema.UserID)] = userID
 	return u
 }
 
 // Update is an autogenerated method
 // nolint: dupl
-func (u GithubUserUpdater) Update() error {
+func (u OIDCUserUpdater) Update() error {
 	return u.db.Updates(u.fields).Error
 }
 
 // UpdateNum is an autogenerated method
 // nolint: dupl
-func (u GithubUserUpdater) UpdateNum() (int64, error) {
+func (u OIDCUserUpdater) UpdateNum() (int64, error) {
 	db := u.db.Updates(u.fields)
 	return db.RowsAffected, db.Error
 }
 
-// ===== END of query set GithubUserQuerySet
+// ===== END of query set OIDCUserQuerySet
 
-// ===== BEGIN of GithubUser modifiers
+// ===== BEGIN of OIDCUser modifiers
 
-// GithubUserDBSchemaField describes database schema field. It requires for method 'Update'
-type GithubUserDBSchemaField string
+// OIDCUserDBSchemaField describes database schema field. It requires for method 'Update'
+type OIDCUserDBSchemaField string
 
 // String method returns string representation of field.
 // nolint: dupl
-func (f GithubUserDBSchemaField) String() string {
+func (f OIDCUserDBSchemaField) String() string {
 	return string(f)
 }
 
-// GithubUserDBSchema stores db field names of GithubUser
-var GithubUserDBSchema = struct {
-	ID        GithubUserDBSchemaField
-	CreatedAt GithubUserDBSchemaField
-	UpdatedAt GithubUserDBSchemaField
-	DeletedAt GithubUserDBSchemaField
-	UserID    GithubUserDBSchemaField
-	User      GithubUserDBSchemaField
-	GithubID  GithubUserDBSchemaField
-	Login     GithubUserDBSchemaField
-	AvatarURL GithubUserDBSchemaField
-	Location  GithubUserDBSchemaField
+// OIDCUserDBSchema stores db field names of OIDCUser
+var OIDCUserDBSchema = struct {
+	ID        OIDCUserDBSchemaField
+	CreatedAt OIDCUserDBSchemaField
+	UpdatedAt OIDCUserDBSchemaField
+	DeletedAt OIDCUserDBSchemaField
+	UserID    OIDCUserDBSchemaField
+	User      OIDCUserDBSchemaField
+	Provider  OIDCUserDBSchemaField
+	Subject   OIDCUserDBSchemaField
+	Email     OIDCUserDBSchemaField
 }{
 
-	ID:        GithubUserDBSchemaField("id"),
-	CreatedAt: GithubUserDBSchemaField("created_at"),
-	UpdatedAt: GithubUserDBSchemaField("updated_at"),
-	DeletedAt: GithubUserDBSchemaField("deleted_at"),
-	UserID:    GithubUserDBSchemaField("user_id"),
-	User:      GithubUserDBSchemaField("user"),
-	GithubID:  GithubUserDBSchemaField("github_id"),
-	Login:     GithubUserDBSchemaField("login"),
-	AvatarURL: GithubUserDBSchemaField("avatar_url"),
-	Location:  GithubUserDBSchemaField("location"),
+	ID:        OIDCUserDBSchemaField("id"),
+	CreatedAt: OIDCUserDBSchemaField("created_at"),
+	UpdatedAt: OIDCUserDBSchemaField("updated_at"),
+	DeletedAt: OIDCUserDBSchemaField("deleted_at"),
+	UserID:    OIDCUserDBSchemaField("user_id"),
+	User:      OIDCUserDBSchemaField("user"),
+	Provider:  OIDCUserDBSchemaField("provider"),
+	Subject:   OIDCUserDBSchemaField("subject"),
+	Email:     OIDCUserDBSchemaField("email"),
 }
 
-// Update updates GithubUser fields by primary key
+// Update updates OIDCUser fields by primary key
 // nolint: dupl
-func (o *GithubUser) Update(db *gorm.DB, fields ...GithubUserDBSchemaField) error {
+func (o *OIDCUser) Update(db *gorm.DB, fields ...OIDCUserDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
 		"id":         o.ID,
 		"created_at": o.CreatedAt,
@@ -3044,10 +10728,9 @@ func (o *GithubUser) Update(db *gorm.DB, fields ...GithubUserDBSchemaField) erro
 		"deleted_at": o.DeletedAt,
 		"user_id":    o.UserID,
 		"user":       o.User,
-		"github_id":  o.GithubID,
-		"login":      o.Login,
-		"avatar_url": o.AvatarURL,
-		"location":   o.Location,
+		"provider":   o.Provider,
+		"subject":    o.Subject,
+		"email":      o.Email,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -3059,55 +10742,55 @@ func (o *GithubUser) Update(db *gorm.DB, fields ...GithubUserDBSchemaField) erro
 			return err
 		}
 
-		return fmt.Errorf("can't update GithubUser %v fields %v: %s",
+		return fmt.Errorf("can't update OIDCUser %v fields %v: %s",
 			o, fields, err)
 	}
 
 	return nil
 }
 
-// GithubUserUpdater is an GithubUser updates manager
-type GithubUserUpdater struct {
+// OIDCUserUpdater is an OIDCUser updates manager
+type OIDCUserUpdater struct {
 	fields map[string]interface{}
 	db     *gorm.DB
 }
 
-// NewGithubUserUpdater creates new GithubUser updater
+// NewOIDCUserUpdater creates new OIDCUser updater
 // nolint: dupl
-func NewGithubUserUpdater(db *gorm.DB) GithubUserUpdater {
-	return GithubUserUpdater{
+func NewOIDCUserUpdater(db *gorm.DB) OIDCUserUpdater {
+	return OIDCUserUpdater{
 		fields: map[string]interface{}{},
-		db:     db.Model(&GithubUser{}),
+		db:     db.Model(&OIDCUser{}),
 	}
 }
 
-// ===== END of GithubUser modifiers
+// ===== END of OIDCUser modifiers
 
-// ===== BEGIN of query set InvitationQuerySet
+// ===== BEGIN of query set OrganizationQuerySet
 
-// InvitationQuerySet is an queryset type for Invitation
-type InvitationQuerySet struct {
+// OrganizationQuerySet is an queryset type for Organization
+type OrganizationQuerySet struct {
 	db *gorm.DB
 }
 
-// NewInvitationQuerySet constructs new InvitationQuerySet
-func NewInvitationQuerySet(db *gorm.DB) InvitationQuerySet {
-	return InvitationQuerySet{
-		db: db.Model(&Invitation{}),
+// NewOrganizationQuerySet constructs new OrganizationQuerySet
+func NewOrganizationQuerySet(db *gorm.DB) OrganizationQuerySet {
+	return OrganizationQuerySet{
+		db: db.Model(&Organization{}),
 	}
 }
 
-func (qs InvitationQuerySet) w(db *gorm.DB) InvitationQuerySet {
-	return NewInvitationQuerySet(db)
+func (qs OrganizationQuerySet) w(db *gorm.DB) OrganizationQuerySet {
+	return NewOrganizationQuerySet(db)
 }
 
-func (qs InvitationQuerySet) Preload(query string, args ...interface{}) InvitationQuerySet {
-	return NewInvitationQuerySet(qs.db.Preload(query, args...))
+func (qs OrganizationQuerySet) Preload(query string, args ...interface{}) OrganizationQuerySet {
+	return NewOrganizationQuerySet(qs.db.Preload(query, args...))
 }
 
 // Preload preload associations with given conditions
 //    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
-func (qs InvitationQuerySet) Select(fields ...InvitationDBSchemaField) InvitationQuerySet {
+func (qs OrganizationQuerySet) Select(fields ...OrganizationDBSchemaField) OrganizationQuerySet {
 	names := []string{}
 	for _, f := range fields {
 		names = append(names, f.String())
@@ -3118,25 +10801,25 @@ func (qs InvitationQuerySet) Select(fields ...InvitationDBSchemaField) Invitatio
 
 // Create is an autogenerated method
 // nolint: dupl
-func (o *Invitation) Create(db *gorm.DB) error {
+func (o *Organization) Create(db *gorm.DB) error {
 	return db.Create(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (o *Invitation) Delete(db *gorm.DB) error {
+func (o *Organization) Delete(db *gorm.DB) error {
 	return db.Delete(o).Error
 }
 
 // All is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) All(ret *[]Invitation) error {
+func (qs OrganizationQuerySet) All(ret *[]Organization) error {
 	return qs.db.Find(ret).Error
 }
 
 // Count is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) Count() (int64, error) {
+func (qs OrganizationQuerySet) Count() (int64, error) {
 	var count int64
 	err := qs.db.Count(&count).Error
 	return count, err
@@ -3144,753 +10827,645 @@ func (qs InvitationQuerySet) Count() (int64, error) {
 
 // CreatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) CreatedAtEq(createdAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`created_at` = ?", createdAt))
+func (qs OrganizationQuerySet) CreatedAtEq(createdAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) CreatedAtGt(createdAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`created_at` > ?", createdAt))
+func (qs OrganizationQuerySet) CreatedAtGt(createdAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) CreatedAtGte(createdAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`created_at` >= ?", createdAt))
+func (qs OrganizationQuerySet) CreatedAtGte(createdAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) CreatedAtLt(createdAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`created_at` < ?", createdAt))
+func (qs OrganizationQuerySet) CreatedAtLt(createdAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) CreatedAtLte(createdAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`created_at` <= ?", createdAt))
+func (qs OrganizationQuerySet) CreatedAtLte(createdAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtNe is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) CreatedAtNe(createdAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`created_at` != ?", createdAt))
-}
-
-// Delete is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) Delete() error {
-	return qs.db.Delete(Invitation{}).Error
-}
-
-// DeleteNum is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(Invitation{})
-	return db.RowsAffected, db.Error
-}
-
-// DeleteNumUnscoped is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(Invitation{})
-	return db.RowsAffected, db.Error
-}
-
-// DeletedAtEq is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) DeletedAtEq(deletedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` = ?", deletedAt))
-}
-
-// DeletedAtGt is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) DeletedAtGt(deletedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` > ?", deletedAt))
-}
-
-// DeletedAtGte is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) DeletedAtGte(deletedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` >= ?", deletedAt))
-}
-
-// DeletedAtIsNotNull is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) DeletedAtIsNotNull() InvitationQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
-}
-
-// DeletedAtIsNull is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) DeletedAtIsNull() InvitationQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NULL"))
-}
-
-// DeletedAtLt is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) DeletedAtLt(deletedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` < ?", deletedAt))
-}
-
-// DeletedAtLte is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) DeletedAtLte(deletedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` <= ?", deletedAt))
-}
-
-// DeletedAtNe is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) DeletedAtNe(deletedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` != ?", deletedAt))
-}
-
-// GetDB is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) GetDB() *gorm.DB {
-	return qs.db
-}
-
-// GetUpdater is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) GetUpdater() InvitationUpdater {
-	return NewInvitationUpdater(qs.db)
-}
-
-// IDEq is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) IDEq(ID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`id` = ?", ID))
-}
-
-// IDGt is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) IDGt(ID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`id` > ?", ID))
-}
-
-// IDGte is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) IDGte(ID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`id` >= ?", ID))
-}
-
-// IDIn is an autogenerated method
-// nolint: dupl
-func (qs InvitationQuerySet) IDIn(ID ...ID) InvitationQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+func (qs OrganizationQuerySet) CreatedAtNe(createdAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
 }
 
-// IDLt is an autogenerated method
+// CreatedByIDEq is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) IDLt(ID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`id` < ?", ID))
+func (qs OrganizationQuerySet) CreatedByIDEq(createdByID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_by_id", Value: createdByID}))
 }
 
-// IDLte is an autogenerated method
+// CreatedByIDGt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) IDLte(ID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`id` <= ?", ID))
+func (qs OrganizationQuerySet) CreatedByIDGt(createdByID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_by_id", Value: createdByID}))
 }
 
-// IDNe is an autogenerated method
+// CreatedByIDGte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) IDNe(ID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`id` != ?", ID))
+func (qs OrganizationQuerySet) CreatedByIDGte(createdByID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_by_id", Value: createdByID}))
 }
 
-// IDNotIn is an autogenerated method
+// CreatedByIDIn is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) IDNotIn(ID ...ID) InvitationQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+func (qs OrganizationQuerySet) CreatedByIDIn(createdByID ...ID) OrganizationQuerySet {
+	if len(createdByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one createdByID in CreatedByIDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "created_by_id"}, createdByID))
 }
 
-// InvitedByIDEq is an autogenerated method
+// CreatedByIDLt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) InvitedByIDEq(invitedByID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`invited_by_id` = ?", invitedByID))
+func (qs OrganizationQuerySet) CreatedByIDLt(createdByID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_by_id", Value: createdByID}))
 }
 
-// InvitedByIDGt is an autogenerated method
+// CreatedByIDLte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) InvitedByIDGt(invitedByID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`invited_by_id` > ?", invitedByID))
+func (qs OrganizationQuerySet) CreatedByIDLte(createdByID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_by_id", Value: createdByID}))
 }
 
-// InvitedByIDGte is an autogenerated method
+// CreatedByIDNe is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) InvitedByIDGte(invitedByID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`invited_by_id` >= ?", invitedByID))
+func (qs OrganizationQuerySet) CreatedByIDNe(createdByID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_by_id", Value: createdByID}))
 }
 
-// InvitedByIDIn is an autogenerated method
+// CreatedByIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) InvitedByIDIn(invitedByID ...ID) InvitationQuerySet {
-	if len(invitedByID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one invitedByID in InvitedByIDIn"))
+func (qs OrganizationQuerySet) CreatedByIDNotIn(createdByID ...ID) OrganizationQuerySet {
+	if len(createdByID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one createdByID in CreatedByIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("invited_by_id IN (?)", invitedByID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "created_by_id"}, createdByID))
 }
 
-// InvitedByIDLt is an autogenerated method
+// CreatedByIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) InvitedByIDLt(invitedByID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`invited_by_id` < ?", invitedByID))
+func (qs OrganizationQuerySet) CreatedByIsNotNull() OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_by", Value: nil}))
 }
 
-// InvitedByIDLte is an autogenerated method
+// CreatedByIsNull is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) InvitedByIDLte(invitedByID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`invited_by_id` <= ?", invitedByID))
+func (qs OrganizationQuerySet) CreatedByIsNull() OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_by", Value: nil}))
 }
 
-// InvitedByIDNe is an autogenerated method
+// Delete is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) InvitedByIDNe(invitedByID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`invited_by_id` != ?", invitedByID))
+func (qs OrganizationQuerySet) Delete() error {
+	return qs.db.Delete(Organization{}).Error
 }
 
-// InvitedByIDNotIn is an autogenerated method
+// DeleteNum is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) InvitedByIDNotIn(invitedByID ...ID) InvitationQuerySet {
-	if len(invitedByID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one invitedByID in InvitedByIDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("invited_by_id NOT IN (?)", invitedByID))
+func (qs OrganizationQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(Organization{})
+	return db.RowsAffected, db.Error
 }
 
-// InvitedByIsNotNull is an autogenerated method
+// DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) InvitedByIsNotNull() InvitationQuerySet {
-	return qs.w(qs.db.Where("invited_by IS NOT NULL"))
+func (qs OrganizationQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(Organization{})
+	return db.RowsAffected, db.Error
 }
 
-// InvitedByIsNull is an autogenerated method
+// DeletedAtEq is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) InvitedByIsNull() InvitationQuerySet {
-	return qs.w(qs.db.Where("invited_by IS NULL"))
+func (qs OrganizationQuerySet) DeletedAtEq(deletedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
 }
 
-// Limit is an autogenerated method
+// DeletedAtGt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) Limit(limit int) InvitationQuerySet {
-	return qs.w(qs.db.Limit(limit))
+func (qs OrganizationQuerySet) DeletedAtGt(deletedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
 }
 
-// NetworkIDEq is an autogenerated method
+// DeletedAtGte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) NetworkIDEq(networkID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`network_id` = ?", networkID))
+func (qs OrganizationQuerySet) DeletedAtGte(deletedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
 }
 
-// NetworkIDGt is an autogenerated method
+// DeletedAtIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) NetworkIDGt(networkID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`network_id` > ?", networkID))
+func (qs OrganizationQuerySet) DeletedAtIsNotNull() OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
 }
 
-// NetworkIDGte is an autogenerated method
+// DeletedAtIsNull is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) NetworkIDGte(networkID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`network_id` >= ?", networkID))
+func (qs OrganizationQuerySet) DeletedAtIsNull() OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
 }
 
-// NetworkIDIn is an autogenerated method
+// DeletedAtLt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) NetworkIDIn(networkID ...ID) InvitationQuerySet {
-	if len(networkID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("network_id IN (?)", networkID))
+func (qs OrganizationQuerySet) DeletedAtLt(deletedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
 }
 
-// NetworkIDLt is an autogenerated method
+// DeletedAtLte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) NetworkIDLt(networkID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`network_id` < ?", networkID))
+func (qs OrganizationQuerySet) DeletedAtLte(deletedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
 }
 
-// NetworkIDLte is an autogenerated method
+// DeletedAtNe is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) NetworkIDLte(networkID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`network_id` <= ?", networkID))
+func (qs OrganizationQuerySet) DeletedAtNe(deletedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
 }
 
-// NetworkIDNe is an autogenerated method
+// DescriptionEq is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) NetworkIDNe(networkID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`network_id` != ?", networkID))
+func (qs OrganizationQuerySet) DescriptionEq(description string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "description", Value: description}))
 }
 
-// NetworkIDNotIn is an autogenerated method
+// DescriptionGt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) NetworkIDNotIn(networkID ...ID) InvitationQuerySet {
-	if len(networkID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("network_id NOT IN (?)", networkID))
+func (qs OrganizationQuerySet) DescriptionGt(description string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "description", Value: description}))
 }
 
-// NetworkIsNotNull is an autogenerated method
+// DescriptionGte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) NetworkIsNotNull() InvitationQuerySet {
-	return qs.w(qs.db.Where("network IS NOT NULL"))
+func (qs OrganizationQuerySet) DescriptionGte(description string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "description", Value: description}))
 }
 
-// NetworkIsNull is an autogenerated method
+// DescriptionIn is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) NetworkIsNull() InvitationQuerySet {
-	return qs.w(qs.db.Where("network IS NULL"))
+func (qs OrganizationQuerySet) DescriptionIn(description ...string) OrganizationQuerySet {
+	if len(description) == 0 {
+		qs.db.AddError(errors.New("must at least pass one description in DescriptionIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "description"}, description))
 }
 
-// Offset is an autogenerated method
+// DescriptionLike is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) Offset(offset int) InvitationQuerySet {
-	return qs.w(qs.db.Offset(offset))
+func (qs OrganizationQuerySet) DescriptionLike(description string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "description", Value: description}))
 }
 
-// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
-// if nothing was fetched
-func (qs InvitationQuerySet) One(ret *Invitation) error {
-	return qs.db.First(ret).Error
+// DescriptionLt is an autogenerated method
+// nolint: dupl
+func (qs OrganizationQuerySet) DescriptionLt(description string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "description", Value: description}))
 }
 
-// OrderAscByCreatedAt is an autogenerated method
+// DescriptionLte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderAscByCreatedAt() InvitationQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+func (qs OrganizationQuerySet) DescriptionLte(description string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "description", Value: description}))
 }
 
-// OrderAscByDeletedAt is an autogenerated method
+// DescriptionNe is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderAscByDeletedAt() InvitationQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+func (qs OrganizationQuerySet) DescriptionNe(description string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "description", Value: description}))
 }
 
-// OrderAscByID is an autogenerated method
+// DescriptionNotIn is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderAscByID() InvitationQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+func (qs OrganizationQuerySet) DescriptionNotIn(description ...string) OrganizationQuerySet {
+	if len(description) == 0 {
+		qs.db.AddError(errors.New("must at least pass one description in DescriptionNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "description"}, description))
 }
 
-// OrderAscByInvitedByID is an autogenerated method
+// DescriptionNotlike is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderAscByInvitedByID() InvitationQuerySet {
-	return qs.w(qs.db.Order("invited_by_id ASC"))
+func (qs OrganizationQuerySet) DescriptionNotlike(description string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "description", Value: description})))
 }
 
-// OrderAscByNetworkID is an autogenerated method
+// GetDB is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderAscByNetworkID() InvitationQuerySet {
-	return qs.w(qs.db.Order("network_id ASC"))
+func (qs OrganizationQuerySet) GetDB() *gorm.DB {
+	return qs.db
 }
 
-// OrderAscByRole is an autogenerated method
+// GetUpdater is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderAscByRole() InvitationQuerySet {
-	return qs.w(qs.db.Order("role ASC"))
+func (qs OrganizationQuerySet) GetUpdater() OrganizationUpdater {
+	return NewOrganizationUpdater(qs.db)
 }
 
-// OrderAscByUpdatedAt is an autogenerated method
+// IDEq is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderAscByUpdatedAt() InvitationQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+func (qs OrganizationQuerySet) IDEq(ID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
 }
 
-// OrderAscByUserID is an autogenerated method
+// IDGt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderAscByUserID() InvitationQuerySet {
-	return qs.w(qs.db.Order("user_id ASC"))
+func (qs OrganizationQuerySet) IDGt(ID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
 }
 
-// OrderDescByCreatedAt is an autogenerated method
+// IDGte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderDescByCreatedAt() InvitationQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+func (qs OrganizationQuerySet) IDGte(ID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
 }
 
-// OrderDescByDeletedAt is an autogenerated method
+// IDIn is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderDescByDeletedAt() InvitationQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+func (qs OrganizationQuerySet) IDIn(ID ...ID) OrganizationQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
 }
 
-// OrderDescByID is an autogenerated method
+// IDLt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderDescByID() InvitationQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (qs OrganizationQuerySet) IDLt(ID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
 }
 
-// OrderDescByInvitedByID is an autogenerated method
+// IDLte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderDescByInvitedByID() InvitationQuerySet {
-	return qs.w(qs.db.Order("invited_by_id DESC"))
+func (qs OrganizationQuerySet) IDLte(ID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
 }
 
-// OrderDescByNetworkID is an autogenerated method
+// IDNe is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderDescByNetworkID() InvitationQuerySet {
-	return qs.w(qs.db.Order("network_id DESC"))
+func (qs OrganizationQuerySet) IDNe(ID ID) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
 }
 
-// OrderDescByRole is an autogenerated method
+// IDNotIn is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderDescByRole() InvitationQuerySet {
-	return qs.w(qs.db.Order("role DESC"))
+func (qs OrganizationQuerySet) IDNotIn(ID ...ID) OrganizationQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
 }
 
-// OrderDescByUpdatedAt is an autogenerated method
+// Limit is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderDescByUpdatedAt() InvitationQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+func (qs OrganizationQuerySet) Limit(limit int) OrganizationQuerySet {
+	return qs.w(qs.db.Limit(limit))
 }
 
-// OrderDescByUserID is an autogenerated method
+// NameEq is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) OrderDescByUserID() InvitationQuerySet {
-	return qs.w(qs.db.Order("user_id DESC"))
+func (qs OrganizationQuerySet) NameEq(name string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "name", Value: name}))
 }
 
-// PreloadInvitedBy is an autogenerated method
+// NameGt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) PreloadInvitedBy() InvitationQuerySet {
-	return qs.w(qs.db.Preload("InvitedBy"))
+func (qs OrganizationQuerySet) NameGt(name string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "name", Value: name}))
 }
 
-// PreloadNetwork is an autogenerated method
+// NameGte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) PreloadNetwork() InvitationQuerySet {
-	return qs.w(qs.db.Preload("Network"))
+func (qs OrganizationQuerySet) NameGte(name string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "name", Value: name}))
 }
 
-// PreloadUser is an autogenerated method
+// NameIn is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) PreloadUser() InvitationQuerySet {
-	return qs.w(qs.db.Preload("User"))
+func (qs OrganizationQuerySet) NameIn(name ...string) OrganizationQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "name"}, name))
 }
 
-// RoleEq is an autogenerated method
+// NameLike is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) RoleEq(role RoleType) InvitationQuerySet {
-	return qs.w(qs.db.Where("`role` = ?", role))
+func (qs OrganizationQuerySet) NameLike(name string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "name", Value: name}))
 }
 
-// RoleGt is an autogenerated method
+// NameLt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) RoleGt(role RoleType) InvitationQuerySet {
-	return qs.w(qs.db.Where("`role` > ?", role))
+func (qs OrganizationQuerySet) NameLt(name string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "name", Value: name}))
 }
 
-// RoleGte is an autogenerated method
+// NameLte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) RoleGte(role RoleType) InvitationQuerySet {
-	return qs.w(qs.db.Where("`role` >= ?", role))
+func (qs OrganizationQuerySet) NameLte(name string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "name", Value: name}))
 }
 
-// RoleIn is an autogenerated method
+// NameNe is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) RoleIn(role ...RoleType) InvitationQuerySet {
-	if len(role) == 0 {
-		qs.db.AddError(errors.New("must at least pass one role in RoleIn"))
+func (qs OrganizationQuerySet) NameNe(name string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "name", Value: name}))
+}
+
+// NameNotIn is an autogenerated method
+// nolint: dupl
+func (qs OrganizationQuerySet) NameNotIn(name ...string) OrganizationQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("role IN (?)", role))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "name"}, name))
 }
 
-// RoleLike is an autogenerated method
+// NameNotlike is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) RoleLike(role RoleType) InvitationQuerySet {
-	return qs.w(qs.db.Where("`role` LIKE ?", role))
+func (qs OrganizationQuerySet) NameNotlike(name string) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "name", Value: name})))
 }
 
-// RoleLt is an autogenerated method
+// Offset is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) RoleLt(role RoleType) InvitationQuerySet {
-	return qs.w(qs.db.Where("`role` < ?", role))
+func (qs OrganizationQuerySet) Offset(offset int) OrganizationQuerySet {
+	return qs.w(qs.db.Offset(offset))
 }
 
-// RoleLte is an autogenerated method
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs OrganizationQuerySet) One(ret *Organization) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) RoleLte(role RoleType) InvitationQuerySet {
-	return qs.w(qs.db.Where("`role` <= ?", role))
+func (qs OrganizationQuerySet) OrderAscByCreatedAt() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
 }
 
-// RoleNe is an autogenerated method
+// OrderAscByCreatedByID is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) RoleNe(role RoleType) InvitationQuerySet {
-	return qs.w(qs.db.Where("`role` != ?", role))
+func (qs OrganizationQuerySet) OrderAscByCreatedByID() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_by_id"}}))
 }
 
-// RoleNotIn is an autogenerated method
+// OrderAscByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) RoleNotIn(role ...RoleType) InvitationQuerySet {
-	if len(role) == 0 {
-		qs.db.AddError(errors.New("must at least pass one role in RoleNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("role NOT IN (?)", role))
+func (qs OrganizationQuerySet) OrderAscByDeletedAt() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
 }
 
-// RoleNotlike is an autogenerated method
+// OrderAscByDescription is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) RoleNotlike(role RoleType) InvitationQuerySet {
-	return qs.w(qs.db.Where("`role` NOT LIKE ?", role))
+func (qs OrganizationQuerySet) OrderAscByDescription() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "description"}}))
 }
 
-// UpdatedAtEq is an autogenerated method
+// OrderAscByID is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UpdatedAtEq(updatedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`updated_at` = ?", updatedAt))
+func (qs OrganizationQuerySet) OrderAscByID() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
 }
 
-// UpdatedAtGt is an autogenerated method
+// OrderAscByName is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UpdatedAtGt(updatedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`updated_at` > ?", updatedAt))
+func (qs OrganizationQuerySet) OrderAscByName() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "name"}}))
 }
 
-// UpdatedAtGte is an autogenerated method
+// OrderAscByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UpdatedAtGte(updatedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`updated_at` >= ?", updatedAt))
+func (qs OrganizationQuerySet) OrderAscByUpdatedAt() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
 }
 
-// UpdatedAtIsNotNull is an autogenerated method
+// OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UpdatedAtIsNotNull() InvitationQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NOT NULL"))
+func (qs OrganizationQuerySet) OrderDescByCreatedAt() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
 }
 
-// UpdatedAtIsNull is an autogenerated method
+// OrderDescByCreatedByID is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UpdatedAtIsNull() InvitationQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NULL"))
+func (qs OrganizationQuerySet) OrderDescByCreatedByID() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_by_id"}, Desc: true}))
 }
 
-// UpdatedAtLt is an autogenerated method
+// OrderDescByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UpdatedAtLt(updatedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`updated_at` < ?", updatedAt))
+func (qs OrganizationQuerySet) OrderDescByDeletedAt() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
 }
 
-// UpdatedAtLte is an autogenerated method
+// OrderDescByDescription is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UpdatedAtLte(updatedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`updated_at` <= ?", updatedAt))
+func (qs OrganizationQuerySet) OrderDescByDescription() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "description"}, Desc: true}))
 }
 
-// UpdatedAtNe is an autogenerated method
+// OrderDescByID is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UpdatedAtNe(updatedAt time.Time) InvitationQuerySet {
-	return qs.w(qs.db.Where("`updated_at` != ?", updatedAt))
+func (qs OrganizationQuerySet) OrderDescByID() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
 }
 
-// UserIDEq is an autogenerated method
+// OrderDescByName is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UserIDEq(userID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`user_id` = ?", userID))
+func (qs OrganizationQuerySet) OrderDescByName() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "name"}, Desc: true}))
 }
 
-// UserIDGt is an autogenerated method
+// OrderDescByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UserIDGt(userID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`user_id` > ?", userID))
+func (qs OrganizationQuerySet) OrderDescByUpdatedAt() OrganizationQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
 }
 
-// UserIDGte is an autogenerated method
+// PreloadCreatedBy is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UserIDGte(userID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`user_id` >= ?", userID))
+func (qs OrganizationQuerySet) PreloadCreatedBy() OrganizationQuerySet {
+	return qs.w(qs.db.Preload("CreatedBy"))
 }
 
-// UserIDIn is an autogenerated method
+// UpdatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UserIDIn(userID ...ID) InvitationQuerySet {
-	if len(userID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("user_id IN (?)", userID))
+func (qs OrganizationQuerySet) UpdatedAtEq(updatedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
 }
 
-// UserIDLt is an autogenerated method
+// UpdatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UserIDLt(userID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`user_id` < ?", userID))
+func (qs OrganizationQuerySet) UpdatedAtGt(updatedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
 }
 
-// UserIDLte is an autogenerated method
+// UpdatedAtGte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UserIDLte(userID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`user_id` <= ?", userID))
+func (qs OrganizationQuerySet) UpdatedAtGte(updatedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
 }
 
-// UserIDNe is an autogenerated method
+// UpdatedAtIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UserIDNe(userID ID) InvitationQuerySet {
-	return qs.w(qs.db.Where("`user_id` != ?", userID))
+func (qs OrganizationQuerySet) UpdatedAtIsNotNull() OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
 }
 
-// UserIDNotIn is an autogenerated method
+// UpdatedAtIsNull is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UserIDNotIn(userID ...ID) InvitationQuerySet {
-	if len(userID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+func (qs OrganizationQuerySet) UpdatedAtIsNull() OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
 }
 
-// UserIsNotNull is an autogenerated method
+// UpdatedAtLt is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UserIsNotNull() InvitationQuerySet {
-	return qs.w(qs.db.Where("user IS NOT NULL"))
+func (qs OrganizationQuerySet) UpdatedAtLt(updatedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
 }
 
-// UserIsNull is an autogenerated method
+// UpdatedAtLte is an autogenerated method
 // nolint: dupl
-func (qs InvitationQuerySet) UserIsNull() InvitationQuerySet {
-	return qs.w(qs.db.Where("user IS NULL"))
+func (qs OrganizationQuerySet) UpdatedAtLte(updatedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
 }
 
-// SetCreatedAt is an autogenerated method
+// UpdatedAtNe is an autogenerated method
 // nolint: dupl
-func (u InvitationUpdater) SetCreatedAt(createdAt time.Time) InvitationUpdater {
-	u.fields[string(InvitationDBSchema.CreatedAt)] = createdAt
-	return u
+func (qs OrganizationQuerySet) UpdatedAtNe(updatedAt time.Time) OrganizationQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
 }
 
-// SetDeletedAt is an autogenerated method
+// SetCreatedAt is an autogenerated method
 // nolint: dupl
-func (u InvitationUpdater) SetDeletedAt(deletedAt *time.Time) InvitationUpdater {
-	u.fields[string(InvitationDBSchema.DeletedAt)] = deletedAt
+func (u OrganizationUpdater) SetCreatedAt(createdAt time.Time) OrganizationUpdater {
+	u.fields[string(OrganizationDBSchema.CreatedAt)] = createdAt
 	return u
 }
 
-// SetID is an autogenerated method
+// SetCreatedByID is an autogenerated method
 // nolint: dupl
-func (u InvitationUpdater) SetID(ID ID) InvitationUpdater {
-	u.fields[string(InvitationDBSchema.ID)] = ID
+func (u OrganizationUpdater) SetCreatedByID(createdByID ID) OrganizationUpdater {
+	u.fields[string(OrganizationDBSchema.CreatedByID)] = createdByID
 	return u
 }
 
-// SetInvitedByID is an autogenerated method
+// SetDeletedAt is an autogenerated method
 // nolint: dupl
-func (u InvitationUpdater) SetInvitedByID(invitedByID ID) InvitationUpdater {
-	u.fields[string(InvitationDBSchema.InvitedByID)] = invitedByID
+func (u OrganizationUpdater) SetDeletedAt(deletedAt *time.Time) OrganizationUpdater {
+	u.fields[string(OrganizationDBSchema.DeletedAt)] = deletedAt
 	return u
 }
 
-// SetNetworkID is an autogenerated method
+// SetDescription is an autogenerated method
 // nolint: dupl
-func (u InvitationUpdater) SetNetworkID(networkID ID) InvitationUpdater {
-	u.fields[string(InvitationDBSchema.NetworkID)] = networkID
+func (u OrganizationUpdater) SetDescription(description string) OrganizationUpdater {
+	u.fields[string(OrganizationDBSchema.Description)] = description
 	return u
 }
 
-// SetRole is an autogenerated method
+// SetID is an autogenerated method
 // nolint: dupl
-func (u InvitationUpdater) SetRole(role RoleType) InvitationUpdater {
-	u.fields[string(InvitationDBSchema.Role)] = role
+func (u OrganizationUpdater) SetID(ID ID) OrganizationUpdater {
+	u.fields[string(OrganizationDBSchema.ID)] = ID
 	return u
 }
 
-// SetUpdatedAt is an autogenerated method
+// SetName is an autogenerated method
 // nolint: dupl
-func (u InvitationUpdater) SetUpdatedAt(updatedAt *time.Time) InvitationUpdater {
-	u.fields[string(InvitationDBSchema.UpdatedAt)] = updatedAt
+func (u OrganizationUpdater) SetName(name string) OrganizationUpdater {
+	u.fields[string(OrganizationDBSchema.Name)] = name
 	return u
 }
 
-// SetUserID is an autogenerated method
+// SetUpdatedAt is an autogenerated method
 // nolint: dupl
-func (u InvitationUpdater) SetUserID(userID ID) InvitationUpdater {
-	u.fields[string(InvitationDBSchema.UserID)] = userID
+func (u OrganizationUpdater) SetUpdatedAt(updatedAt *time.Time) OrganizationUpdater {
+	u.fields[string(OrganizationDBSchema.UpdatedAt)] = updatedAt
 	return u
 }
 
 // Update is an autogenerated method
 // nolint: dupl
-func (u InvitationUpdater) Update() error {
+func (u OrganizationUpdater) Update() error {
 	return u.db.Updates(u.fields).Error
 }
 
 // UpdateNum is an autogenerated method
 // nolint: dupl
-func (u InvitationUpdater) UpdateNum() (int64, error) {
+func (u OrganizationUpdater) UpdateNum() (int64, error) {
 	db := u.db.Updates(u.fields)
 	return db.RowsAffected, db.Error
 }
 
-// ===== END of query set InvitationQuerySet
+// ===== END of query set OrganizationQuerySet
 
-// ===== BEGIN of Invitation modifiers
+// ===== BEGIN of Organization modifiers
 
-// InvitationDBSchemaField describes database schema field. It requires for method 'Update'
-type InvitationDBSchemaField string
+// OrganizationDBSchemaField describes database schema field. It requires for method 'Update'
+type OrganizationDBSchemaField string
 
 // String method returns string representation of field.
 // nolint: dupl
-func (f InvitationDBSchemaField) String() string {
+func (f OrganizationDBSchemaField) String() string {
 	return string(f)
 }
 
-// InvitationDBSchema stores db field names of Invitation
-var InvitationDBSchema = struct {
-	ID          InvitationDBSchemaField
-	CreatedAt   InvitationDBSchemaField
-	UpdatedAt   InvitationDBSchemaField
-	DeletedAt   InvitationDBSchemaField
-	NetworkID   InvitationDBSchemaField
-	Network     InvitationDBSchemaField
-	InvitedByID InvitationDBSchemaField
-	InvitedBy   InvitationDBSchemaField
-	UserID      InvitationDBSchemaField
-	User        InvitationDBSchemaField
-	Role        InvitationDBSchemaField
+// OrganizationDBSchema stores db field names of Organization
+var OrganizationDBSchema = struct {
+	ID          OrganizationDBSchemaField
+	CreatedAt   OrganizationDBSchemaField
+	UpdatedAt   OrganizationDBSchemaField
+	DeletedAt   OrganizationDBSchemaField
+	CreatedByID OrganizationDBSchemaField
+	CreatedBy   OrganizationDBSchemaField
+	Name        OrganizationDBSchemaField
+	Description OrganizationDBSchemaField
 }{
 
-	ID:          InvitationDBSchemaField("id"),
-	CreatedAt:   InvitationDBSchemaField("created_at"),
-	UpdatedAt:   InvitationDBSchemaField("updated_at"),
-	DeletedAt:   InvitationDBSchemaField("deleted_at"),
-	NetworkID:   InvitationDBSchemaField("network_id"),
-	Network:     InvitationDBSchemaField("network"),
-	InvitedByID: InvitationDBSchemaField("invited_by_id"),
-	InvitedBy:   InvitationDBSchemaField("invited_by"),
-	UserID:      InvitationDBSchemaField("user_id"),
-	User:        InvitationDBSchemaField("user"),
-	Role:        InvitationDBSchemaField("role"),
+	ID:          OrganizationDBSchemaField("id"),
+	CreatedAt:   OrganizationDBSchemaField("created_at"),
+	UpdatedAt:   OrganizationDBSchemaField("updated_at"),
+	DeletedAt:   OrganizationDBSchemaField("deleted_at"),
+	CreatedByID: OrganizationDBSchemaField("created_by_id"),
+	CreatedBy:   OrganizationDBSchemaField("created_by"),
+	Name:        OrganizationDBSchemaField("name"),
+	Description: OrganizationDBSchemaField("description"),
 }
 
-// Update updates Invitation fields by primary key
+// Update updates Organization fields by primary key
 // nolint: dupl
-func (o *Invitation) Update(db *gorm.DB, fields ...InvitationDBSchemaField) error {
+func (o *Organization) Update(db *gorm.DB, fields ...OrganizationDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
 		"id":            o.ID,
 		"created_at":    o.CreatedAt,
 		"updated_at":    o.UpdatedAt,
 		"deleted_at":    o.DeletedAt,
-		"network_id":    o.NetworkID,
-		"network":       o.Network,
-		"invited_by_id": o.InvitedByID,
-		"invited_by":    o.InvitedBy,
-		"user_id":       o.UserID,
-		"user":          o.User,
-		"role":          o.Role,
+		"created_by_id": o.CreatedByID,
+		"created_by":    o.CreatedBy,
+		"name":          o.Name,
+		"description":   o.Description,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -3902,55 +11477,55 @@ func (o *Invitation) Update(db *gorm.DB, fields ...InvitationDBSchemaField) erro
 			return err
 		}
 
-		return fmt.Errorf("can't update Invitation %v fields %v: %s",
+		return fmt.Errorf("can't update Organization %v fields %v: %s",
 			o, fields, err)
 	}
 
 	return nil
 }
 
-// InvitationUpdater is an Invitation updates manager
-type InvitationUpdater struct {
+// OrganizationUpdater is an Organization updates manager
+type OrganizationUpdater struct {
 	fields map[string]interface{}
 	db     *gorm.DB
 }
 
-// NewInvitationUpdater creates new Invitation updater
+// NewOrganizationUpdater creates new Organization updater
 // nolint: dupl
-func NewInvitationUpdater(db *gorm.DB) InvitationUpdater {
-	return InvitationUpdater{
+func NewOrganizationUpdater(db *gorm.DB) OrganizationUpdater {
+	return OrganizationUpdater{
 		fields: map[string]interface{}{},
-		db:     db.Model(&Invitation{}),
+		db:     db.Model(&Organization{}),
 	}
 }
 
-// ===== END of Invitation modifiers
+// ===== END of Organization modifiers
 
-// ===== BEGIN of query set NetworkQuerySet
+// ===== BEGIN of query set OrganizationRelayQuerySet
 
-// NetworkQuerySet is an queryset type for Network
-type NetworkQuerySet struct {
+// OrganizationRelayQuerySet is an queryset type for OrganizationRelay
+type OrganizationRelayQuerySet struct {
 	db *gorm.DB
 }
 
-// NewNetworkQuerySet constructs new NetworkQuerySet
-func NewNetworkQuerySet(db *gorm.DB) NetworkQuerySet {
-	return NetworkQuerySet{
-		db: db.Model(&Network{}),
+// NewOrganizationRelayQuerySet constructs new OrganizationRelayQuerySet
+func NewOrganizationRelayQuerySet(db *gorm.DB) OrganizationRelayQuerySet {
+	return OrganizationRelayQuerySet{
+		db: db.Model(&OrganizationRelay{}),
 	}
 }
 
-func (qs NetworkQuerySet) w(db *gorm.DB) NetworkQuerySet {
-	return NewNetworkQuerySet(db)
+func (qs OrganizationRelayQuerySet) w(db *gorm.DB) OrganizationRelayQuerySet {
+	return NewOrganizationRelayQuerySet(db)
 }
 
-func (qs NetworkQuerySet) Preload(query string, args ...interface{}) NetworkQuerySet {
-	return NewNetworkQuerySet(qs.db.Preload(query, args...))
+func (qs OrganizationRelayQuerySet) Preload(query string, args ...interface{}) OrganizationRelayQuerySet {
+	return NewOrganizationRelayQuerySet(qs.db.Preload(query, args...))
 }
 
 // Preload preload associations with given conditions
 //    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
-func (qs NetworkQuerySet) Select(fields ...NetworkDBSchemaField) NetworkQuerySet {
+func (qs OrganizationRelayQuerySet) Select(fields ...OrganizationRelayDBSchemaField) OrganizationRelayQuerySet {
 	names := []string{}
 	for _, f := range fields {
 		names = append(names, f.String())
@@ -3959,673 +11534,571 @@ func (qs NetworkQuerySet) Select(fields ...NetworkDBSchemaField) NetworkQuerySet
 	return qs.w(qs.db.Select(strings.Join(names, ",")))
 }
 
-// Create is an autogenerated method
-// nolint: dupl
-func (o *Network) Create(db *gorm.DB) error {
-	return db.Create(o).Error
-}
-
-// Delete is an autogenerated method
-// nolint: dupl
-func (o *Network) Delete(db *gorm.DB) error {
-	return db.Delete(o).Error
-}
-
-// All is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) All(ret *[]Network) error {
-	return qs.db.Find(ret).Error
-}
-
-// Count is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) Count() (int64, error) {
-	var count int64
-	err := qs.db.Count(&count).Error
-	return count, err
-}
-
-// CreatedAtEq is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedAtEq(createdAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_at` = ?", createdAt))
-}
-
-// CreatedAtGt is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedAtGt(createdAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_at` > ?", createdAt))
-}
-
-// CreatedAtGte is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedAtGte(createdAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_at` >= ?", createdAt))
-}
-
-// CreatedAtLt is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedAtLt(createdAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_at` < ?", createdAt))
-}
-
-// CreatedAtLte is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedAtLte(createdAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_at` <= ?", createdAt))
-}
-
-// CreatedAtNe is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedAtNe(createdAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_at` != ?", createdAt))
-}
-
-// CreatedByIDEq is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedByIDEq(createdByID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_by_id` = ?", createdByID))
-}
-
-// CreatedByIDGt is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedByIDGt(createdByID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_by_id` > ?", createdByID))
-}
-
-// CreatedByIDGte is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedByIDGte(createdByID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_by_id` >= ?", createdByID))
-}
-
-// CreatedByIDIn is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedByIDIn(createdByID ...ID) NetworkQuerySet {
-	if len(createdByID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one createdByID in CreatedByIDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("created_by_id IN (?)", createdByID))
-}
-
-// CreatedByIDLt is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedByIDLt(createdByID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_by_id` < ?", createdByID))
-}
-
-// CreatedByIDLte is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedByIDLte(createdByID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_by_id` <= ?", createdByID))
-}
-
-// CreatedByIDNe is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedByIDNe(createdByID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`created_by_id` != ?", createdByID))
-}
-
-// CreatedByIDNotIn is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedByIDNotIn(createdByID ...ID) NetworkQuerySet {
-	if len(createdByID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one createdByID in CreatedByIDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("created_by_id NOT IN (?)", createdByID))
-}
-
-// CreatedByIsNotNull is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedByIsNotNull() NetworkQuerySet {
-	return qs.w(qs.db.Where("created_by IS NOT NULL"))
-}
-
-// CreatedByIsNull is an autogenerated method
-// nolint: dupl
-func (qs NetworkQuerySet) CreatedByIsNull() NetworkQuerySet {
-	return qs.w(qs.db.Where("created_by IS NULL"))
-}
-
-// Delete is an autogenerated method
+// Create is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) Delete() error {
-	return qs.db.Delete(Network{}).Error
+func (o *OrganizationRelay) Create(db *gorm.DB) error {
+	return db.Create(o).Error
 }
 
-// DeleteNum is an autogenerated method
+// Delete is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(Network{})
-	return db.RowsAffected, db.Error
+func (o *OrganizationRelay) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
 }
 
-// DeleteNumUnscoped is an autogenerated method
+// All is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(Network{})
-	return db.RowsAffected, db.Error
+func (qs OrganizationRelayQuerySet) All(ret *[]OrganizationRelay) error {
+	return qs.db.Find(ret).Error
 }
 
-// DeletedAtEq is an autogenerated method
+// Count is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DeletedAtEq(deletedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` = ?", deletedAt))
+func (qs OrganizationRelayQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
 }
 
-// DeletedAtGt is an autogenerated method
+// CreatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DeletedAtGt(deletedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` > ?", deletedAt))
+func (qs OrganizationRelayQuerySet) CreatedAtEq(createdAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
 }
 
-// DeletedAtGte is an autogenerated method
+// CreatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DeletedAtGte(deletedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` >= ?", deletedAt))
+func (qs OrganizationRelayQuerySet) CreatedAtGt(createdAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
 }
 
-// DeletedAtIsNotNull is an autogenerated method
+// CreatedAtGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DeletedAtIsNotNull() NetworkQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+func (qs OrganizationRelayQuerySet) CreatedAtGte(createdAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
 }
 
-// DeletedAtIsNull is an autogenerated method
+// CreatedAtLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DeletedAtIsNull() NetworkQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NULL"))
+func (qs OrganizationRelayQuerySet) CreatedAtLt(createdAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
 }
 
-// DeletedAtLt is an autogenerated method
+// CreatedAtLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DeletedAtLt(deletedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` < ?", deletedAt))
+func (qs OrganizationRelayQuerySet) CreatedAtLte(createdAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
 }
 
-// DeletedAtLte is an autogenerated method
+// CreatedAtNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DeletedAtLte(deletedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` <= ?", deletedAt))
+func (qs OrganizationRelayQuerySet) CreatedAtNe(createdAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
 }
 
-// DeletedAtNe is an autogenerated method
+// Delete is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DeletedAtNe(deletedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` != ?", deletedAt))
+func (qs OrganizationRelayQuerySet) Delete() error {
+	return qs.db.Delete(OrganizationRelay{}).Error
 }
 
-// DescriptionEq is an autogenerated method
+// DeleteNum is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DescriptionEq(description string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`description` = ?", description))
+func (qs OrganizationRelayQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(OrganizationRelay{})
+	return db.RowsAffected, db.Error
 }
 
-// DescriptionGt is an autogenerated method
+// DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DescriptionGt(description string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`description` > ?", description))
+func (qs OrganizationRelayQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(OrganizationRelay{})
+	return db.RowsAffected, db.Error
 }
 
-// DescriptionGte is an autogenerated method
+// DeletedAtEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DescriptionGte(description string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`description` >= ?", description))
+func (qs OrganizationRelayQuerySet) DeletedAtEq(deletedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
 }
 
-// DescriptionIn is an autogenerated method
+// DeletedAtGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DescriptionIn(description ...string) NetworkQuerySet {
-	if len(description) == 0 {
-		qs.db.AddError(errors.New("must at least pass one description in DescriptionIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("description IN (?)", description))
+func (qs OrganizationRelayQuerySet) DeletedAtGt(deletedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
 }
 
-// DescriptionLike is an autogenerated method
+// DeletedAtGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DescriptionLike(description string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`description` LIKE ?", description))
+func (qs OrganizationRelayQuerySet) DeletedAtGte(deletedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
 }
 
-// DescriptionLt is an autogenerated method
+// DeletedAtIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DescriptionLt(description string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`description` < ?", description))
+func (qs OrganizationRelayQuerySet) DeletedAtIsNotNull() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
 }
 
-// DescriptionLte is an autogenerated method
+// DeletedAtIsNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DescriptionLte(description string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`description` <= ?", description))
+func (qs OrganizationRelayQuerySet) DeletedAtIsNull() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
 }
 
-// DescriptionNe is an autogenerated method
+// DeletedAtLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DescriptionNe(description string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`description` != ?", description))
+func (qs OrganizationRelayQuerySet) DeletedAtLt(deletedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
 }
 
-// DescriptionNotIn is an autogenerated method
+// DeletedAtLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DescriptionNotIn(description ...string) NetworkQuerySet {
-	if len(description) == 0 {
-		qs.db.AddError(errors.New("must at least pass one description in DescriptionNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("description NOT IN (?)", description))
+func (qs OrganizationRelayQuerySet) DeletedAtLte(deletedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
 }
 
-// DescriptionNotlike is an autogenerated method
+// DeletedAtNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) DescriptionNotlike(description string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`description` NOT LIKE ?", description))
+func (qs OrganizationRelayQuerySet) DeletedAtNe(deletedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
 }
 
 // GetDB is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) GetDB() *gorm.DB {
+func (qs OrganizationRelayQuerySet) GetDB() *gorm.DB {
 	return qs.db
 }
 
 // GetUpdater is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) GetUpdater() NetworkUpdater {
-	return NewNetworkUpdater(qs.db)
+func (qs OrganizationRelayQuerySet) GetUpdater() OrganizationRelayUpdater {
+	return NewOrganizationRelayUpdater(qs.db)
 }
 
 // IDEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) IDEq(ID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`id` = ?", ID))
+func (qs OrganizationRelayQuerySet) IDEq(ID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
 }
 
 // IDGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) IDGt(ID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`id` > ?", ID))
+func (qs OrganizationRelayQuerySet) IDGt(ID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
 }
 
 // IDGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) IDGte(ID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`id` >= ?", ID))
+func (qs OrganizationRelayQuerySet) IDGte(ID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
 }
 
 // IDIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) IDIn(ID ...ID) NetworkQuerySet {
+func (qs OrganizationRelayQuerySet) IDIn(ID ...ID) OrganizationRelayQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // IDLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) IDLt(ID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`id` < ?", ID))
+func (qs OrganizationRelayQuerySet) IDLt(ID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
 }
 
 // IDLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) IDLte(ID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`id` <= ?", ID))
+func (qs OrganizationRelayQuerySet) IDLte(ID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
 }
 
 // IDNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) IDNe(ID ID) NetworkQuerySet {
-	return qs.w(qs.db.Where("`id` != ?", ID))
+func (qs OrganizationRelayQuerySet) IDNe(ID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
 }
 
 // IDNotIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) IDNotIn(ID ...ID) NetworkQuerySet {
+func (qs OrganizationRelayQuerySet) IDNotIn(ID ...ID) OrganizationRelayQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // Limit is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) Limit(limit int) NetworkQuerySet {
+func (qs OrganizationRelayQuerySet) Limit(limit int) OrganizationRelayQuerySet {
 	return qs.w(qs.db.Limit(limit))
 }
 
-// NameEq is an autogenerated method
+// Offset is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) NameEq(name string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`name` = ?", name))
+func (qs OrganizationRelayQuerySet) Offset(offset int) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Offset(offset))
 }
 
-// NameGt is an autogenerated method
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs OrganizationRelayQuerySet) One(ret *OrganizationRelay) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) NameGt(name string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`name` > ?", name))
+func (qs OrganizationRelayQuerySet) OrderAscByCreatedAt() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
 }
 
-// NameGte is an autogenerated method
+// OrderAscByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) NameGte(name string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`name` >= ?", name))
+func (qs OrganizationRelayQuerySet) OrderAscByDeletedAt() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
 }
 
-// NameIn is an autogenerated method
+// OrderAscByID is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) NameIn(name ...string) NetworkQuerySet {
-	if len(name) == 0 {
-		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("name IN (?)", name))
+func (qs OrganizationRelayQuerySet) OrderAscByID() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
 }
 
-// NameLike is an autogenerated method
+// OrderAscByOrganizationID is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) NameLike(name string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`name` LIKE ?", name))
+func (qs OrganizationRelayQuerySet) OrderAscByOrganizationID() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}}))
 }
 
-// NameLt is an autogenerated method
+// OrderAscByRelayServerID is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) NameLt(name string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`name` < ?", name))
+func (qs OrganizationRelayQuerySet) OrderAscByRelayServerID() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "relay_server_id"}}))
 }
 
-// NameLte is an autogenerated method
+// OrderAscByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) NameLte(name string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`name` <= ?", name))
+func (qs OrganizationRelayQuerySet) OrderAscByUpdatedAt() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
 }
 
-// NameNe is an autogenerated method
+// OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) NameNe(name string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`name` != ?", name))
+func (qs OrganizationRelayQuerySet) OrderDescByCreatedAt() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
 }
 
-// NameNotIn is an autogenerated method
+// OrderDescByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) NameNotIn(name ...string) NetworkQuerySet {
-	if len(name) == 0 {
-		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("name NOT IN (?)", name))
+func (qs OrganizationRelayQuerySet) OrderDescByDeletedAt() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
 }
 
-// NameNotlike is an autogenerated method
+// OrderDescByID is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) NameNotlike(name string) NetworkQuerySet {
-	return qs.w(qs.db.Where("`name` NOT LIKE ?", name))
+func (qs OrganizationRelayQuerySet) OrderDescByID() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
 }
 
-// Offset is an autogenerated method
+// OrderDescByOrganizationID is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) Offset(offset int) NetworkQuerySet {
-	return qs.w(qs.db.Offset(offset))
+func (qs OrganizationRelayQuerySet) OrderDescByOrganizationID() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}, Desc: true}))
 }
 
-// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
-// if nothing was fetched
-func (qs NetworkQuerySet) One(ret *Network) error {
-	return qs.db.First(ret).Error
+// OrderDescByRelayServerID is an autogenerated method
+// nolint: dupl
+func (qs OrganizationRelayQuerySet) OrderDescByRelayServerID() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "relay_server_id"}, Desc: true}))
 }
 
-// OrderAscByCreatedAt is an autogenerated method
+// OrderDescByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderAscByCreatedAt() NetworkQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+func (qs OrganizationRelayQuerySet) OrderDescByUpdatedAt() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
 }
 
-// OrderAscByCreatedByID is an autogenerated method
+// OrganizationIDEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderAscByCreatedByID() NetworkQuerySet {
-	return qs.w(qs.db.Order("created_by_id ASC"))
+func (qs OrganizationRelayQuerySet) OrganizationIDEq(organizationID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderAscByDeletedAt is an autogenerated method
+// OrganizationIDGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderAscByDeletedAt() NetworkQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+func (qs OrganizationRelayQuerySet) OrganizationIDGt(organizationID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderAscByDescription is an autogenerated method
+// OrganizationIDGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderAscByDescription() NetworkQuerySet {
-	return qs.w(qs.db.Order("description ASC"))
+func (qs OrganizationRelayQuerySet) OrganizationIDGte(organizationID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderAscByID is an autogenerated method
+// OrganizationIDIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderAscByID() NetworkQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+func (qs OrganizationRelayQuerySet) OrganizationIDIn(organizationID ...ID) OrganizationRelayQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "organization_id"}, organizationID))
 }
 
-// OrderAscByName is an autogenerated method
+// OrganizationIDLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderAscByName() NetworkQuerySet {
-	return qs.w(qs.db.Order("name ASC"))
+func (qs OrganizationRelayQuerySet) OrganizationIDLt(organizationID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderAscByUpdatedAt is an autogenerated method
+// OrganizationIDLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderAscByUpdatedAt() NetworkQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+func (qs OrganizationRelayQuerySet) OrganizationIDLte(organizationID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderDescByCreatedAt is an autogenerated method
+// OrganizationIDNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderDescByCreatedAt() NetworkQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+func (qs OrganizationRelayQuerySet) OrganizationIDNe(organizationID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderDescByCreatedByID is an autogenerated method
+// OrganizationIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderDescByCreatedByID() NetworkQuerySet {
-	return qs.w(qs.db.Order("created_by_id DESC"))
+func (qs OrganizationRelayQuerySet) OrganizationIDNotIn(organizationID ...ID) OrganizationRelayQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "organization_id"}, organizationID))
 }
 
-// OrderDescByDeletedAt is an autogenerated method
+// PreloadRelayServer is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderDescByDeletedAt() NetworkQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+func (qs OrganizationRelayQuerySet) PreloadRelayServer() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Preload("RelayServer"))
 }
 
-// OrderDescByDescription is an autogenerated method
+// RelayServerIDEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderDescByDescription() NetworkQuerySet {
-	return qs.w(qs.db.Order("description DESC"))
+func (qs OrganizationRelayQuerySet) RelayServerIDEq(relayServerID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "relay_server_id", Value: relayServerID}))
 }
 
-// OrderDescByID is an autogenerated method
+// RelayServerIDGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderDescByID() NetworkQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (qs OrganizationRelayQuerySet) RelayServerIDGt(relayServerID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "relay_server_id", Value: relayServerID}))
 }
 
-// OrderDescByName is an autogenerated method
+// RelayServerIDGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderDescByName() NetworkQuerySet {
-	return qs.w(qs.db.Order("name DESC"))
+func (qs OrganizationRelayQuerySet) RelayServerIDGte(relayServerID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "relay_server_id", Value: relayServerID}))
 }
 
-// OrderDescByUpdatedAt is an autogenerated method
+// RelayServerIDIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) OrderDescByUpdatedAt() NetworkQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+func (qs OrganizationRelayQuerySet) RelayServerIDIn(relayServerID ...ID) OrganizationRelayQuerySet {
+	if len(relayServerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one relayServerID in RelayServerIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "relay_server_id"}, relayServerID))
 }
 
-// PreloadCreatedBy is an autogenerated method
+// RelayServerIDLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) PreloadCreatedBy() NetworkQuerySet {
-	return qs.w(qs.db.Preload("CreatedBy"))
+func (qs OrganizationRelayQuerySet) RelayServerIDLt(relayServerID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "relay_server_id", Value: relayServerID}))
+}
+
+// RelayServerIDLte is an autogenerated method
+// nolint: dupl
+func (qs OrganizationRelayQuerySet) RelayServerIDLte(relayServerID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "relay_server_id", Value: relayServerID}))
+}
+
+// RelayServerIDNe is an autogenerated method
+// nolint: dupl
+func (qs OrganizationRelayQuerySet) RelayServerIDNe(relayServerID ID) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "relay_server_id", Value: relayServerID}))
+}
+
+// RelayServerIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs OrganizationRelayQuerySet) RelayServerIDNotIn(relayServerID ...ID) OrganizationRelayQuerySet {
+	if len(relayServerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one relayServerID in RelayServerIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "relay_server_id"}, relayServerID))
+}
+
+// RelayServerIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs OrganizationRelayQuerySet) RelayServerIsNotNull() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "relay_server", Value: nil}))
+}
+
+// RelayServerIsNull is an autogenerated method
+// nolint: dupl
+func (qs OrganizationRelayQuerySet) RelayServerIsNull() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "relay_server", Value: nil}))
 }
 
 // UpdatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) UpdatedAtEq(updatedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`updated_at` = ?", updatedAt))
+func (qs OrganizationRelayQuerySet) UpdatedAtEq(updatedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) UpdatedAtGt(updatedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`updated_at` > ?", updatedAt))
+func (qs OrganizationRelayQuerySet) UpdatedAtGt(updatedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) UpdatedAtGte(updatedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`updated_at` >= ?", updatedAt))
+func (qs OrganizationRelayQuerySet) UpdatedAtGte(updatedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) UpdatedAtIsNotNull() NetworkQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NOT NULL"))
+func (qs OrganizationRelayQuerySet) UpdatedAtIsNotNull() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
 }
 
 // UpdatedAtIsNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) UpdatedAtIsNull() NetworkQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NULL"))
+func (qs OrganizationRelayQuerySet) UpdatedAtIsNull() OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
 }
 
 // UpdatedAtLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) UpdatedAtLt(updatedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`updated_at` < ?", updatedAt))
+func (qs OrganizationRelayQuerySet) UpdatedAtLt(updatedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) UpdatedAtLte(updatedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`updated_at` <= ?", updatedAt))
+func (qs OrganizationRelayQuerySet) UpdatedAtLte(updatedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkQuerySet) UpdatedAtNe(updatedAt time.Time) NetworkQuerySet {
-	return qs.w(qs.db.Where("`updated_at` != ?", updatedAt))
+func (qs OrganizationRelayQuerySet) UpdatedAtNe(updatedAt time.Time) OrganizationRelayQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
 }
 
 // SetCreatedAt is an autogenerated method
 // nolint: dupl
-func (u NetworkUpdater) SetCreatedAt(createdAt time.Time) NetworkUpdater {
-	u.fields[string(NetworkDBSchema.CreatedAt)] = createdAt
-	return u
-}
-
-// SetCreatedByID is an autogenerated method
-// nolint: dupl
-func (u NetworkUpdater) SetCreatedByID(createdByID ID) NetworkUpdater {
-	u.fields[string(NetworkDBSchema.CreatedByID)] = createdByID
+func (u OrganizationRelayUpdater) SetCreatedAt(createdAt time.Time) OrganizationRelayUpdater {
+	u.fields[string(OrganizationRelayDBSchema.CreatedAt)] = createdAt
 	return u
 }
 
 // SetDeletedAt is an autogenerated method
 // nolint: dupl
-func (u NetworkUpdater) SetDeletedAt(deletedAt *time.Time) NetworkUpdater {
-	u.fields[string(NetworkDBSchema.DeletedAt)] = deletedAt
+func (u OrganizationRelayUpdater) SetDeletedAt(deletedAt *time.Time) OrganizationRelayUpdater {
+	u.fields[string(OrganizationRelayDBSchema.DeletedAt)] = deletedAt
 	return u
 }
 
-// SetDescription is an autogenerated method
+// SetID is an autogenerated method
 // nolint: dupl
-func (u NetworkUpdater) SetDescription(description string) NetworkUpdater {
-	u.fields[string(NetworkDBSchema.Description)] = description
+func (u OrganizationRelayUpdater) SetID(ID ID) OrganizationRelayUpdater {
+	u.fields[string(OrganizationRelayDBSchema.ID)] = ID
 	return u
 }
 
-// SetID is an autogenerated method
+// SetOrganizationID is an autogenerated method
 // nolint: dupl
-func (u NetworkUpdater) SetID(ID ID) NetworkUpdater {
-	u.fields[string(NetworkDBSchema.ID)] = ID
+func (u OrganizationRelayUpdater) SetOrganizationID(organizationID ID) OrganizationRelayUpdater {
+	u.fields[string(OrganizationRelayDBSchema.OrganizationID)] = organizationID
 	return u
 }
 
-// SetName is an autogenerated method
+// SetRelayServerID is an autogenerated method
 // nolint: dupl
-func (u NetworkUpdater) SetName(name string) NetworkUpdater {
-	u.fields[string(NetworkDBSchema.Name)] = name
+func (u OrganizationRelayUpdater) SetRelayServerID(relayServerID ID) OrganizationRelayUpdater {
+	u.fields[string(OrganizationRelayDBSchema.RelayServerID)] = relayServerID
 	return u
 }
 
 // SetUpdatedAt is an autogenerated method
 // nolint: dupl
-func (u NetworkUpdater) SetUpdatedAt(updatedAt *time.Time) NetworkUpdater {
-	u.fields[string(NetworkDBSchema.UpdatedAt)] = updatedAt
+func (u OrganizationRelayUpdater) SetUpdatedAt(updatedAt *time.Time) OrganizationRelayUpdater {
+	u.fields[string(OrganizationRelayDBSchema.UpdatedAt)] = updatedAt
 	return u
 }
 
 // Update is an autogenerated method
 // nolint: dupl
-func (u NetworkUpdater) Update() error {
+func (u OrganizationRelayUpdater) Update() error {
 	return u.db.Updates(u.fields).Error
 }
 
 // UpdateNum is an autogenerated method
 // nolint: dupl
-func (u NetworkUpdater) UpdateNum() (int64, error) {
+func (u OrganizationRelayUpdater) UpdateNum() (int64, error) {
 	db := u.db.Updates(u.fields)
 	return db.RowsAffected, db.Error
 }
 
-// ===== END of query set NetworkQuerySet
+// ===== END of query set OrganizationRelayQuerySet
 
-// ===== BEGIN of Network modifiers
+// ===== BEGIN of OrganizationRelay modifiers
 
-// NetworkDBSchemaField describes database schema field. It requires for method 'Update'
-type NetworkDBSchemaField string
+// OrganizationRelayDBSchemaField describes database schema field. It requires for method 'Update'
+type OrganizationRelayDBSchemaField string
 
 // String method returns string representation of field.
 // nolint: dupl
-func (f NetworkDBSchemaField) String() string {
+func (f OrganizationRelayDBSchemaField) String() string {
 	return string(f)
 }
 
-// NetworkDBSchema stores db field names of Network
-var NetworkDBSchema = struct {
-	ID          NetworkDBSchemaField
-	CreatedAt   NetworkDBSchemaField
-	UpdatedAt   NetworkDBSchemaField
-	DeletedAt   NetworkDBSchemaField
-	CreatedByID NetworkDBSchemaField
-	CreatedBy   NetworkDBSchemaField
-	Name        NetworkDBSchemaField
-	Description NetworkDBSchemaField
+// OrganizationRelayDBSchema stores db field names of OrganizationRelay
+var OrganizationRelayDBSchema = struct {
+	ID             OrganizationRelayDBSchemaField
+	CreatedAt      OrganizationRelayDBSchemaField
+	UpdatedAt      OrganizationRelayDBSchemaField
+	DeletedAt      OrganizationRelayDBSchemaField
+	OrganizationID OrganizationRelayDBSchemaField
+	RelayServerID  OrganizationRelayDBSchemaField
+	RelayServer    OrganizationRelayDBSchemaField
 }{
 
-	ID:          NetworkDBSchemaField("id"),
-	CreatedAt:   NetworkDBSchemaField("created_at"),
-	UpdatedAt:   NetworkDBSchemaField("updated_at"),
-	DeletedAt:   NetworkDBSchemaField("deleted_at"),
-	CreatedByID: NetworkDBSchemaField("created_by_id"),
-	CreatedBy:   NetworkDBSchemaField("created_by"),
-	Name:        NetworkDBSchemaField("name"),
-	Description: NetworkDBSchemaField("description"),
+	ID:             OrganizationRelayDBSchemaField("id"),
+	CreatedAt:      OrganizationRelayDBSchemaField("created_at"),
+	UpdatedAt:      OrganizationRelayDBSchemaField("updated_at"),
+	DeletedAt:      OrganizationRelayDBSchemaField("deleted_at"),
+	OrganizationID: OrganizationRelayDBSchemaField("organization_id"),
+	RelayServerID:  OrganizationRelayDBSchemaField("relay_server_id"),
+	RelayServer:    OrganizationRelayDBSchemaField("relay_server"),
 }
 
-// Update updates Network fields by primary key
+// Update updates OrganizationRelay fields by primary key
 // nolint: dupl
-func (o *Network) Update(db *gorm.DB, fields ...NetworkDBSchemaField) error {
+func (o *OrganizationRelay) Update(db *gorm.DB, fields ...OrganizationRelayDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
-		"id":            o.ID,
-		"created_at":    o.CreatedAt,
-		"updated_at":    o.UpdatedAt,
-		"deleted_at":    o.DeletedAt,
-		"created_by_id": o.CreatedByID,
-		"created_by":    o.CreatedBy,
-		"name":          o.Name,
-		"description":   o.Description,
+		"id":              o.ID,
+		"created_at":      o.CreatedAt,
+		"updated_at":      o.UpdatedAt,
+		"deleted_at":      o.DeletedAt,
+		"organization_id": o.OrganizationID,
+		"relay_server_id": o.RelayServerID,
+		"relay_server":    o.RelayServer,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -4637,55 +12110,55 @@ func (o *Network) Update(db *gorm.DB, fields ...NetworkDBSchemaField) error {
 			return err
 		}
 
-		return fmt.Errorf("can't update Network %v fields %v: %s",
+		return fmt.Errorf("can't update OrganizationRelay %v fields %v: %s",
 			o, fields, err)
 	}
 
 	return nil
 }
 
-// NetworkUpdater is an Network updates manager
-type NetworkUpdater struct {
+// OrganizationRelayUpdater is an OrganizationRelay updates manager
+type OrganizationRelayUpdater struct {
 	fields map[string]interface{}
 	db     *gorm.DB
 }
 
-// NewNetworkUpdater creates new Network updater
+// NewOrganizationRelayUpdater creates new OrganizationRelay updater
 // nolint: dupl
-func NewNetworkUpdater(db *gorm.DB) NetworkUpdater {
-	return NetworkUpdater{
+func NewOrganizationRelayUpdater(db *gorm.DB) OrganizationRelayUpdater {
+	return OrganizationRelayUpdater{
 		fields: map[string]interface{}{},
-		db:     db.Model(&Network{}),
+		db:     db.Model(&OrganizationRelay{}),
 	}
 }
 
-// ===== END of Network modifiers
+// ===== END of OrganizationRelay modifiers
 
-// ===== BEGIN of query set NetworkUserQuerySet
+// ===== BEGIN of query set OrganizationUserQuerySet
 
-// NetworkUserQuerySet is an queryset type for NetworkUser
-type NetworkUserQuerySet struct {
+// OrganizationUserQuerySet is an queryset type for OrganizationUser
+type OrganizationUserQuerySet struct {
 	db *gorm.DB
 }
 
-// NewNetworkUserQuerySet constructs new NetworkUserQuerySet
-func NewNetworkUserQuerySet(db *gorm.DB) NetworkUserQuerySet {
-	return NetworkUserQuerySet{
-		db: db.Model(&NetworkUser{}),
+// NewOrganizationUserQuerySet constructs new OrganizationUserQuerySet
+func NewOrganizationUserQuerySet(db *gorm.DB) OrganizationUserQuerySet {
+	return OrganizationUserQuerySet{
+		db: db.Model(&OrganizationUser{}),
 	}
 }
 
-func (qs NetworkUserQuerySet) w(db *gorm.DB) NetworkUserQuerySet {
-	return NewNetworkUserQuerySet(db)
+func (qs OrganizationUserQuerySet) w(db *gorm.DB) OrganizationUserQuerySet {
+	return NewOrganizationUserQuerySet(db)
 }
 
-func (qs NetworkUserQuerySet) Preload(query string, args ...interface{}) NetworkUserQuerySet {
-	return NewNetworkUserQuerySet(qs.db.Preload(query, args...))
+func (qs OrganizationUserQuerySet) Preload(query string, args ...interface{}) OrganizationUserQuerySet {
+	return NewOrganizationUserQuerySet(qs.db.Preload(query, args...))
 }
 
 // Preload preload associations with given conditions
 //    db.Preload("Orders", "state NOT IN (?)", "cancelled").Find(&users)
-func (qs NetworkUserQuerySet) Select(fields ...NetworkUserDBSchemaField) NetworkUserQuerySet {
+func (qs OrganizationUserQuerySet) Select(fields ...OrganizationUserDBSchemaField) OrganizationUserQuerySet {
 	names := []string{}
 	for _, f := range fields {
 		names = append(names, f.String())
@@ -4696,25 +12169,25 @@ func (qs NetworkUserQuerySet) Select(fields ...NetworkUserDBSchemaField) Network
 
 // Create is an autogenerated method
 // nolint: dupl
-func (o *NetworkUser) Create(db *gorm.DB) error {
+func (o *OrganizationUser) Create(db *gorm.DB) error {
 	return db.Create(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (o *NetworkUser) Delete(db *gorm.DB) error {
+func (o *OrganizationUser) Delete(db *gorm.DB) error {
 	return db.Delete(o).Error
 }
 
 // All is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) All(ret *[]NetworkUser) error {
+func (qs OrganizationUserQuerySet) All(ret *[]OrganizationUser) error {
 	return qs.db.Find(ret).Error
 }
 
 // Count is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) Count() (int64, error) {
+func (qs OrganizationUserQuerySet) Count() (int64, error) {
 	var count int64
 	err := qs.db.Count(&count).Error
 	return count, err
@@ -4722,654 +12195,654 @@ func (qs NetworkUserQuerySet) Count() (int64, error) {
 
 // CreatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) CreatedAtEq(createdAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` = ?", createdAt))
+func (qs OrganizationUserQuerySet) CreatedAtEq(createdAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) CreatedAtGt(createdAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` > ?", createdAt))
+func (qs OrganizationUserQuerySet) CreatedAtGt(createdAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) CreatedAtGte(createdAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` >= ?", createdAt))
+func (qs OrganizationUserQuerySet) CreatedAtGte(createdAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) CreatedAtLt(createdAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` < ?", createdAt))
+func (qs OrganizationUserQuerySet) CreatedAtLt(createdAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) CreatedAtLte(createdAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` <= ?", createdAt))
+func (qs OrganizationUserQuerySet) CreatedAtLte(createdAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) CreatedAtNe(createdAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` != ?", createdAt))
+func (qs OrganizationUserQuerySet) CreatedAtNe(createdAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) Delete() error {
-	return qs.db.Delete(NetworkUser{}).Error
+func (qs OrganizationUserQuerySet) Delete() error {
+	return qs.db.Delete(OrganizationUser{}).Error
 }
 
 // DeleteNum is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(NetworkUser{})
+func (qs OrganizationUserQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(OrganizationUser{})
 	return db.RowsAffected, db.Error
 }
 
 // DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(NetworkUser{})
+func (qs OrganizationUserQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(OrganizationUser{})
 	return db.RowsAffected, db.Error
 }
 
 // DeletedAtEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) DeletedAtEq(deletedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` = ?", deletedAt))
+func (qs OrganizationUserQuerySet) DeletedAtEq(deletedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) DeletedAtGt(deletedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` > ?", deletedAt))
+func (qs OrganizationUserQuerySet) DeletedAtGt(deletedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) DeletedAtGte(deletedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` >= ?", deletedAt))
+func (qs OrganizationUserQuerySet) DeletedAtGte(deletedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) DeletedAtIsNotNull() NetworkUserQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+func (qs OrganizationUserQuerySet) DeletedAtIsNotNull() OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtIsNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) DeletedAtIsNull() NetworkUserQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NULL"))
+func (qs OrganizationUserQuerySet) DeletedAtIsNull() OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) DeletedAtLt(deletedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` < ?", deletedAt))
+func (qs OrganizationUserQuerySet) DeletedAtLt(deletedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) DeletedAtLte(deletedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` <= ?", deletedAt))
+func (qs OrganizationUserQuerySet) DeletedAtLte(deletedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) DeletedAtNe(deletedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` != ?", deletedAt))
+func (qs OrganizationUserQuerySet) DeletedAtNe(deletedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
 }
 
 // GetDB is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) GetDB() *gorm.DB {
+func (qs OrganizationUserQuerySet) GetDB() *gorm.DB {
 	return qs.db
 }
 
 // GetUpdater is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) GetUpdater() NetworkUserUpdater {
-	return NewNetworkUserUpdater(qs.db)
+func (qs OrganizationUserQuerySet) GetUpdater() OrganizationUserUpdater {
+	return NewOrganizationUserUpdater(qs.db)
 }
 
 // IDEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) IDEq(ID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`id` = ?", ID))
+func (qs OrganizationUserQuerySet) IDEq(ID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
 }
 
 // IDGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) IDGt(ID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`id` > ?", ID))
+func (qs OrganizationUserQuerySet) IDGt(ID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
 }
 
 // IDGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) IDGte(ID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`id` >= ?", ID))
+func (qs OrganizationUserQuerySet) IDGte(ID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
 }
 
 // IDIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) IDIn(ID ...ID) NetworkUserQuerySet {
+func (qs OrganizationUserQuerySet) IDIn(ID ...ID) OrganizationUserQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // IDLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) IDLt(ID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`id` < ?", ID))
+func (qs OrganizationUserQuerySet) IDLt(ID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
 }
 
 // IDLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) IDLte(ID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`id` <= ?", ID))
+func (qs OrganizationUserQuerySet) IDLte(ID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
 }
 
 // IDNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) IDNe(ID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`id` != ?", ID))
+func (qs OrganizationUserQuerySet) IDNe(ID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
 }
 
 // IDNotIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) IDNotIn(ID ...ID) NetworkUserQuerySet {
+func (qs OrganizationUserQuerySet) IDNotIn(ID ...ID) OrganizationUserQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // Limit is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) Limit(limit int) NetworkUserQuerySet {
+func (qs OrganizationUserQuerySet) Limit(limit int) OrganizationUserQuerySet {
 	return qs.w(qs.db.Limit(limit))
 }
 
-// NetworkIDEq is an autogenerated method
+// Offset is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) NetworkIDEq(networkID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`network_id` = ?", networkID))
+func (qs OrganizationUserQuerySet) Offset(offset int) OrganizationUserQuerySet {
+	return qs.w(qs.db.Offset(offset))
 }
 
-// NetworkIDGt is an autogenerated method
-// nolint: dupl
-func (qs NetworkUserQuerySet) NetworkIDGt(networkID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`network_id` > ?", networkID))
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs OrganizationUserQuerySet) One(ret *OrganizationUser) error {
+	return qs.db.First(ret).Error
 }
 
-// NetworkIDGte is an autogenerated method
+// OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) NetworkIDGte(networkID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`network_id` >= ?", networkID))
+func (qs OrganizationUserQuerySet) OrderAscByCreatedAt() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
 }
 
-// NetworkIDIn is an autogenerated method
+// OrderAscByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) NetworkIDIn(networkID ...ID) NetworkUserQuerySet {
-	if len(networkID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("network_id IN (?)", networkID))
+func (qs OrganizationUserQuerySet) OrderAscByDeletedAt() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
 }
 
-// NetworkIDLt is an autogenerated method
+// OrderAscByID is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) NetworkIDLt(networkID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`network_id` < ?", networkID))
+func (qs OrganizationUserQuerySet) OrderAscByID() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
 }
 
-// NetworkIDLte is an autogenerated method
+// OrderAscByOrganizationID is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) NetworkIDLte(networkID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`network_id` <= ?", networkID))
+func (qs OrganizationUserQuerySet) OrderAscByOrganizationID() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}}))
 }
 
-// NetworkIDNe is an autogenerated method
+// OrderAscByRole is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) NetworkIDNe(networkID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`network_id` != ?", networkID))
+func (qs OrganizationUserQuerySet) OrderAscByRole() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "role"}}))
 }
 
-// NetworkIDNotIn is an autogenerated method
+// OrderAscByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) NetworkIDNotIn(networkID ...ID) NetworkUserQuerySet {
-	if len(networkID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one networkID in NetworkIDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("network_id NOT IN (?)", networkID))
+func (qs OrganizationUserQuerySet) OrderAscByUpdatedAt() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
 }
 
-// NetworkIsNotNull is an autogenerated method
+// OrderAscByUserID is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) NetworkIsNotNull() NetworkUserQuerySet {
-	return qs.w(qs.db.Where("network IS NOT NULL"))
+func (qs OrganizationUserQuerySet) OrderAscByUserID() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}}))
 }
 
-// NetworkIsNull is an autogenerated method
+// OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) NetworkIsNull() NetworkUserQuerySet {
-	return qs.w(qs.db.Where("network IS NULL"))
+func (qs OrganizationUserQuerySet) OrderDescByCreatedAt() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
 }
 
-// Offset is an autogenerated method
+// OrderDescByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) Offset(offset int) NetworkUserQuerySet {
-	return qs.w(qs.db.Offset(offset))
+func (qs OrganizationUserQuerySet) OrderDescByDeletedAt() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
 }
 
-// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
-// if nothing was fetched
-func (qs NetworkUserQuerySet) One(ret *NetworkUser) error {
-	return qs.db.First(ret).Error
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs OrganizationUserQuerySet) OrderDescByID() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
 }
 
-// OrderAscByCreatedAt is an autogenerated method
+// OrderDescByOrganizationID is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderAscByCreatedAt() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+func (qs OrganizationUserQuerySet) OrderDescByOrganizationID() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "organization_id"}, Desc: true}))
 }
 
-// OrderAscByDeletedAt is an autogenerated method
+// OrderDescByRole is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderAscByDeletedAt() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+func (qs OrganizationUserQuerySet) OrderDescByRole() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "role"}, Desc: true}))
 }
 
-// OrderAscByID is an autogenerated method
+// OrderDescByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderAscByID() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+func (qs OrganizationUserQuerySet) OrderDescByUpdatedAt() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
 }
 
-// OrderAscByNetworkID is an autogenerated method
+// OrderDescByUserID is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderAscByNetworkID() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("network_id ASC"))
+func (qs OrganizationUserQuerySet) OrderDescByUserID() OrganizationUserQuerySet {
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}, Desc: true}))
 }
 
-// OrderAscByRole is an autogenerated method
+// OrganizationIDEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderAscByRole() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("role ASC"))
+func (qs OrganizationUserQuerySet) OrganizationIDEq(organizationID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderAscByUpdatedAt is an autogenerated method
+// OrganizationIDGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderAscByUpdatedAt() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+func (qs OrganizationUserQuerySet) OrganizationIDGt(organizationID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderAscByUserID is an autogenerated method
+// OrganizationIDGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderAscByUserID() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("user_id ASC"))
+func (qs OrganizationUserQuerySet) OrganizationIDGte(organizationID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderDescByCreatedAt is an autogenerated method
+// OrganizationIDIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderDescByCreatedAt() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+func (qs OrganizationUserQuerySet) OrganizationIDIn(organizationID ...ID) OrganizationUserQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "organization_id"}, organizationID))
 }
 
-// OrderDescByDeletedAt is an autogenerated method
+// OrganizationIDLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderDescByDeletedAt() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+func (qs OrganizationUserQuerySet) OrganizationIDLt(organizationID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderDescByID is an autogenerated method
+// OrganizationIDLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderDescByID() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (qs OrganizationUserQuerySet) OrganizationIDLte(organizationID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderDescByNetworkID is an autogenerated method
+// OrganizationIDNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderDescByNetworkID() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("network_id DESC"))
+func (qs OrganizationUserQuerySet) OrganizationIDNe(organizationID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "organization_id", Value: organizationID}))
 }
 
-// OrderDescByRole is an autogenerated method
+// OrganizationIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderDescByRole() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("role DESC"))
+func (qs OrganizationUserQuerySet) OrganizationIDNotIn(organizationID ...ID) OrganizationUserQuerySet {
+	if len(organizationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one organizationID in OrganizationIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "organization_id"}, organizationID))
 }
 
-// OrderDescByUpdatedAt is an autogenerated method
+// OrganizationIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderDescByUpdatedAt() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+func (qs OrganizationUserQuerySet) OrganizationIsNotNull() OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "organization", Value: nil}))
 }
 
-// OrderDescByUserID is an autogenerated method
+// OrganizationIsNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) OrderDescByUserID() NetworkUserQuerySet {
-	return qs.w(qs.db.Order("user_id DESC"))
+func (qs OrganizationUserQuerySet) OrganizationIsNull() OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "organization", Value: nil}))
 }
 
-// PreloadNetwork is an autogenerated method
+// PreloadOrganization is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) PreloadNetwork() NetworkUserQuerySet {
-	return qs.w(qs.db.Preload("Network"))
+func (qs OrganizationUserQuerySet) PreloadOrganization() OrganizationUserQuerySet {
+	return qs.w(qs.db.Preload("Organization"))
 }
 
 // PreloadUser is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) PreloadUser() NetworkUserQuerySet {
+func (qs OrganizationUserQuerySet) PreloadUser() OrganizationUserQuerySet {
 	return qs.w(qs.db.Preload("User"))
 }
 
 // RoleEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) RoleEq(role RoleType) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`role` = ?", role))
+func (qs OrganizationUserQuerySet) RoleEq(role OrganizationRoleType) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "role", Value: role}))
 }
 
 // RoleGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) RoleGt(role RoleType) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`role` > ?", role))
+func (qs OrganizationUserQuerySet) RoleGt(role OrganizationRoleType) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "role", Value: role}))
 }
 
 // RoleGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) RoleGte(role RoleType) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`role` >= ?", role))
+func (qs OrganizationUserQuerySet) RoleGte(role OrganizationRoleType) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "role", Value: role}))
 }
 
 // RoleIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) RoleIn(role ...RoleType) NetworkUserQuerySet {
+func (qs OrganizationUserQuerySet) RoleIn(role ...OrganizationRoleType) OrganizationUserQuerySet {
 	if len(role) == 0 {
 		qs.db.AddError(errors.New("must at least pass one role in RoleIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("role IN (?)", role))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "role"}, role))
 }
 
 // RoleLike is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) RoleLike(role RoleType) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`role` LIKE ?", role))
+func (qs OrganizationUserQuerySet) RoleLike(role OrganizationRoleType) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Like{Column: "role", Value: role}))
 }
 
 // RoleLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) RoleLt(role RoleType) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`role` < ?", role))
+func (qs OrganizationUserQuerySet) RoleLt(role OrganizationRoleType) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "role", Value: role}))
 }
 
 // RoleLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) RoleLte(role RoleType) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`role` <= ?", role))
+func (qs OrganizationUserQuerySet) RoleLte(role OrganizationRoleType) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "role", Value: role}))
 }
 
 // RoleNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) RoleNe(role RoleType) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`role` != ?", role))
+func (qs OrganizationUserQuerySet) RoleNe(role OrganizationRoleType) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "role", Value: role}))
 }
 
 // RoleNotIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) RoleNotIn(role ...RoleType) NetworkUserQuerySet {
+func (qs OrganizationUserQuerySet) RoleNotIn(role ...OrganizationRoleType) OrganizationUserQuerySet {
 	if len(role) == 0 {
 		qs.db.AddError(errors.New("must at least pass one role in RoleNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("role NOT IN (?)", role))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "role"}, role))
 }
 
 // RoleNotlike is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) RoleNotlike(role RoleType) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`role` NOT LIKE ?", role))
+func (qs OrganizationUserQuerySet) RoleNotlike(role OrganizationRoleType) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "role", Value: role})))
 }
 
 // UpdatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UpdatedAtEq(updatedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` = ?", updatedAt))
+func (qs OrganizationUserQuerySet) UpdatedAtEq(updatedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UpdatedAtGt(updatedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` > ?", updatedAt))
+func (qs OrganizationUserQuerySet) UpdatedAtGt(updatedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UpdatedAtGte(updatedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` >= ?", updatedAt))
+func (qs OrganizationUserQuerySet) UpdatedAtGte(updatedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UpdatedAtIsNotNull() NetworkUserQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NOT NULL"))
+func (qs OrganizationUserQuerySet) UpdatedAtIsNotNull() OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
 }
 
 // UpdatedAtIsNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UpdatedAtIsNull() NetworkUserQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NULL"))
+func (qs OrganizationUserQuerySet) UpdatedAtIsNull() OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
 }
 
 // UpdatedAtLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UpdatedAtLt(updatedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` < ?", updatedAt))
+func (qs OrganizationUserQuerySet) UpdatedAtLt(updatedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UpdatedAtLte(updatedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` <= ?", updatedAt))
+func (qs OrganizationUserQuerySet) UpdatedAtLte(updatedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UpdatedAtNe(updatedAt time.Time) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` != ?", updatedAt))
+func (qs OrganizationUserQuerySet) UpdatedAtNe(updatedAt time.Time) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
 }
 
 // UserIDEq is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UserIDEq(userID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` = ?", userID))
+func (qs OrganizationUserQuerySet) UserIDEq(userID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user_id", Value: userID}))
 }
 
 // UserIDGt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UserIDGt(userID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` > ?", userID))
+func (qs OrganizationUserQuerySet) UserIDGt(userID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gt{Column: "user_id", Value: userID}))
 }
 
 // UserIDGte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UserIDGte(userID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` >= ?", userID))
+func (qs OrganizationUserQuerySet) UserIDGte(userID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Gte{Column: "user_id", Value: userID}))
 }
 
 // UserIDIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UserIDIn(userID ...ID) NetworkUserQuerySet {
+func (qs OrganizationUserQuerySet) UserIDIn(userID ...ID) OrganizationUserQuerySet {
 	if len(userID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("user_id IN (?)", userID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "user_id"}, userID))
 }
 
 // UserIDLt is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UserIDLt(userID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` < ?", userID))
+func (qs OrganizationUserQuerySet) UserIDLt(userID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lt{Column: "user_id", Value: userID}))
 }
 
 // UserIDLte is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UserIDLte(userID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` <= ?", userID))
+func (qs OrganizationUserQuerySet) UserIDLte(userID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Lte{Column: "user_id", Value: userID}))
 }
 
 // UserIDNe is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UserIDNe(userID ID) NetworkUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` != ?", userID))
+func (qs OrganizationUserQuerySet) UserIDNe(userID ID) OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user_id", Value: userID}))
 }
 
 // UserIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UserIDNotIn(userID ...ID) NetworkUserQuerySet {
+func (qs OrganizationUserQuerySet) UserIDNotIn(userID ...ID) OrganizationUserQuerySet {
 	if len(userID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "user_id"}, userID))
 }
 
 // UserIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UserIsNotNull() NetworkUserQuerySet {
-	return qs.w(qs.db.Where("user IS NOT NULL"))
+func (qs OrganizationUserQuerySet) UserIsNotNull() OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Neq{Column: "user", Value: nil}))
 }
 
 // UserIsNull is an autogenerated method
 // nolint: dupl
-func (qs NetworkUserQuerySet) UserIsNull() NetworkUserQuerySet {
-	return qs.w(qs.db.Where("user IS NULL"))
+func (qs OrganizationUserQuerySet) UserIsNull() OrganizationUserQuerySet {
+	return qs.w(qs.db.Where(clause.Eq{Column: "user", Value: nil}))
 }
 
 // SetCreatedAt is an autogenerated method
 // nolint: dupl
-func (u NetworkUserUpdater) SetCreatedAt(createdAt time.Time) NetworkUserUpdater {
-	u.fields[string(NetworkUserDBSchema.CreatedAt)] = createdAt
+func (u OrganizationUserUpdater) SetCreatedAt(createdAt time.Time) OrganizationUserUpdater {
+	u.fields[string(OrganizationUserDBSchema.CreatedAt)] = createdAt
 	return u
 }
 
 // SetDeletedAt is an autogenerated method
 // nolint: dupl
-func (u NetworkUserUpdater) SetDeletedAt(deletedAt *time.Time) NetworkUserUpdater {
-	u.fields[string(NetworkUserDBSchema.DeletedAt)] = deletedAt
+func (u OrganizationUserUpdater) SetDeletedAt(deletedAt *time.Time) OrganizationUserUpdater {
+	u.fields[string(OrganizationUserDBSchema.DeletedAt)] = deletedAt
 	return u
 }
 
 // SetID is an autogenerated method
 // nolint: dupl
-func (u NetworkUserUpdater) SetID(ID ID) NetworkUserUpdater {
-	u.fields[string(NetworkUserDBSchema.ID)] = ID
+func (u OrganizationUserUpdater) SetID(ID ID) OrganizationUserUpdater {
+	u.fields[string(OrganizationUserDBSchema.ID)] = ID
 	return u
 }
 
-// SetNetworkID is an autogenerated method
+// SetOrganizationID is an autogenerated method
 // nolint: dupl
-func (u NetworkUserUpdater) SetNetworkID(networkID ID) NetworkUserUpdater {
-	u.fields[string(NetworkUserDBSchema.NetworkID)] = networkID
+func (u OrganizationUserUpdater) SetOrganizationID(organizationID ID) OrganizationUserUpdater {
+	u.fields[string(OrganizationUserDBSchema.OrganizationID)] = organizationID
 	return u
 }
 
 // SetRole is an autogenerated method
 // nolint: dupl
-func (u NetworkUserUpdater) SetRole(role RoleType) NetworkUserUpdater {
-	u.fields[string(NetworkUserDBSchema.Role)] = role
+func (u OrganizationUserUpdater) SetRole(role OrganizationRoleType) OrganizationUserUpdater {
+	u.fields[string(OrganizationUserDBSchema.Role)] = role
 	return u
 }
 
 // SetUpdatedAt is an autogenerated method
 // nolint: dupl
-func (u NetworkUserUpdater) SetUpdatedAt(updatedAt *time.Time) NetworkUserUpdater {
-	u.fields[string(NetworkUserDBSchema.UpdatedAt)] = updatedAt
+func (u OrganizationUserUpdater) SetUpdatedAt(updatedAt *time.Time) OrganizationUserUpdater {
+	u.fields[string(OrganizationUserDBSchema.UpdatedAt)] = updatedAt
 	return u
 }
 
 // SetUserID is an autogenerated method
 // nolint: dupl
-func (u NetworkUserUpdater) SetUserID(userID ID) NetworkUserUpdater {
-	u.fields[string(NetworkUserDBSchema.UserID)] = userID
+func (u OrganizationUserUpdater) SetUserID(userID ID) OrganizationUserUpdater {
+	u.fields[string(OrganizationUserDBSchema.UserID)] = userID
 	return u
 }
 
 // Update is an autogenerated method
 // nolint: dupl
-func (u NetworkUserUpdater) Update() error {
+func (u OrganizationUserUpdater) Update() error {
 	return u.db.Updates(u.fields).Error
 }
 
 // UpdateNum is an autogenerated method
 // nolint: dupl
-func (u NetworkUserUpdater) UpdateNum() (int64, error) {
+func (u OrganizationUserUpdater) UpdateNum() (int64, error) {
 	db := u.db.Updates(u.fields)
 	return db.RowsAffected, db.Error
 }
 
-// ===== END of query set NetworkUserQuerySet
+// ===== END of query set OrganizationUserQuerySet
 
-// ===== BEGIN of NetworkUser modifiers
+// ===== BEGIN of OrganizationUser modifiers
 
-// NetworkUserDBSchemaField describes database schema field. It requires for method 'Update'
-type NetworkUserDBSchemaField string
+// OrganizationUserDBSchemaField describes database schema field. It requires for method 'Update'
+type OrganizationUserDBSchemaField string
 
 // String method returns string representation of field.
 // nolint: dupl
-func (f NetworkUserDBSchemaField) String() string {
+func (f OrganizationUserDBSchemaField) String() string {
 	return string(f)
 }
 
-// NetworkUserDBSchema stores db field names of NetworkUser
-var NetworkUserDBSchema = struct {
-	ID        NetworkUserDBSchemaField
-	CreatedAt NetworkUserDBSchemaField
-	UpdatedAt NetworkUserDBSchemaField
-	DeletedAt NetworkUserDBSchemaField
-	UserID    NetworkUserDBSchemaField
-	User      NetworkUserDBSchemaField
-	NetworkID NetworkUserDBSchemaField
-	Network   NetworkUserDBSchemaField
-	Role      NetworkUserDBSchemaField
+// OrganizationUserDBSchema stores db field names of OrganizationUser
+var OrganizationUserDBSchema = struct {
+	ID             OrganizationUserDBSchemaField
+	CreatedAt      OrganizationUserDBSchemaField
+	UpdatedAt      OrganizationUserDBSchemaField
+	DeletedAt      OrganizationUserDBSchemaField
+	OrganizationID OrganizationUserDBSchemaField
+	Organization   OrganizationUserDBSchemaField
+	UserID         OrganizationUserDBSchemaField
+	User           OrganizationUserDBSchemaField
+	Role           OrganizationUserDBSchemaField
 }{
 
-	ID:        NetworkUserDBSchemaField("id"),
-	CreatedAt: NetworkUserDBSchemaField("created_at"),
-	UpdatedAt: NetworkUserDBSchemaField("updated_at"),
-	DeletedAt: NetworkUserDBSchemaField("deleted_at"),
-	UserID:    NetworkUserDBSchemaField("user_id"),
-	User:      NetworkUserDBSchemaField("user"),
-	NetworkID: NetworkUserDBSchemaField("network_id"),
-	Network:   NetworkUserDBSchemaField("network"),
-	Role:      NetworkUserDBSchemaField("role"),
+	ID:             OrganizationUserDBSchemaField("id"),
+	CreatedAt:      OrganizationUserDBSchemaField("created_at"),
+	UpdatedAt:      OrganizationUserDBSchemaField("updated_at"),
+	DeletedAt:      OrganizationUserDBSchemaField("deleted_at"),
+	OrganizationID: OrganizationUserDBSchemaField("organization_id"),
+	Organization:   OrganizationUserDBSchemaField("organization"),
+	UserID:         OrganizationUserDBSchemaField("user_id"),
+	User:           OrganizationUserDBSchemaField("user"),
+	Role:           OrganizationUserDBSchemaField("role"),
 }
 
-// Update updates NetworkUser fields by primary key
+// Update updates OrganizationUser fields by primary key
 // nolint: dupl
-func (o *NetworkUser) Update(db *gorm.DB, fields ...NetworkUserDBSchemaField) error {
+func (o *OrganizationUser) Update(db *gorm.DB, fields ...OrganizationUserDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
-		"id":         o.ID,
-		"created_at": o.CreatedAt,
-		"updated_at": o.UpdatedAt,
-		"deleted_at": o.DeletedAt,
-		"user_id":    o.UserID,
-		"user":       o.User,
-		"network_id": o.NetworkID,
-		"network":    o.Network,
-		"role":       o.Role,
+		"id":              o.ID,
+		"created_at":      o.CreatedAt,
+		"updated_at":      o.UpdatedAt,
+		"deleted_at":      o.DeletedAt,
+		"organization_id": o.OrganizationID,
+		"organization":    o.Organization,
+		"user_id":         o.UserID,
+		"user":            o.User,
+		"role":            o.Role,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -5381,29 +12854,29 @@ func (o *NetworkUser) Update(db *gorm.DB, fields ...NetworkUserDBSchemaField) er
 			return err
 		}
 
-		return fmt.Errorf("can't update NetworkUser %v fields %v: %s",
+		return fmt.Errorf("can't update OrganizationUser %v fields %v: %s",
 			o, fields, err)
 	}
 
 	return nil
 }
 
-// NetworkUserUpdater is an NetworkUser updates manager
-type NetworkUserUpdater struct {
+// OrganizationUserUpdater is an OrganizationUser updates manager
+type OrganizationUserUpdater struct {
 	fields map[string]interface{}
 	db     *gorm.DB
 }
 
-// NewNetworkUserUpdater creates new NetworkUser updater
+// NewOrganizationUserUpdater creates new OrganizationUser updater
 // nolint: dupl
-func NewNetworkUserUpdater(db *gorm.DB) NetworkUserUpdater {
-	return NetworkUserUpdater{
+func NewOrganizationUserUpdater(db *gorm.DB) OrganizationUserUpdater {
+	return OrganizationUserUpdater{
 		fields: map[string]interface{}{},
-		db:     db.Model(&NetworkUser{}),
+		db:     db.Model(&OrganizationUser{}),
 	}
 }
 
-// ===== END of NetworkUser modifiers
+// ===== END of OrganizationUser modifiers
 
 // ===== BEGIN of query set RelayServerQuerySet
 
@@ -5467,37 +12940,37 @@ func (qs RelayServerQuerySet) Count() (int64, error) {
 // CreatedAtEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) CreatedAtEq(createdAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`created_at` = ?", createdAt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) CreatedAtGt(createdAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`created_at` > ?", createdAt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) CreatedAtGte(createdAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`created_at` >= ?", createdAt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) CreatedAtLt(createdAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`created_at` < ?", createdAt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) CreatedAtLte(createdAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`created_at` <= ?", createdAt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) CreatedAtNe(createdAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`created_at` != ?", createdAt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
 }
 
 // Delete is an autogenerated method
@@ -5523,49 +12996,49 @@ func (qs RelayServerQuerySet) DeleteNumUnscoped() (int64, error) {
 // DeletedAtEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) DeletedAtEq(deletedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` = ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) DeletedAtGt(deletedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` > ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) DeletedAtGte(deletedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` >= ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtIsNotNull is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) DeletedAtIsNotNull() RelayServerQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtIsNull is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) DeletedAtIsNull() RelayServerQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NULL"))
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) DeletedAtLt(deletedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` < ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) DeletedAtLte(deletedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` <= ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) DeletedAtNe(deletedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` != ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
 }
 
 // GetDB is an autogenerated method
@@ -5583,19 +13056,19 @@ func (qs RelayServerQuerySet) GetUpdater() RelayServerUpdater {
 // HostEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) HostEq(host string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`host` = ?", host))
+	return qs.w(qs.db.Where(clause.Eq{Column: "host", Value: host}))
 }
 
 // HostGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) HostGt(host string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`host` > ?", host))
+	return qs.w(qs.db.Where(clause.Gt{Column: "host", Value: host}))
 }
 
 // HostGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) HostGte(host string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`host` >= ?", host))
+	return qs.w(qs.db.Where(clause.Gte{Column: "host", Value: host}))
 }
 
 // HostIn is an autogenerated method
@@ -5605,31 +13078,31 @@ func (qs RelayServerQuerySet) HostIn(host ...string) RelayServerQuerySet {
 		qs.db.AddError(errors.New("must at least pass one host in HostIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("host IN (?)", host))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "host"}, host))
 }
 
 // HostLike is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) HostLike(host string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`host` LIKE ?", host))
+	return qs.w(qs.db.Where(clause.Like{Column: "host", Value: host}))
 }
 
 // HostLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) HostLt(host string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`host` < ?", host))
+	return qs.w(qs.db.Where(clause.Lt{Column: "host", Value: host}))
 }
 
 // HostLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) HostLte(host string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`host` <= ?", host))
+	return qs.w(qs.db.Where(clause.Lte{Column: "host", Value: host}))
 }
 
 // HostNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) HostNe(host string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`host` != ?", host))
+	return qs.w(qs.db.Where(clause.Neq{Column: "host", Value: host}))
 }
 
 // HostNotIn is an autogenerated method
@@ -5639,31 +13112,31 @@ func (qs RelayServerQuerySet) HostNotIn(host ...string) RelayServerQuerySet {
 		qs.db.AddError(errors.New("must at least pass one host in HostNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("host NOT IN (?)", host))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "host"}, host))
 }
 
 // HostNotlike is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) HostNotlike(host string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`host` NOT LIKE ?", host))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "host", Value: host})))
 }
 
 // IDEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) IDEq(ID ID) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`id` = ?", ID))
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
 }
 
 // IDGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) IDGt(ID ID) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`id` > ?", ID))
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
 }
 
 // IDGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) IDGte(ID ID) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`id` >= ?", ID))
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
 }
 
 // IDIn is an autogenerated method
@@ -5673,25 +13146,25 @@ func (qs RelayServerQuerySet) IDIn(ID ...ID) RelayServerQuerySet {
 		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // IDLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) IDLt(ID ID) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`id` < ?", ID))
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
 }
 
 // IDLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) IDLte(ID ID) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`id` <= ?", ID))
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
 }
 
 // IDNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) IDNe(ID ID) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`id` != ?", ID))
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
 }
 
 // IDNotIn is an autogenerated method
@@ -5701,43 +13174,43 @@ func (qs RelayServerQuerySet) IDNotIn(ID ...ID) RelayServerQuerySet {
 		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // KeepaliveAtEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) KeepaliveAtEq(keepaliveAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`keepalive_at` = ?", keepaliveAt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "keepalive_at", Value: keepaliveAt}))
 }
 
 // KeepaliveAtGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) KeepaliveAtGt(keepaliveAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`keepalive_at` > ?", keepaliveAt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "keepalive_at", Value: keepaliveAt}))
 }
 
 // KeepaliveAtGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) KeepaliveAtGte(keepaliveAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`keepalive_at` >= ?", keepaliveAt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "keepalive_at", Value: keepaliveAt}))
 }
 
 // KeepaliveAtLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) KeepaliveAtLt(keepaliveAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`keepalive_at` < ?", keepaliveAt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "keepalive_at", Value: keepaliveAt}))
 }
 
 // KeepaliveAtLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) KeepaliveAtLte(keepaliveAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`keepalive_at` <= ?", keepaliveAt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "keepalive_at", Value: keepaliveAt}))
 }
 
 // KeepaliveAtNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) KeepaliveAtNe(keepaliveAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`keepalive_at` != ?", keepaliveAt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "keepalive_at", Value: keepaliveAt}))
 }
 
 // Limit is an autogenerated method
@@ -5749,19 +13222,19 @@ func (qs RelayServerQuerySet) Limit(limit int) RelayServerQuerySet {
 // NameEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) NameEq(name string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`name` = ?", name))
+	return qs.w(qs.db.Where(clause.Eq{Column: "name", Value: name}))
 }
 
 // NameGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) NameGt(name string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`name` > ?", name))
+	return qs.w(qs.db.Where(clause.Gt{Column: "name", Value: name}))
 }
 
 // NameGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) NameGte(name string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`name` >= ?", name))
+	return qs.w(qs.db.Where(clause.Gte{Column: "name", Value: name}))
 }
 
 // NameIn is an autogenerated method
@@ -5771,31 +13244,31 @@ func (qs RelayServerQuerySet) NameIn(name ...string) RelayServerQuerySet {
 		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("name IN (?)", name))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "name"}, name))
 }
 
 // NameLike is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) NameLike(name string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`name` LIKE ?", name))
+	return qs.w(qs.db.Where(clause.Like{Column: "name", Value: name}))
 }
 
 // NameLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) NameLt(name string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`name` < ?", name))
+	return qs.w(qs.db.Where(clause.Lt{Column: "name", Value: name}))
 }
 
 // NameLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) NameLte(name string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`name` <= ?", name))
+	return qs.w(qs.db.Where(clause.Lte{Column: "name", Value: name}))
 }
 
 // NameNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) NameNe(name string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`name` != ?", name))
+	return qs.w(qs.db.Where(clause.Neq{Column: "name", Value: name}))
 }
 
 // NameNotIn is an autogenerated method
@@ -5805,13 +13278,13 @@ func (qs RelayServerQuerySet) NameNotIn(name ...string) RelayServerQuerySet {
 		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("name NOT IN (?)", name))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "name"}, name))
 }
 
 // NameNotlike is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) NameNotlike(name string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`name` NOT LIKE ?", name))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "name", Value: name})))
 }
 
 // Offset is an autogenerated method
@@ -5829,163 +13302,163 @@ func (qs RelayServerQuerySet) One(ret *RelayServer) error {
 // OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscByCreatedAt() RelayServerQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
 }
 
 // OrderAscByDeletedAt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscByDeletedAt() RelayServerQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
 }
 
 // OrderAscByHost is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscByHost() RelayServerQuerySet {
-	return qs.w(qs.db.Order("host ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "host"}}))
 }
 
 // OrderAscByID is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscByID() RelayServerQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
 }
 
 // OrderAscByKeepaliveAt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscByKeepaliveAt() RelayServerQuerySet {
-	return qs.w(qs.db.Order("keepalive_at ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "keepalive_at"}}))
 }
 
 // OrderAscByName is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscByName() RelayServerQuerySet {
-	return qs.w(qs.db.Order("name ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "name"}}))
 }
 
 // OrderAscByPort is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscByPort() RelayServerQuerySet {
-	return qs.w(qs.db.Order("port ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "port"}}))
 }
 
 // OrderAscByPublicKey is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscByPublicKey() RelayServerQuerySet {
-	return qs.w(qs.db.Order("public_key ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "public_key"}}))
 }
 
 // OrderAscByRegion is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscByRegion() RelayServerQuerySet {
-	return qs.w(qs.db.Order("region ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "region"}}))
 }
 
 // OrderAscBySTUNPort is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscBySTUNPort() RelayServerQuerySet {
-	return qs.w(qs.db.Order("stun_port ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "stun_port"}}))
 }
 
 // OrderAscByStartedAt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscByStartedAt() RelayServerQuerySet {
-	return qs.w(qs.db.Order("started_at ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "started_at"}}))
 }
 
 // OrderAscByUpdatedAt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderAscByUpdatedAt() RelayServerQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
 }
 
 // OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescByCreatedAt() RelayServerQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
 }
 
 // OrderDescByDeletedAt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescByDeletedAt() RelayServerQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
 }
 
 // OrderDescByHost is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescByHost() RelayServerQuerySet {
-	return qs.w(qs.db.Order("host DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "host"}, Desc: true}))
 }
 
 // OrderDescByID is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescByID() RelayServerQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
 }
 
 // OrderDescByKeepaliveAt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescByKeepaliveAt() RelayServerQuerySet {
-	return qs.w(qs.db.Order("keepalive_at DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "keepalive_at"}, Desc: true}))
 }
 
 // OrderDescByName is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescByName() RelayServerQuerySet {
-	return qs.w(qs.db.Order("name DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "name"}, Desc: true}))
 }
 
 // OrderDescByPort is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescByPort() RelayServerQuerySet {
-	return qs.w(qs.db.Order("port DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "port"}, Desc: true}))
 }
 
 // OrderDescByPublicKey is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescByPublicKey() RelayServerQuerySet {
-	return qs.w(qs.db.Order("public_key DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "public_key"}, Desc: true}))
 }
 
 // OrderDescByRegion is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescByRegion() RelayServerQuerySet {
-	return qs.w(qs.db.Order("region DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "region"}, Desc: true}))
 }
 
 // OrderDescBySTUNPort is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescBySTUNPort() RelayServerQuerySet {
-	return qs.w(qs.db.Order("stun_port DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "stun_port"}, Desc: true}))
 }
 
 // OrderDescByStartedAt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescByStartedAt() RelayServerQuerySet {
-	return qs.w(qs.db.Order("started_at DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "started_at"}, Desc: true}))
 }
 
 // OrderDescByUpdatedAt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) OrderDescByUpdatedAt() RelayServerQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
 }
 
 // PortEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PortEq(port int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`port` = ?", port))
+	return qs.w(qs.db.Where(clause.Eq{Column: "port", Value: port}))
 }
 
 // PortGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PortGt(port int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`port` > ?", port))
+	return qs.w(qs.db.Where(clause.Gt{Column: "port", Value: port}))
 }
 
 // PortGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PortGte(port int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`port` >= ?", port))
+	return qs.w(qs.db.Where(clause.Gte{Column: "port", Value: port}))
 }
 
 // PortIn is an autogenerated method
@@ -5995,25 +13468,25 @@ func (qs RelayServerQuerySet) PortIn(port ...int) RelayServerQuerySet {
 		qs.db.AddError(errors.New("must at least pass one port in PortIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("port IN (?)", port))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "port"}, port))
 }
 
 // PortLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PortLt(port int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`port` < ?", port))
+	return qs.w(qs.db.Where(clause.Lt{Column: "port", Value: port}))
 }
 
 // PortLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PortLte(port int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`port` <= ?", port))
+	return qs.w(qs.db.Where(clause.Lte{Column: "port", Value: port}))
 }
 
 // PortNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PortNe(port int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`port` != ?", port))
+	return qs.w(qs.db.Where(clause.Neq{Column: "port", Value: port}))
 }
 
 // PortNotIn is an autogenerated method
@@ -6023,25 +13496,25 @@ func (qs RelayServerQuerySet) PortNotIn(port ...int) RelayServerQuerySet {
 		qs.db.AddError(errors.New("must at least pass one port in PortNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("port NOT IN (?)", port))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "port"}, port))
 }
 
 // PublicKeyEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PublicKeyEq(publicKey string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`public_key` = ?", publicKey))
+	return qs.w(qs.db.Where(clause.Eq{Column: "public_key", Value: publicKey}))
 }
 
 // PublicKeyGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PublicKeyGt(publicKey string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`public_key` > ?", publicKey))
+	return qs.w(qs.db.Where(clause.Gt{Column: "public_key", Value: publicKey}))
 }
 
 // PublicKeyGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PublicKeyGte(publicKey string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`public_key` >= ?", publicKey))
+	return qs.w(qs.db.Where(clause.Gte{Column: "public_key", Value: publicKey}))
 }
 
 // PublicKeyIn is an autogenerated method
@@ -6051,31 +13524,31 @@ func (qs RelayServerQuerySet) PublicKeyIn(publicKey ...string) RelayServerQueryS
 		qs.db.AddError(errors.New("must at least pass one publicKey in PublicKeyIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("public_key IN (?)", publicKey))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "public_key"}, publicKey))
 }
 
 // PublicKeyLike is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PublicKeyLike(publicKey string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`public_key` LIKE ?", publicKey))
+	return qs.w(qs.db.Where(clause.Like{Column: "public_key", Value: publicKey}))
 }
 
 // PublicKeyLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PublicKeyLt(publicKey string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`public_key` < ?", publicKey))
+	return qs.w(qs.db.Where(clause.Lt{Column: "public_key", Value: publicKey}))
 }
 
 // PublicKeyLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PublicKeyLte(publicKey string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`public_key` <= ?", publicKey))
+	return qs.w(qs.db.Where(clause.Lte{Column: "public_key", Value: publicKey}))
 }
 
 // PublicKeyNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PublicKeyNe(publicKey string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`public_key` != ?", publicKey))
+	return qs.w(qs.db.Where(clause.Neq{Column: "public_key", Value: publicKey}))
 }
 
 // PublicKeyNotIn is an autogenerated method
@@ -6085,31 +13558,31 @@ func (qs RelayServerQuerySet) PublicKeyNotIn(publicKey ...string) RelayServerQue
 		qs.db.AddError(errors.New("must at least pass one publicKey in PublicKeyNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("public_key NOT IN (?)", publicKey))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "public_key"}, publicKey))
 }
 
 // PublicKeyNotlike is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) PublicKeyNotlike(publicKey string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`public_key` NOT LIKE ?", publicKey))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "public_key", Value: publicKey})))
 }
 
 // RegionEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) RegionEq(region string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`region` = ?", region))
+	return qs.w(qs.db.Where(clause.Eq{Column: "region", Value: region}))
 }
 
 // RegionGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) RegionGt(region string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`region` > ?", region))
+	return qs.w(qs.db.Where(clause.Gt{Column: "region", Value: region}))
 }
 
 // RegionGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) RegionGte(region string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`region` >= ?", region))
+	return qs.w(qs.db.Where(clause.Gte{Column: "region", Value: region}))
 }
 
 // RegionIn is an autogenerated method
@@ -6119,31 +13592,31 @@ func (qs RelayServerQuerySet) RegionIn(region ...string) RelayServerQuerySet {
 		qs.db.AddError(errors.New("must at least pass one region in RegionIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("region IN (?)", region))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "region"}, region))
 }
 
 // RegionLike is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) RegionLike(region string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`region` LIKE ?", region))
+	return qs.w(qs.db.Where(clause.Like{Column: "region", Value: region}))
 }
 
 // RegionLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) RegionLt(region string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`region` < ?", region))
+	return qs.w(qs.db.Where(clause.Lt{Column: "region", Value: region}))
 }
 
 // RegionLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) RegionLte(region string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`region` <= ?", region))
+	return qs.w(qs.db.Where(clause.Lte{Column: "region", Value: region}))
 }
 
 // RegionNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) RegionNe(region string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`region` != ?", region))
+	return qs.w(qs.db.Where(clause.Neq{Column: "region", Value: region}))
 }
 
 // RegionNotIn is an autogenerated method
@@ -6153,31 +13626,31 @@ func (qs RelayServerQuerySet) RegionNotIn(region ...string) RelayServerQuerySet
 		qs.db.AddError(errors.New("must at least pass one region in RegionNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("region NOT IN (?)", region))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "region"}, region))
 }
 
 // RegionNotlike is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) RegionNotlike(region string) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`region` NOT LIKE ?", region))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "region", Value: region})))
 }
 
 // STUNPortEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) STUNPortEq(sTUNPort int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`stun_port` = ?", sTUNPort))
+	return qs.w(qs.db.Where(clause.Eq{Column: "stun_port", Value: sTUNPort}))
 }
 
 // STUNPortGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) STUNPortGt(sTUNPort int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`stun_port` > ?", sTUNPort))
+	return qs.w(qs.db.Where(clause.Gt{Column: "stun_port", Value: sTUNPort}))
 }
 
 // STUNPortGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) STUNPortGte(sTUNPort int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`stun_port` >= ?", sTUNPort))
+	return qs.w(qs.db.Where(clause.Gte{Column: "stun_port", Value: sTUNPort}))
 }
 
 // STUNPortIn is an autogenerated method
@@ -6187,25 +13660,25 @@ func (qs RelayServerQuerySet) STUNPortIn(sTUNPort ...int) RelayServerQuerySet {
 		qs.db.AddError(errors.New("must at least pass one sTUNPort in STUNPortIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("stun_port IN (?)", sTUNPort))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "stun_port"}, sTUNPort))
 }
 
 // STUNPortLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) STUNPortLt(sTUNPort int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`stun_port` < ?", sTUNPort))
+	return qs.w(qs.db.Where(clause.Lt{Column: "stun_port", Value: sTUNPort}))
 }
 
 // STUNPortLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) STUNPortLte(sTUNPort int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`stun_port` <= ?", sTUNPort))
+	return qs.w(qs.db.Where(clause.Lte{Column: "stun_port", Value: sTUNPort}))
 }
 
 // STUNPortNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) STUNPortNe(sTUNPort int) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`stun_port` != ?", sTUNPort))
+	return qs.w(qs.db.Where(clause.Neq{Column: "stun_port", Value: sTUNPort}))
 }
 
 // STUNPortNotIn is an autogenerated method
@@ -6215,91 +13688,91 @@ func (qs RelayServerQuerySet) STUNPortNotIn(sTUNPort ...int) RelayServerQuerySet
 		qs.db.AddError(errors.New("must at least pass one sTUNPort in STUNPortNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("stun_port NOT IN (?)", sTUNPort))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "stun_port"}, sTUNPort))
 }
 
 // StartedAtEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) StartedAtEq(startedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`started_at` = ?", startedAt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "started_at", Value: startedAt}))
 }
 
 // StartedAtGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) StartedAtGt(startedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`started_at` > ?", startedAt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "started_at", Value: startedAt}))
 }
 
 // StartedAtGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) StartedAtGte(startedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`started_at` >= ?", startedAt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "started_at", Value: startedAt}))
 }
 
 // StartedAtLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) StartedAtLt(startedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`started_at` < ?", startedAt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "started_at", Value: startedAt}))
 }
 
 // StartedAtLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) StartedAtLte(startedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`started_at` <= ?", startedAt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "started_at", Value: startedAt}))
 }
 
 // StartedAtNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) StartedAtNe(startedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`started_at` != ?", startedAt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "started_at", Value: startedAt}))
 }
 
 // UpdatedAtEq is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) UpdatedAtEq(updatedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`updated_at` = ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtGt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) UpdatedAtGt(updatedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`updated_at` > ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtGte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) UpdatedAtGte(updatedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`updated_at` >= ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtIsNotNull is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) UpdatedAtIsNotNull() RelayServerQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NOT NULL"))
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
 }
 
 // UpdatedAtIsNull is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) UpdatedAtIsNull() RelayServerQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NULL"))
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
 }
 
 // UpdatedAtLt is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) UpdatedAtLt(updatedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`updated_at` < ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtLte is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) UpdatedAtLte(updatedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`updated_at` <= ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtNe is an autogenerated method
 // nolint: dupl
 func (qs RelayServerQuerySet) UpdatedAtNe(updatedAt time.Time) RelayServerQuerySet {
-	return qs.w(qs.db.Where("`updated_at` != ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
 }
 
 // SetCreatedAt is an autogenerated method
@@ -6547,19 +14020,19 @@ func (qs UserQuerySet) All(ret *[]User) error {
 // AvatarEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) AvatarEq(avatar string) UserQuerySet {
-	return qs.w(qs.db.Where("`avatar` = ?", avatar))
+	return qs.w(qs.db.Where(clause.Eq{Column: "avatar", Value: avatar}))
 }
 
 // AvatarGt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) AvatarGt(avatar string) UserQuerySet {
-	return qs.w(qs.db.Where("`avatar` > ?", avatar))
+	return qs.w(qs.db.Where(clause.Gt{Column: "avatar", Value: avatar}))
 }
 
 // AvatarGte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) AvatarGte(avatar string) UserQuerySet {
-	return qs.w(qs.db.Where("`avatar` >= ?", avatar))
+	return qs.w(qs.db.Where(clause.Gte{Column: "avatar", Value: avatar}))
 }
 
 // AvatarIn is an autogenerated method
@@ -6569,31 +14042,31 @@ func (qs UserQuerySet) AvatarIn(avatar ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one avatar in AvatarIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("avatar IN (?)", avatar))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "avatar"}, avatar))
 }
 
 // AvatarLike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) AvatarLike(avatar string) UserQuerySet {
-	return qs.w(qs.db.Where("`avatar` LIKE ?", avatar))
+	return qs.w(qs.db.Where(clause.Like{Column: "avatar", Value: avatar}))
 }
 
 // AvatarLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) AvatarLt(avatar string) UserQuerySet {
-	return qs.w(qs.db.Where("`avatar` < ?", avatar))
+	return qs.w(qs.db.Where(clause.Lt{Column: "avatar", Value: avatar}))
 }
 
 // AvatarLte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) AvatarLte(avatar string) UserQuerySet {
-	return qs.w(qs.db.Where("`avatar` <= ?", avatar))
+	return qs.w(qs.db.Where(clause.Lte{Column: "avatar", Value: avatar}))
 }
 
 // AvatarNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) AvatarNe(avatar string) UserQuerySet {
-	return qs.w(qs.db.Where("`avatar` != ?", avatar))
+	return qs.w(qs.db.Where(clause.Neq{Column: "avatar", Value: avatar}))
 }
 
 // AvatarNotIn is an autogenerated method
@@ -6603,13 +14076,13 @@ func (qs UserQuerySet) AvatarNotIn(avatar ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one avatar in AvatarNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("avatar NOT IN (?)", avatar))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "avatar"}, avatar))
 }
 
 // AvatarNotlike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) AvatarNotlike(avatar string) UserQuerySet {
-	return qs.w(qs.db.Where("`avatar` NOT LIKE ?", avatar))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "avatar", Value: avatar})))
 }
 
 // Count is an autogenerated method
@@ -6623,37 +14096,37 @@ func (qs UserQuerySet) Count() (int64, error) {
 // CreatedAtEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) CreatedAtEq(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`created_at` = ?", createdAt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) CreatedAtGt(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`created_at` > ?", createdAt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) CreatedAtGte(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`created_at` >= ?", createdAt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) CreatedAtLt(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`created_at` < ?", createdAt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) CreatedAtLte(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`created_at` <= ?", createdAt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) CreatedAtNe(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`created_at` != ?", createdAt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
 }
 
 // Delete is an autogenerated method
@@ -6679,67 +14152,67 @@ func (qs UserQuerySet) DeleteNumUnscoped() (int64, error) {
 // DeletedAtEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeletedAtEq(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` = ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeletedAtGt(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` > ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeletedAtGte(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` >= ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtIsNotNull is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeletedAtIsNotNull() UserQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtIsNull is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeletedAtIsNull() UserQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NULL"))
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeletedAtLt(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` < ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtLte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeletedAtLte(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` <= ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeletedAtNe(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` != ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
 }
 
 // EmailEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) EmailEq(email string) UserQuerySet {
-	return qs.w(qs.db.Where("`email` = ?", email))
+	return qs.w(qs.db.Where(clause.Eq{Column: "email", Value: email}))
 }
 
 // EmailGt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) EmailGt(email string) UserQuerySet {
-	return qs.w(qs.db.Where("`email` > ?", email))
+	return qs.w(qs.db.Where(clause.Gt{Column: "email", Value: email}))
 }
 
 // EmailGte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) EmailGte(email string) UserQuerySet {
-	return qs.w(qs.db.Where("`email` >= ?", email))
+	return qs.w(qs.db.Where(clause.Gte{Column: "email", Value: email}))
 }
 
 // EmailIn is an autogenerated method
@@ -6749,31 +14222,31 @@ func (qs UserQuerySet) EmailIn(email ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one email in EmailIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("email IN (?)", email))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "email"}, email))
 }
 
 // EmailLike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) EmailLike(email string) UserQuerySet {
-	return qs.w(qs.db.Where("`email` LIKE ?", email))
+	return qs.w(qs.db.Where(clause.Like{Column: "email", Value: email}))
 }
 
 // EmailLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) EmailLt(email string) UserQuerySet {
-	return qs.w(qs.db.Where("`email` < ?", email))
+	return qs.w(qs.db.Where(clause.Lt{Column: "email", Value: email}))
 }
 
 // EmailLte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) EmailLte(email string) UserQuerySet {
-	return qs.w(qs.db.Where("`email` <= ?", email))
+	return qs.w(qs.db.Where(clause.Lte{Column: "email", Value: email}))
 }
 
 // EmailNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) EmailNe(email string) UserQuerySet {
-	return qs.w(qs.db.Where("`email` != ?", email))
+	return qs.w(qs.db.Where(clause.Neq{Column: "email", Value: email}))
 }
 
 // EmailNotIn is an autogenerated method
@@ -6783,13 +14256,13 @@ func (qs UserQuerySet) EmailNotIn(email ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one email in EmailNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("email NOT IN (?)", email))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "email"}, email))
 }
 
 // EmailNotlike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) EmailNotlike(email string) UserQuerySet {
-	return qs.w(qs.db.Where("`email` NOT LIKE ?", email))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "email", Value: email})))
 }
 
 // GetDB is an autogenerated method
@@ -6807,19 +14280,19 @@ func (qs UserQuerySet) GetUpdater() UserUpdater {
 // HashEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) HashEq(hash string) UserQuerySet {
-	return qs.w(qs.db.Where("`hash` = ?", hash))
+	return qs.w(qs.db.Where(clause.Eq{Column: "hash", Value: hash}))
 }
 
 // HashGt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) HashGt(hash string) UserQuerySet {
-	return qs.w(qs.db.Where("`hash` > ?", hash))
+	return qs.w(qs.db.Where(clause.Gt{Column: "hash", Value: hash}))
 }
 
 // HashGte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) HashGte(hash string) UserQuerySet {
-	return qs.w(qs.db.Where("`hash` >= ?", hash))
+	return qs.w(qs.db.Where(clause.Gte{Column: "hash", Value: hash}))
 }
 
 // HashIn is an autogenerated method
@@ -6829,31 +14302,31 @@ func (qs UserQuerySet) HashIn(hash ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one hash in HashIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("hash IN (?)", hash))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "hash"}, hash))
 }
 
 // HashLike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) HashLike(hash string) UserQuerySet {
-	return qs.w(qs.db.Where("`hash` LIKE ?", hash))
+	return qs.w(qs.db.Where(clause.Like{Column: "hash", Value: hash}))
 }
 
 // HashLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) HashLt(hash string) UserQuerySet {
-	return qs.w(qs.db.Where("`hash` < ?", hash))
+	return qs.w(qs.db.Where(clause.Lt{Column: "hash", Value: hash}))
 }
 
 // HashLte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) HashLte(hash string) UserQuerySet {
-	return qs.w(qs.db.Where("`hash` <= ?", hash))
+	return qs.w(qs.db.Where(clause.Lte{Column: "hash", Value: hash}))
 }
 
 // HashNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) HashNe(hash string) UserQuerySet {
-	return qs.w(qs.db.Where("`hash` != ?", hash))
+	return qs.w(qs.db.Where(clause.Neq{Column: "hash", Value: hash}))
 }
 
 // HashNotIn is an autogenerated method
@@ -6863,31 +14336,31 @@ func (qs UserQuerySet) HashNotIn(hash ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one hash in HashNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("hash NOT IN (?)", hash))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "hash"}, hash))
 }
 
 // HashNotlike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) HashNotlike(hash string) UserQuerySet {
-	return qs.w(qs.db.Where("`hash` NOT LIKE ?", hash))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "hash", Value: hash})))
 }
 
 // IDEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) IDEq(ID ID) UserQuerySet {
-	return qs.w(qs.db.Where("`id` = ?", ID))
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
 }
 
 // IDGt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) IDGt(ID ID) UserQuerySet {
-	return qs.w(qs.db.Where("`id` > ?", ID))
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
 }
 
 // IDGte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) IDGte(ID ID) UserQuerySet {
-	return qs.w(qs.db.Where("`id` >= ?", ID))
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
 }
 
 // IDIn is an autogenerated method
@@ -6897,25 +14370,25 @@ func (qs UserQuerySet) IDIn(ID ...ID) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // IDLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) IDLt(ID ID) UserQuerySet {
-	return qs.w(qs.db.Where("`id` < ?", ID))
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
 }
 
 // IDLte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) IDLte(ID ID) UserQuerySet {
-	return qs.w(qs.db.Where("`id` <= ?", ID))
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
 }
 
 // IDNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) IDNe(ID ID) UserQuerySet {
-	return qs.w(qs.db.Where("`id` != ?", ID))
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
 }
 
 // IDNotIn is an autogenerated method
@@ -6925,7 +14398,7 @@ func (qs UserQuerySet) IDNotIn(ID ...ID) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // Limit is an autogenerated method
@@ -6937,19 +14410,19 @@ func (qs UserQuerySet) Limit(limit int) UserQuerySet {
 // NameEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) NameEq(name string) UserQuerySet {
-	return qs.w(qs.db.Where("`name` = ?", name))
+	return qs.w(qs.db.Where(clause.Eq{Column: "name", Value: name}))
 }
 
 // NameGt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) NameGt(name string) UserQuerySet {
-	return qs.w(qs.db.Where("`name` > ?", name))
+	return qs.w(qs.db.Where(clause.Gt{Column: "name", Value: name}))
 }
 
 // NameGte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) NameGte(name string) UserQuerySet {
-	return qs.w(qs.db.Where("`name` >= ?", name))
+	return qs.w(qs.db.Where(clause.Gte{Column: "name", Value: name}))
 }
 
 // NameIn is an autogenerated method
@@ -6959,31 +14432,31 @@ func (qs UserQuerySet) NameIn(name ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("name IN (?)", name))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "name"}, name))
 }
 
 // NameLike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) NameLike(name string) UserQuerySet {
-	return qs.w(qs.db.Where("`name` LIKE ?", name))
+	return qs.w(qs.db.Where(clause.Like{Column: "name", Value: name}))
 }
 
 // NameLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) NameLt(name string) UserQuerySet {
-	return qs.w(qs.db.Where("`name` < ?", name))
+	return qs.w(qs.db.Where(clause.Lt{Column: "name", Value: name}))
 }
 
 // NameLte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) NameLte(name string) UserQuerySet {
-	return qs.w(qs.db.Where("`name` <= ?", name))
+	return qs.w(qs.db.Where(clause.Lte{Column: "name", Value: name}))
 }
 
 // NameNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) NameNe(name string) UserQuerySet {
-	return qs.w(qs.db.Where("`name` != ?", name))
+	return qs.w(qs.db.Where(clause.Neq{Column: "name", Value: name}))
 }
 
 // NameNotIn is an autogenerated method
@@ -6993,13 +14466,13 @@ func (qs UserQuerySet) NameNotIn(name ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("name NOT IN (?)", name))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "name"}, name))
 }
 
 // NameNotlike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) NameNotlike(name string) UserQuerySet {
-	return qs.w(qs.db.Where("`name` NOT LIKE ?", name))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "name", Value: name})))
 }
 
 // Offset is an autogenerated method
@@ -7017,151 +14490,151 @@ func (qs UserQuerySet) One(ret *User) error {
 // OrderAscByAvatar is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByAvatar() UserQuerySet {
-	return qs.w(qs.db.Order("avatar ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "avatar"}}))
 }
 
 // OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByCreatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
 }
 
 // OrderAscByDeletedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByDeletedAt() UserQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
 }
 
 // OrderAscByEmail is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByEmail() UserQuerySet {
-	return qs.w(qs.db.Order("email ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "email"}}))
 }
 
 // OrderAscByHash is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByHash() UserQuerySet {
-	return qs.w(qs.db.Order("hash ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "hash"}}))
 }
 
 // OrderAscByID is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByID() UserQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
 }
 
 // OrderAscByName is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByName() UserQuerySet {
-	return qs.w(qs.db.Order("name ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "name"}}))
 }
 
 // OrderAscByOrigin is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByOrigin() UserQuerySet {
-	return qs.w(qs.db.Order("origin ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "origin"}}))
 }
 
 // OrderAscBySalt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscBySalt() UserQuerySet {
-	return qs.w(qs.db.Order("salt ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "salt"}}))
 }
 
 // OrderAscBySecretKey is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscBySecretKey() UserQuerySet {
-	return qs.w(qs.db.Order("secret_key ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "secret_key"}}))
 }
 
 // OrderAscByUpdatedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByUpdatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
 }
 
 // OrderDescByAvatar is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByAvatar() UserQuerySet {
-	return qs.w(qs.db.Order("avatar DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "avatar"}, Desc: true}))
 }
 
 // OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByCreatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
 }
 
 // OrderDescByDeletedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByDeletedAt() UserQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
 }
 
 // OrderDescByEmail is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByEmail() UserQuerySet {
-	return qs.w(qs.db.Order("email DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "email"}, Desc: true}))
 }
 
 // OrderDescByHash is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByHash() UserQuerySet {
-	return qs.w(qs.db.Order("hash DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "hash"}, Desc: true}))
 }
 
 // OrderDescByID is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByID() UserQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
 }
 
 // OrderDescByName is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByName() UserQuerySet {
-	return qs.w(qs.db.Order("name DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "name"}, Desc: true}))
 }
 
 // OrderDescByOrigin is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByOrigin() UserQuerySet {
-	return qs.w(qs.db.Order("origin DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "origin"}, Desc: true}))
 }
 
 // OrderDescBySalt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescBySalt() UserQuerySet {
-	return qs.w(qs.db.Order("salt DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "salt"}, Desc: true}))
 }
 
 // OrderDescBySecretKey is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescBySecretKey() UserQuerySet {
-	return qs.w(qs.db.Order("secret_key DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "secret_key"}, Desc: true}))
 }
 
 // OrderDescByUpdatedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByUpdatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
 }
 
 // OriginEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OriginEq(origin string) UserQuerySet {
-	return qs.w(qs.db.Where("`origin` = ?", origin))
+	return qs.w(qs.db.Where(clause.Eq{Column: "origin", Value: origin}))
 }
 
 // OriginGt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OriginGt(origin string) UserQuerySet {
-	return qs.w(qs.db.Where("`origin` > ?", origin))
+	return qs.w(qs.db.Where(clause.Gt{Column: "origin", Value: origin}))
 }
 
 // OriginGte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OriginGte(origin string) UserQuerySet {
-	return qs.w(qs.db.Where("`origin` >= ?", origin))
+	return qs.w(qs.db.Where(clause.Gte{Column: "origin", Value: origin}))
 }
 
 // OriginIn is an autogenerated method
@@ -7171,31 +14644,31 @@ func (qs UserQuerySet) OriginIn(origin ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one origin in OriginIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("origin IN (?)", origin))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "origin"}, origin))
 }
 
 // OriginLike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OriginLike(origin string) UserQuerySet {
-	return qs.w(qs.db.Where("`origin` LIKE ?", origin))
+	return qs.w(qs.db.Where(clause.Like{Column: "origin", Value: origin}))
 }
 
 // OriginLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OriginLt(origin string) UserQuerySet {
-	return qs.w(qs.db.Where("`origin` < ?", origin))
+	return qs.w(qs.db.Where(clause.Lt{Column: "origin", Value: origin}))
 }
 
 // OriginLte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OriginLte(origin string) UserQuerySet {
-	return qs.w(qs.db.Where("`origin` <= ?", origin))
+	return qs.w(qs.db.Where(clause.Lte{Column: "origin", Value: origin}))
 }
 
 // OriginNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OriginNe(origin string) UserQuerySet {
-	return qs.w(qs.db.Where("`origin` != ?", origin))
+	return qs.w(qs.db.Where(clause.Neq{Column: "origin", Value: origin}))
 }
 
 // OriginNotIn is an autogenerated method
@@ -7205,31 +14678,31 @@ func (qs UserQuerySet) OriginNotIn(origin ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one origin in OriginNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("origin NOT IN (?)", origin))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "origin"}, origin))
 }
 
 // OriginNotlike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OriginNotlike(origin string) UserQuerySet {
-	return qs.w(qs.db.Where("`origin` NOT LIKE ?", origin))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "origin", Value: origin})))
 }
 
 // SaltEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SaltEq(salt string) UserQuerySet {
-	return qs.w(qs.db.Where("`salt` = ?", salt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "salt", Value: salt}))
 }
 
 // SaltGt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SaltGt(salt string) UserQuerySet {
-	return qs.w(qs.db.Where("`salt` > ?", salt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "salt", Value: salt}))
 }
 
 // SaltGte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SaltGte(salt string) UserQuerySet {
-	return qs.w(qs.db.Where("`salt` >= ?", salt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "salt", Value: salt}))
 }
 
 // SaltIn is an autogenerated method
@@ -7239,31 +14712,31 @@ func (qs UserQuerySet) SaltIn(salt ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one salt in SaltIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("salt IN (?)", salt))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "salt"}, salt))
 }
 
 // SaltLike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SaltLike(salt string) UserQuerySet {
-	return qs.w(qs.db.Where("`salt` LIKE ?", salt))
+	return qs.w(qs.db.Where(clause.Like{Column: "salt", Value: salt}))
 }
 
 // SaltLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SaltLt(salt string) UserQuerySet {
-	return qs.w(qs.db.Where("`salt` < ?", salt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "salt", Value: salt}))
 }
 
 // SaltLte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SaltLte(salt string) UserQuerySet {
-	return qs.w(qs.db.Where("`salt` <= ?", salt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "salt", Value: salt}))
 }
 
 // SaltNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SaltNe(salt string) UserQuerySet {
-	return qs.w(qs.db.Where("`salt` != ?", salt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "salt", Value: salt}))
 }
 
 // SaltNotIn is an autogenerated method
@@ -7273,31 +14746,31 @@ func (qs UserQuerySet) SaltNotIn(salt ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one salt in SaltNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("salt NOT IN (?)", salt))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "salt"}, salt))
 }
 
 // SaltNotlike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SaltNotlike(salt string) UserQuerySet {
-	return qs.w(qs.db.Where("`salt` NOT LIKE ?", salt))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "salt", Value: salt})))
 }
 
 // SecretKeyEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SecretKeyEq(secretKey string) UserQuerySet {
-	return qs.w(qs.db.Where("`secret_key` = ?", secretKey))
+	return qs.w(qs.db.Where(clause.Eq{Column: "secret_key", Value: secretKey}))
 }
 
 // SecretKeyGt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SecretKeyGt(secretKey string) UserQuerySet {
-	return qs.w(qs.db.Where("`secret_key` > ?", secretKey))
+	return qs.w(qs.db.Where(clause.Gt{Column: "secret_key", Value: secretKey}))
 }
 
 // SecretKeyGte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SecretKeyGte(secretKey string) UserQuerySet {
-	return qs.w(qs.db.Where("`secret_key` >= ?", secretKey))
+	return qs.w(qs.db.Where(clause.Gte{Column: "secret_key", Value: secretKey}))
 }
 
 // SecretKeyIn is an autogenerated method
@@ -7307,31 +14780,31 @@ func (qs UserQuerySet) SecretKeyIn(secretKey ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one secretKey in SecretKeyIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("secret_key IN (?)", secretKey))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "secret_key"}, secretKey))
 }
 
 // SecretKeyLike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SecretKeyLike(secretKey string) UserQuerySet {
-	return qs.w(qs.db.Where("`secret_key` LIKE ?", secretKey))
+	return qs.w(qs.db.Where(clause.Like{Column: "secret_key", Value: secretKey}))
 }
 
 // SecretKeyLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SecretKeyLt(secretKey string) UserQuerySet {
-	return qs.w(qs.db.Where("`secret_key` < ?", secretKey))
+	return qs.w(qs.db.Where(clause.Lt{Column: "secret_key", Value: secretKey}))
 }
 
 // SecretKeyLte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SecretKeyLte(secretKey string) UserQuerySet {
-	return qs.w(qs.db.Where("`secret_key` <= ?", secretKey))
+	return qs.w(qs.db.Where(clause.Lte{Column: "secret_key", Value: secretKey}))
 }
 
 // SecretKeyNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SecretKeyNe(secretKey string) UserQuerySet {
-	return qs.w(qs.db.Where("`secret_key` != ?", secretKey))
+	return qs.w(qs.db.Where(clause.Neq{Column: "secret_key", Value: secretKey}))
 }
 
 // SecretKeyNotIn is an autogenerated method
@@ -7341,61 +14814,61 @@ func (qs UserQuerySet) SecretKeyNotIn(secretKey ...string) UserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one secretKey in SecretKeyNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("secret_key NOT IN (?)", secretKey))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "secret_key"}, secretKey))
 }
 
 // SecretKeyNotlike is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) SecretKeyNotlike(secretKey string) UserQuerySet {
-	return qs.w(qs.db.Where("`secret_key` NOT LIKE ?", secretKey))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "secret_key", Value: secretKey})))
 }
 
 // UpdatedAtEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) UpdatedAtEq(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` = ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtGt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) UpdatedAtGt(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` > ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtGte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) UpdatedAtGte(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` >= ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtIsNotNull is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) UpdatedAtIsNotNull() UserQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NOT NULL"))
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
 }
 
 // UpdatedAtIsNull is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) UpdatedAtIsNull() UserQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NULL"))
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
 }
 
 // UpdatedAtLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) UpdatedAtLt(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` < ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtLte is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) UpdatedAtLte(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` <= ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) UpdatedAtNe(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` != ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
 }
 
 // SetAvatar is an autogenerated method
@@ -7633,19 +15106,19 @@ func (qs WechatUserQuerySet) All(ret *[]WechatUser) error {
 // CityEq is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CityEq(city string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`city` = ?", city))
+	return qs.w(qs.db.Where(clause.Eq{Column: "city", Value: city}))
 }
 
 // CityGt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CityGt(city string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`city` > ?", city))
+	return qs.w(qs.db.Where(clause.Gt{Column: "city", Value: city}))
 }
 
 // CityGte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CityGte(city string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`city` >= ?", city))
+	return qs.w(qs.db.Where(clause.Gte{Column: "city", Value: city}))
 }
 
 // CityIn is an autogenerated method
@@ -7655,31 +15128,31 @@ func (qs WechatUserQuerySet) CityIn(city ...string) WechatUserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one city in CityIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("city IN (?)", city))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "city"}, city))
 }
 
 // CityLike is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CityLike(city string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`city` LIKE ?", city))
+	return qs.w(qs.db.Where(clause.Like{Column: "city", Value: city}))
 }
 
 // CityLt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CityLt(city string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`city` < ?", city))
+	return qs.w(qs.db.Where(clause.Lt{Column: "city", Value: city}))
 }
 
 // CityLte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CityLte(city string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`city` <= ?", city))
+	return qs.w(qs.db.Where(clause.Lte{Column: "city", Value: city}))
 }
 
 // CityNe is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CityNe(city string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`city` != ?", city))
+	return qs.w(qs.db.Where(clause.Neq{Column: "city", Value: city}))
 }
 
 // CityNotIn is an autogenerated method
@@ -7689,13 +15162,13 @@ func (qs WechatUserQuerySet) CityNotIn(city ...string) WechatUserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one city in CityNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("city NOT IN (?)", city))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "city"}, city))
 }
 
 // CityNotlike is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CityNotlike(city string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`city` NOT LIKE ?", city))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "city", Value: city})))
 }
 
 // Count is an autogenerated method
@@ -7709,37 +15182,37 @@ func (qs WechatUserQuerySet) Count() (int64, error) {
 // CreatedAtEq is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CreatedAtEq(createdAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` = ?", createdAt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CreatedAtGt(createdAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` > ?", createdAt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtGte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CreatedAtGte(createdAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` >= ?", createdAt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CreatedAtLt(createdAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` < ?", createdAt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtLte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CreatedAtLte(createdAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` <= ?", createdAt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "created_at", Value: createdAt}))
 }
 
 // CreatedAtNe is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) CreatedAtNe(createdAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`created_at` != ?", createdAt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "created_at", Value: createdAt}))
 }
 
 // Delete is an autogenerated method
@@ -7765,49 +15238,49 @@ func (qs WechatUserQuerySet) DeleteNumUnscoped() (int64, error) {
 // DeletedAtEq is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) DeletedAtEq(deletedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` = ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) DeletedAtGt(deletedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` > ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtGte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) DeletedAtGte(deletedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` >= ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtIsNotNull is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) DeletedAtIsNotNull() WechatUserQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtIsNull is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) DeletedAtIsNull() WechatUserQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NULL"))
+	return qs.w(qs.db.Where(clause.Eq{Column: "deleted_at", Value: nil}))
 }
 
 // DeletedAtLt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) DeletedAtLt(deletedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` < ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtLte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) DeletedAtLte(deletedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` <= ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "deleted_at", Value: deletedAt}))
 }
 
 // DeletedAtNe is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) DeletedAtNe(deletedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`deleted_at` != ?", deletedAt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "deleted_at", Value: deletedAt}))
 }
 
 // GetDB is an autogenerated method
@@ -7825,19 +15298,19 @@ func (qs WechatUserQuerySet) GetUpdater() WechatUserUpdater {
 // HeadImgURLEq is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) HeadImgURLEq(headImgURL string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`head_img_url` = ?", headImgURL))
+	return qs.w(qs.db.Where(clause.Eq{Column: "head_img_url", Value: headImgURL}))
 }
 
 // HeadImgURLGt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) HeadImgURLGt(headImgURL string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`head_img_url` > ?", headImgURL))
+	return qs.w(qs.db.Where(clause.Gt{Column: "head_img_url", Value: headImgURL}))
 }
 
 // HeadImgURLGte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) HeadImgURLGte(headImgURL string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`head_img_url` >= ?", headImgURL))
+	return qs.w(qs.db.Where(clause.Gte{Column: "head_img_url", Value: headImgURL}))
 }
 
 // HeadImgURLIn is an autogenerated method
@@ -7847,31 +15320,31 @@ func (qs WechatUserQuerySet) HeadImgURLIn(headImgURL ...string) WechatUserQueryS
 		qs.db.AddError(errors.New("must at least pass one headImgURL in HeadImgURLIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("head_img_url IN (?)", headImgURL))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "head_img_url"}, headImgURL))
 }
 
 // HeadImgURLLike is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) HeadImgURLLike(headImgURL string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`head_img_url` LIKE ?", headImgURL))
+	return qs.w(qs.db.Where(clause.Like{Column: "head_img_url", Value: headImgURL}))
 }
 
 // HeadImgURLLt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) HeadImgURLLt(headImgURL string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`head_img_url` < ?", headImgURL))
+	return qs.w(qs.db.Where(clause.Lt{Column: "head_img_url", Value: headImgURL}))
 }
 
 // HeadImgURLLte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) HeadImgURLLte(headImgURL string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`head_img_url` <= ?", headImgURL))
+	return qs.w(qs.db.Where(clause.Lte{Column: "head_img_url", Value: headImgURL}))
 }
 
 // HeadImgURLNe is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) HeadImgURLNe(headImgURL string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`head_img_url` != ?", headImgURL))
+	return qs.w(qs.db.Where(clause.Neq{Column: "head_img_url", Value: headImgURL}))
 }
 
 // HeadImgURLNotIn is an autogenerated method
@@ -7881,31 +15354,31 @@ func (qs WechatUserQuerySet) HeadImgURLNotIn(headImgURL ...string) WechatUserQue
 		qs.db.AddError(errors.New("must at least pass one headImgURL in HeadImgURLNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("head_img_url NOT IN (?)", headImgURL))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "head_img_url"}, headImgURL))
 }
 
 // HeadImgURLNotlike is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) HeadImgURLNotlike(headImgURL string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`head_img_url` NOT LIKE ?", headImgURL))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "head_img_url", Value: headImgURL})))
 }
 
 // IDEq is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) IDEq(ID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`id` = ?", ID))
+	return qs.w(qs.db.Where(clause.Eq{Column: "id", Value: ID}))
 }
 
 // IDGt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) IDGt(ID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`id` > ?", ID))
+	return qs.w(qs.db.Where(clause.Gt{Column: "id", Value: ID}))
 }
 
 // IDGte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) IDGte(ID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`id` >= ?", ID))
+	return qs.w(qs.db.Where(clause.Gte{Column: "id", Value: ID}))
 }
 
 // IDIn is an autogenerated method
@@ -7915,25 +15388,25 @@ func (qs WechatUserQuerySet) IDIn(ID ...ID) WechatUserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // IDLt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) IDLt(ID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`id` < ?", ID))
+	return qs.w(qs.db.Where(clause.Lt{Column: "id", Value: ID}))
 }
 
 // IDLte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) IDLte(ID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`id` <= ?", ID))
+	return qs.w(qs.db.Where(clause.Lte{Column: "id", Value: ID}))
 }
 
 // IDNe is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) IDNe(ID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`id` != ?", ID))
+	return qs.w(qs.db.Where(clause.Neq{Column: "id", Value: ID}))
 }
 
 // IDNotIn is an autogenerated method
@@ -7943,7 +15416,7 @@ func (qs WechatUserQuerySet) IDNotIn(ID ...ID) WechatUserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "id"}, ID))
 }
 
 // Limit is an autogenerated method
@@ -7955,19 +15428,19 @@ func (qs WechatUserQuerySet) Limit(limit int) WechatUserQuerySet {
 // NicknameEq is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) NicknameEq(nickname string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`nickname` = ?", nickname))
+	return qs.w(qs.db.Where(clause.Eq{Column: "nickname", Value: nickname}))
 }
 
 // NicknameGt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) NicknameGt(nickname string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`nickname` > ?", nickname))
+	return qs.w(qs.db.Where(clause.Gt{Column: "nickname", Value: nickname}))
 }
 
 // NicknameGte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) NicknameGte(nickname string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`nickname` >= ?", nickname))
+	return qs.w(qs.db.Where(clause.Gte{Column: "nickname", Value: nickname}))
 }
 
 // NicknameIn is an autogenerated method
@@ -7977,31 +15450,31 @@ func (qs WechatUserQuerySet) NicknameIn(nickname ...string) WechatUserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one nickname in NicknameIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("nickname IN (?)", nickname))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "nickname"}, nickname))
 }
 
 // NicknameLike is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) NicknameLike(nickname string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`nickname` LIKE ?", nickname))
+	return qs.w(qs.db.Where(clause.Like{Column: "nickname", Value: nickname}))
 }
 
 // NicknameLt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) NicknameLt(nickname string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`nickname` < ?", nickname))
+	return qs.w(qs.db.Where(clause.Lt{Column: "nickname", Value: nickname}))
 }
 
 // NicknameLte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) NicknameLte(nickname string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`nickname` <= ?", nickname))
+	return qs.w(qs.db.Where(clause.Lte{Column: "nickname", Value: nickname}))
 }
 
 // NicknameNe is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) NicknameNe(nickname string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`nickname` != ?", nickname))
+	return qs.w(qs.db.Where(clause.Neq{Column: "nickname", Value: nickname}))
 }
 
 // NicknameNotIn is an autogenerated method
@@ -8011,13 +15484,13 @@ func (qs WechatUserQuerySet) NicknameNotIn(nickname ...string) WechatUserQuerySe
 		qs.db.AddError(errors.New("must at least pass one nickname in NicknameNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("nickname NOT IN (?)", nickname))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "nickname"}, nickname))
 }
 
 // NicknameNotlike is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) NicknameNotlike(nickname string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`nickname` NOT LIKE ?", nickname))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "nickname", Value: nickname})))
 }
 
 // Offset is an autogenerated method
@@ -8035,109 +15508,109 @@ func (qs WechatUserQuerySet) One(ret *WechatUser) error {
 // OrderAscByCity is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderAscByCity() WechatUserQuerySet {
-	return qs.w(qs.db.Order("city ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "city"}}))
 }
 
 // OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderAscByCreatedAt() WechatUserQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}}))
 }
 
 // OrderAscByDeletedAt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderAscByDeletedAt() WechatUserQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}}))
 }
 
 // OrderAscByHeadImgURL is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderAscByHeadImgURL() WechatUserQuerySet {
-	return qs.w(qs.db.Order("head_img_url ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "head_img_url"}}))
 }
 
 // OrderAscByID is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderAscByID() WechatUserQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}))
 }
 
 // OrderAscByNickname is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderAscByNickname() WechatUserQuerySet {
-	return qs.w(qs.db.Order("nickname ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "nickname"}}))
 }
 
 // OrderAscByUnionID is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderAscByUnionID() WechatUserQuerySet {
-	return qs.w(qs.db.Order("union_id ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "union_id"}}))
 }
 
 // OrderAscByUpdatedAt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderAscByUpdatedAt() WechatUserQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}}))
 }
 
 // OrderAscByUserID is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderAscByUserID() WechatUserQuerySet {
-	return qs.w(qs.db.Order("user_id ASC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}}))
 }
 
 // OrderDescByCity is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderDescByCity() WechatUserQuerySet {
-	return qs.w(qs.db.Order("city DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "city"}, Desc: true}))
 }
 
 // OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderDescByCreatedAt() WechatUserQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
 }
 
 // OrderDescByDeletedAt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderDescByDeletedAt() WechatUserQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "deleted_at"}, Desc: true}))
 }
 
 // OrderDescByHeadImgURL is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderDescByHeadImgURL() WechatUserQuerySet {
-	return qs.w(qs.db.Order("head_img_url DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "head_img_url"}, Desc: true}))
 }
 
 // OrderDescByID is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderDescByID() WechatUserQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: true}))
 }
 
 // OrderDescByNickname is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderDescByNickname() WechatUserQuerySet {
-	return qs.w(qs.db.Order("nickname DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "nickname"}, Desc: true}))
 }
 
 // OrderDescByUnionID is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderDescByUnionID() WechatUserQuerySet {
-	return qs.w(qs.db.Order("union_id DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "union_id"}, Desc: true}))
 }
 
 // OrderDescByUpdatedAt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderDescByUpdatedAt() WechatUserQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "updated_at"}, Desc: true}))
 }
 
 // OrderDescByUserID is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) OrderDescByUserID() WechatUserQuerySet {
-	return qs.w(qs.db.Order("user_id DESC"))
+	return qs.w(qs.db.Order(clause.OrderByColumn{Column: clause.Column{Name: "user_id"}, Desc: true}))
 }
 
 // PreloadUser is an autogenerated method
@@ -8149,19 +15622,19 @@ func (qs WechatUserQuerySet) PreloadUser() WechatUserQuerySet {
 // UnionIDEq is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UnionIDEq(unionID string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`union_id` = ?", unionID))
+	return qs.w(qs.db.Where(clause.Eq{Column: "union_id", Value: unionID}))
 }
 
 // UnionIDGt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UnionIDGt(unionID string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`union_id` > ?", unionID))
+	return qs.w(qs.db.Where(clause.Gt{Column: "union_id", Value: unionID}))
 }
 
 // UnionIDGte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UnionIDGte(unionID string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`union_id` >= ?", unionID))
+	return qs.w(qs.db.Where(clause.Gte{Column: "union_id", Value: unionID}))
 }
 
 // UnionIDIn is an autogenerated method
@@ -8171,31 +15644,31 @@ func (qs WechatUserQuerySet) UnionIDIn(unionID ...string) WechatUserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one unionID in UnionIDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("union_id IN (?)", unionID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "union_id"}, unionID))
 }
 
 // UnionIDLike is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UnionIDLike(unionID string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`union_id` LIKE ?", unionID))
+	return qs.w(qs.db.Where(clause.Like{Column: "union_id", Value: unionID}))
 }
 
 // UnionIDLt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UnionIDLt(unionID string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`union_id` < ?", unionID))
+	return qs.w(qs.db.Where(clause.Lt{Column: "union_id", Value: unionID}))
 }
 
 // UnionIDLte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UnionIDLte(unionID string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`union_id` <= ?", unionID))
+	return qs.w(qs.db.Where(clause.Lte{Column: "union_id", Value: unionID}))
 }
 
 // UnionIDNe is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UnionIDNe(unionID string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`union_id` != ?", unionID))
+	return qs.w(qs.db.Where(clause.Neq{Column: "union_id", Value: unionID}))
 }
 
 // UnionIDNotIn is an autogenerated method
@@ -8205,79 +15678,79 @@ func (qs WechatUserQuerySet) UnionIDNotIn(unionID ...string) WechatUserQuerySet
 		qs.db.AddError(errors.New("must at least pass one unionID in UnionIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("union_id NOT IN (?)", unionID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "union_id"}, unionID))
 }
 
 // UnionIDNotlike is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UnionIDNotlike(unionID string) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`union_id` NOT LIKE ?", unionID))
+	return qs.w(qs.db.Where(clause.Not(clause.Like{Column: "union_id", Value: unionID})))
 }
 
 // UpdatedAtEq is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UpdatedAtEq(updatedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` = ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtGt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UpdatedAtGt(updatedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` > ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Gt{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtGte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UpdatedAtGte(updatedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` >= ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Gte{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtIsNotNull is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UpdatedAtIsNotNull() WechatUserQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NOT NULL"))
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: nil}))
 }
 
 // UpdatedAtIsNull is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UpdatedAtIsNull() WechatUserQuerySet {
-	return qs.w(qs.db.Where("updated_at IS NULL"))
+	return qs.w(qs.db.Where(clause.Eq{Column: "updated_at", Value: nil}))
 }
 
 // UpdatedAtLt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UpdatedAtLt(updatedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` < ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Lt{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtLte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UpdatedAtLte(updatedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` <= ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Lte{Column: "updated_at", Value: updatedAt}))
 }
 
 // UpdatedAtNe is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UpdatedAtNe(updatedAt time.Time) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`updated_at` != ?", updatedAt))
+	return qs.w(qs.db.Where(clause.Neq{Column: "updated_at", Value: updatedAt}))
 }
 
 // UserIDEq is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UserIDEq(userID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` = ?", userID))
+	return qs.w(qs.db.Where(clause.Eq{Column: "user_id", Value: userID}))
 }
 
 // UserIDGt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UserIDGt(userID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` > ?", userID))
+	return qs.w(qs.db.Where(clause.Gt{Column: "user_id", Value: userID}))
 }
 
 // UserIDGte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UserIDGte(userID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` >= ?", userID))
+	return qs.w(qs.db.Where(clause.Gte{Column: "user_id", Value: userID}))
 }
 
 // UserIDIn is an autogenerated method
@@ -8287,25 +15760,25 @@ func (qs WechatUserQuerySet) UserIDIn(userID ...ID) WechatUserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("user_id IN (?)", userID))
+	return qs.w(qs.db.Where("? IN (?)", clause.Column{Name: "user_id"}, userID))
 }
 
 // UserIDLt is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UserIDLt(userID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` < ?", userID))
+	return qs.w(qs.db.Where(clause.Lt{Column: "user_id", Value: userID}))
 }
 
 // UserIDLte is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UserIDLte(userID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` <= ?", userID))
+	return qs.w(qs.db.Where(clause.Lte{Column: "user_id", Value: userID}))
 }
 
 // UserIDNe is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UserIDNe(userID ID) WechatUserQuerySet {
-	return qs.w(qs.db.Where("`user_id` != ?", userID))
+	return qs.w(qs.db.Where(clause.Neq{Column: "user_id", Value: userID}))
 }
 
 // UserIDNotIn is an autogenerated method
@@ -8315,19 +15788,19 @@ func (qs WechatUserQuerySet) UserIDNotIn(userID ...ID) WechatUserQuerySet {
 		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+	return qs.w(qs.db.Where("? NOT IN (?)", clause.Column{Name: "user_id"}, userID))
 }
 
 // UserIsNotNull is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UserIsNotNull() WechatUserQuerySet {
-	return qs.w(qs.db.Where("user IS NOT NULL"))
+	return qs.w(qs.db.Where(clause.Neq{Column: "user", Value: nil}))
 }
 
 // UserIsNull is an autogenerated method
 // nolint: dupl
 func (qs WechatUserQuerySet) UserIsNull() WechatUserQuerySet {
-	return qs.w(qs.db.Where("user IS NULL"))
+	return qs.w(qs.db.Where(clause.Eq{Column: "user", Value: nil}))
 }
 
 // SetCity is an autogenerated method
@@ -52,6 +52,36 @@ func (k KeyType) String() string {
 	return string(k)
 }
 
+// ACLAction represents the action of an ACL rule
+type ACLAction string
+
+// ACLAction constants are values representing the actions of ACL rules
+const (
+	ACLActionAccept ACLAction = "accept"
+	ACLActionDrop   ACLAction = "drop"
+)
+
+// String implements the fmt.Stringer interface
+func (a ACLAction) String() string {
+	return string(a)
+}
+
+// ACLProtocol represents the protocol matched by an ACL rule
+type ACLProtocol string
+
+// ACLProtocol constants are values representing the protocols of ACL rules
+const (
+	ACLProtocolAny  ACLProtocol = "any"
+	ACLProtocolTCP  ACLProtocol = "tcp"
+	ACLProtocolUDP  ACLProtocol = "udp"
+	ACLProtocolICMP ACLProtocol = "icmp"
+)
+
+// String implements the fmt.Stringer interface
+func (p ACLProtocol) String() string {
+	return string(p)
+}
+
 // DeviceStatusType represents the status of a device
 type DeviceStatusType string
 
@@ -65,3 +95,63 @@ const (
 func (d DeviceStatusType) String() string {
 	return string(d)
 }
+
+// OrganizationRoleType represents the role of a user in an organization
+type OrganizationRoleType string
+
+// OrganizationRoleType constants are values representing the organization roles.
+// The organization admin manages everything of the organization, the network
+// admin manages all networks of the organization, the auditor has read-only
+// access to all resources of the organization, and the member can register
+// devices to the organization.
+const (
+	OrganizationRoleAdmin        OrganizationRoleType = "admin"
+	OrganizationRoleNetworkAdmin OrganizationRoleType = "network-admin"
+	OrganizationRoleAuditor      OrganizationRoleType = "auditor"
+	OrganizationRoleMember       OrganizationRoleType = "member"
+)
+
+// String implements the fmt.Stringer interface
+func (r OrganizationRoleType) String() string {
+	return string(r)
+}
+
+// Valid returns whether the role is a known organization role
+func (r OrganizationRoleType) Valid() bool {
+	switch r {
+	case OrganizationRoleAdmin, OrganizationRoleNetworkAdmin, OrganizationRoleAuditor, OrganizationRoleMember:
+		return true
+	}
+	return false
+}
+
+// AuditAction represents the administrative action recorded by the audit log
+type AuditAction string
+
+// AuditAction constants are values representing the audited actions
+const (
+	AuditActionCreateNetwork                AuditAction = "network.create"
+	AuditActionDeleteMember                 AuditAction = "network.member.delete"
+	AuditActionChangeMemberRole             AuditAction = "network.member.role"
+	AuditActionJoinNetwork                  AuditAction = "network.invitation.join"
+	AuditActionDeclineNetwork               AuditAction = "network.invitation.decline"
+	AuditActionCreateKey                    AuditAction = "key.create"
+	AuditActionChangeKey                    AuditAction = "key.change"
+	AuditActionDeleteKey                    AuditAction = "key.delete"
+	AuditActionUpdateDevice                 AuditAction = "device.update"
+	AuditActionRevokeDevice                 AuditAction = "device.revoke"
+	AuditActionRestoreDevice                AuditAction = "device.restore"
+	AuditActionDeleteDevice                 AuditAction = "device.delete"
+	AuditActionApproveDevice                AuditAction = "network.device.approve"
+	AuditActionRejectDevice                 AuditAction = "network.device.reject"
+	AuditActionUpdateOrganization           AuditAction = "organization.update"
+	AuditActionAddOrganizationMember        AuditAction = "organization.member.add"
+	AuditActionChangeOrganizationMemberRole AuditAction = "organization.member.role"
+	AuditActionDeleteOrganizationMember     AuditAction = "organization.member.delete"
+	AuditActionAssignOrganizationRelays     AuditAction = "organization.relay.assign"
+)
+
+// String implements the fmt.Stringer interface
+func (a AuditAction) String() string {
+	return string(a)
+}
@@ -15,15 +15,33 @@
 package models
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math/rand"
 
+	"github.com/pairmesh/pairmesh/constant"
+
 	"gorm.io/gorm"
 	"inet.af/netaddr"
 )
 
 const maxRetry = 200
 
+// IPv4Prefix is the IPv4 prefix of devices.
+var IPv4Prefix = netaddr.MustParseIPPrefix(constant.MeshIPv4Prefix)
+
+// ULAPrefix is the unique local IPv6 prefix (fd00::/8) of devices, and the
+// 40-bit global ID is the ASCII of "pairm".
+var ULAPrefix = netaddr.MustParseIPPrefix(constant.MeshIPv6Prefix)
+
+// DeviceIPv6 returns the IPv6 address derived from the device identifier. The
+// interface identifier (the lower 64 bits) of address is the device identifier.
+func DeviceIPv6(id ID) string {
+	addr := ULAPrefix.IP().As16()
+	binary.BigEndian.PutUint64(addr[8:], uint64(id))
+	return netaddr.IPFrom16(addr).String()
+}
+
 // NextIP retrieve the next available IP address
 // TODO: maybe use algorithm like
 func NextIP(tx *gorm.DB) (string, error) {
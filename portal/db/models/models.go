@@ -55,7 +55,7 @@ type (
 		Salt      string `gorm:"type:varchar(64);not null"`
 		Hash      string `gorm:"type:varchar(64);not null"`
 		SecretKey string `gorm:"type:varchar(64);not null"`
-		Origin    string `gorm:"type:enum('pairmesh','github','wechat');default:'pairmesh'"`
+		Origin    string `gorm:"type:varchar(16);default:'pairmesh'"`
 	}
 
 	// Device represents a virtual network device
@@ -71,6 +71,44 @@ type (
 		MachineID     string    `gorm:"type:varchar(128);not null"`
 		LastSeen      time.Time `gorm:"not null"`
 		Address       string    `gorm:"type:varchar(32);not null"`
+		// ExitNode indicates the device offers to forward the internet traffics
+		// for the peers in the same networks.
+		ExitNode bool `gorm:"not null;default:FALSE"`
+		// OrganizationID is inherited from the auth key which registers the
+		// device, and the zero value means a personal device.
+		OrganizationID ID `gorm:"not null;default:0;index"`
+		// Ephemeral indicates the device is registered with an ephemeral key,
+		// and it is removed after offline for a while or logged out.
+		Ephemeral bool `gorm:"not null;default:FALSE;index"`
+		// RevokedAt is the time the device is revoked by the admin, and the
+		// credential of a revoked device is neither renewed nor accepted.
+		RevokedAt *time.Time
+	}
+
+	// DeviceRoute represents the subnet route advertised by a device, and the
+	// route will be distributed to the peers after approved.
+	DeviceRoute struct {
+		Deletable
+
+		DeviceID     ID      `gorm:"not null;index"`
+		Device       *Device `gorm:"foreignkey:DeviceID"`
+		Prefix       string  `gorm:"type:varchar(64);not null"`
+		Approved     bool    `gorm:"not null;default:FALSE"`
+		ApprovedByID ID      `gorm:"not null;default:0"`
+	}
+
+	// DeviceApproval records the decision of the admin about a device joining
+	// a network. The device is pending until the decision is made if the
+	// network requires approval, and the rejected device is kept out of the
+	// network anyway.
+	DeviceApproval struct {
+		Deletable
+
+		DeviceID     ID      `gorm:"not null;index"`
+		Device       *Device `gorm:"foreignkey:DeviceID"`
+		NetworkID    ID      `gorm:"not null;index"`
+		Approved     bool    `gorm:"not null;default:FALSE"`
+		ApprovedByID ID      `gorm:"not null;default:0"`
 	}
 
 	// Network represents a network
@@ -82,6 +120,32 @@ type (
 		CreatedBy   *User  `gorm:"foreignkey:CreatedByID"`
 		Name        string `gorm:"type:varchar(64);not null"`
 		Description string `gorm:"type:varchar(256);not null"`
+		// Broadcast enables forwarding the broadcast/multicast packets to all
+		// devices of the network.
+		Broadcast bool `gorm:"not null;default:FALSE"`
+		// The zero value of OrganizationID means a personal network.
+		OrganizationID ID `gorm:"not null;default:0;index"`
+		// RequireApproval keeps the new devices of the members out of the
+		// network until approved by the admin.
+		RequireApproval bool `gorm:"not null;default:FALSE"`
+	}
+
+	// ACLRule represents an access control rule of the network, and the rules
+	// of a network are evaluated in ascending order of priority. The zero value
+	// of the source/destination columns matches any.
+	ACLRule struct {
+		Deletable
+
+		NetworkID   ID          `gorm:"not null;index"`
+		Network     *Network    `gorm:"foreignkey:NetworkID"`
+		Priority    int         `gorm:"not null;default:0"`
+		Action      ACLAction   `gorm:"type:varchar(16);default:'accept'"`
+		SrcUserID   ID          `gorm:"not null;default:0"`
+		SrcDeviceID ID          `gorm:"not null;default:0"`
+		DstDeviceID ID          `gorm:"not null;default:0"`
+		Protocol    ACLProtocol `gorm:"type:varchar(16);default:'any'"`
+		Ports       string      `gorm:"type:varchar(256);not null"`
+		CreatedByID ID          `gorm:"not null"`
 	}
 
 	// NetworkUser is used to associate users to networks
@@ -92,7 +156,10 @@ type (
 		User      *User    `gorm:"foreignkey:UserID"`
 		NetworkID ID       `gorm:"not null"`
 		Network   *Network `gorm:"foreignkey:NetworkID"`
-		Role      RoleType `gorm:"type:enum('owner','admin', 'member');default:'member'"`
+		Role      RoleType `gorm:"type:varchar(16);default:'member'"`
+		// Origin is the identity provider which grants the membership by the
+		// group mapping, and it's empty if the membership is joined otherwise.
+		Origin string `gorm:"type:varchar(64);not null;default:''"`
 	}
 
 	// Invitation represents the invitation request from the team admin/owner
@@ -105,7 +172,40 @@ type (
 		InvitedBy   *User    `gorm:"foreignkey:InvitedByID"`
 		UserID      ID       `gorm:"not null"`
 		User        *User    `gorm:"foreignkey:UserID"`
-		Role        RoleType `gorm:"type:enum('admin', 'member');default:'member'"`
+		Role        RoleType `gorm:"type:varchar(16);default:'member'"`
+	}
+
+	// Organization represents a tenant which owns networks, devices, auth keys
+	// and relay server assignments.
+	Organization struct {
+		Deletable
+
+		CreatedByID ID     `gorm:"not null"`
+		CreatedBy   *User  `gorm:"foreignkey:CreatedByID"`
+		Name        string `gorm:"type:varchar(64);not null"`
+		Description string `gorm:"type:varchar(256);not null"`
+	}
+
+	// OrganizationUser is used to associate users to organizations
+	OrganizationUser struct {
+		Deletable
+
+		OrganizationID ID                   `gorm:"not null;index"`
+		Organization   *Organization        `gorm:"foreignkey:OrganizationID"`
+		UserID         ID                   `gorm:"not null;index"`
+		User           *User                `gorm:"foreignkey:UserID"`
+		Role           OrganizationRoleType `gorm:"type:varchar(16);default:'member'"`
+	}
+
+	// OrganizationRelay assigns a relay server to an organization. The devices
+	// of an organization are restricted to the assigned relay servers, and any
+	// relay server can be used if none is assigned.
+	OrganizationRelay struct {
+		Deletable
+
+		OrganizationID ID           `gorm:"not null;index"`
+		RelayServerID  ID           `gorm:"not null"`
+		RelayServer    *RelayServer `gorm:"foreignkey:RelayServerID"`
 	}
 
 	// RelayServer describes a relay server packet relay node running within a RelayRe.
@@ -145,17 +245,71 @@ type (
 		City       string `gorm:"type:varchar(128)"`
 	}
 
+	// OIDCUser represents the user of a generic OpenID Connect provider, which
+	// is identified by the provider name and the subject.
+	OIDCUser struct {
+		Deletable
+
+		UserID   ID     `gorm:"not null;unique"`
+		User     *User  `gorm:"foreignkey:UserID"`
+		Provider string `gorm:"type:varchar(64);not null;uniqueIndex:idx_oidc_users_subject"`
+		Subject  string `gorm:"type:varchar(255);not null;uniqueIndex:idx_oidc_users_subject"`
+		Email    string `gorm:"type:varchar(64)"`
+	}
+
 	// AuthKey stores the pre-authentication keys
 	AuthKey struct {
 		Deletable
 
 		UserID    ID        `gorm:"not null"`
 		User      *User     `gorm:"foreignkey:UserID"`
-		Type      KeyType   `gorm:"not null"`
-		Role      KeyType   `gorm:"type:enum('one-off','reusable');default:'reusable'"`
-		Key       string    `gorm:"not null;unique"`
+		Type      KeyType   `gorm:"type:varchar(16);not null"`
+		Role      KeyType   `gorm:"type:varchar(16);default:'reusable'"`
+		Key       string    `gorm:"type:varchar(128);not null;unique"`
 		MachineID string    `gorm:"type:varchar(128);"`
 		ExpiredAt time.Time `gorm:"not null"`
 		Enabled   bool      `gorm:"not null;default:TRUE"`
+		// The devices registered with the key belong to the organization.
+		OrganizationID ID `gorm:"not null;default:0"`
+	}
+
+	// AuditLog records the administrative actions, which is append-only and
+	// the rows can never be updated or deleted.
+	AuditLog struct {
+		Base
+
+		ActorID        ID          `gorm:"not null;index"`
+		Action         AuditAction `gorm:"type:varchar(64);not null;index"`
+		TargetType     string      `gorm:"type:varchar(32);not null"`
+		TargetID       ID          `gorm:"not null"`
+		OrganizationID ID          `gorm:"not null;default:0;index"`
+		NetworkID      ID          `gorm:"not null;default:0;index"`
+		Before         string      `gorm:"type:text"`
+		After          string      `gorm:"type:text"`
+		RemoteAddr     string      `gorm:"type:varchar(64)"`
+		UserAgent      string      `gorm:"type:varchar(512)"`
 	}
 )
+
+// Tables returns all tables which are migrated at startup.
+func Tables() []interface{} {
+	return []interface{}{
+		&User{},
+		&AuthKey{},
+		&NetworkUser{},
+		&Invitation{},
+		&Network{},
+		&ACLRule{},
+		&Device{},
+		&DeviceRoute{},
+		&DeviceApproval{},
+		&RelayServer{},
+		&GithubUser{},
+		&WechatUser{},
+		&OIDCUser{},
+		&Organization{},
+		&OrganizationUser{},
+		&OrganizationRelay{},
+		&AuditLog{},
+	}
+}
@@ -34,6 +34,37 @@ WHERE user_id IN
 	return devices, tx.Error
 }
 
+// PendingDevices returns the devices of the network members which the admin
+// hasn't decided to approve or reject, and the revoked devices are excluded.
+func PendingDevices(tx *gorm.DB, networkID ID) ([]Device, error) {
+	var devices []Device
+	err := tx.Raw(`
+SELECT *
+FROM devices
+WHERE revoked_at IS NULL
+  AND user_id IN (SELECT user_id FROM network_users WHERE network_id = ?)
+  AND id NOT IN (SELECT device_id FROM device_approvals WHERE network_id = ?)
+ORDER BY id
+`, networkID, networkID).Scan(&devices).Error
+
+	return devices, err
+}
+
+// IsNetworkAdminOf returns whether the admin is the owner/admin of any network
+// which the user belongs to.
+func IsNetworkAdminOf(tx *gorm.DB, adminID, userID ID) (bool, error) {
+	var count int64
+	err := tx.Raw(`
+SELECT COUNT(*)
+FROM network_users
+WHERE user_id = ?
+  AND role IN (?, ?)
+  AND network_id IN (SELECT network_id FROM network_users WHERE user_id = ?)
+`, adminID, RoleTypeOwner, RoleTypeAdmin, userID).Scan(&count).Error
+
+	return count > 0, err
+}
+
 // NetworkStats gets network stats info from database
 func NetworkStats(tx *gorm.DB, networkID ID) (userCount, deviceCount int64, err error) {
 	userCount, err = NewNetworkUserQuerySet(tx).NetworkIDEq(networkID).Count()
@@ -45,3 +76,59 @@ func NetworkStats(tx *gorm.DB, networkID ID) (userCount, deviceCount int64, err
 	err = tx.Error
 	return
 }
+
+// IsNetworkPeerOf returns whether the user and the peer belong to any network
+// in common.
+func IsNetworkPeerOf(tx *gorm.DB, userID, peerID ID) (bool, error) {
+	var count int64
+	err := tx.Raw(`
+SELECT COUNT(*)
+FROM network_users
+WHERE user_id = ?
+  AND network_id IN (SELECT network_id FROM network_users WHERE user_id = ?)
+`, userID, peerID).Scan(&count).Error
+
+	return count > 0, err
+}
+
+// PeerUserIDs returns the users who belong to any network in common with the
+// specified users, including the specified users themselves.
+func PeerUserIDs(tx *gorm.DB, userIDs ...ID) ([]ID, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	var ids []ID
+	err := tx.Raw(`
+SELECT DISTINCT user_id
+FROM network_users
+WHERE network_id IN (SELECT network_id FROM network_users WHERE user_id IN ?)
+`, userIDs).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// The users without networks aren't returned by the query.
+	found := make(map[ID]struct{}, len(ids))
+	for _, id := range ids {
+		found[id] = struct{}{}
+	}
+	for _, id := range userIDs {
+		if _, ok := found[id]; !ok {
+			found[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// NetworkMemberIDs returns the members of the specified networks.
+func NetworkMemberIDs(tx *gorm.DB, networkIDs ...ID) ([]ID, error) {
+	if len(networkIDs) == 0 {
+		return nil, nil
+	}
+
+	var ids []ID
+	err := tx.Raw("SELECT DISTINCT user_id FROM network_users WHERE network_id IN ?", networkIDs).Scan(&ids).Error
+	return ids, err
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pairmesh/pairmesh/portal/db/dbtest"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeviceApproval(t *testing.T) {
+	a := assert.New(t)
+	tx := dbtest.SQLite(t)
+
+	var alice, bob models.User
+	a.Nil(alice.Create(tx))
+	a.Nil(bob.Create(tx))
+	network := &models.Network{CreatedByID: alice.ID, Name: "office", RequireApproval: true}
+	a.Nil(network.Create(tx))
+	count, err := models.NewNetworkQuerySet(tx).RequireApprovalEq(true).Count()
+	a.Nil(err)
+	a.Equal(int64(1), count)
+	a.Nil((&models.NetworkUser{UserID: alice.ID, NetworkID: network.ID, Role: models.RoleTypeOwner}).Create(tx))
+	a.Nil((&models.NetworkUser{UserID: bob.ID, NetworkID: network.ID}).Create(tx))
+
+	now := time.Now()
+	laptop := &models.Device{UserID: alice.ID, Name: "laptop", Address: "10.0.0.2", LastSeen: now}
+	a.Nil(laptop.Create(tx))
+
+	// The existing devices are approved when the approval becomes required.
+	a.Nil(models.ApproveNetworkDevices(tx, network.ID, alice.ID))
+	pending, err := models.PendingDevices(tx, network.ID)
+	a.Nil(err)
+	a.Empty(pending)
+
+	phone := &models.Device{UserID: bob.ID, Name: "phone", Address: "10.0.0.3", LastSeen: now}
+	a.Nil(phone.Create(tx))
+	revoked := &models.Device{UserID: bob.ID, Name: "stolen", Address: "10.0.0.4", LastSeen: now, RevokedAt: &now}
+	a.Nil(revoked.Create(tx))
+	pending, err = models.PendingDevices(tx, network.ID)
+	a.Nil(err)
+	a.Len(pending, 1)
+	a.Equal(phone.ID, pending[0].ID)
+
+	// The rejected devices are no longer pending, and the decision can be
+	// changed later.
+	a.Nil(models.DecideDeviceApproval(tx, network.ID, phone.ID, alice.ID, false))
+	pending, err = models.PendingDevices(tx, network.ID)
+	a.Nil(err)
+	a.Empty(pending)
+	a.Nil(models.DecideDeviceApproval(tx, network.ID, phone.ID, alice.ID, true))
+
+	var approvals []models.DeviceApproval
+	a.Nil(models.NewDeviceApprovalQuerySet(tx).NetworkIDEq(network.ID).ApprovedEq(true).OrderAscByDeviceID().All(&approvals))
+	a.Len(approvals, 2)
+	a.Equal(laptop.ID, approvals[0].DeviceID)
+	a.Equal(phone.ID, approvals[1].DeviceID)
+	a.Equal(alice.ID, approvals[1].ApprovedByID)
+
+	// The approvals are removed with the device.
+	a.Nil(models.DeleteDevice(tx, phone.ID))
+	count, err = models.NewDeviceApprovalQuerySet(tx).DeviceIDEq(phone.ID).Count()
+	a.Nil(err)
+	a.Zero(count)
+}
+
+func TestPeerUserIDs(t *testing.T) {
+	a := assert.New(t)
+	tx := dbtest.SQLite(t)
+
+	var alice, bob, carol models.User
+	for _, u := range []*models.User{&alice, &bob, &carol} {
+		a.Nil(u.Create(tx))
+	}
+	network := &models.Network{CreatedByID: alice.ID, Name: "home"}
+	a.Nil(network.Create(tx))
+	a.Nil((&models.NetworkUser{UserID: alice.ID, NetworkID: network.ID, Role: models.RoleTypeOwner}).Create(tx))
+	a.Nil((&models.NetworkUser{UserID: bob.ID, NetworkID: network.ID}).Create(tx))
+
+	ids, err := models.PeerUserIDs(tx, alice.ID)
+	a.Nil(err)
+	a.Len(ids, 2)
+	a.Contains(ids, alice.ID)
+	a.Contains(ids, bob.ID)
+
+	// The users without networks are affected by their own changes only.
+	ids, err = models.PeerUserIDs(tx, carol.ID)
+	a.Nil(err)
+	a.Equal([]models.ID{carol.ID}, ids)
+
+	ids, err = models.NetworkMemberIDs(tx, network.ID)
+	a.Nil(err)
+	a.Len(ids, 2)
+	a.Contains(ids, bob.ID)
+}
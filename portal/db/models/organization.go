@@ -0,0 +1,71 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+// OrganizationRole returns the role of the user in the organization, and the
+// empty role is returned if the user doesn't belong to the organization.
+func OrganizationRole(tx *gorm.DB, organizationID, userID ID) (OrganizationRoleType, error) {
+	if organizationID == 0 {
+		return "", nil
+	}
+	var orgUser OrganizationUser
+	err := NewOrganizationUserQuerySet(tx).OrganizationIDEq(organizationID).UserIDEq(userID).One(&orgUser)
+	if err == gorm.ErrRecordNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return orgUser.Role, nil
+}
+
+// IsOrganizationRoleOf returns whether the admin has any of the roles in any
+// organization which the user belongs to.
+func IsOrganizationRoleOf(tx *gorm.DB, adminID, userID ID, roles ...OrganizationRoleType) (bool, error) {
+	if len(roles) == 0 {
+		return false, nil
+	}
+	var count int64
+	err := tx.Raw(`
+SELECT COUNT(*)
+FROM organization_users
+WHERE user_id = ?
+  AND role IN ?
+  AND organization_id IN (SELECT organization_id FROM organization_users WHERE user_id = ?)
+`, adminID, roles, userID).Scan(&count).Error
+
+	return count > 0, err
+}
+
+// OrganizationRelayServerIDs returns the relay servers assigned to the organization.
+func OrganizationRelayServerIDs(tx *gorm.DB, organizationID ID) ([]ID, error) {
+	if organizationID == 0 {
+		return nil, nil
+	}
+	var relays []OrganizationRelay
+	err := NewOrganizationRelayQuerySet(tx).OrganizationIDEq(organizationID).All(&relays)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]ID, 0, len(relays))
+	for _, r := range relays {
+		ids = append(ids, r.RelayServerID)
+	}
+	return ids, nil
+}
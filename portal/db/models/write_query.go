@@ -17,6 +17,7 @@ package models
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"sort"
 
 	"github.com/pairmesh/pairmesh/security"
 
@@ -36,11 +37,163 @@ func CreateUser(tx *gorm.DB, user *User, ssoUser interface{}) error {
 		t.UserID = user.ID
 	case *WechatUser:
 		t.UserID = user.ID
+	case *OIDCUser:
+		t.UserID = user.ID
 	}
 
 	return tx.Create(ssoUser).Error
 }
 
+// SyncDeviceRoutes synchronizes the subnet routes advertised by the device. The
+// newly advertised routes are pending for approval and the routes which are no
+// longer advertised will be removed.
+func SyncDeviceRoutes(tx *gorm.DB, deviceID ID, prefixes []string) error {
+	if len(prefixes) == 0 {
+		return NewDeviceRouteQuerySet(tx).DeviceIDEq(deviceID).Delete()
+	}
+
+	err := NewDeviceRouteQuerySet(tx).DeviceIDEq(deviceID).PrefixNotIn(prefixes...).Delete()
+	if err != nil {
+		return err
+	}
+
+	var existing []DeviceRoute
+	err = NewDeviceRouteQuerySet(tx).DeviceIDEq(deviceID).All(&existing)
+	if err != nil {
+		return err
+	}
+	advertised := map[string]struct{}{}
+	for _, r := range existing {
+		advertised[r.Prefix] = struct{}{}
+	}
+
+	for _, prefix := range prefixes {
+		if _, found := advertised[prefix]; found {
+			continue
+		}
+		advertised[prefix] = struct{}{}
+		err := tx.Create(&DeviceRoute{DeviceID: deviceID, Prefix: prefix}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteDevice removes the device, its subnet routes and approvals, which
+// frees the address and the device quota of the owner.
+func DeleteDevice(tx *gorm.DB, deviceID ID) error {
+	if err := NewDeviceRouteQuerySet(tx).DeviceIDEq(deviceID).Delete(); err != nil {
+		return err
+	}
+	if err := NewDeviceApprovalQuerySet(tx).DeviceIDEq(deviceID).Delete(); err != nil {
+		return err
+	}
+	return NewDeviceQuerySet(tx).IDEq(deviceID).Delete()
+}
+
+// DecideDeviceApproval records the decision of the admin about the device
+// joining the network, and the previous decision is overwritten.
+func DecideDeviceApproval(tx *gorm.DB, networkID, deviceID, adminID ID, approved bool) error {
+	var existing []DeviceApproval
+	err := NewDeviceApprovalQuerySet(tx).NetworkIDEq(networkID).DeviceIDEq(deviceID).All(&existing)
+	if err != nil {
+		return err
+	}
+	if len(existing) == 0 {
+		return tx.Create(&DeviceApproval{
+			DeviceID:     deviceID,
+			NetworkID:    networkID,
+			Approved:     approved,
+			ApprovedByID: adminID,
+		}).Error
+	}
+	return NewDeviceApprovalQuerySet(tx).
+		NetworkIDEq(networkID).
+		DeviceIDEq(deviceID).
+		GetUpdater().
+		SetApproved(approved).
+		SetApprovedByID(adminID).
+		Update()
+}
+
+// ApproveNetworkDevices approves all pending devices of the network, which
+// keeps the existing devices in the network when the approval is required.
+func ApproveNetworkDevices(tx *gorm.DB, networkID, adminID ID) error {
+	devices, err := PendingDevices(tx, networkID)
+	if err != nil {
+		return err
+	}
+	for _, d := range devices {
+		err := tx.Create(&DeviceApproval{
+			DeviceID:     d.ID,
+			NetworkID:    networkID,
+			Approved:     true,
+			ApprovedByID: adminID,
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SyncOriginMemberships synchronizes the network memberships of the user which
+// are granted by the identity provider. The memberships joined otherwise are
+// never changed, and the granted memberships which are absent now are removed.
+// The networks which don't exist are ignored. It returns the networks which the
+// user joins or leaves.
+func SyncOriginMemberships(tx *gorm.DB, userID ID, origin string, memberships map[ID]RoleType) ([]ID, error) {
+	var existing []NetworkUser
+	if err := NewNetworkUserQuerySet(tx).UserIDEq(userID).All(&existing); err != nil {
+		return nil, err
+	}
+
+	var changed []ID
+	joined := map[ID]struct{}{}
+	for _, nu := range existing {
+		joined[nu.NetworkID] = struct{}{}
+		if nu.Origin != origin {
+			continue
+		}
+		role, found := memberships[nu.NetworkID]
+		var err error
+		switch {
+		case !found:
+			err = NewNetworkUserQuerySet(tx).IDEq(nu.ID).Delete()
+			changed = append(changed, nu.NetworkID)
+		case role != nu.Role:
+			err = NewNetworkUserQuerySet(tx).IDEq(nu.ID).GetUpdater().SetRole(role).Update()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var networkIDs []ID
+	for networkID := range memberships {
+		if _, found := joined[networkID]; !found {
+			networkIDs = append(networkIDs, networkID)
+		}
+	}
+	sort.Slice(networkIDs, func(i, j int) bool { return networkIDs[i] < networkIDs[j] })
+	for _, networkID := range networkIDs {
+		count, err := NewNetworkQuerySet(tx).IDEq(networkID).Count()
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			continue
+		}
+		nu := &NetworkUser{UserID: userID, NetworkID: networkID, Role: memberships[networkID], Origin: origin}
+		if err := tx.Create(nu).Error; err != nil {
+			return nil, err
+		}
+		changed = append(changed, networkID)
+	}
+	return changed, nil
+}
+
 // BuildUser generate a stub user for sso
 func BuildUser() (User, error) {
 	secretKey := [32]byte{}
@@ -0,0 +1,157 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package release
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-semver/semver"
+	"gopkg.in/yaml.v3"
+)
+
+// Channel represents the release channel which the nodes subscribe
+type Channel string
+
+// Channel constants are the release channels
+const (
+	ChannelStable Channel = "stable"
+	ChannelBeta   Channel = "beta"
+)
+
+// Valid returns whether the channel is a known channel
+func (c Channel) Valid() bool {
+	return c == ChannelStable || c == ChannelBeta
+}
+
+// Includes returns whether the releases of the other channel are offered to the
+// nodes of the channel.
+func (c Channel) Includes(other Channel) bool {
+	return c == other || (c == ChannelBeta && other == ChannelStable)
+}
+
+type (
+	// Release represents a release binary of a platform
+	Release struct {
+		Version   string  `yaml:"version"`
+		Channel   Channel `yaml:"channel"`
+		OS        string  `yaml:"os"`
+		Arch      string  `yaml:"arch"`
+		URL       string  `yaml:"url"`
+		SHA256    string  `yaml:"sha256"`
+		Signature string  `yaml:"signature"`
+		Notes     string  `yaml:"notes"`
+
+		semver *semver.Version
+	}
+
+	// Manifest represents all releases served to the nodes
+	Manifest struct {
+		Releases []*Release `yaml:"releases"`
+	}
+
+	// Store loads the manifest from the path, and reloads it after modified.
+	Store struct {
+		path string
+
+		mu       sync.Mutex
+		modTime  time.Time
+		manifest *Manifest
+	}
+)
+
+// SemVer returns the semantic version of the release
+func (r *Release) SemVer() *semver.Version {
+	return r.semver
+}
+
+// Parse parses and validates the manifest
+func Parse(data []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := yaml.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	for i, r := range m.Releases {
+		v, err := semver.NewVersion(r.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version of release %d: %w", i, err)
+		}
+		r.semver = v
+		if r.Channel == "" {
+			r.Channel = ChannelStable
+		}
+		if !r.Channel.Valid() {
+			return nil, fmt.Errorf("unknown channel %s of release %s", r.Channel, r.Version)
+		}
+		if r.OS == "" || r.URL == "" {
+			return nil, fmt.Errorf("missing os or url of release %s", r.Version)
+		}
+	}
+	return m, nil
+}
+
+// Latest returns the latest release of the platform offered to the channel, nil
+// if no release found.
+func (m *Manifest) Latest(os, arch string, channel Channel) *Release {
+	var latest *Release
+	for _, r := range m.Releases {
+		if r.OS != os || (r.Arch != "" && r.Arch != arch) || !channel.Includes(r.Channel) {
+			continue
+		}
+		if latest == nil || latest.semver.LessThan(*r.semver) {
+			latest = r
+		}
+	}
+	return latest
+}
+
+// NewStore returns the store of the manifest, an empty path means no release.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Manifest returns the latest manifest, and it's reloaded if the file has been
+// modified. The previous manifest is kept if the modified file is invalid.
+func (s *Store) Manifest() (*Manifest, error) {
+	if s.path == "" {
+		return &Manifest{}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return nil, err
+	}
+	if s.manifest != nil && info.ModTime().Equal(s.modTime) {
+		return s.manifest, nil
+	}
+
+	data, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	m, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	s.manifest = m
+	s.modTime = info.ModTime()
+	return m, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package release
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const manifest = `
+releases:
+  - version: 0.1.1
+    os: linux
+    arch: amd64
+    url: https://example.com/0.1.1/linux-amd64
+  - version: 0.2.0-beta.1
+    channel: beta
+    os: linux
+    arch: amd64
+    url: https://example.com/0.2.0-beta.1/linux-amd64
+  - version: 0.1.2
+    channel: stable
+    os: darwin
+    url: https://example.com/0.1.2/darwin
+  - version: 0.1.10
+    channel: stable
+    os: linux
+    arch: amd64
+    url: https://example.com/0.1.10/linux-amd64
+`
+
+func TestLatest(t *testing.T) {
+	a := assert.New(t)
+
+	m, err := Parse([]byte(manifest))
+	a.Nil(err)
+
+	a.Equal("0.1.10", m.Latest("linux", "amd64", ChannelStable).Version)
+	a.Equal("0.2.0-beta.1", m.Latest("linux", "amd64", ChannelBeta).Version)
+	a.Nil(m.Latest("linux", "arm64", ChannelStable))
+	a.Equal("0.1.2", m.Latest("darwin", "arm64", ChannelStable).Version)
+	a.Nil(m.Latest("windows", "amd64", ChannelBeta))
+
+	_, err = Parse([]byte("releases: [{version: latest, os: linux, url: x}]"))
+	a.NotNil(err)
+	_, err = Parse([]byte("releases: [{version: 0.1.0, channel: nightly, os: linux, url: x}]"))
+	a.NotNil(err)
+}
+
+func TestStore(t *testing.T) {
+	a := assert.New(t)
+
+	m, err := NewStore("").Manifest()
+	a.Nil(err)
+	a.Empty(m.Releases)
+
+	path := filepath.Join(t.TempDir(), "releases.yaml")
+	a.Nil(ioutil.WriteFile(path, []byte(manifest), 0644))
+	s := NewStore(path)
+	m, err = s.Manifest()
+	a.Nil(err)
+	a.Len(m.Releases, 4)
+
+	// Reload after modified
+	a.Nil(ioutil.WriteFile(path, []byte("releases: []"), 0644))
+	a.Nil(os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	m, err = s.Manifest()
+	a.Nil(err)
+	a.Empty(m.Releases)
+}
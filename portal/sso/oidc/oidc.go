@@ -0,0 +1,502 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pairmesh/pairmesh/internal/ledis"
+	"github.com/pairmesh/pairmesh/portal/config"
+	"github.com/pairmesh/pairmesh/portal/db"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+	"github.com/pairmesh/pairmesh/portal/sso"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"gorm.io/gorm"
+)
+
+const (
+	discoveryPath   = "/.well-known/openid-configuration"
+	stateExpiration = 10 * time.Minute
+	requestTimeout  = 10 * time.Second
+	// discoveryBackoff is the duration to fail the logins without discovering
+	// again after the discovery of provider metadata failed.
+	discoveryBackoff = 30 * time.Second
+)
+
+var defaultScopes = []string{"openid", "email", "profile"}
+
+type (
+	// discovery is the provider metadata of OpenID Connect discovery
+	discovery struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	}
+
+	// provider is a generic OpenID Connect provider, which uses the authorization
+	// code flow. The ID token is received from the token endpoint directly, so
+	// the TLS server validation is used in place of checking the signature.
+	provider struct {
+		cfg      config.OIDC
+		client   *http.Client
+		redirect string
+
+		mu        sync.Mutex
+		discovery *discovery
+		// discovering is closed once the inflight discovery is done, and the
+		// failure of the last discovery is returned until the backoff ends.
+		discovering chan struct{}
+		failure     error
+		failedAt    time.Time
+	}
+)
+
+func newProvider(cfg config.OIDC) sso.Provider {
+	return &provider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// origin returns the origin of the network memberships granted by the provider.
+func (p *provider) origin() string {
+	return "oidc:" + p.cfg.Name
+}
+
+// Setup validates the configuration of the provider, and the provider metadata
+// is discovered on the first login, so the portal service can start up while
+// the provider is unavailable.
+func (p *provider) Setup(cfg *config.SSO) error {
+	if p.cfg.Issuer == "" || p.cfg.ClientID == "" {
+		return errors.Errorf("the issuer and client ID of OpenID Connect provider %s are required", p.cfg.Name)
+	}
+	for _, g := range p.cfg.Groups {
+		if g.Group == "" || g.NetworkID == 0 {
+			return errors.Errorf("illegal group mapping of OpenID Connect provider %s", p.cfg.Name)
+		}
+		if g.Role != "" && g.Role != string(models.RoleTypeAdmin) && g.Role != string(models.RoleTypeMember) {
+			return errors.Errorf("illegal role %s of OpenID Connect provider %s", g.Role, p.cfg.Name)
+		}
+	}
+	p.redirect = fmt.Sprintf("%s%s/oidc/%s", strings.TrimRight(cfg.Redirect, "/"), sso.URIAuthCodeCallback, url.PathEscape(p.cfg.Name))
+	return nil
+}
+
+// discover returns the provider metadata, which is cached after discovered
+// successfully. The metadata is fetched without holding the lock, and the
+// concurrent callers wait for the inflight discovery.
+func (p *provider) discover() (*discovery, error) {
+	p.mu.Lock()
+	if p.discovery != nil {
+		defer p.mu.Unlock()
+		return p.discovery, nil
+	}
+	if p.failure != nil && time.Since(p.failedAt) < discoveryBackoff {
+		defer p.mu.Unlock()
+		return nil, p.failure
+	}
+	if ch := p.discovering; ch != nil {
+		p.mu.Unlock()
+		<-ch
+		return p.discover()
+	}
+	ch := make(chan struct{})
+	p.discovering = ch
+	p.mu.Unlock()
+
+	d, err := p.fetchDiscovery()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	close(ch)
+	p.discovering = nil
+	if err != nil {
+		p.failure, p.failedAt = err, time.Now()
+		return nil, err
+	}
+	p.discovery, p.failure = d, nil
+	return d, nil
+}
+
+// fetchDiscovery fetches the provider metadata from the issuer.
+func (p *provider) fetchDiscovery() (*discovery, error) {
+	issuer := strings.TrimRight(p.cfg.Issuer, "/")
+	resp, err := p.client.Get(issuer + discoveryPath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("discover OpenID Connect provider %s failed: %s", p.cfg.Name, resp.Status)
+	}
+
+	d := &discovery{}
+	if err := json.NewDecoder(resp.Body).Decode(d); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if strings.TrimRight(d.Issuer, "/") != issuer {
+		return nil, errors.Errorf("mismatch issuer %s of OpenID Connect provider %s", d.Issuer, p.cfg.Name)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" {
+		return nil, errors.Errorf("missing endpoints of OpenID Connect provider %s", p.cfg.Name)
+	}
+	return d, nil
+}
+
+func (p *provider) oauth2Config(d *discovery) *oauth2.Config {
+	scopes := p.cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+	hasOpenID := false
+	for _, s := range scopes {
+		hasOpenID = hasOpenID || s == "openid"
+	}
+	if !hasOpenID {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Scopes:       scopes,
+		RedirectURL:  p.redirect,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  d.AuthorizationEndpoint,
+			TokenURL: d.TokenEndpoint,
+		},
+	}
+}
+
+func stateKey(state string) string {
+	return "oidc-state:" + state
+}
+
+// AuthCodeURL returns a URL to the consent page of the provider. The redirect
+// URI is fixed to `{redirect}/login/auth/callback/oidc/{name}` which must be
+// registered to the provider, and the client is passed by the state.
+func (p *provider) AuthCodeURL(_ string, client string) string {
+	d, err := p.discover()
+	if err != nil {
+		zap.L().Error("Discover OpenID Connect provider failed", zap.String("name", p.cfg.Name), zap.Error(err))
+		return ""
+	}
+
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		zap.L().Error("Generate state code failed", zap.Error(err))
+		return ""
+	}
+	state := base64.RawURLEncoding.EncodeToString(b[:])
+	err = ledis.Shared().Set(context.Background(), stateKey(state), p.cfg.Name+" "+client, stateExpiration)
+	if err != nil {
+		zap.L().Error("Set state code failed", zap.Error(err))
+		return ""
+	}
+	return p.oauth2Config(d).AuthCodeURL(state)
+}
+
+// ConsumeState validates the state of the authorization response, and returns
+// the client which starts the login. The state can only be used once.
+func ConsumeState(name, state string) (string, error) {
+	if state == "" {
+		return "", errors.New("illegal parameter, no state")
+	}
+	ctx := context.Background()
+	v, err := ledis.Shared().Get(ctx, stateKey(state))
+	if err != nil {
+		return "", err
+	}
+	_ = ledis.Shared().Del(ctx, stateKey(state))
+
+	prefix := name + " "
+	if !strings.HasPrefix(v.(string), prefix) {
+		return "", errors.New("invalid or expired state")
+	}
+	return strings.TrimPrefix(v.(string), prefix), nil
+}
+
+// AccessToken exchanges the authorization code for the tokens.
+func (p *provider) AccessToken(code string) (*sso.Token, error) {
+	d, err := p.discover()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, p.client)
+	token, err := p.oauth2Config(d).Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	idToken, _ := token.Extra("id_token").(string)
+	if idToken == "" {
+		return nil, errors.New("missing id token")
+	}
+	return &sso.Token{
+		AccessToken: token.AccessToken,
+		Raw:         map[string]string{"id_token": idToken},
+	}, nil
+}
+
+// parseIDToken returns the claims of the ID token after validating the issuer,
+// audience and expiration.
+func parseIDToken(raw, issuer, clientID string, now time.Time) (map[string]interface{}, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed id token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if iss, _ := claims["iss"].(string); strings.TrimRight(iss, "/") != strings.TrimRight(issuer, "/") {
+		return nil, errors.Errorf("mismatch id token issuer %s", iss)
+	}
+	audienceMatched := false
+	switch aud := claims["aud"].(type) {
+	case string:
+		audienceMatched = aud == clientID
+	case []interface{}:
+		for _, a := range aud {
+			audienceMatched = audienceMatched || a == clientID
+		}
+	}
+	if !audienceMatched {
+		return nil, errors.New("mismatch id token audience")
+	}
+	exp, _ := claims["exp"].(float64)
+	if now.Unix() >= int64(exp) {
+		return nil, errors.New("id token expired")
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return nil, errors.New("missing id token subject")
+	}
+	return claims, nil
+}
+
+// userinfo returns the claims of the userinfo endpoint.
+func (p *provider) userinfo(d *discovery, accessToken string) (map[string]interface{}, error) {
+	req, err := http.NewRequest(http.MethodGet, d.UserinfoEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("request userinfo failed: %s", resp.Status)
+	}
+
+	claims := map[string]interface{}{}
+	if err := json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return claims, nil
+}
+
+// claims returns the claims of the user, and the claims of the userinfo
+// endpoint take precedence over the ID token.
+func (p *provider) claims(token *sso.Token) (map[string]interface{}, error) {
+	d, err := p.discover()
+	if err != nil {
+		return nil, err
+	}
+	claims, err := parseIDToken(token.Raw["id_token"], d.Issuer, p.cfg.ClientID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if d.UserinfoEndpoint == "" || token.AccessToken == "" {
+		return claims, nil
+	}
+
+	info, err := p.userinfo(d, token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if info["sub"] != claims["sub"] {
+		return nil, errors.New("mismatch userinfo subject")
+	}
+	for k, v := range info {
+		claims[k] = v
+	}
+	return claims, nil
+}
+
+func claimName(name, def string) string {
+	if name == "" {
+		return def
+	}
+	return name
+}
+
+func stringClaim(claims map[string]interface{}, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
+
+// groupsClaim returns the groups of the claim, which is either an array or a
+// comma separated string.
+func groupsClaim(v interface{}) []string {
+	var groups []string
+	switch t := v.(type) {
+	case string:
+		for _, g := range strings.Split(t, ",") {
+			if g = strings.TrimSpace(g); g != "" {
+				groups = append(groups, g)
+			}
+		}
+	case []interface{}:
+		for _, g := range t {
+			if s, ok := g.(string); ok && s != "" {
+				groups = append(groups, s)
+			}
+		}
+	}
+	return groups
+}
+
+// memberships returns the network memberships of the groups, and the admin role
+// takes precedence if a network is mapped by multiple groups.
+func (p *provider) memberships(groups []string) map[models.ID]models.RoleType {
+	in := map[string]struct{}{}
+	for _, g := range groups {
+		in[g] = struct{}{}
+	}
+
+	memberships := map[models.ID]models.RoleType{}
+	for _, m := range p.cfg.Groups {
+		if _, found := in[m.Group]; !found {
+			continue
+		}
+		networkID := models.ID(m.NetworkID)
+		if m.Role == string(models.RoleTypeAdmin) {
+			memberships[networkID] = models.RoleTypeAdmin
+		} else if _, found := memberships[networkID]; !found {
+			memberships[networkID] = models.RoleTypeMember
+		}
+	}
+	return memberships
+}
+
+// UserInfo returns the user of the token, the user is created on the first login
+// and the network memberships are synchronized with the groups on every login.
+func (p *provider) UserInfo(token *sso.Token) (*models.User, bool, error) {
+	claims, err := p.claims(token)
+	if err != nil {
+		zap.L().Error("Read the user's claims failed", zap.String("name", p.cfg.Name), zap.Error(err))
+		return nil, false, err
+	}
+
+	subject := stringClaim(claims, "sub")
+	email := stringClaim(claims, claimName(p.cfg.Claims.Email, "email"))
+	name := stringClaim(claims, claimName(p.cfg.Claims.Name, "name"))
+	if name == "" {
+		name = stringClaim(claims, "preferred_username")
+	}
+	if name == "" {
+		name = email
+	}
+	memberships := p.memberships(groupsClaim(claims[claimName(p.cfg.Claims.Groups, "groups")]))
+
+	var (
+		user    models.User
+		newUser bool
+		changed []models.ID
+	)
+	err = db.Tx(func(tx *gorm.DB) error {
+		var oidcUser models.OIDCUser
+		err := models.NewOIDCUserQuerySet(tx).
+			ProviderEq(p.cfg.Name).
+			SubjectEq(subject).
+			One(&oidcUser)
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return errors.WithStack(err)
+		}
+
+		// Create new user if not existing.
+		newUser = err == gorm.ErrRecordNotFound
+		if newUser {
+			user, err = models.BuildUser()
+			if err != nil {
+				return err
+			}
+			user.Origin = sso.OIDC.String()
+			user.Name = name
+			user.Email = email
+
+			ssoUser := &models.OIDCUser{
+				Provider: p.cfg.Name,
+				Subject:  subject,
+				Email:    email,
+			}
+			if err := models.CreateUser(tx, &user, ssoUser); err != nil {
+				return err
+			}
+			changed, err = models.SyncOriginMemberships(tx, user.ID, p.origin(), memberships)
+			return err
+		}
+
+		// Update user information if user exists.
+		if err := models.NewUserQuerySet(tx).IDEq(oidcUser.UserID).One(&user); err != nil {
+			return err
+		}
+		if user.Name != name || user.Email != email {
+			err := models.NewUserQuerySet(tx).
+				IDEq(user.ID).
+				GetUpdater().
+				SetName(name).
+				SetEmail(email).
+				Update()
+			if err != nil {
+				return err
+			}
+			user.Name, user.Email = name, email
+		}
+		changed, err = models.SyncOriginMemberships(tx, user.ID, p.origin(), memberships)
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	sso.NotifyMemberships(user.ID, changed)
+
+	return &user, newUser, nil
+}
+
+func init() {
+	sso.RegisterOIDC(newProvider)
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pairmesh/pairmesh/portal/config"
+	"github.com/pairmesh/pairmesh/portal/db/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func idToken(t *testing.T, claims map[string]interface{}) string {
+	payload, err := json.Marshal(claims)
+	assert.Nil(t, err)
+	return "e30." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
+func TestParseIDToken(t *testing.T) {
+	a := assert.New(t)
+	now := time.Unix(1000, 0)
+	claims := func(aud interface{}, exp int64) map[string]interface{} {
+		return map[string]interface{}{"iss": "https://idp.example.com", "aud": aud, "exp": exp, "sub": "u1"}
+	}
+
+	res, err := parseIDToken(idToken(t, claims("client", 2000)), "https://idp.example.com/", "client", now)
+	a.Nil(err)
+	a.Equal("u1", res["sub"])
+
+	_, err = parseIDToken(idToken(t, claims([]string{"other", "client"}, 2000)), "https://idp.example.com", "client", now)
+	a.Nil(err)
+
+	_, err = parseIDToken(idToken(t, claims("other", 2000)), "https://idp.example.com", "client", now)
+	a.NotNil(err)
+
+	_, err = parseIDToken(idToken(t, claims("client", 1000)), "https://idp.example.com", "client", now)
+	a.NotNil(err)
+
+	_, err = parseIDToken(idToken(t, claims("client", 2000)), "https://evil.example.com", "client", now)
+	a.NotNil(err)
+
+	_, err = parseIDToken("malformed", "https://idp.example.com", "client", now)
+	a.NotNil(err)
+}
+
+func TestMemberships(t *testing.T) {
+	p := &provider{cfg: config.OIDC{
+		Groups: []config.OIDCGroup{
+			{Group: "dev", NetworkID: 1},
+			{Group: "ops", NetworkID: 1, Role: "admin"},
+			{Group: "ops", NetworkID: 2},
+			{Group: "sales", NetworkID: 3},
+		},
+	}}
+
+	assert.Equal(t, map[models.ID]models.RoleType{
+		1: models.RoleTypeAdmin,
+		2: models.RoleTypeMember,
+	}, p.memberships(groupsClaim([]interface{}{"dev", "ops"})))
+	assert.Equal(t, map[models.ID]models.RoleType{
+		1: models.RoleTypeMember,
+		3: models.RoleTypeMember,
+	}, p.memberships(groupsClaim("dev, sales")))
+	assert.Empty(t, p.memberships(nil))
+}
+
+func TestDiscover(t *testing.T) {
+	a := assert.New(t)
+
+	var issuer string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal(discoveryPath, r.URL.Path)
+		_ = json.NewEncoder(w).Encode(&discovery{
+			Issuer:                issuer,
+			AuthorizationEndpoint: issuer + "/authorize",
+			TokenEndpoint:         issuer + "/token",
+		})
+	}))
+	defer srv.Close()
+	issuer = srv.URL
+
+	p := newProvider(config.OIDC{Name: "corp", Issuer: srv.URL + "/", ClientID: "client"}).(*provider)
+	a.Nil(p.Setup(&config.SSO{Redirect: "https://portal.example.com/"}))
+	a.Equal("https://portal.example.com/login/auth/callback/oidc/corp", p.redirect)
+
+	d, err := p.discover()
+	a.Nil(err)
+	a.Equal(srv.URL+"/token", d.TokenEndpoint)
+
+	c := p.oauth2Config(d)
+	a.Equal(defaultScopes, c.Scopes)
+
+	p.cfg.Scopes = []string{"email"}
+	a.Equal([]string{"openid", "email"}, p.oauth2Config(d).Scopes)
+
+	issuer = "https://evil.example.com"
+	p.discovery = nil
+	_, err = p.discover()
+	a.NotNil(err)
+}
+
+func TestDiscoverBackoff(t *testing.T) {
+	a := assert.New(t)
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	p := newProvider(config.OIDC{Name: "corp", Issuer: srv.URL, ClientID: "client"}).(*provider)
+	a.Nil(p.Setup(&config.SSO{Redirect: "https://portal.example.com"}))
+
+	_, err := p.discover()
+	a.NotNil(err)
+	a.Equal(1, requests)
+
+	// The failure is returned without discovering again during the backoff.
+	_, err = p.discover()
+	a.NotNil(err)
+	a.Equal(1, requests)
+	a.Equal("", p.AuthCodeURL("", "client"))
+	a.Equal(1, requests)
+
+	p.failedAt = time.Now().Add(-discoveryBackoff)
+	_, err = p.discover()
+	a.NotNil(err)
+	a.Equal(2, requests)
+}
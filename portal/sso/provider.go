@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -33,15 +34,23 @@ type Vendor int
 // Contant variables for Vender
 const (
 	GitHub Vendor = iota
+	// OIDC is the vendor of the generic OpenID Connect providers, which may be
+	// configured multiple times with different names.
+	OIDC
 )
 
 // URIAuthCodeCallback is the URI of login auth, which will trigger callback function
 const URIAuthCodeCallback = "/login/auth/callback"
 
+// URIAuthCodeLogin is the URI to start the login with a provider, which redirects
+// to the consent page of the provider.
+const URIAuthCodeLogin = "/api/v1/login/sso"
+
 var errIllegalParam = errors.New("illegal parameter(s)")
 
 var vendorName = map[Vendor]string{
 	GitHub: "GitHub",
+	OIDC:   "OIDC",
 }
 
 // String implements the fmt.Stringer interface
@@ -67,16 +76,32 @@ type Provider interface {
 	UserInfo(token *Token) (*models.User, bool, error)
 }
 
+// OIDCFactory creates a generic OpenID Connect provider of the configuration
+type OIDCFactory func(cfg config.OIDC) Provider
+
+// MembershipObserver observes the networks which the user joins or leaves by
+// the memberships granted by the providers.
+type MembershipObserver func(userID models.ID, networkIDs []models.ID)
+
 type providerMgr struct {
 	sync.RWMutex
 
 	r         *rand.Rand
 	providers map[Vendor]Provider
+
+	oidcFactory OIDCFactory
+	// oidcNames are the names of the OpenID Connect providers in the
+	// configured order.
+	oidcNames []string
+	oidc      map[string]Provider
+
+	observer MembershipObserver
 }
 
 var gMgr = &providerMgr{
 	r:         rand.New(rand.NewSource(time.Now().Unix())),
 	providers: make(map[Vendor]Provider),
+	oidc:      make(map[string]Provider),
 }
 
 func (pm *providerMgr) init(cfg *config.SSO) error {
@@ -86,6 +111,28 @@ func (pm *providerMgr) init(cfg *config.SSO) error {
 			return err
 		}
 	}
+
+	if len(cfg.OIDC) > 0 && pm.oidcFactory == nil {
+		return errors.New("the OpenID Connect provider isn't registered")
+	}
+
+	pm.Lock()
+	defer pm.Unlock()
+	for _, c := range cfg.OIDC {
+		if c.Name == "" {
+			return errors.New("the name of OpenID Connect provider is required")
+		}
+		if _, found := pm.oidc[c.Name]; found {
+			return fmt.Errorf("duplicated OpenID Connect provider %s", c.Name)
+		}
+		zap.L().Info("register the OpenID Connect provider", zap.String("name", c.Name), zap.String("issuer", c.Issuer))
+		p := pm.oidcFactory(c)
+		if err := p.Setup(cfg); err != nil {
+			return err
+		}
+		pm.oidc[c.Name] = p
+		pm.oidcNames = append(pm.oidcNames, c.Name)
+	}
 	return nil
 }
 
@@ -115,6 +162,13 @@ func (pm *providerMgr) provider(name Vendor) Provider {
 	return nil
 }
 
+func (pm *providerMgr) oidcProvider(name string) Provider {
+	pm.RLock()
+	defer pm.RUnlock()
+
+	return pm.oidc[name]
+}
+
 func (pm *providerMgr) randString(len int) string {
 	bytes := make([]byte, len)
 	for i := 0; i < len; i++ {
@@ -137,6 +191,42 @@ func Register(name Vendor, p Provider) {
 	}
 }
 
+// WithOIDCName returns the OpenID Connect provider with the configured name
+func WithOIDCName(name string) Provider {
+	return gMgr.oidcProvider(name)
+}
+
+// RegisterOIDC registers the factory of the OpenID Connect providers, which
+// creates the providers configured on initialization.
+func RegisterOIDC(f OIDCFactory) {
+	gMgr.Lock()
+	defer gMgr.Unlock()
+	gMgr.oidcFactory = f
+}
+
+// ObserveMemberships registers the observer of the memberships granted by the
+// providers.
+func ObserveMemberships(o MembershipObserver) {
+	gMgr.Lock()
+	defer gMgr.Unlock()
+	gMgr.observer = o
+}
+
+// NotifyMemberships notifies the observer that the user joins or leaves the
+// networks after the memberships are committed.
+func NotifyMemberships(userID models.ID, networkIDs []models.ID) {
+	if len(networkIDs) == 0 {
+		return
+	}
+
+	gMgr.RLock()
+	o := gMgr.observer
+	gMgr.RUnlock()
+	if o != nil {
+		o(userID, networkIDs)
+	}
+}
+
 // Initialize  init the provider(s) successfully, if not, crash it
 func Initialize(sso *config.SSO) error {
 	if sso == nil {
@@ -158,7 +248,8 @@ type Link struct {
 
 // AuthCodeLinks generates the all sso vendor name &　the auth code link pairs
 // If the node port equals `0`, which means the login request is not started
-// from the PairMesh node.
+// from the PairMesh node. The links lead to the portal service, and the state
+// of the login is created only after the user picks a provider.
 func AuthCodeLinks(redirect string, client string) []Link {
 	//sort for html render
 	names := []Vendor{
@@ -166,12 +257,22 @@ func AuthCodeLinks(redirect string, client string) []Link {
 		// Other 3rd providers...
 	}
 
+	query := url.Values{"client": []string{client}}.Encode()
 	var ret []Link
 	for _, v := range names {
-		url := gMgr.provider(v).AuthCodeURL(redirect, client)
 		ret = append(ret, Link{
 			Name: vendorName[v],
-			Link: url,
+			Link: fmt.Sprintf("%s%s/%s?%s", redirect, URIAuthCodeLogin, v, query),
+		})
+	}
+
+	gMgr.RLock()
+	oidcNames := gMgr.oidcNames
+	gMgr.RUnlock()
+	for _, name := range oidcNames {
+		ret = append(ret, Link{
+			Name: name,
+			Link: fmt.Sprintf("%s%s/%s/%s?%s", redirect, URIAuthCodeLogin, OIDC, url.PathEscape(name), query),
 		})
 	}
 
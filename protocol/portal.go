@@ -14,6 +14,15 @@
 
 package protocol
 
+import "time"
+
+// The types of the peer change events.
+const (
+	PeerEventAdd    PeerEventType = "add"
+	PeerEventUpdate PeerEventType = "update"
+	PeerEventRemove PeerEventType = "remove"
+)
+
 type (
 	// PeerID is the id of a peer
 	PeerID uint64
@@ -27,22 +36,31 @@ type (
 		ID    NetworkID `json:"id"`
 		Name  string    `json:"name"`
 		Peers []PeerID  `json:"peers"`
+		// Broadcast indicates the broadcast/multicast packets are forwarded
+		// to all peers of the network.
+		Broadcast bool `json:"broadcast,omitempty"`
 	}
 
 	// Peer is the struct of a peer node instance
 	Peer struct {
 		ID       PeerID   `json:"id"`
 		UserID   UserID   `json:"user_id"`
+		UserName string   `json:"user_name,omitempty"`
 		Name     string   `json:"name,omitempty"`
 		IPv4     string   `json:"ipv4"`
+		IPv6     string   `json:"ipv6,omitempty"`
 		ServerID ServerID `json:"server_id"`
 		Active   bool     `json:"active"`
+		// Routes are the approved subnet routes advertised by the peer.
+		Routes []string `json:"routes,omitempty"`
+		// ExitNode indicates the peer can be used as an exit node.
+		ExitNode bool `json:"exit_node,omitempty"`
 	}
 
 	// PeerGraphResponse represents the topology of peers.
 	PeerGraphResponse struct {
-		// NotModified indicates the change status of peer graph and set to false
-		// if no change from last access.
+		// NotModified indicates the change status of peer graph and set to true
+		// if no change from the peer graph identified by the requested hash.
 		NotModified bool   `json:"not_modified"`
 		UniqueHash  string `json:"unique_hash"`
 		// UpdateInterval indicates the interval of update peers graph from
@@ -51,6 +69,58 @@ type (
 		RelayServers   []RelayServer `json:"relay_servers,omitempty"`
 		Peers          []Peer        `json:"peers,omitempty"`
 		Networks       []Network     `json:"networks,omitempty"`
+		// ACL are the access control rules of the networks.
+		ACL []ACLRule `json:"acl,omitempty"`
+	}
+
+	// PeerEventType represents the type of the peer change events.
+	PeerEventType string
+
+	// PeerEvent represents an incremental change of the peers in the peer graph.
+	PeerEvent struct {
+		Type PeerEventType `json:"type"`
+		Peer Peer          `json:"peer"`
+	}
+
+	// PeerGraphDelta represents the changes of the peer graph since the peer
+	// graph identified by BaseHash, which is pushed to the nodes by the long
+	// polling requests.
+	PeerGraphDelta struct {
+		BaseHash   string `json:"base_hash"`
+		UniqueHash string `json:"unique_hash"`
+		// Full is the complete peer graph if the delta cannot be computed from
+		// the base peer graph, e.g. the portal service restarted.
+		Full *PeerGraphResponse `json:"full,omitempty"`
+		// Events are the changes of the peers.
+		Events []PeerEvent `json:"events,omitempty"`
+		// TopologyChanged indicates any of the relay servers, networks and ACL
+		// changed, and they are replaced as a whole by the following fields.
+		TopologyChanged bool          `json:"topology_changed,omitempty"`
+		RelayServers    []RelayServer `json:"relay_servers,omitempty"`
+		Networks        []Network     `json:"networks,omitempty"`
+		ACL             []ACLRule     `json:"acl,omitempty"`
+	}
+
+	// PortRange represents the inclusive range of ports.
+	PortRange struct {
+		First uint16 `json:"first"`
+		Last  uint16 `json:"last"`
+	}
+
+	// ACLRule represents an access control rule of the network. The rules of
+	// a network are evaluated in order and the first matched rule decides the
+	// action. The zero value of the source/destination fields matches any.
+	ACLRule struct {
+		ID        uint64    `json:"id"`
+		NetworkID NetworkID `json:"network_id"`
+		// Action is one of `accept` and `drop`.
+		Action    string `json:"action"`
+		SrcUserID UserID `json:"src_user_id,omitempty"`
+		SrcPeerID PeerID `json:"src_peer_id,omitempty"`
+		DstPeerID PeerID `json:"dst_peer_id,omitempty"`
+		// Protocol is one of `tcp`, `udp` and `icmp`, and empty means any.
+		Protocol string      `json:"protocol,omitempty"`
+		Ports    []PortRange `json:"ports,omitempty"`
 	}
 
 	// KeyExchangeResponse is response to requests to exchange key
@@ -63,6 +133,31 @@ type (
 	PreflightRequest struct {
 		OS   string `json:"os"`
 		Host string `json:"host"`
+		// Routes are the subnet routes advertised by the node.
+		Routes []string `json:"routes,omitempty"`
+		// ExitNode indicates the node offers to be an exit node.
+		ExitNode bool `json:"exit_node,omitempty"`
+		// Latencies are the latest measured latencies to the relay servers,
+		// which are used to select the primary relay server.
+		Latencies []RelayLatency `json:"latencies,omitempty"`
+	}
+
+	// RelayLatency represents the round-trip time from the node to the relay server.
+	RelayLatency struct {
+		ServerID ServerID      `json:"server_id"`
+		RTT      time.Duration `json:"rtt"`
+	}
+
+	// RelayLatencyRequest is the request to report the latencies to the relay
+	// servers measured by the node.
+	RelayLatencyRequest struct {
+		Latencies []RelayLatency `json:"latencies"`
+	}
+
+	// RelayLatencyResponse is the response to the relay latency report with the
+	// primary relay server selected for the node.
+	RelayLatencyResponse struct {
+		PrimaryServer RelayServer `json:"primary_server"`
 	}
 
 	// PreflightResponse is the response to preflight requests with data needed
@@ -74,9 +169,13 @@ type (
 		UserID          UserID
 		Name            string      `json:"name"` // DNS
 		IPv4            string      `json:"ipv4"`
+		IPv6            string      `json:"ipv6,omitempty"`
 		PrimaryServer   RelayServer `json:"primary_server"`
 		Credential      string      `json:"credential"`
 		CredentialLease uint64      `json:"credential_lease"`
+		// Ephemeral indicates the node is registered with an ephemeral key,
+		// and the node should logout after terminated to remove the device.
+		Ephemeral bool `json:"ephemeral,omitempty"`
 	}
 
 	// RenewCredentialRequest is used to request renew the credential
@@ -91,4 +190,19 @@ type (
 		Credential      string `json:"credential,omitempty"`
 		CredentialLease uint64 `json:"credential_lease"`
 	}
+
+	// VersionCheckResponse is the response to a version check request. The
+	// signature is the BASE64 Ed25519 signature of the release record (version,
+	// platform, channel and SHA-256 digest), which is signed by the release key.
+	// The empty arch means the release runs on all architectures of the OS.
+	VersionCheckResponse struct {
+		NewVersion      bool   `json:"new_version"`
+		NewVersionCode  string `json:"new_version_code"`
+		DownloadAddress string `json:"download_address"`
+		Arch            string `json:"arch,omitempty"`
+		Channel         string `json:"channel,omitempty"`
+		SHA256          string `json:"sha256,omitempty"`
+		Signature       string `json:"signature,omitempty"`
+		ReleaseNotes    string `json:"release_notes,omitempty"`
+	}
 )
@@ -76,15 +76,41 @@ type (
 
 		Peers []PeerID `json:"peers"`
 
+		// Sessions are all peers connected to the relay server, which retrieve
+		// the reachable peers of them.
+		Sessions []PeerID `json:"sessions,omitempty"`
+
 		// StartedAt represents the unix timestamp of relay server start time
 		StartedAt int64 `json:"started_at,omitempty"`
 	}
 
+	// VerifyingKey represents a public key which is used to validate the
+	// credentials signed by the key of the same ID.
+	VerifyingKey struct {
+		ID        string `json:"id"`
+		PublicKey string `json:"public_key"`
+	}
+
 	// RelayKeepaliveResponse is the response to keep alive requests
 	RelayKeepaliveResponse struct {
-		// PublicKey represents the public key which is used to validate the credential
-		PublicKey  string `json:"public_key,omitempty"`
-		SyncFailed bool   `json:"sync_failed"`
+		// PublicKeys represents all public keys which are used to validate the
+		// credentials, including the keys being retired.
+		PublicKeys []VerifyingKey `json:"public_keys,omitempty"`
+		SyncFailed bool           `json:"sync_failed"`
+		// ServerID is the id of the relay server sending the keepalive request,
+		// and RelayServers are the active relay servers to link with.
+		ServerID     ServerID      `json:"server_id"`
+		RelayServers []RelayServer `json:"relay_servers,omitempty"`
+		// RevokedPeers are the revoked devices whose credentials may be still
+		// valid, and the relay server rejects them. It's null if the portal
+		// failed to retrieve them, and the relay server keeps the previous ones.
+		RevokedPeers []PeerID `json:"revoked_peers"`
+		// ReachablePeers are the peers which each session of the relay server
+		// is allowed to forward the messages to, i.e: the devices of the same
+		// user and the devices sharing any approved network. It's null if the
+		// portal failed to retrieve them, and the relay server keeps the
+		// previous ones.
+		ReachablePeers map[PeerID][]PeerID `json:"reachable_peers"`
 	}
 
 	// RelayPeerOfflineRequest is the request to mark given peers as offline
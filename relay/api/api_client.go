@@ -37,7 +37,7 @@ func NewClient(server string, authKey string) *Client {
 }
 
 // Keepalive request the portal server to keepalive
-func (c *Client) Keepalive(node *config.Config, peers []protocol.PeerID, startedAt time.Time) (*protocol.RelayKeepaliveResponse, error) {
+func (c *Client) Keepalive(node *config.Config, peers, sessions []protocol.PeerID, startedAt time.Time) (*protocol.RelayKeepaliveResponse, error) {
 	req := &protocol.RelayKeepaliveRequest{
 		Name:      node.Name,
 		Region:    node.Region,
@@ -46,6 +46,7 @@ func (c *Client) Keepalive(node *config.Config, peers []protocol.PeerID, started
 		STUNPort:  node.STUNPort,
 		PublicKey: node.DHKey.Public.String(),
 		Peers:     peers,
+		Sessions:  sessions,
 		StartedAt: startedAt.UnixNano(),
 	}
 
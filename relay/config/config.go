@@ -52,8 +52,18 @@ type Config struct {
 	// https://datatracker.ietf.org/doc/html/rfc5389#section-18.4
 	STUNPort int `yaml:"stunPort,omitempty"`
 
-	DHKey  *security.DHKey `yaml:"dhKey"`
-	Portal *Portal         `yaml:"portal"`
+	DHKey   *security.DHKey `yaml:"dhKey"`
+	Portal  *Portal         `yaml:"portal"`
+	Metrics *Metrics        `yaml:"metrics,omitempty"`
+}
+
+// Metrics represents the configuration of the metrics HTTP listener
+type Metrics struct {
+	// Addr is the listening address of the metrics HTTP listener, and the
+	// metrics listener is disabled if it is empty.
+	Addr string `yaml:"addr,omitempty"`
+	// Path is the HTTP path of metrics. Empty means /metrics.
+	Path string `yaml:"path,omitempty"`
 }
 
 // Portal represents the gateway instance configuration
@@ -26,14 +26,52 @@ import (
 type (
 	callbacks struct {
 		server *relay.Server
+		links  *links
 	}
 )
 
-func registerCallback(server *relay.Server) {
-	callback := &callbacks{server: server}
+func registerCallback(server *relay.Server, links *links) {
+	callback := &callbacks{server: server, links: links}
 	server.Handler().On(message.PacketType_Forward, callback.onForward)
 	server.Handler().On(message.PacketType_SyncPeer, callback.onSyncPeer)
 	server.Handler().On(message.PacketType_ProbeRequest, callback.onProbe)
+	server.Handler().On(message.PacketType_RelayPeers, links.onRelayPeers)
+}
+
+// forwardLink forwards the message to the linked relay server which the peer
+// is connected to. The messages received from links are never forwarded again
+// to avoid loops between relay servers.
+func (h *callbacks) forwardLink(self *relay.Session, peerID protocol.PeerID, typ message.PacketType, msg proto.Message) (bool, error) {
+	if self.IsLink() {
+		return false, nil
+	}
+	return h.links.forward(peerID, typ, msg)
+}
+
+// reachable returns whether the peer of the session is allowed to send messages
+// to the destination peer, i.e: the peers share any approved network or belong
+// to the same user. The messages received from links have been checked by the
+// relay server which the source peer is connected to.
+func (h *callbacks) reachable(self *relay.Session, peerID protocol.PeerID) bool {
+	if self.IsLink() || h.server.IsReachable(self.PeerID(), peerID) {
+		return true
+	}
+	unreachableDropped.Inc()
+	if logutil.IsEnableRelay() {
+		zap.L().Debug("Drop the message to unreachable peer", zap.Any("peer_id", self.PeerID()), zap.Any("dst_peer_id", peerID))
+	}
+	return false
+}
+
+// isSender returns whether the sender claimed by the message is the peer of the
+// session, which keeps the peers from impersonating others. The messages from
+// links have been checked by the relay server which the sender is connected to.
+func isSender(self *relay.Session, peerID uint64) bool {
+	if self.IsLink() || protocol.PeerID(peerID) == self.PeerID() {
+		return true
+	}
+	zap.L().Warn("Drop the message with mismatched sender", zap.Any("peer_id", self.PeerID()), zap.Uint64("sender", peerID))
+	return false
 }
 
 func (h *callbacks) onForward(self *relay.Session, _ message.PacketType, msg proto.Message) error {
@@ -42,17 +80,42 @@ func (h *callbacks) onForward(self *relay.Session, _ message.PacketType, msg pro
 		zap.L().Debug("On forward", zap.Stringer("msg", forward), zap.Any("peer_id", self.PeerID()))
 	}
 
-	peerSession := h.server.Session(protocol.PeerID(forward.DstPeerID))
-	if peerSession == nil {
-		zap.L().Error("Peer session not found", zap.Any("peer_id", forward.DstPeerID))
+	forwardedPacketsIn.Inc()
+	forwardedBytesIn.Add(uint64(len(forward.Fragment)))
+
+	if !isSender(self, forward.SrcPeerID) || !h.reachable(self, protocol.PeerID(forward.DstPeerID)) {
+		forwardDropped.Inc()
 		return nil
 	}
 
-	return peerSession.Send(message.PacketType_Forward, forward)
+	var err error
+	peerSession := h.server.Session(protocol.PeerID(forward.DstPeerID))
+	if peerSession == nil {
+		var routed bool
+		routed, err = h.forwardLink(self, protocol.PeerID(forward.DstPeerID), message.PacketType_Forward, forward)
+		if !routed {
+			forwardDropped.Inc()
+			zap.L().Error("Peer session not found", zap.Any("peer_id", forward.DstPeerID))
+			return nil
+		}
+		if err == nil {
+			linkForwardedPackets.Inc()
+		}
+	} else {
+		err = peerSession.Send(message.PacketType_Forward, forward)
+	}
+	if err != nil {
+		forwardDropped.Inc()
+		return err
+	}
+	forwardedPacketsOut.Inc()
+	forwardedBytesOut.Add(uint64(len(forward.Fragment)))
+	return nil
 }
 
 func (h *callbacks) onProbe(self *relay.Session, _ message.PacketType, msg proto.Message) error {
 	probe := msg.(*message.PacketProbeRequest)
+	probeRequests.Inc()
 	if logutil.IsEnableRelay() {
 		zap.L().Debug("On probe", zap.Stringer("msg", probe), zap.Any("peer_id", self.PeerID()))
 	}
@@ -60,7 +123,7 @@ func (h *callbacks) onProbe(self *relay.Session, _ message.PacketType, msg proto
 	res := &message.PacketProbeResponse{}
 	for _, peerID := range probe.Peers {
 		peerSession := h.server.Session(protocol.PeerID(peerID))
-		if peerSession == nil {
+		if peerSession == nil && !h.links.routed(protocol.PeerID(peerID)) {
 			res.OfflinePeers = append(res.OfflinePeers, peerID)
 		} else {
 			res.OnlinePeers = append(res.OnlinePeers, peerID)
@@ -72,14 +135,25 @@ func (h *callbacks) onProbe(self *relay.Session, _ message.PacketType, msg proto
 
 func (h *callbacks) onSyncPeer(self *relay.Session, _ message.PacketType, msg proto.Message) error {
 	syncPeer := msg.(*message.PacketSyncPeer)
+	syncPeers.Inc()
 	if logutil.IsEnableRelay() {
 		zap.L().Debug("On sync peer", zap.Stringer("msg", syncPeer), zap.Any("peer_id", self.PeerID()))
 	}
 
+	if syncPeer.Peer == nil || !isSender(self, syncPeer.Peer.PeerID) {
+		return nil
+	}
+	if !h.reachable(self, protocol.PeerID(syncPeer.DstPeerID)) {
+		return nil
+	}
+
 	peerSession := h.server.Session(protocol.PeerID(syncPeer.DstPeerID))
 	if peerSession == nil {
-		zap.L().Error("Peer session not found", zap.Any("peer_id", syncPeer.DstPeerID))
-		return nil
+		routed, err := h.forwardLink(self, protocol.PeerID(syncPeer.DstPeerID), message.PacketType_SyncPeer, syncPeer)
+		if !routed {
+			zap.L().Error("Peer session not found", zap.Any("peer_id", syncPeer.DstPeerID))
+		}
+		return err
 	}
 
 	return peerSession.Send(message.PacketType_SyncPeer, syncPeer)
@@ -16,9 +16,10 @@ package server
 
 import (
 	"context"
-	"crypto/rsa"
-	"crypto/x509"
+	"crypto/ed25519"
 	"encoding/base64"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -26,31 +27,89 @@ import (
 	"github.com/pairmesh/pairmesh/protocol"
 	"github.com/pairmesh/pairmesh/relay/api"
 	"github.com/pairmesh/pairmesh/relay/config"
+	"github.com/pairmesh/pairmesh/security"
 	"go.uber.org/zap"
 )
 
 var startedAt = time.Now()
 
-func keepaliveWithPortal(apiClient *api.Client, cfg *config.Config, peers []protocol.PeerID) (*rsa.PublicKey, bool, error) {
-	resp, err := apiClient.Keepalive(cfg, peers, startedAt)
+// reachableRefreshInterval is the minimum interval of the keepalive requests
+// sent early to refresh the reachable peers once any pair of peers is unreachable.
+const reachableRefreshInterval = 5 * time.Second
+
+func keepaliveWithPortal(apiClient *api.Client, cfg *config.Config, peers, sessions []protocol.PeerID) (*protocol.RelayKeepaliveResponse, security.VerifyingKeys, error) {
+	start := time.Now()
+	resp, err := apiClient.Keepalive(cfg, peers, sessions, startedAt)
+	keepaliveDuration.Observe(time.Since(start).Seconds())
 	if err != nil {
-		return nil, true, err
+		keepaliveErrors.Inc()
+		return nil, nil, err
 	}
-	rawbytes, err := base64.RawStdEncoding.DecodeString(resp.PublicKey)
+	keys, err := parseVerifyingKeys(resp.PublicKeys)
 	if err != nil {
-		return nil, resp.SyncFailed, err
+		return nil, nil, err
 	}
-	key, err := x509.ParsePKCS1PublicKey(rawbytes)
-	if err != nil {
-		return nil, resp.SyncFailed, err
+	return resp, keys, nil
+}
+
+// parseVerifyingKeys parses the public keys distributed by the portal service.
+func parseVerifyingKeys(publicKeys []protocol.VerifyingKey) (security.VerifyingKeys, error) {
+	if len(publicKeys) == 0 {
+		return nil, errors.New("no public key is provided by portal")
+	}
+	keys := security.VerifyingKeys{}
+	for _, k := range publicKeys {
+		id, err := security.ParseKeyID(k.ID)
+		if err != nil {
+			return nil, err
+		}
+		rawbytes, err := base64.RawStdEncoding.DecodeString(k.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if len(rawbytes) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid public key size of key %s: %d", k.ID, len(rawbytes))
+		}
+		keys[id] = rawbytes
 	}
-	return key, resp.SyncFailed, nil
+	return keys, nil
+}
+
+// updateRelayServers updates the relay servers which are allowed to link with
+// the server and the links to them.
+func updateRelayServers(server *relay.Server, links *links, resp *protocol.RelayKeepaliveResponse) {
+	server.SetRelayServers(resp.RelayServers)
+	links.update(resp.ServerID, resp.RelayServers)
 }
 
-func keepalive(ctx context.Context, wg *sync.WaitGroup, server *relay.Server, apiClient *api.Client, cfg *config.Config) {
+// setRevokedPeers updates the revoked peers rejected by the server. The previous
+// revoked peers are kept if the portal failed to retrieve them.
+func setRevokedPeers(server *relay.Server, resp *protocol.RelayKeepaliveResponse) {
+	if resp.RevokedPeers == nil {
+		zap.L().Warn("Portal service retrieve revoked peers failed, keep the previous ones")
+		return
+	}
+	server.SetRevokedPeers(resp.RevokedPeers)
+}
+
+// setReachablePeers updates the reachable peers of the sessions. The previous
+// reachable peers are kept if the portal failed to retrieve them.
+func setReachablePeers(server *relay.Server, resp *protocol.RelayKeepaliveResponse) {
+	if resp.ReachablePeers == nil {
+		zap.L().Warn("Portal service retrieve reachable peers failed, keep the previous ones")
+		return
+	}
+	server.SetReachablePeers(resp.ReachablePeers)
+}
+
+func keepalive(ctx context.Context, wg *sync.WaitGroup, server *relay.Server, links *links, apiClient *api.Client, cfg *config.Config) {
 	defer wg.Done()
 	ticker := time.NewTicker(cfg.Portal.KeepaliveInterval)
-	var peers []protocol.PeerID
+	var (
+		peers       []protocol.PeerID
+		sessions    []protocol.PeerID
+		keepaliveAt time.Time
+	)
 	for {
 		select {
 		case <-ctx.Done():
@@ -59,30 +118,46 @@ func keepalive(ctx context.Context, wg *sync.WaitGroup, server *relay.Server, ap
 			return
 
 		case <-ticker.C:
-			peers = peers[:0]
-			server.ForeachSession(func(s *relay.Session) {
-				if s.IsPrimary() && time.Since(s.SyncAt()) > cfg.Portal.SyncInterval {
-					peers = append(peers, s.PeerID())
-				}
-			})
-			publicKey, syncFailed, err := keepaliveWithPortal(apiClient, cfg, peers)
-			if err != nil {
-				zap.L().Error("Retrieve the latest portal server information failed", zap.Error(err))
+
+		case <-server.Unreachable():
+			// The reachable peers are refreshed early for the peers connected
+			// or approved recently, but not more frequently than the interval.
+			select {
+			case <-ctx.Done():
 				continue
+			case <-time.After(time.Until(keepaliveAt.Add(reachableRefreshInterval))):
 			}
-			server.SetRSAPublicKey(publicKey)
-			if syncFailed {
-				zap.L().Error("Portal service sync peers failed")
-				continue
+		}
+
+		keepaliveAt = time.Now()
+		peers = peers[:0]
+		sessions = sessions[:0]
+		server.ForeachSession(func(s *relay.Session) {
+			sessions = append(sessions, s.PeerID())
+			if s.IsPrimary() && time.Since(s.SyncAt()) > cfg.Portal.SyncInterval {
+				peers = append(peers, s.PeerID())
 			}
-			now := time.Now()
-			for _, peerID := range peers {
-				s := server.Session(peerID)
-				if s == nil {
-					continue
-				}
-				s.SetSyncAt(now)
+		})
+		resp, keys, err := keepaliveWithPortal(apiClient, cfg, peers, sessions)
+		if err != nil {
+			zap.L().Error("Retrieve the latest portal server information failed", zap.Error(err))
+			continue
+		}
+		server.SetVerifyingKeys(keys)
+		setRevokedPeers(server, resp)
+		setReachablePeers(server, resp)
+		updateRelayServers(server, links, resp)
+		if resp.SyncFailed {
+			zap.L().Error("Portal service sync peers failed")
+			continue
+		}
+		now := time.Now()
+		for _, peerID := range peers {
+			s := server.Session(peerID)
+			if s == nil {
+				continue
 			}
+			s.SetSyncAt(now)
 		}
 	}
 }
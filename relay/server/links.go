@@ -0,0 +1,323 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/flynn/noise"
+	"github.com/pairmesh/pairmesh/internal/relay"
+	"github.com/pairmesh/pairmesh/message"
+	"github.com/pairmesh/pairmesh/protocol"
+	"github.com/pairmesh/pairmesh/security"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	linkConnectTimeout  = 3 * time.Second
+	linkReconnectPeriod = 5 * time.Second
+)
+
+type (
+	// links maintains the links between the current relay server and the other
+	// relay servers. Every relay server dials all other relay servers, and the
+	// outgoing links are used to send packets and advertise the peers whose
+	// primary relay server is the current one, while the packets from other
+	// relay servers are received by the link sessions of the current server.
+	// The discovery of the relay servers is bounded by the keepalive interval
+	// with the portal service.
+	links struct {
+		server *relay.Server
+		dhKey  noise.DHKey
+
+		mu      sync.Mutex
+		ctx     context.Context
+		relays  map[protocol.ServerID]protocol.RelayServer
+		clients map[protocol.ServerID]*relay.Client
+		// dialing records the relay servers whose links are being established,
+		// so a slow handshake doesn't delay the other links or get started twice.
+		dialing map[protocol.ServerID]struct{}
+		// routes records the relay servers which the remote peers are connected to
+		// as the primary relay server.
+		routes map[protocol.PeerID]protocol.ServerID
+	}
+)
+
+func newLinks(ctx context.Context, server *relay.Server, dhKey noise.DHKey) *links {
+	return &links{
+		server:  server,
+		dhKey:   dhKey,
+		ctx:     ctx,
+		relays:  map[protocol.ServerID]protocol.RelayServer{},
+		clients: map[protocol.ServerID]*relay.Client{},
+		dialing: map[protocol.ServerID]struct{}{},
+		routes:  map[protocol.PeerID]protocol.ServerID{},
+	}
+}
+
+// update updates the relay servers to link with, the links to new relay servers
+// are established and the links to removed relay servers are closed.
+func (l *links) update(self protocol.ServerID, relayServers []protocol.RelayServer) {
+	relays := map[protocol.ServerID]protocol.RelayServer{}
+	for _, r := range relayServers {
+		if r.ID == self {
+			continue
+		}
+		relays[r.ID] = r
+	}
+
+	l.mu.Lock()
+	l.relays = relays
+	var closing []*relay.Client
+	for id, c := range l.clients {
+		r, found := relays[id]
+		if found && r.Host == c.RelayServer().Host && r.Port == c.RelayServer().Port && r.PublicKey == c.RelayServer().PublicKey {
+			continue
+		}
+		delete(l.clients, id)
+		closing = append(closing, c)
+	}
+	l.mu.Unlock()
+
+	for _, c := range closing {
+		if err := c.Close(); err != nil {
+			zap.L().Error("Close relay link failed", zap.Error(err))
+		}
+	}
+	l.connect()
+}
+
+// connect establishes the links which are not connected. Every link is dialed
+// in its own goroutine, so connect never blocks the caller.
+func (l *links) connect() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for id, r := range l.relays {
+		if _, found := l.clients[id]; found {
+			continue
+		}
+		if _, found := l.dialing[id]; found {
+			continue
+		}
+		l.dialing[id] = struct{}{}
+		go l.link(r)
+	}
+}
+
+// link dials the relay server and advertises the local peers to it.
+func (l *links) link(r protocol.RelayServer) {
+	client, err := l.dial(r)
+
+	l.mu.Lock()
+	delete(l.dialing, r.ID)
+	if err != nil {
+		l.mu.Unlock()
+		zap.L().Error("Link to relay server failed", zap.String("name", r.Name), zap.String("host", r.Host), zap.Error(err))
+		return
+	}
+	current, found := l.relays[r.ID]
+	if !found || current != r || l.clients[r.ID] != nil || l.ctx.Err() != nil {
+		l.mu.Unlock()
+		_ = client.Close()
+		return
+	}
+	l.clients[r.ID] = client
+	l.mu.Unlock()
+
+	zap.L().Info("Relay link established", zap.String("name", r.Name), zap.String("host", r.Host))
+
+	// Advertise all peers whose primary relay server is the current server.
+	peers := &message.PacketRelayPeers{Full: true}
+	l.server.ForeachSession(func(s *relay.Session) {
+		if s.IsPrimary() {
+			peers.Online = append(peers.Online, uint64(s.PeerID()))
+		}
+	})
+	if err := client.Send(message.PacketType_RelayPeers, peers); err != nil {
+		zap.L().Error("Advertise peers to relay server failed", zap.String("name", r.Name), zap.Error(err))
+	}
+}
+
+func (l *links) dial(r protocol.RelayServer) (*relay.Client, error) {
+	publicKey, err := base64.StdEncoding.DecodeString(r.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	client := relay.NewClient(relay.NewLinkTransporter(r, l.dhKey, security.NewDHPublic(publicKey)))
+	go client.Serve(l.ctx)
+
+	// The context of connecting is used by the connection after connected, so
+	// the timeout is implemented by closing the client.
+	timer := time.AfterFunc(linkConnectTimeout, func() { _ = client.Close() })
+	err = client.Connect(l.ctx)
+	if !timer.Stop() && err == nil {
+		err = errors.New("link handshake timeout")
+	}
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+
+	id := r.ID
+	client.OnClosed(func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if l.clients[id] == client {
+			delete(l.clients, id)
+		}
+	})
+	return client, nil
+}
+
+// run reconnects the closed links periodically until the context is done.
+func (l *links) run(wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(linkReconnectPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.ctx.Done():
+			l.mu.Lock()
+			clients := l.clients
+			l.clients = map[protocol.ServerID]*relay.Client{}
+			l.mu.Unlock()
+			for _, c := range clients {
+				_ = c.Close()
+			}
+			return
+		case <-ticker.C:
+			l.connect()
+		}
+	}
+}
+
+// broadcast sends the message to all linked relay servers.
+func (l *links) broadcast(typ message.PacketType, msg proto.Message) {
+	l.mu.Lock()
+	clients := make([]*relay.Client, 0, len(l.clients))
+	for _, c := range l.clients {
+		clients = append(clients, c)
+	}
+	l.mu.Unlock()
+
+	for _, c := range clients {
+		if err := c.Send(typ, msg); err != nil {
+			zap.L().Error("Send message to linked relay server failed", zap.Stringer("type", typ), zap.Error(err))
+		}
+	}
+}
+
+// routed returns whether the peer is connected to a linked relay server.
+func (l *links) routed(peerID protocol.PeerID) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, found := l.routes[peerID]
+	return found
+}
+
+// forward forwards the message to the relay server which the peer is connected
+// to. It returns false if no route to the peer is found.
+func (l *links) forward(peerID protocol.PeerID, typ message.PacketType, msg proto.Message) (bool, error) {
+	l.mu.Lock()
+	var client *relay.Client
+	if id, found := l.routes[peerID]; found {
+		client = l.clients[id]
+	}
+	l.mu.Unlock()
+
+	if client == nil {
+		return false, nil
+	}
+	return true, client.Send(typ, msg)
+}
+
+// onRelayPeers updates the routes advertised by a linked relay server.
+func (l *links) onRelayPeers(self *relay.Session, _ message.PacketType, msg proto.Message) error {
+	if !self.IsLink() {
+		return nil
+	}
+
+	peers := msg.(*message.PacketRelayPeers)
+	serverID := self.LinkServerID()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if peers.Full {
+		l.removeRoutes(serverID)
+	}
+	for _, peerID := range peers.Online {
+		l.routes[protocol.PeerID(peerID)] = serverID
+	}
+	for _, peerID := range peers.Offline {
+		if l.routes[protocol.PeerID(peerID)] == serverID {
+			delete(l.routes, protocol.PeerID(peerID))
+		}
+	}
+	return nil
+}
+
+func (l *links) removeRoutes(serverID protocol.ServerID) {
+	for peerID, id := range l.routes {
+		if id == serverID {
+			delete(l.routes, peerID)
+		}
+	}
+}
+
+// OnSessionHandshake implements the relay.SessionLifetimeHook interface.
+func (l *links) OnSessionHandshake(ses *relay.Session) {
+	if ses.IsLink() || !ses.IsPrimary() {
+		return
+	}
+	l.broadcast(message.PacketType_RelayPeers, &message.PacketRelayPeers{Online: []uint64{uint64(ses.PeerID())}})
+}
+
+// OnSessionHandshakeFailed implements the relay.SessionLifetimeHook interface.
+func (l *links) OnSessionHandshakeFailed(*relay.Session, error) {}
+
+// OnSessionClosed implements the relay.SessionLifetimeHook interface.
+func (l *links) OnSessionClosed(ses *relay.Session) {
+	if ses.IsLink() {
+		// The routes are kept if the link is replaced by a new session.
+		if l.server.LinkSession(ses.LinkServerID()) != nil {
+			return
+		}
+		l.mu.Lock()
+		l.removeRoutes(ses.LinkServerID())
+		l.mu.Unlock()
+		return
+	}
+	if !ses.IsPrimary() || l.server.Session(ses.PeerID()) != nil {
+		return
+	}
+	l.broadcast(message.PacketType_RelayPeers, &message.PacketRelayPeers{Offline: []uint64{uint64(ses.PeerID())}})
+}
+
+// stats returns the number of established links and routes.
+func (l *links) stats() (int, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.clients), len(l.routes)
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pairmesh/pairmesh/internal/relay"
+	"github.com/pairmesh/pairmesh/pkg/metrics"
+	"github.com/pairmesh/pairmesh/relay/config"
+	"go.uber.org/zap"
+)
+
+const defaultMetricsPath = "/metrics"
+
+var (
+	registry = metrics.NewRegistry()
+
+	forwardedPacketsIn   = registry.NewCounter("pairrelay_forwarded_packets_total", "Number of forwarded packets by direction.", metrics.Label{Name: "direction", Value: "in"})
+	forwardedPacketsOut  = registry.NewCounter("pairrelay_forwarded_packets_total", "Number of forwarded packets by direction.", metrics.Label{Name: "direction", Value: "out"})
+	forwardedBytesIn     = registry.NewCounter("pairrelay_forwarded_bytes_total", "Number of forwarded fragment bytes by direction.", metrics.Label{Name: "direction", Value: "in"})
+	forwardedBytesOut    = registry.NewCounter("pairrelay_forwarded_bytes_total", "Number of forwarded fragment bytes by direction.", metrics.Label{Name: "direction", Value: "out"})
+	linkForwardedPackets = registry.NewCounter("pairrelay_link_forwarded_packets_total", "Number of packets forwarded to the linked relay servers.")
+	forwardDropped       = registry.NewCounter("pairrelay_forward_dropped_total", "Number of packets dropped due to the destination unavailable.")
+	unreachableDropped   = registry.NewCounter("pairrelay_unreachable_dropped_total", "Number of messages dropped due to the peers sharing no approved network.")
+	probeRequests        = registry.NewCounter("pairrelay_probe_requests_total", "Number of probe requests.")
+	syncPeers            = registry.NewCounter("pairrelay_sync_peer_total", "Number of sync peer messages.")
+	stunRequests         = registry.NewCounter("pairrelay_stun_requests_total", "Number of STUN binding requests served.")
+	keepaliveDuration    = registry.NewHistogram("pairrelay_portal_keepalive_duration_seconds", "Latency of keepalive requests to the portal service.", nil)
+	keepaliveErrors      = registry.NewCounter("pairrelay_portal_keepalive_errors_total", "Number of failed keepalive requests to the portal service.")
+)
+
+// registerServerMetrics registers the metrics collected from the relay server.
+func registerServerMetrics(server *relay.Server, links *links) {
+	registry.NewGaugeFunc("pairrelay_links", "Number of established links to other relay servers.", func() float64 {
+		n, _ := links.stats()
+		return float64(n)
+	})
+	registry.NewGaugeFunc("pairrelay_link_routes", "Number of peers reachable via the linked relay servers.", func() float64 {
+		_, n := links.stats()
+		return float64(n)
+	})
+	registry.NewGaugeFunc("pairrelay_sessions", "Number of active sessions.", func() float64 {
+		return float64(server.Stats().Sessions)
+	})
+	registry.NewGaugeFunc("pairrelay_primary_sessions", "Number of active sessions of the peers using the server as primary relay.", func() float64 {
+		return float64(server.Stats().PrimarySessions)
+	})
+	registry.NewCounterFunc("pairrelay_handshakes_total", "Number of session handshakes by result.", func() uint64 {
+		return server.Stats().Handshakes
+	}, metrics.Label{Name: "result", Value: "success"})
+	registry.NewCounterFunc("pairrelay_handshakes_total", "Number of session handshakes by result.", func() uint64 {
+		return server.Stats().HandshakeFailures
+	}, metrics.Label{Name: "result", Value: "failure"})
+	registry.NewCounterFunc("pairrelay_session_resumptions_total", "Number of sessions resumed by resumption tickets.", func() uint64 {
+		return server.Stats().Resumptions
+	})
+}
+
+// serveMetrics serves the metrics HTTP listener until the context is done.
+func serveMetrics(ctx context.Context, wg *sync.WaitGroup, cfg *config.Metrics) {
+	defer wg.Done()
+
+	path := cfg.Path
+	if path == "" {
+		path = defaultMetricsPath
+	}
+	mux := http.NewServeMux()
+	mux.Handle(path, registry)
+	srv := &http.Server{Addr: cfg.Addr, Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	zap.L().Info("The metrics listener is running", zap.String("addr", cfg.Addr), zap.String("path", path))
+	err := srv.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		zap.L().Error("Serve metrics failed", zap.Error(err))
+	}
+}
@@ -16,6 +16,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -33,21 +34,47 @@ func Serve(ctx context.Context, wg *sync.WaitGroup, cfg *config.Config) error {
 	apiClient := api.NewClient(cfg.Portal.URL, cfg.Portal.Key)
 
 	// Start first keepalive ticker to retrieve the latest information of portal service.
-	publicKey, _, err := keepaliveWithPortal(apiClient, cfg, nil)
+	resp, keys, err := keepaliveWithPortal(apiClient, cfg, nil, nil)
 	if err != nil {
 		return err
 	}
+	// The revoked peers can't be rejected without the list, so the server
+	// doesn't start until the portal service retrieves it.
+	if resp.RevokedPeers == nil {
+		return errors.New("portal service failed to retrieve the revoked peers")
+	}
 
 	// Preflight the relay server
 	addr := fmt.Sprintf(":%d", cfg.Port)
-	server := relay.NewServer(addr, constant.HeartbeatInterval, cfg.DHKey.ToNoiseDHKey(), publicKey)
+	server := relay.NewServer(addr, constant.HeartbeatInterval, cfg.DHKey.ToNoiseDHKey(), keys)
+
+	// Link with the other relay servers to forward the packets across regions.
+	links := newLinks(ctx, server, cfg.DHKey.ToNoiseDHKey())
+	server.SetObserver(links)
+	server.SetRelayServers(resp.RelayServers)
+	server.SetRevokedPeers(resp.RevokedPeers)
 
 	// Register the packet customized callback.
-	registerCallback(server)
+	registerCallback(server, links)
+	registerServerMetrics(server, links)
+
+	// Start the metrics listener if configured.
+	if cfg.Metrics != nil && cfg.Metrics.Addr != "" {
+		wg.Add(1)
+		go serveMetrics(ctx, wg, cfg.Metrics)
+	}
 
 	// Start the keepalive goroutine to keep alive with the portal service.
 	wg.Add(1)
-	go keepalive(ctx, wg, server, apiClient, cfg)
+	go keepalive(ctx, wg, server, links, apiClient, cfg)
+
+	// Start the links goroutine to connect the other relay servers, which must be
+	// started after the server is serving to accept the links from others.
+	wg.Add(1)
+	go func() {
+		links.update(resp.ServerID, resp.RelayServers)
+		links.run(wg)
+	}()
 
 	// Start serving STUN service to assist the PairMesh nodes to detect their external addresses.
 	wg.Add(1)
@@ -68,8 +68,12 @@ func serveSTUN(ctx context.Context, cfg *config.Config, wg *sync.WaitGroup) {
 
 		res := stun.Response(txid, remote.IP, uint16(remote.Port))
 		_, err = udpConn.WriteToUDP(res, remote)
+		if err != nil {
+			return err
+		}
 
-		return err
+		stunRequests.Inc()
+		return nil
 	}
 
 	for {
@@ -24,6 +24,7 @@ var (
 
 	// HandshakePatternNN represents the handshake pattern which is used to exchange the DH key.
 	HandshakePatternNN = noise.HandshakeNN
-	// HandshakePatternIK is handshake pattern to be used later
+	// HandshakePatternIK represents the handshake pattern which is used to link
+	// the relay servers, and both sides are authenticated by the static keys.
 	HandshakePatternIK = noise.HandshakeIK
 )
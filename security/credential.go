@@ -15,10 +15,7 @@
 package security
 
 import (
-	"crypto"
-	"crypto/rand"
-	"crypto/rsa"
-	"crypto/sha256"
+	"crypto/ed25519"
 	"encoding/binary"
 	"net"
 	"time"
@@ -27,57 +24,92 @@ import (
 )
 
 const (
-	credentialValidationHeaderSize = 25
+	credentialVersion              = 1
+	credentialKeyIDSize            = 5
+	credentialValidationHeaderSize = credentialKeyIDSize + 25
 )
 
 // Credential returns a credential to identity the Peer, which contains
-// the expiration time and the reclaimed IP address. All requests relevant
-// to IP address, the credential is required. We will verify the triple:
-// -> (PeerID, IP, Expiration).
+// the expiration time and the reclaimed IP addresses. All requests relevant
+// to IP address, the credential is required. We will verify the tuple:
+// -> (PeerID, IP, IPv6, Expiration).
 // The schema of credential:
-// |   PeerID    |  PeerID    |  Expiration  |  IPLen  |    IP     |
-// |  8 bytes    | 8 bytes    |    8 bytes   |  1 byte |  Variant  |
-// The credential delivered to the client will be encrypted by secret key.
-// The secret key is a private key only held by the gateway.
-func Credential(privateKey *rsa.PrivateKey, userID protocol.UserID, peerID protocol.PeerID, ip net.IP, lease time.Duration) ([]byte, error) {
-	data := make([]byte, credentialValidationHeaderSize+len(ip))
-	binary.BigEndian.PutUint64(data[:8], uint64(userID))
-	binary.BigEndian.PutUint64(data[8:16], uint64(peerID))
+// | Version |  KeyID   |   UserID    |  PeerID    |  Expiration  |  IPLen  |    IP     |  IPv6Len  |   IPv6    |
+// | 1 byte  | 4 bytes  |  8 bytes    | 8 bytes    |    8 bytes   |  1 byte |  Variant  |   1 byte  |  Variant  |
+// The IPv6 address is optional and the IPv6Len will be zero if absent.
+// The credential is followed by the Ed25519 signature of the key identified by
+// the KeyID, and the signing key is only held by the portal.
+func Credential(key *SigningKey, userID protocol.UserID, peerID protocol.PeerID, ip, ip6 net.IP, lease time.Duration) ([]byte, error) {
+	ip6Offset := credentialValidationHeaderSize + len(ip)
+	data := make([]byte, ip6Offset+1+len(ip6), ip6Offset+1+len(ip6)+ed25519.SignatureSize)
+	data[0] = credentialVersion
+	binary.BigEndian.PutUint32(data[1:credentialKeyIDSize], uint32(key.ID))
+	header := data[credentialKeyIDSize:]
+	binary.BigEndian.PutUint64(header[:8], uint64(userID))
+	binary.BigEndian.PutUint64(header[8:16], uint64(peerID))
 	expirationAt := time.Now().Add(lease).Unix()
-	binary.BigEndian.PutUint64(data[16:24], uint64(expirationAt))
+	binary.BigEndian.PutUint64(header[16:24], uint64(expirationAt))
 	data[credentialValidationHeaderSize-1] = byte(len(ip))
-	copy(data[credentialValidationHeaderSize:credentialValidationHeaderSize+len(ip)], ip)
+	copy(data[credentialValidationHeaderSize:ip6Offset], ip)
+	data[ip6Offset] = byte(len(ip6))
+	copy(data[ip6Offset+1:], ip6)
 
-	digest := sha256.Sum256(data[:])
-	signed, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest[:])
-	if err != nil {
-		return nil, err
-	}
-	credential := append(data[:], signed...)
+	signed := ed25519.Sign(key.Private, data)
+	credential := append(data, signed...)
 	return credential, nil
 }
 
-// VerifyCredential verifies the expiration of the credential, returns the networkID, peerID and IP
-func VerifyCredential(publicKey *rsa.PublicKey, credential []byte) (userID protocol.UserID, peerID protocol.PeerID, ip net.IP, valid bool) {
+// VerifyCredential verifies the signature by the key of the KeyID and the
+// expiration of the credential, returns the userID, peerID, IP and IPv6
+func VerifyCredential(keys VerifyingKeys, credential []byte) (userID protocol.UserID, peerID protocol.PeerID, ip, ip6 net.IP, valid bool) {
 	// Illegal credentials
-	if len(credential) <= credentialValidationHeaderSize+net.IPv4len {
-		return 0, 0, nil, false
+	if len(credential) <= credentialValidationHeaderSize+net.IPv4len || credential[0] != credentialVersion {
+		return 0, 0, nil, nil, false
 	}
 
-	ipLen := credential[credentialValidationHeaderSize-1]
+	ip6Offset := credentialValidationHeaderSize + int(credential[credentialValidationHeaderSize-1])
+	if len(credential) <= ip6Offset {
+		return 0, 0, nil, nil, false
+	}
+	signedSize := ip6Offset + 1 + int(credential[ip6Offset])
+	if len(credential) != signedSize+ed25519.SignatureSize {
+		return 0, 0, nil, nil, false
+	}
 
-	digest := sha256.Sum256(credential[:credentialValidationHeaderSize+ipLen])
-	err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], credential[credentialValidationHeaderSize+ipLen:])
-	if err != nil {
-		return 0, 0, nil, false
+	publicKey, found := keys[KeyID(binary.BigEndian.Uint32(credential[1:credentialKeyIDSize]))]
+	if !found || !ed25519.Verify(publicKey, credential[:signedSize], credential[signedSize:]) {
+		return 0, 0, nil, nil, false
 	}
 
 	// expiration
-	if time.Now().Unix() > int64(binary.BigEndian.Uint64(credential[16:credentialValidationHeaderSize-1])) {
-		return 0, 0, nil, false
+	header := credential[credentialKeyIDSize:]
+	if time.Now().Unix() > int64(binary.BigEndian.Uint64(header[16:24])) {
+		return 0, 0, nil, nil, false
+	}
+	userID = protocol.UserID(binary.BigEndian.Uint64(header[:8]))
+	peerID = protocol.PeerID(binary.BigEndian.Uint64(header[8:16]))
+	ip = credential[credentialValidationHeaderSize:ip6Offset]
+	if signedSize > ip6Offset+1 {
+		ip6 = credential[ip6Offset+1 : signedSize]
+	}
+	return userID, peerID, ip, ip6, true
+}
+
+// CredentialKeyID returns the ID of the key which signed the credential, and
+// the credential must be verified by VerifyCredential before.
+func CredentialKeyID(credential []byte) KeyID {
+	if len(credential) < credentialKeyIDSize {
+		return 0
+	}
+	return KeyID(binary.BigEndian.Uint32(credential[1:credentialKeyIDSize]))
+}
+
+// CredentialExpiration returns the expiration time of the credential, and the
+// credential must be verified by VerifyCredential before.
+func CredentialExpiration(credential []byte) time.Time {
+	if len(credential) < credentialValidationHeaderSize {
+		return time.Time{}
 	}
-	userID = protocol.UserID(binary.BigEndian.Uint64(credential[:8]))
-	peerID = protocol.PeerID(binary.BigEndian.Uint64(credential[8:16]))
-	ip = credential[credentialValidationHeaderSize : credentialValidationHeaderSize+ipLen]
-	return userID, peerID, ip, true
+	header := credential[credentialKeyIDSize:]
+	return time.Unix(int64(binary.BigEndian.Uint64(header[16:24])), 0)
 }
@@ -15,8 +15,8 @@
 package security_test
 
 import (
+	"crypto/ed25519"
 	"crypto/rand"
-	"crypto/rsa"
 	"net"
 	"testing"
 	"time"
@@ -29,8 +29,10 @@ import (
 func TestCredential(t *testing.T) {
 	a := assert.New(t)
 
-	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
 	a.Nil(err)
+	key := security.NewSigningKey(privateKey)
+	keys := security.NewKeyRing(privateKey).VerifyingKeys()
 
 	var (
 		userID = protocol.UserID(88888)
@@ -38,22 +40,26 @@ func TestCredential(t *testing.T) {
 		ip     = net.IP{0x01, 0x02, 0x03, 0x04}
 	)
 
-	credential, err := security.Credential(privateKey, userID, peerID, ip, time.Second*10)
+	credential, err := security.Credential(key, userID, peerID, ip, nil, time.Second*10)
 	a.Nil(err)
 	a.NotNil(credential)
 
-	userID2, peerID2, ip2, valid := security.VerifyCredential(&privateKey.PublicKey, credential)
+	userID2, peerID2, ip2, ip6, valid := security.VerifyCredential(keys, credential)
 	a.True(valid)
 	a.Equal(peerID2, peerID)
 	a.Equal(userID2, userID)
 	a.Equal(ip2, ip)
+	a.Nil(ip6)
+	a.WithinDuration(time.Now().Add(time.Second*10), security.CredentialExpiration(credential), time.Second*2)
 }
 
 func TestCredentialIPv6(t *testing.T) {
 	a := assert.New(t)
 
-	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
 	a.Nil(err)
+	key := security.NewSigningKey(privateKey)
+	keys := security.NewKeyRing(privateKey).VerifyingKeys()
 
 	var (
 		userID = protocol.UserID(88888)
@@ -61,22 +67,57 @@ func TestCredentialIPv6(t *testing.T) {
 		ip     = net.IPv6zero
 	)
 
-	credential, err := security.Credential(privateKey, userID, peerID, ip, time.Second*10)
+	credential, err := security.Credential(key, userID, peerID, ip, nil, time.Second*10)
 	a.Nil(err)
 	a.NotNil(credential)
 
-	userID2, peerID2, ip2, valid := security.VerifyCredential(&privateKey.PublicKey, credential)
+	userID2, peerID2, ip2, ip6, valid := security.VerifyCredential(keys, credential)
 	a.True(valid)
 	a.Equal(peerID2, peerID)
 	a.Equal(userID2, userID)
 	a.Equal(ip2, ip)
+	a.Nil(ip6)
+}
+
+func TestCredentialDualStack(t *testing.T) {
+	a := assert.New(t)
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	a.Nil(err)
+	key := security.NewSigningKey(privateKey)
+	keys := security.NewKeyRing(privateKey).VerifyingKeys()
+
+	var (
+		userID = protocol.UserID(88888)
+		peerID = protocol.PeerID(12345678)
+		ip     = net.IP{0x01, 0x02, 0x03, 0x04}
+		ip6    = net.ParseIP("fd70:6169:726d::bc:614e")
+	)
+
+	credential, err := security.Credential(key, userID, peerID, ip, ip6, time.Second*10)
+	a.Nil(err)
+	a.NotNil(credential)
+
+	userID2, peerID2, ip2, ip62, valid := security.VerifyCredential(keys, credential)
+	a.True(valid)
+	a.Equal(peerID2, peerID)
+	a.Equal(userID2, userID)
+	a.Equal(ip2, ip)
+	a.Equal(ip62, ip6)
+
+	// Compromise and change one bit of the IPv6 address
+	credential[len(credential)-65] ^= 1
+	_, _, _, _, valid = security.VerifyCredential(keys, credential)
+	a.False(valid)
 }
 
 func TestCredentialExpired(t *testing.T) {
 	a := assert.New(t)
 
-	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
 	a.Nil(err)
+	key := security.NewSigningKey(privateKey)
+	keys := security.NewKeyRing(privateKey).VerifyingKeys()
 
 	var (
 		userID = protocol.UserID(88888)
@@ -84,22 +125,25 @@ func TestCredentialExpired(t *testing.T) {
 		ip     = net.IP{0x01, 0x02, 0x03, 0x04}
 	)
 
-	credential, err := security.Credential(privateKey, userID, peerID, ip, -time.Second*10)
+	credential, err := security.Credential(key, userID, peerID, ip, nil, -time.Second*10)
 	a.Nil(err)
 	a.NotNil(credential)
 
-	userID2, peerID2, ip2, valid := security.VerifyCredential(&privateKey.PublicKey, credential)
+	userID2, peerID2, ip2, ip6, valid := security.VerifyCredential(keys, credential)
 	a.False(valid)
 	a.Zero(peerID2)
 	a.Zero(userID2)
 	a.Nil(ip2)
+	a.Nil(ip6)
 }
 
 func TestCredentialCompromised(t *testing.T) {
 	a := assert.New(t)
 
-	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
 	a.Nil(err)
+	key := security.NewSigningKey(privateKey)
+	keys := security.NewKeyRing(privateKey).VerifyingKeys()
 
 	var (
 		userID = protocol.UserID(88888)
@@ -107,16 +151,58 @@ func TestCredentialCompromised(t *testing.T) {
 		ip     = net.IP{0x01, 0x02, 0x03, 0x04}
 	)
 
-	credential, err := security.Credential(privateKey, userID, peerID, ip, time.Second*10)
+	credential, err := security.Credential(key, userID, peerID, ip, nil, time.Second*10)
 	a.Nil(err)
 	a.NotNil(credential)
 
-	// Compromise and change one bit in credential
-	credential[0] = credential[0] + 1
+	// Compromise and change one bit of the user id in credential
+	credential[12] = credential[12] + 1
 
-	userID2, peerID2, ip2, valid := security.VerifyCredential(&privateKey.PublicKey, credential)
+	userID2, peerID2, ip2, ip6, valid := security.VerifyCredential(keys, credential)
 	a.False(valid)
 	a.Zero(peerID2)
 	a.Zero(userID2)
 	a.Nil(ip2)
+	a.Nil(ip6)
+}
+
+func TestCredentialKeyRotation(t *testing.T) {
+	a := assert.New(t)
+
+	_, oldKey, err := ed25519.GenerateKey(rand.Reader)
+	a.Nil(err)
+	_, newKey, err := ed25519.GenerateKey(rand.Reader)
+	a.Nil(err)
+
+	var (
+		userID = protocol.UserID(88888)
+		peerID = protocol.PeerID(12345678)
+		ip     = net.IP{0x01, 0x02, 0x03, 0x04}
+	)
+
+	oldCredential, err := security.Credential(security.NewSigningKey(oldKey), userID, peerID, ip, nil, time.Second*10)
+	a.Nil(err)
+
+	// The credential signed by the retiring key is still valid.
+	ring := security.NewKeyRing(newKey, oldKey.Public().(ed25519.PublicKey))
+	a.Len(ring.VerifyingKeys(), 2)
+	_, peerID2, _, _, valid := security.VerifyCredential(ring.VerifyingKeys(), oldCredential)
+	a.True(valid)
+	a.Equal(peerID, peerID2)
+
+	newCredential, err := security.Credential(ring.Active(), userID, peerID, ip, nil, time.Second*10)
+	a.Nil(err)
+	_, _, _, _, valid = security.VerifyCredential(ring.VerifyingKeys(), newCredential)
+	a.True(valid)
+
+	// The credential is rejected after the key is removed from the ring.
+	ring = security.NewKeyRing(newKey)
+	_, _, _, _, valid = security.VerifyCredential(ring.VerifyingKeys(), oldCredential)
+	a.False(valid)
+	_, _, _, _, valid = security.VerifyCredential(ring.VerifyingKeys(), newCredential)
+	a.True(valid)
+
+	id, err := security.ParseKeyID(ring.Active().ID.String())
+	a.Nil(err)
+	a.Equal(ring.Active().ID, id)
 }
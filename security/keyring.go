@@ -0,0 +1,103 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+)
+
+type (
+	// KeyID identifies the key which signs the credential, and it is derived
+	// from the public key.
+	KeyID uint32
+
+	// SigningKey is an Ed25519 private key to sign the credentials.
+	SigningKey struct {
+		ID      KeyID
+		Private ed25519.PrivateKey
+	}
+
+	// VerifyingKeys is the set of Ed25519 public keys to verify the credentials.
+	VerifyingKeys map[KeyID]ed25519.PublicKey
+
+	// KeyRing holds the active key to sign the new credentials and the retiring
+	// keys, which only verify the credentials signed before the rotation until
+	// they are expired.
+	KeyRing struct {
+		active   *SigningKey
+		verifies VerifyingKeys
+	}
+)
+
+// NewKeyID returns the key ID of the public key.
+func NewKeyID(publicKey ed25519.PublicKey) KeyID {
+	sum := sha256.Sum256(publicKey)
+	return KeyID(binary.BigEndian.Uint32(sum[:4]))
+}
+
+// ParseKeyID parses the hex representation of the key ID.
+func ParseKeyID(s string) (KeyID, error) {
+	id, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid key id %q: %w", s, err)
+	}
+	return KeyID(id), nil
+}
+
+// String implements the fmt.Stringer interface
+func (id KeyID) String() string {
+	return fmt.Sprintf("%08x", uint32(id))
+}
+
+// NewSigningKey returns the signing key of the private key.
+func NewSigningKey(privateKey ed25519.PrivateKey) *SigningKey {
+	return &SigningKey{
+		ID:      NewKeyID(privateKey.Public().(ed25519.PublicKey)),
+		Private: privateKey,
+	}
+}
+
+// Public returns the public key of the signing key.
+func (k *SigningKey) Public() ed25519.PublicKey {
+	return k.Private.Public().(ed25519.PublicKey)
+}
+
+// NewKeyRing returns a key ring with the active private key and the public keys
+// of the retiring keys.
+func NewKeyRing(active ed25519.PrivateKey, retiring ...ed25519.PublicKey) *KeyRing {
+	r := &KeyRing{
+		active:   NewSigningKey(active),
+		verifies: VerifyingKeys{},
+	}
+	r.verifies[r.active.ID] = r.active.Public()
+	for _, key := range retiring {
+		r.verifies[NewKeyID(key)] = key
+	}
+	return r
+}
+
+// Active returns the key to sign the new credentials.
+func (r *KeyRing) Active() *SigningKey {
+	return r.active
+}
+
+// VerifyingKeys returns the public keys of the active and retiring keys.
+func (r *KeyRing) VerifyingKeys() VerifyingKeys {
+	return r.verifies
+}
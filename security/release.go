@@ -0,0 +1,104 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// releaseSignaturePrefix separates the release signatures from the other
+// signatures made by the same key.
+const releaseSignaturePrefix = "pairmesh-release:v2\n"
+
+// Release is the record of a release binary signed by the release key. The
+// signature covers the version and the platform besides the digest, so a signed
+// binary can't be offered as another version or to another platform. The empty
+// arch means the binary runs on all architectures of the OS.
+type Release struct {
+	Version string
+	OS      string
+	Arch    string
+	Channel string
+	SHA256  []byte
+}
+
+// message returns the canonical representation of the release signed by the
+// release key.
+func (r *Release) message() ([]byte, error) {
+	fields := []string{r.Version, r.OS, r.Arch, r.Channel}
+	for _, f := range fields {
+		if strings.ContainsAny(f, "\n\x00") {
+			return nil, fmt.Errorf("invalid release field %q", f)
+		}
+	}
+	if r.Version == "" || r.OS == "" || len(r.SHA256) != sha256.Size {
+		return nil, fmt.Errorf("incomplete release %s %s/%s", r.Version, r.OS, r.Arch)
+	}
+	var b strings.Builder
+	b.WriteString(releaseSignaturePrefix)
+	for i, name := range []string{"version", "os", "arch", "channel"} {
+		b.WriteString(name + "=" + fields[i] + "\n")
+	}
+	b.WriteString("sha256=" + hex.EncodeToString(r.SHA256) + "\n")
+	return []byte(b.String()), nil
+}
+
+// ReleaseDigest returns the SHA-256 digest of the release.
+func ReleaseDigest(r io.Reader) ([]byte, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// SignRelease signs the release record, and returns the signature in BASE64
+// representation.
+func SignRelease(key ed25519.PrivateKey, r *Release) (string, error) {
+	msg, err := r.message()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(key, msg)), nil
+}
+
+// VerifyRelease verifies the hex SHA-256 digest and the BASE64 signature of the
+// release record, whose digest is the one of the downloaded release.
+func VerifyRelease(key ed25519.PublicKey, r *Release, sha256Hex, signature string) error {
+	if len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid release key")
+	}
+	if sha256Hex != "" && sha256Hex != hex.EncodeToString(r.SHA256) {
+		return fmt.Errorf("mismatch release digest %s", sha256Hex)
+	}
+	msg, err := r.message()
+	if err != nil {
+		return err
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid release signature: %w", err)
+	}
+	if !ed25519.Verify(key, msg, sig) {
+		return fmt.Errorf("invalid release signature")
+	}
+	return nil
+}
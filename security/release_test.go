@@ -0,0 +1,73 @@
+// Copyright 2021 PairMesh, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/pairmesh/pairmesh/security"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelease(t *testing.T) {
+	a := assert.New(t)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	a.Nil(err)
+
+	digest, err := security.ReleaseDigest(bytes.NewReader([]byte("pairmesh-0.2.0")))
+	a.Nil(err)
+	release := security.Release{Version: "0.2.0", OS: "linux", Arch: "amd64", Channel: "stable", SHA256: digest}
+	sig, err := security.SignRelease(privateKey, &release)
+	a.Nil(err)
+	a.Nil(security.VerifyRelease(publicKey, &release, hex.EncodeToString(digest), sig))
+	a.Nil(security.VerifyRelease(publicKey, &release, "", sig))
+
+	// Tampered release
+	tampered, err := security.ReleaseDigest(bytes.NewReader([]byte("pairmesh-0.2.1")))
+	a.Nil(err)
+	r := release
+	r.SHA256 = tampered
+	a.NotNil(security.VerifyRelease(publicKey, &r, "", sig))
+	a.NotNil(security.VerifyRelease(publicKey, &release, hex.EncodeToString(tampered), sig))
+
+	// The same binary offered as another version, platform or channel
+	for _, modify := range []func(r *security.Release){
+		func(r *security.Release) { r.Version = "0.3.0" },
+		func(r *security.Release) { r.OS = "windows" },
+		func(r *security.Release) { r.Arch = "arm64" },
+		func(r *security.Release) { r.Arch = "" },
+		func(r *security.Release) { r.Channel = "beta" },
+		func(r *security.Release) { r.Version, r.OS = "0.2.0\nos=linux", "" },
+	} {
+		r := release
+		modify(&r)
+		a.NotNil(security.VerifyRelease(publicKey, &r, "", sig))
+	}
+
+	// Incomplete release
+	_, err = security.SignRelease(privateKey, &security.Release{OS: "linux", SHA256: digest})
+	a.NotNil(err)
+
+	// Signed by another key
+	otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+	a.Nil(err)
+	a.NotNil(security.VerifyRelease(otherKey, &release, "", sig))
+	a.NotNil(security.VerifyRelease(nil, &release, "", sig))
+}
@@ -0,0 +1,6 @@
+package p
+				import "gorm.io/gorm"
+				type T struct {
+					gorm.Model
+					F int
+				}
\ No newline at end of file
@@ -147,8 +147,8 @@ func NewBinaryFilterMethod(ctx QsFieldContext) BinaryFilterMethod {
 		onFieldMethod:         ctx.onFieldMethod(),
 		oneArgMethod:          newOneArgMethod(argName, ctx.fieldTypeName()),
 		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
-		qsCallGormMethod: newQsCallGormMethod("Where", "\"`%s` %s\", %s",
-			ctx.fieldDBName(), getWhereCondition(ctx.operationName), argName),
+		qsCallGormMethod: newQsCallGormMethod("Where", "%s",
+			getWhereCondition(ctx.operationName, ctx.fieldDBName(), argName)),
 	}
 }
 
@@ -179,8 +179,8 @@ func newInFilterMethodImpl(ctx QsFieldContext, operationName, sql string) InFilt
 		onFieldMethod:         ctx.onFieldMethod(),
 		nArgsMethod:           args,
 		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
-		qsCallGormMethod: newQsCallGormMethod("Where", "\"%s %s (?)\", %s",
-			ctx.fieldDBName(), sql, argName),
+		qsCallGormMethod: newQsCallGormMethod("Where", "\"? %s (?)\", clause.Column{Name: \"%s\"}, %s",
+			sql, ctx.fieldDBName(), argName),
 	}
 }
 
@@ -194,23 +194,29 @@ func NewNotInFilterMethod(ctx QsFieldContext) InFilterMethod {
 	return newInFilterMethodImpl(ctx, "NotIn", "NOT IN")
 }
 
-func getWhereCondition(name string) string {
-	nameToOp := map[string]string{
-		"eq":      "=",
-		"ne":      "!=",
-		"lt":      "<",
-		"lte":     "<=",
-		"gt":      ">",
-		"gte":     ">=",
-		"like":    "LIKE",
-		"notlike": "NOT LIKE",
+// getWhereCondition returns the clause expression of the filter, which quotes
+// the column name by the dialect of the database.
+func getWhereCondition(name, column, argName string) string {
+	nameToClause := map[string]string{
+		"eq":      "Eq",
+		"ne":      "Neq",
+		"lt":      "Lt",
+		"lte":     "Lte",
+		"gt":      "Gt",
+		"gte":     "Gte",
+		"like":    "Like",
+		"notlike": "Like",
 	}
-	op := nameToOp[name]
-	if op == "" {
+	c := nameToClause[name]
+	if c == "" {
 		log.Fatalf("no operation for filter %q", name)
 	}
 
-	return fmt.Sprintf("%s ?", op)
+	cond := fmt.Sprintf(`clause.%s{Column: "%s", Value: %s}`, c, column, argName)
+	if name == "notlike" {
+		cond = fmt.Sprintf("clause.Not(%s)", cond)
+	}
+	return cond
 }
 
 // UnaryFilterMethod represents unary filter
@@ -221,11 +227,11 @@ type UnaryFilterMethod struct {
 	qsCallGormMethod
 }
 
-func newUnaryFilterMethod(ctx QsFieldContext, op string) UnaryFilterMethod {
+func newUnaryFilterMethod(ctx QsFieldContext, c string) UnaryFilterMethod {
 	r := UnaryFilterMethod{
 		onFieldMethod: ctx.onFieldMethod(),
-		qsCallGormMethod: newQsCallGormMethod("Where", `"%s %s"`,
-			ctx.fieldDBName(), op),
+		qsCallGormMethod: newQsCallGormMethod("Where", `clause.%s{Column: "%s", Value: nil}`,
+			c, ctx.fieldDBName()),
 		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
 	}
 	return r
@@ -372,7 +378,7 @@ func NewPreloadMethod(ctx QsFieldContext) FieldOperationNoArgsMethod {
 func NewOrderAscByMethod(ctx QsFieldContext) FieldOperationNoArgsMethod {
 	r := newFieldOperationNoArgsMethod(ctx.WithOperationName("OrderAscBy"), true)
 	r.setGormMethodName("Order")
-	r.setGormMethodArgs(fmt.Sprintf(`"%s ASC"`, ctx.fieldDBName()))
+	r.setGormMethodArgs(fmt.Sprintf(`clause.OrderByColumn{Column: clause.Column{Name: "%s"}}`, ctx.fieldDBName()))
 	return r
 }
 
@@ -380,7 +386,7 @@ func NewOrderAscByMethod(ctx QsFieldContext) FieldOperationNoArgsMethod {
 func NewOrderDescByMethod(ctx QsFieldContext) FieldOperationNoArgsMethod {
 	r := newFieldOperationNoArgsMethod(ctx.WithOperationName("OrderDescBy"), true)
 	r.setGormMethodName("Order")
-	r.setGormMethodArgs(fmt.Sprintf(`"%s DESC"`, ctx.fieldDBName()))
+	r.setGormMethodArgs(fmt.Sprintf(`clause.OrderByColumn{Column: clause.Column{Name: "%s"}, Desc: true}`, ctx.fieldDBName()))
 	return r
 }
 
@@ -410,10 +416,10 @@ func NewOneMethod(structName, qsTypeName string) SelectMethod {
 
 // NewIsNullMethod create IsNull method
 func NewIsNullMethod(ctx QsFieldContext) UnaryFilterMethod {
-	return newUnaryFilterMethod(ctx.WithOperationName("IsNull"), "IS NULL")
+	return newUnaryFilterMethod(ctx.WithOperationName("IsNull"), "Eq")
 }
 
 // NewIsNotNullMethod create IsNotNull method
 func NewIsNotNullMethod(ctx QsFieldContext) UnaryFilterMethod {
-	return newUnaryFilterMethod(ctx.WithOperationName("IsNotNull"), "IS NOT NULL")
+	return newUnaryFilterMethod(ctx.WithOperationName("IsNotNull"), "Neq")
 }
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/pairmesh/pairmesh/security"
+)
+
+// signrelease signs the release binaries with the release key, and prints the
+// sha256 and signature fields of the release manifest. The signature covers the
+// version, os, arch and channel, which must match the manifest entry.
+//
+//	go run ./tools/signrelease -generate -k release.pem
+//	go run ./tools/signrelease -k release.pem -version 0.2.0 -os linux -arch amd64 bin/pairmesh
+func main() {
+	keyPath := flag.String("k", "release.pem", "path to the Ed25519 private key (PKCS #8 PEM)")
+	generate := flag.Bool("generate", false, "generate a new release key")
+	version := flag.String("version", "", "version of the release")
+	goos := flag.String("os", "", "os of the release")
+	arch := flag.String("arch", "", "arch of the release, empty for all architectures of the os")
+	channel := flag.String("channel", "stable", "channel of the release")
+	flag.Parse()
+
+	if *generate {
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			log.Fatalf("can't generate key: %s", err)
+		}
+		data, err := x509.MarshalPKCS8PrivateKey(priv)
+		if err != nil {
+			log.Fatalf("can't marshal key: %s", err)
+		}
+		block := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: data})
+		if err := ioutil.WriteFile(*keyPath, block, 0600); err != nil {
+			log.Fatalf("can't write key: %s", err)
+		}
+	}
+
+	data, err := ioutil.ReadFile(*keyPath)
+	if err != nil {
+		log.Fatalf("can't read key: %s", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		log.Fatalf("can't decode key %s", *keyPath)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		log.Fatalf("can't parse key: %s", err)
+	}
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		log.Fatalf("%s is not an Ed25519 private key", *keyPath)
+	}
+	fmt.Printf("# RELEASE_KEY=%s\n", base64.StdEncoding.EncodeToString(priv.Public().(ed25519.PublicKey)))
+
+	for _, path := range flag.Args() {
+		f, err := os.Open(path)
+		if err != nil {
+			log.Fatalf("can't open release: %s", err)
+		}
+		digest, err := security.ReleaseDigest(f)
+		_ = f.Close()
+		if err != nil {
+			log.Fatalf("can't read release: %s", err)
+		}
+		sig, err := security.SignRelease(priv, &security.Release{
+			Version: *version,
+			OS:      *goos,
+			Arch:    *arch,
+			Channel: *channel,
+			SHA256:  digest,
+		})
+		if err != nil {
+			log.Fatalf("can't sign release: %s", err)
+		}
+		fmt.Printf("# %s\nsha256: %s\nsignature: %s\n", path, hex.EncodeToString(digest), sig)
+	}
+}
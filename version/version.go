@@ -30,6 +30,9 @@ var (
 	GitHash = "Unknown"
 	// GitBranch is the current git branch name
 	GitBranch = "Unknown"
+	// ReleaseKey is the BASE64 Ed25519 public key to verify the signatures of
+	// the releases, and the updates are not installed automatically if empty.
+	ReleaseKey = ""
 )
 
 // Version is the semver of TiOps